@@ -76,6 +76,16 @@ type Node interface {
 	json.Marshaler
 }
 
+// Copy returns a deep copy of the node.
+// The copy shares no mutable references with the original,
+// so either may be modified without affecting the other.
+func Copy(node Node) Node {
+	if node == nil {
+		return nil
+	}
+	return node.Copy()
+}
+
 func (*Package) node()           {}
 func (*File) node()              {}
 func (*PackageClause) node()     {}
@@ -91,6 +101,8 @@ func (*TestStatement) node()       {}
 func (*VariableAssignment) node()  {}
 func (*MemberAssignment) node()    {}
 
+func (*DestructuringAssignment) node() {}
+
 func (*ArrayExpression) node()       {}
 func (*FunctionExpression) node()    {}
 func (*BinaryExpression) node()      {}
@@ -100,12 +112,17 @@ func (*LogicalExpression) node()     {}
 func (*MemberExpression) node()      {}
 func (*IndexExpression) node()       {}
 func (*PipeExpression) node()        {}
+func (*PipePlaceholder) node()       {}
 func (*ObjectExpression) node()      {}
+func (*StringExpression) node()      {}
 func (*UnaryExpression) node()       {}
 
 func (*Property) node()   {}
 func (*Identifier) node() {}
 
+func (*TextPart) node()         {}
+func (*InterpolatedPart) node() {}
+
 func (*BooleanLiteral) node()         {}
 func (*DateTimeLiteral) node()        {}
 func (*DurationLiteral) node()        {}
@@ -118,8 +135,9 @@ func (*UnsignedIntegerLiteral) node() {}
 
 // BaseNode holds the attributes every expression or statement should have
 type BaseNode struct {
-	Loc    *SourceLocation `json:"location,omitempty"`
-	Errors []Error         `json:"errors,omitempty"`
+	Loc      *SourceLocation `json:"location,omitempty"`
+	Errors   []Error         `json:"errors,omitempty"`
+	Comments []Comment       `json:"comments,omitempty"`
 }
 
 // Location is the source location of the Node
@@ -142,9 +160,25 @@ func (b BaseNode) Copy() BaseNode {
 		copy(cpy, b.Errors)
 		b.Errors = cpy
 	}
+	if len(b.Comments) > 0 {
+		cpy := make([]Comment, len(b.Comments))
+		for i, c := range b.Comments {
+			cpy[i] = Comment{Text: c.Text, Loc: c.Loc.Copy()}
+		}
+		b.Comments = cpy
+	}
 	return b
 }
 
+// Comment represents a line comment from the source that is attached to a node.
+// Comments that precede a node are attached to the outermost node that directly follows them.
+// A comment that follows a node on the same line is attached to the outermost node it follows.
+type Comment struct {
+	// Text is the text of the comment including the leading // and excluding the line ending.
+	Text string          `json:"text"`
+	Loc  *SourceLocation `json:"location,omitempty"`
+}
+
 // Error represents an error in the AST construction.
 // The node that this is attached to is not valid.
 type Error struct {
@@ -260,6 +294,8 @@ func (d *ImportDeclaration) Copy() Node {
 	*nd = *d
 	nd.BaseNode = d.BaseNode.Copy()
 
+	nd.As = d.As.Copy().(*Identifier)
+	nd.Path = d.Path.Copy().(*StringLiteral)
 	return nd
 }
 
@@ -304,6 +340,8 @@ func (*OptionStatement) stmt()     {}
 func (*BuiltinStatement) stmt()    {}
 func (*TestStatement) stmt()       {}
 
+func (*DestructuringAssignment) stmt() {}
+
 type Assignment interface {
 	Statement
 	assignment()
@@ -471,6 +509,8 @@ func (d *VariableAssignment) Copy() Node {
 	*nd = *d
 	nd.BaseNode = d.BaseNode.Copy()
 
+	nd.ID = d.ID.Copy().(*Identifier)
+
 	if d.Init != nil {
 		nd.Init = d.Init.Copy().(Expression)
 	}
@@ -504,6 +544,39 @@ func (a *MemberAssignment) Copy() Node {
 	return na
 }
 
+// DestructuringAssignment represents the declaration of several variables at once,
+// each bound to a part of the value of Init.
+// The Pattern is either an ArrayExpression of identifiers, which are bound to
+// the elements of an array in order, or an ObjectExpression whose properties
+// bind the identifier of their value, or of their key when there is no value,
+// to the property of an object with the same key.
+type DestructuringAssignment struct {
+	BaseNode
+	Pattern Expression `json:"pattern"`
+	Init    Expression `json:"init"`
+}
+
+// Type is the abstract type
+func (*DestructuringAssignment) Type() string { return "DestructuringAssignment" }
+
+func (a *DestructuringAssignment) Copy() Node {
+	if a == nil {
+		return a
+	}
+	na := new(DestructuringAssignment)
+	*na = *a
+	na.BaseNode = a.BaseNode.Copy()
+
+	if a.Pattern != nil {
+		na.Pattern = a.Pattern.Copy().(Expression)
+	}
+	if a.Init != nil {
+		na.Init = a.Init.Copy().(Expression)
+	}
+
+	return na
+}
+
 // Expression represents an action that can be performed by InfluxDB that can be evaluated to a value.
 type Expression interface {
 	Node
@@ -527,7 +600,9 @@ func (*IndexExpression) expression()        {}
 func (*ObjectExpression) expression()       {}
 func (*PipeExpression) expression()         {}
 func (*PipeLiteral) expression()            {}
+func (*PipePlaceholder) expression()        {}
 func (*RegexpLiteral) expression()          {}
+func (*StringExpression) expression()       {}
 func (*StringLiteral) expression()          {}
 func (*UnaryExpression) expression()        {}
 func (*UnsignedIntegerLiteral) expression() {}
@@ -678,7 +753,8 @@ func (e *FunctionExpression) Copy() Node {
 // Result of evaluating an equality operator is always of type Boolean based on whether the
 // comparison is true
 // Arithmetic operators take numerical values (either literals or variables) as their operands
-//  and return a single numerical value.
+//
+//	and return a single numerical value.
 type OperatorKind int
 
 const (
@@ -877,8 +953,10 @@ func (e *ArrayExpression) Copy() Node {
 }
 
 // ObjectExpression allows the declaration of an anonymous object within a declaration.
+// When With is set, the object extends the object referenced by With with the properties.
 type ObjectExpression struct {
 	BaseNode
+	With       *Identifier `json:"with,omitempty"`
 	Properties []*Property `json:"properties"`
 }
 
@@ -893,6 +971,10 @@ func (e *ObjectExpression) Copy() Node {
 	*ne = *e
 	ne.BaseNode = e.BaseNode.Copy()
 
+	if e.With != nil {
+		ne.With = e.With.Copy().(*Identifier)
+	}
+
 	if len(e.Properties) > 0 {
 		ne.Properties = make([]*Property, len(e.Properties))
 		for i, p := range e.Properties {
@@ -957,6 +1039,9 @@ func (p *Property) Copy() Node {
 	*np = *p
 	np.BaseNode = p.BaseNode.Copy()
 
+	if p.Key != nil {
+		np.Key = p.Key.Copy().(PropertyKey)
+	}
 	if p.Value != nil {
 		np.Value = p.Value.Copy().(Expression)
 	}
@@ -1028,6 +1113,106 @@ func (i *PipeLiteral) Copy() Node {
 	return ni
 }
 
+// PipePlaceholder represents the value piped into the nearest pipe expression whose call contains it.
+// It is written as an underscore, e.g. `a |> f(x: _)`.
+type PipePlaceholder struct {
+	BaseNode
+}
+
+// Type is the abstract type
+func (*PipePlaceholder) Type() string { return "PipePlaceholder" }
+
+func (i *PipePlaceholder) Copy() Node {
+	if i == nil {
+		return i
+	}
+	ni := new(PipePlaceholder)
+	*ni = *i
+	ni.BaseNode = i.BaseNode.Copy()
+	return ni
+}
+
+// StringExpression represents an interpolated string, e.g. `"a ${b} c"`.
+// The string is the concatenation of its parts in order.
+type StringExpression struct {
+	BaseNode
+	Parts []StringExpressionPart `json:"parts"`
+}
+
+// Type is the abstract type
+func (*StringExpression) Type() string { return "StringExpression" }
+
+func (e *StringExpression) Copy() Node {
+	if e == nil {
+		return e
+	}
+	ne := new(StringExpression)
+	*ne = *e
+	ne.BaseNode = e.BaseNode.Copy()
+
+	if len(e.Parts) > 0 {
+		ne.Parts = make([]StringExpressionPart, len(e.Parts))
+		for i, p := range e.Parts {
+			ne.Parts[i] = p.Copy().(StringExpressionPart)
+		}
+	}
+	return ne
+}
+
+// StringExpressionPart is a part of a StringExpression.
+// It is either a TextPart or an InterpolatedPart.
+type StringExpressionPart interface {
+	Node
+	stringPart()
+}
+
+func (*TextPart) stringPart()         {}
+func (*InterpolatedPart) stringPart() {}
+
+// TextPart is the text between interpolations of a StringExpression.
+type TextPart struct {
+	BaseNode
+	// Value is the unescaped value of the text
+	Value string `json:"value"`
+}
+
+// Type is the abstract type
+func (*TextPart) Type() string { return "TextPart" }
+
+func (p *TextPart) Copy() Node {
+	if p == nil {
+		return p
+	}
+	np := new(TextPart)
+	*np = *p
+	np.BaseNode = p.BaseNode.Copy()
+	return np
+}
+
+// InterpolatedPart is an expression within a StringExpression
+// surrounded by `${` and `}`.
+type InterpolatedPart struct {
+	BaseNode
+	Expression Expression `json:"expression"`
+}
+
+// Type is the abstract type
+func (*InterpolatedPart) Type() string { return "InterpolatedPart" }
+
+func (p *InterpolatedPart) Copy() Node {
+	if p == nil {
+		return p
+	}
+	np := new(InterpolatedPart)
+	*np = *p
+	np.BaseNode = p.BaseNode.Copy()
+
+	if p.Expression != nil {
+		np.Expression = p.Expression.Copy().(Expression)
+	}
+	return np
+}
+
 // StringLiteral expressions begin and end with double quote marks.
 type StringLiteral struct {
 	BaseNode
@@ -1145,9 +1330,7 @@ func (l *RegexpLiteral) Copy() Node {
 	*nl = *l
 	nl.BaseNode = l.BaseNode.Copy()
 
-	if l.Value != nil {
-		nl.Value = l.Value
-	}
+	// A compiled regular expression is immutable so it is safe to share.
 	return nl
 }
 
@@ -1158,6 +1341,11 @@ type Duration struct {
 	Unit      string `json:"unit"`
 }
 
+// MonthDuration is the length of an average month in the Gregorian calendar.
+// Month and year durations are approximated as whole multiples of it,
+// so functions that work with calendar months can recover the number of months.
+const MonthDuration = 2629746 * time.Second
+
 // toDuration returns a time.Duration corresponding to Duration.  It is an approximation, as months, etc
 // can't be properly figured out without knowing the time from when.
 // This may have to be modified to also accept a time.Time to make this exact.
@@ -1171,13 +1359,9 @@ func toDuration(l Duration) (time.Duration, error) {
 	switch unit {
 	case "y":
 		mag *= 12
-		unit = "mo"
 		fallthrough
 	case "mo":
-		const weeksPerMonth = 365.25 / 12 / 7
-		mag = int64(float64(mag) * weeksPerMonth)
-		unit = "w"
-		fallthrough
+		dur = time.Duration(mag) * MonthDuration
 	case "w":
 		mag *= 7
 		unit = "d"
@@ -18,6 +18,7 @@ var IgnoreBaseNodeOptions = []cmp.Option{
 	cmpopts.IgnoreFields(ast.CallExpression{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.ConditionalExpression{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.DateTimeLiteral{}, "BaseNode"),
+	cmpopts.IgnoreFields(ast.DestructuringAssignment{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.DurationLiteral{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.ExpressionStatement{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.File{}, "BaseNode"),
@@ -27,6 +28,7 @@ var IgnoreBaseNodeOptions = []cmp.Option{
 	cmpopts.IgnoreFields(ast.ImportDeclaration{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.IndexExpression{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.IntegerLiteral{}, "BaseNode"),
+	cmpopts.IgnoreFields(ast.InterpolatedPart{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.LogicalExpression{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.MemberAssignment{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.MemberExpression{}, "BaseNode"),
@@ -36,11 +38,14 @@ var IgnoreBaseNodeOptions = []cmp.Option{
 	cmpopts.IgnoreFields(ast.PackageClause{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.PipeExpression{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.PipeLiteral{}, "BaseNode"),
+	cmpopts.IgnoreFields(ast.PipePlaceholder{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.Property{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.RegexpLiteral{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.ReturnStatement{}, "BaseNode"),
+	cmpopts.IgnoreFields(ast.StringExpression{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.StringLiteral{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.TestStatement{}, "BaseNode"),
+	cmpopts.IgnoreFields(ast.TextPart{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.UnaryExpression{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.UnsignedIntegerLiteral{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.VariableAssignment{}, "BaseNode"),
@@ -2,6 +2,7 @@ package ast_test
 
 import (
 	"fmt"
+	"reflect"
 	"regexp"
 	"testing"
 	"time"
@@ -9,6 +10,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/flux/ast"
 	"github.com/influxdata/flux/ast/asttest"
+	"github.com/influxdata/flux/parser"
 )
 
 func TestCopy(t *testing.T) {
@@ -380,3 +382,76 @@ func TestCopy(t *testing.T) {
 		})
 	}
 }
+
+func TestCopy_Independent(t *testing.T) {
+	src := `package foo
+import bar "path/bar"
+option now = () => 2018-05-22T19:53:00Z
+a = [1, 2.5, "s", /re/, 1h][0]
+b = {x: a, "y": -a}.x
+f = (r, t=<-) => {
+	v = r |> bar.baz(a: 1)
+	return if v > 1 and t then v else 0
+}
+f(r: 1)
+)
+`
+	original := parser.ParseSource(src)
+	ast.Check(original)
+	cpy := ast.Copy(original)
+
+	if !cmp.Equal(original, cpy, asttest.CmpOptions...) {
+		t.Fatalf("copy not equal -want/+got:\n%s", cmp.Diff(original, cpy, asttest.CmpOptions...))
+	}
+
+	// Collect every pointer reachable from the original.
+	pointers := make(map[interface{}]bool)
+	ast.Walk(ast.CreateVisitor(func(n ast.Node) {
+		pointers[n] = true
+		if loc := reflect.ValueOf(n).Elem().FieldByName("Loc").Interface().(*ast.SourceLocation); loc != nil {
+			pointers[loc] = true
+		}
+	}), original)
+
+	// Mutate every node within the copy.
+	ast.Walk(ast.CreateVisitor(func(n ast.Node) {
+		if pointers[n] {
+			t.Errorf("copy shares node %T with the original", n)
+		}
+		base := reflect.ValueOf(n).Elem().FieldByName("BaseNode").Addr().Interface().(*ast.BaseNode)
+		if base.Loc != nil {
+			if pointers[base.Loc] {
+				t.Errorf("copy shares location of node %T with the original", n)
+			}
+			base.Loc.Start.Line = 100
+			base.Loc.Source = "modified"
+		}
+		if len(base.Errors) > 0 {
+			base.Errors[0].Msg = "modified"
+		}
+		base.Errors = append(base.Errors, ast.Error{Msg: "new"})
+		base.Comments = append(base.Comments, ast.Comment{Text: "// new"})
+
+		switch n := n.(type) {
+		case *ast.File:
+			n.Body[0] = &ast.BadStatement{Text: "replaced"}
+			n.Body = append(n.Body, &ast.BadStatement{Text: "appended"})
+		case *ast.Identifier:
+			n.Name = "modified"
+		case *ast.StringLiteral:
+			n.Value = "modified"
+		case *ast.DurationLiteral:
+			n.Values[0].Magnitude = 100
+		case *ast.ObjectExpression:
+			n.Properties[0] = nil
+		case *ast.BinaryExpression:
+			n.Operator = ast.MultiplicationOperator
+		}
+	}), cpy)
+
+	want := parser.ParseSource(src)
+	ast.Check(want)
+	if !cmp.Equal(want, original, asttest.CmpOptions...) {
+		t.Errorf("original modified by changes to the copy -want/+got:\n%s", cmp.Diff(want, original, asttest.CmpOptions...))
+	}
+}
@@ -372,6 +372,18 @@ func match(pattern, node ast.Node, ms sliceMatchingStrategy) bool {
 			return false
 		}
 		return matchMemberAssignment(p, n, ms)
+	case *ast.DestructuringAssignment:
+		n, ok := node.(*ast.DestructuringAssignment)
+		if !ok {
+			return false
+		}
+		if p == nil {
+			return true
+		}
+		if n == nil {
+			return false
+		}
+		return matchDestructuringAssignment(p, n, ms)
 	case *ast.CallExpression:
 		n, ok := node.(*ast.CallExpression)
 		if !ok {
@@ -516,6 +528,54 @@ func match(pattern, node ast.Node, ms sliceMatchingStrategy) bool {
 			return false
 		}
 		return matchPipeLiteral(p, n, ms)
+	case *ast.PipePlaceholder:
+		n, ok := node.(*ast.PipePlaceholder)
+		if !ok {
+			return false
+		}
+		if p == nil {
+			return true
+		}
+		if n == nil {
+			return false
+		}
+		return matchPipePlaceholder(p, n, ms)
+	case *ast.StringExpression:
+		n, ok := node.(*ast.StringExpression)
+		if !ok {
+			return false
+		}
+		if p == nil {
+			return true
+		}
+		if n == nil {
+			return false
+		}
+		return matchStringExpression(p, n, ms)
+	case *ast.TextPart:
+		n, ok := node.(*ast.TextPart)
+		if !ok {
+			return false
+		}
+		if p == nil {
+			return true
+		}
+		if n == nil {
+			return false
+		}
+		return matchTextPart(p, n, ms)
+	case *ast.InterpolatedPart:
+		n, ok := node.(*ast.InterpolatedPart)
+		if !ok {
+			return false
+		}
+		if p == nil {
+			return true
+		}
+		if n == nil {
+			return false
+		}
+		return matchInterpolatedPart(p, n, ms)
 	case *ast.StringLiteral:
 		n, ok := node.(*ast.StringLiteral)
 		if !ok {
@@ -720,6 +780,10 @@ func matchMemberAssignment(p *ast.MemberAssignment, n *ast.MemberAssignment, ms
 	return match(p.Member, n.Member, ms) && match(p.Init, n.Init, ms)
 }
 
+func matchDestructuringAssignment(p *ast.DestructuringAssignment, n *ast.DestructuringAssignment, ms sliceMatchingStrategy) bool {
+	return match(p.Pattern, n.Pattern, ms) && match(p.Init, n.Init, ms)
+}
+
 func matchCallExpression(p *ast.CallExpression, n *ast.CallExpression, ms sliceMatchingStrategy) bool {
 	if !match(p.Callee, n.Callee, ms) {
 		return false
@@ -752,7 +816,7 @@ func matchLogicalExpression(p *ast.LogicalExpression, n *ast.LogicalExpression,
 }
 
 func matchObjectExpression(p *ast.ObjectExpression, n *ast.ObjectExpression, ms sliceMatchingStrategy) bool {
-	return ms.matchProperties(p.Properties, n.Properties)
+	return match(p.With, n.With, ms) && ms.matchProperties(p.Properties, n.Properties)
 }
 
 func matchConditionalExpression(p *ast.ConditionalExpression, n *ast.ConditionalExpression, ms sliceMatchingStrategy) bool {
@@ -771,6 +835,31 @@ func matchPipeLiteral(p *ast.PipeLiteral, n *ast.PipeLiteral, ms sliceMatchingSt
 	return true
 }
 
+func matchPipePlaceholder(p *ast.PipePlaceholder, n *ast.PipePlaceholder, ms sliceMatchingStrategy) bool {
+	return true
+}
+
+// The parts of a string expression are ordered, so they always match by position.
+func matchStringExpression(p *ast.StringExpression, n *ast.StringExpression, ms sliceMatchingStrategy) bool {
+	if len(p.Parts) != len(n.Parts) {
+		return false
+	}
+	for i := range p.Parts {
+		if !match(p.Parts[i], n.Parts[i], ms) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchTextPart(p *ast.TextPart, n *ast.TextPart, ms sliceMatchingStrategy) bool {
+	return p.Value == n.Value
+}
+
+func matchInterpolatedPart(p *ast.InterpolatedPart, n *ast.InterpolatedPart, ms sliceMatchingStrategy) bool {
+	return match(p.Expression, n.Expression, ms)
+}
+
 // If one has specified a literal, the value must match as it is.
 // In order to ignore a literal, don't specify it.
 func matchStringLiteral(p *ast.StringLiteral, n *ast.StringLiteral, ms sliceMatchingStrategy) bool {
@@ -0,0 +1,58 @@
+package edit
+
+import (
+	"fmt"
+	"unicode"
+
+	"github.com/influxdata/flux/ast"
+)
+
+// RenamePackage sets the name of the package declared by `node`, which must be an `*ast.Package` or an `*ast.File`.
+// The package clause of each file is renamed in place, so the rest of the file keeps its positions.
+// A file without a package clause is given a new clause, which has no location.
+// `RenamePackage` returns the previous name of the package, which is empty
+// if the file had no package clause.
+func RenamePackage(node ast.Node, name string) (string, error) {
+	if !isIdentifier(name) {
+		return "", fmt.Errorf("invalid package name %q", name)
+	}
+	switch n := node.(type) {
+	case *ast.Package:
+		old := n.Package
+		n.Package = name
+		for _, file := range n.Files {
+			renamePackageClause(file, name)
+		}
+		return old, nil
+	case *ast.File:
+		return renamePackageClause(n, name), nil
+	default:
+		return "", fmt.Errorf("cannot rename the package of a %s", node.Type())
+	}
+}
+
+func renamePackageClause(file *ast.File, name string) string {
+	if file.Package == nil {
+		file.Package = &ast.PackageClause{}
+	}
+	if file.Package.Name == nil {
+		file.Package.Name = &ast.Identifier{Name: name}
+		return ""
+	}
+	old := file.Package.Name.Name
+	file.Package.Name.Name = name
+	return old
+}
+
+// isIdentifier reports whether s is a valid Flux identifier.
+func isIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		if r != '_' && !unicode.IsLetter(r) && (i == 0 || !unicode.IsDigit(r)) {
+			return false
+		}
+	}
+	return true
+}
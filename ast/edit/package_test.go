@@ -0,0 +1,119 @@
+package edit_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/ast/edit"
+	"github.com/influxdata/flux/parser"
+)
+
+func TestRenamePackage(t *testing.T) {
+	testCases := []struct {
+		name    string
+		in      string
+		rename  string
+		edited  string
+		old     string
+		wantErr bool
+	}{
+		{
+			name:   "rename",
+			in:     "package foo\n\nimport \"strings\"\n\nx = strings.toUpper(v: \"a\")",
+			rename: "bar",
+			edited: "package bar\n\n\nimport \"strings\"\n\nx = strings.toUpper(v: \"a\")",
+			old:    "foo",
+		},
+		{
+			name:   "no package clause",
+			in:     "x = 1\ny = x + 1",
+			rename: "bar",
+			edited: "package bar\n\n\nx = 1\ny = x + 1",
+		},
+		{
+			name:    "invalid name",
+			in:      "package foo",
+			rename:  "foo.bar",
+			wantErr: true,
+		},
+		{
+			name:    "empty name",
+			in:      "package foo",
+			rename:  "",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pkg := parser.ParseSource(tc.in)
+			if ast.Check(pkg) > 0 {
+				t.Fatal(ast.GetError(pkg))
+			}
+			file := pkg.Files[0]
+			before := file.Copy().(*ast.File)
+
+			old, err := edit.RenamePackage(file, tc.rename)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				if !cmp.Equal(before, file) {
+					t.Errorf("file changed on error -want/+got:\n%s", cmp.Diff(before, file))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if old != tc.old {
+				t.Errorf("unexpected previous name: want %q, got %q", tc.old, old)
+			}
+			if got := ast.Format(file); got != tc.edited {
+				t.Errorf("unexpected formatted file -want/+got:\n%s", cmp.Diff(tc.edited, got))
+			}
+			// Only the package clause may change.
+			if !cmp.Equal(before.Imports, file.Imports) {
+				t.Errorf("imports changed -want/+got:\n%s", cmp.Diff(before.Imports, file.Imports))
+			}
+			if !cmp.Equal(before.Body, file.Body) {
+				t.Errorf("body changed -want/+got:\n%s", cmp.Diff(before.Body, file.Body))
+			}
+		})
+	}
+}
+
+func TestRenamePackage_Package(t *testing.T) {
+	pkg := &ast.Package{
+		Package: "foo",
+		Files: []*ast.File{
+			parser.ParseSource("package foo\n\nx = 1").Files[0],
+			parser.ParseSource("y = 2").Files[0],
+		},
+	}
+	old, err := edit.RenamePackage(pkg, "bar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if old != "foo" {
+		t.Errorf("unexpected previous name: want %q, got %q", "foo", old)
+	}
+	if pkg.Package != "bar" {
+		t.Errorf("unexpected package name: want %q, got %q", "bar", pkg.Package)
+	}
+	for i, file := range pkg.Files {
+		if got := file.Package.Name.Name; got != "bar" {
+			t.Errorf("unexpected package clause in file %d: want %q, got %q", i, "bar", got)
+		}
+	}
+	if want, got := "package bar\nx = 1\n\ny = 2", ast.Format(pkg); got != want {
+		t.Errorf("unexpected formatted package -want/+got:\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestRenamePackage_UnsupportedNode(t *testing.T) {
+	if _, err := edit.RenamePackage(&ast.Identifier{Name: "x"}, "bar"); err == nil {
+		t.Fatal("expected error")
+	}
+}
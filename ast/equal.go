@@ -0,0 +1,85 @@
+package ast
+
+import (
+	"reflect"
+	"regexp"
+	"time"
+)
+
+var (
+	baseNodeType = reflect.TypeOf(BaseNode{})
+	regexpType   = reflect.TypeOf((*regexp.Regexp)(nil))
+	timeType     = reflect.TypeOf(time.Time{})
+)
+
+// Equal reports whether the two nodes are structurally equal.
+// The nodes are compared by their type, children and literal values.
+// The BaseNode of each node is ignored, so differences in
+// source locations, errors and comments do not affect the result.
+// A nil slice is considered equal to an empty slice.
+func Equal(a, b Node) bool {
+	return equalValue(reflect.ValueOf(a), reflect.ValueOf(b))
+}
+
+func equalValue(a, b reflect.Value) bool {
+	if !a.IsValid() || !b.IsValid() {
+		return a.IsValid() == b.IsValid()
+	}
+	if a.Type() != b.Type() {
+		return false
+	}
+	switch a.Type() {
+	case regexpType:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+		return a.Interface().(*regexp.Regexp).String() == b.Interface().(*regexp.Regexp).String()
+	case timeType:
+		return a.Interface().(time.Time).Equal(b.Interface().(time.Time))
+	}
+
+	switch a.Kind() {
+	case reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+		return equalValue(a.Elem(), b.Elem())
+	case reflect.Ptr:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+		return equalValue(a.Elem(), b.Elem())
+	case reflect.Slice:
+		if a.Len() != b.Len() {
+			return false
+		}
+		for i := 0; i < a.Len(); i++ {
+			if !equalValue(a.Index(i), b.Index(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if a.Type().Field(i).Type == baseNodeType {
+				continue
+			}
+			if !equalValue(a.Field(i), b.Field(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.String:
+		return a.String() == b.String()
+	case reflect.Bool:
+		return a.Bool() == b.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() == b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return a.Uint() == b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() == b.Float()
+	default:
+		panic("ast: unexpected value kind " + a.Kind().String())
+	}
+}
@@ -0,0 +1,98 @@
+package ast_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/parser"
+)
+
+func TestEqual(t *testing.T) {
+	testCases := []struct {
+		name string
+		a, b ast.Node
+		want bool
+	}{
+		{
+			name: "whitespace",
+			a:    parser.ParseSource("a = 1 + 2\nf = (r) => r.x > a"),
+			b:    parser.ParseSource("a=1+2\n\n\n// comment\nf = (r)   =>\n\tr.x>a\n"),
+			want: true,
+		},
+		{
+			name: "operator",
+			a:    parser.ParseSource("a = 1 + 2"),
+			b:    parser.ParseSource("a = 1 - 2"),
+			want: false,
+		},
+		{
+			name: "literal value",
+			a:    parser.ParseSource(`a = "x"`),
+			b:    parser.ParseSource(`a = "y"`),
+			want: false,
+		},
+		{
+			name: "node type",
+			a:    parser.ParseSource("a = 1"),
+			b:    parser.ParseSource("a = 1.0"),
+			want: false,
+		},
+		{
+			name: "missing statement",
+			a:    parser.ParseSource("a = 1\nb = 2"),
+			b:    parser.ParseSource("a = 1"),
+			want: false,
+		},
+		{
+			name: "datetime in different zones",
+			a:    parser.ParseSource("a = 2018-05-22T19:53:00Z"),
+			b:    parser.ParseSource("a = 2018-05-22T12:53:00-07:00"),
+			want: true,
+		},
+		{
+			name: "regexp",
+			a:    &ast.RegexpLiteral{Value: regexp.MustCompile(`a.*`)},
+			b:    &ast.RegexpLiteral{Value: regexp.MustCompile(`a.*`)},
+			want: true,
+		},
+		{
+			name: "different regexp",
+			a:    &ast.RegexpLiteral{Value: regexp.MustCompile(`a.*`)},
+			b:    &ast.RegexpLiteral{Value: regexp.MustCompile(`b.*`)},
+			want: false,
+		},
+		{
+			name: "nil and empty slice",
+			a:    &ast.ArrayExpression{},
+			b:    &ast.ArrayExpression{Elements: []ast.Expression{}},
+			want: true,
+		},
+		{
+			name: "nil child",
+			a:    &ast.ReturnStatement{},
+			b:    &ast.ReturnStatement{Argument: &ast.Identifier{Name: "a"}},
+			want: false,
+		},
+		{
+			name: "nil nodes",
+			want: true,
+		},
+		{
+			name: "nil node",
+			a:    &ast.Identifier{Name: "a"},
+			want: false,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if got := ast.Equal(tc.a, tc.b); got != tc.want {
+				t.Errorf("unexpected result: want %v got %v", tc.want, got)
+			}
+			if got := ast.Equal(tc.b, tc.a); got != tc.want {
+				t.Errorf("unexpected result in reverse: want %v got %v", tc.want, got)
+			}
+		})
+	}
+}
@@ -8,7 +8,8 @@ import (
 )
 
 // Check will inspect each node and annotate it with any AST errors.
-// It will return the number of errors that were found.
+// It will return the number of errors that were found, including
+// any errors that were attached to the nodes by the parser.
 func Check(root Node) int {
 	v := errorVisitor{}
 	Walk(&v, root)
@@ -16,8 +17,7 @@ func Check(root Node) int {
 }
 
 // check will inspect a single node and annotate it with any AST errors.
-// For convenience, it returns the number of errors that were identified.
-func check(n Node) int {
+func check(n Node) {
 	// TODO(jsternberg): Fill in the details for how we retrieve errors.
 	switch n := n.(type) {
 	case *BadStatement:
@@ -26,7 +26,6 @@ func check(n Node) int {
 		n.Errors = append(n.Errors, Error{
 			Msg: fmt.Sprintf("invalid statement %s@%d:%d-%d:%d: %s", loc.File, loc.Start.Line, loc.Start.Column, loc.End.Line, loc.End.Column, n.Text),
 		})
-		return len(n.Errors)
 	case *PipeExpression:
 		if n.Call == nil {
 			n.Errors = append(n.Errors, Error{
@@ -50,8 +49,6 @@ func check(n Node) int {
 			})
 		}
 	}
-
-	return 0
 }
 
 // GetError will return the first error within an AST.
@@ -75,6 +72,38 @@ func GetErrors(n Node) (errs []error) {
 	return errs
 }
 
+// NodeError is an error within an AST along with
+// the node that the error is attached to.
+type NodeError struct {
+	Node Node
+	Err  Error
+}
+
+// Location returns the location of the node with the error.
+func (e NodeError) Location() SourceLocation {
+	return e.Node.Location()
+}
+
+func (e NodeError) Error() string {
+	loc := e.Node.Location()
+	if !loc.IsValid() {
+		return e.Err.Msg
+	}
+	return fmt.Sprintf("%d:%d: %s", loc.Start.Line, loc.Start.Column, e.Err.Msg)
+}
+
+// GetNodeErrors will return each of the errors within an AST
+// along with the node each error is attached to.
+// The errors are returned in the order the nodes are walked.
+func GetNodeErrors(n Node) (errs []NodeError) {
+	Walk(CreateVisitor(func(node Node) {
+		for _, err := range node.Errs() {
+			errs = append(errs, NodeError{Node: node, Err: err})
+		}
+	}), n)
+	return errs
+}
+
 // PrintErrors will format the errors within the AST and output them
 // to the writer.
 func PrintErrors(w io.Writer, root Node) {
@@ -109,7 +138,8 @@ type errorVisitor struct {
 }
 
 func (ev *errorVisitor) Visit(n Node) Visitor {
-	ev.count += check(n)
+	check(n)
+	ev.count += len(n.Errs())
 	return ev
 }
 
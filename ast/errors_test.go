@@ -48,3 +48,42 @@ error:2:7: invalid statement: &
 		t.Errorf("unexpected output -want/+got\n\t- %q\n\t+ %q", want, got)
 	}
 }
+
+func TestGetNodeErrors(t *testing.T) {
+	bad := &ast.BadStatement{
+		BaseNode: ast.BaseNode{
+			Loc: &ast.SourceLocation{
+				Start: ast.Position{Line: 1, Column: 1},
+				End:   ast.Position{Line: 1, Column: 2},
+			},
+			Errors: []ast.Error{{Msg: "invalid statement: @"}},
+		},
+	}
+	expr := &ast.BinaryExpression{
+		BaseNode: ast.BaseNode{
+			Errors: []ast.Error{{Msg: "missing left hand side of expression"}},
+		},
+	}
+	file := &ast.File{
+		Body: []ast.Statement{
+			bad,
+			&ast.ExpressionStatement{Expression: expr},
+		},
+	}
+
+	errs := ast.GetNodeErrors(file)
+	if got, want := len(errs), 2; got != want {
+		t.Fatalf("unexpected number of errors: want %d got %d", want, got)
+	}
+	if errs[0].Node != bad || errs[1].Node != expr {
+		t.Errorf("errors are attached to the wrong nodes")
+	}
+	for i, want := range []string{
+		"1:1: invalid statement: @",
+		"missing left hand side of expression",
+	} {
+		if got := errs[i].Error(); got != want {
+			t.Errorf("unexpected error message -want/+got\n\t- %q\n\t+ %q", want, got)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package ast
 
 import (
 	"fmt"
+	"reflect"
 	"strconv"
 	"strings"
 	"time"
@@ -13,6 +14,8 @@ import (
 //	(e.g. an `OptionStatement` followed by an `ExpressionStatement`), they are separated by a double newline.
 // - In a function call (or object definition), if the arguments (or properties) are more than 3,
 //	they are split into multiple lines.
+// - Parenthesis are only added where they are needed to preserve the meaning of the AST.
+// Formatting a script that is already formatted produces the same script.
 func Format(n Node) string {
 	f := &formatter{new(strings.Builder), 0}
 	f.formatNode(n)
@@ -67,6 +70,10 @@ const (
 	functionCall = 1
 	member       = 2
 	index        = 3
+	prefix       = 4
+	pipe         = 5
+	conditional  = 6
+	function     = 7
 
 	// Use offsets for operators and logical operators to ensure they are unique keys
 	// in the map of operators precedence.
@@ -99,27 +106,43 @@ var opPrecedence = map[int]int{
 	functionCall: 1,
 	member:       1,
 	index:        1,
+	// prefix is the precedence of the unary + and - operators
+	prefix: 2,
+	pipe:   3,
 	// these are OperatorKinds
-	getIntForOp(MultiplicationOperator):   2,
-	getIntForOp(DivisionOperator):         2,
-	getIntForOp(AdditionOperator):         3,
-	getIntForOp(SubtractionOperator):      3,
-	getIntForOp(LessThanEqualOperator):    4,
-	getIntForOp(LessThanOperator):         4,
-	getIntForOp(GreaterThanEqualOperator): 4,
-	getIntForOp(GreaterThanOperator):      4,
-	getIntForOp(StartsWithOperator):       4,
-	getIntForOp(InOperator):               4,
-	getIntForOp(NotEmptyOperator):         4,
-	getIntForOp(EmptyOperator):            4,
-	getIntForOp(EqualOperator):            4,
-	getIntForOp(NotEqualOperator):         4,
-	getIntForOp(RegexpMatchOperator):      4,
-	getIntForOp(NotRegexpMatchOperator):   4,
-	getIntForOp(NotOperator):              5,
+	getIntForOp(MultiplicationOperator):   4,
+	getIntForOp(DivisionOperator):         4,
+	getIntForOp(AdditionOperator):         5,
+	getIntForOp(SubtractionOperator):      5,
+	getIntForOp(LessThanEqualOperator):    6,
+	getIntForOp(LessThanOperator):         6,
+	getIntForOp(GreaterThanEqualOperator): 6,
+	getIntForOp(GreaterThanOperator):      6,
+	getIntForOp(StartsWithOperator):       6,
+	getIntForOp(InOperator):               6,
+	getIntForOp(NotEmptyOperator):         6,
+	getIntForOp(EmptyOperator):            6,
+	getIntForOp(EqualOperator):            6,
+	getIntForOp(NotEqualOperator):         6,
+	getIntForOp(RegexpMatchOperator):      6,
+	getIntForOp(NotRegexpMatchOperator):   6,
+	getIntForOp(NotOperator):              7,
 	// theses are LogicalOperatorKinds:
-	getIntForLOp(AndOperator): 6,
-	getIntForLOp(OrOperator):  7,
+	getIntForLOp(AndOperator): 8,
+	getIntForLOp(OrOperator):  9,
+	// conditional and function expressions extend as far to the right
+	// as possible, so they bind looser than any operator
+	conditional: 10,
+	function:    10,
+}
+
+// getPrecedenceForUnary returns the precedence for a unary expression.
+// The + and - prefix operators bind tighter than their binary counterparts.
+func getPrecedenceForUnary(op OperatorKind) int {
+	if op == AdditionOperator || op == SubtractionOperator {
+		return getPrecedence(prefix)
+	}
+	return getPrecedenceForOp(op)
 }
 
 // formatChildWithParens applies the generic rule for parenthesis (not for binary expressions).
@@ -155,13 +178,15 @@ func getPrecedences(parent, child Node) (int, int) {
 	case *LogicalExpression:
 		pvp = getPrecedenceForLOp(parent.Operator)
 	case *UnaryExpression:
-		pvp = getPrecedenceForOp(parent.Operator)
+		pvp = getPrecedenceForUnary(parent.Operator)
 	case *CallExpression:
 		pvp = getPrecedence(functionCall)
 	case *MemberExpression:
 		pvp = getPrecedence(member)
 	case *IndexExpression:
 		pvp = getPrecedence(index)
+	case *PipeExpression:
+		pvp = getPrecedence(pipe)
 	}
 
 	switch child := child.(type) {
@@ -170,13 +195,19 @@ func getPrecedences(parent, child Node) (int, int) {
 	case *LogicalExpression:
 		pvc = getPrecedenceForLOp(child.Operator)
 	case *UnaryExpression:
-		pvc = getPrecedenceForOp(child.Operator)
+		pvc = getPrecedenceForUnary(child.Operator)
 	case *CallExpression:
 		pvc = getPrecedence(functionCall)
 	case *MemberExpression:
 		pvc = getPrecedence(member)
 	case *IndexExpression:
 		pvc = getPrecedence(index)
+	case *PipeExpression:
+		pvc = getPrecedence(pipe)
+	case *ConditionalExpression:
+		pvc = getPrecedence(conditional)
+	case *FunctionExpression:
+		pvc = getPrecedence(function)
 	}
 
 	return pvp, pvc
@@ -260,7 +291,8 @@ func (f *formatter) formatFile(n *File, includePkg bool) {
 			f.writeRune(sep)
 
 			// separate different statements with double newline
-			if n.Body[i-1].Type() != n.Body[i].Type() {
+			// and preserve blank lines from the original source
+			if n.Body[i-1].Type() != n.Body[i].Type() || hasBlankLineBetween(n.Body[i-1], n.Body[i]) {
 				f.writeRune(sep)
 			}
 		}
@@ -270,6 +302,25 @@ func (f *formatter) formatFile(n *File, includePkg bool) {
 	}
 }
 
+// hasBlankLineBetween reports whether the source had at least one blank line
+// between the two nodes. Comments that precede the second node are considered
+// part of that node. If either node does not have a location, it returns false.
+func hasBlankLineBetween(prev, next Node) bool {
+	ploc, nloc := prev.Location(), next.Location()
+	if !ploc.IsValid() || !nloc.IsValid() {
+		return false
+	}
+	start := nloc.Start.Line
+	if bnode, ok := reflect.ValueOf(next).Elem().FieldByName("BaseNode").Interface().(BaseNode); ok {
+		for _, c := range bnode.Comments {
+			if c.Loc != nil && c.Loc.Start.Line < start && c.Loc.Start.Line > ploc.End.Line {
+				start = c.Loc.Start.Line
+			}
+		}
+	}
+	return start-ploc.End.Line > 1
+}
+
 func (f *formatter) formatBlock(n *Block) {
 	f.writeRune('{')
 
@@ -349,6 +400,12 @@ func (f *formatter) formatMemberAssignment(n *MemberAssignment) {
 	f.formatNode(n.Init)
 }
 
+func (f *formatter) formatDestructuringAssignment(n *DestructuringAssignment) {
+	f.formatNode(n.Pattern)
+	f.writeString(" = ")
+	f.formatNode(n.Init)
+}
+
 func (f *formatter) formatArrayExpression(n *ArrayExpression) {
 	f.writeRune('[')
 
@@ -449,7 +506,7 @@ func (f *formatter) formatCallExpression(n *CallExpression) {
 }
 
 func (f *formatter) formatPipeExpression(n *PipeExpression) {
-	f.formatNode(n.Argument)
+	f.formatLeftChildWithParens(n, n.Argument)
 	f.writeRune('\n')
 	f.indent()
 	f.writeIndent()
@@ -504,6 +561,14 @@ func (f *formatter) formatObjectExpressionBraces(n *ObjectExpression, braces boo
 		f.writeRune('{')
 	}
 
+	if n.With != nil {
+		f.formatNode(n.With)
+		f.writeString(" with")
+		if !multiline {
+			f.writeRune(' ')
+		}
+	}
+
 	if multiline {
 		f.writeRune('\n')
 		f.indent()
@@ -575,6 +640,29 @@ func (f *formatter) formatStringLiteral(n *StringLiteral) {
 	f.writeRune('"')
 }
 
+func (f *formatter) formatStringExpression(n *StringExpression) {
+	f.writeRune('"')
+	for _, p := range n.Parts {
+		f.formatNode(p)
+	}
+	f.writeRune('"')
+}
+
+func (f *formatter) formatTextPart(n *TextPart) {
+	if n.Loc != nil && n.Loc.Source != "" {
+		// Preserve the exact text if we have it
+		f.writeString(n.Loc.Source)
+		return
+	}
+	f.writeString(escapeStr(n.Value))
+}
+
+func (f *formatter) formatInterpolatedPart(n *InterpolatedPart) {
+	f.writeString("${")
+	f.formatNode(n.Expression)
+	f.writeRune('}')
+}
+
 func escapeStr(s string) string {
 	if !strings.ContainsAny(s, `"\`) {
 		return s
@@ -633,6 +721,10 @@ func (f *formatter) formatPipeLiteral(_ *PipeLiteral) {
 	f.writeString("<-")
 }
 
+func (f *formatter) formatPipePlaceholder(_ *PipePlaceholder) {
+	f.writeString("_")
+}
+
 func (f *formatter) formatRegexpLiteral(n *RegexpLiteral) {
 	f.writeRune('/')
 	f.writeString(strings.Replace(n.Value.String(), "/", "\\/", -1))
@@ -666,6 +758,8 @@ func (f *formatter) formatNode(n Node) {
 		f.formatVariableAssignment(n)
 	case *MemberAssignment:
 		f.formatMemberAssignment(n)
+	case *DestructuringAssignment:
+		f.formatDestructuringAssignment(n)
 	case *CallExpression:
 		f.formatCallExpression(n)
 	case *PipeExpression:
@@ -690,6 +784,14 @@ func (f *formatter) formatNode(n Node) {
 		f.formatIdentifier(n)
 	case *PipeLiteral:
 		f.formatPipeLiteral(n)
+	case *PipePlaceholder:
+		f.formatPipePlaceholder(n)
+	case *StringExpression:
+		f.formatStringExpression(n)
+	case *TextPart:
+		f.formatTextPart(n)
+	case *InterpolatedPart:
+		f.formatInterpolatedPart(n)
 	case *StringLiteral:
 		f.formatStringLiteral(n)
 	case *BooleanLiteral:
@@ -1,14 +1,22 @@
 package ast_test
 
 import (
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/flux/ast"
+	iparser "github.com/influxdata/flux/internal/parser"
+	"github.com/influxdata/flux/internal/token"
 	"github.com/influxdata/flux/parser"
 	"github.com/pkg/errors"
 )
 
+var update = flag.Bool("update", false, "update the golden files for the format tests")
+
 var skip = map[string]string{
 	"array_expr":  "without pars -> bad syntax, with pars formatting removes them",
 	"conditional": "how is a conditional expression defined in spec?",
@@ -86,6 +94,27 @@ func TestFormat_Nodes(t *testing.T) {
 			name:   "object with mixed keys",
 			script: `{"a": 1, b: 2}`,
 		},
+		{
+			name:   "object with",
+			script: `{r with a: 1, b: "c"}`,
+		},
+		{
+			name:   "array destructuring",
+			script: `[a, b] = [1, 2]`,
+		},
+		{
+			name:   "object destructuring",
+			script: `{a, b: c} = r`,
+		},
+		{
+			name: "object with multiline",
+			script: `{r with
+	a: 1,
+	b: 2,
+	c: 3,
+	d: 4,
+}`,
+		},
 		{
 			name:   "member ident",
 			script: `object.property`,
@@ -225,6 +254,16 @@ import bar "path/bar"
 
 from(bucket: "testdb")
 	|> range(start: 2018-05-20T19:53:26Z)`,
+		},
+		{
+			name: "string interpolation",
+			script: `"a ${b}\n${c.d + e}"`,
+		},
+		{
+			name: "pipe placeholder",
+			script: `data
+	|> map(fn: (r) =>
+		(r._value / total(tables: _)))`,
 		},
 		{
 			name: "simple",
@@ -527,3 +566,84 @@ a = 1`,
 		})
 	}
 }
+
+// TestFormat_Golden formats each of the .input files in testdata/format
+// and compares the result with the matching .golden file.
+// The golden files must also be unchanged when they are formatted again.
+// Run with -update to rewrite the golden files.
+func TestFormat_Golden(t *testing.T) {
+	inputs, err := filepath.Glob(filepath.Join("testdata", "format", "*.input"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(inputs) == 0 {
+		t.Fatal("no format test inputs were found")
+	}
+
+	format := func(t *testing.T, src string) (ast.Node, string) {
+		t.Helper()
+		pkg := parser.ParseSource(src)
+		if ast.Check(pkg) > 0 {
+			t.Fatal(errors.Wrapf(ast.GetError(pkg), "source has bad syntax:\n%s", src))
+		}
+		return pkg.Files[0], ast.Format(pkg.Files[0]) + "\n"
+	}
+
+	for _, input := range inputs {
+		input := input
+		name := strings.TrimSuffix(filepath.Base(input), ".input")
+		t.Run(name, func(t *testing.T) {
+			src, err := ioutil.ReadFile(input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			file, got := format(t, string(src))
+
+			golden := strings.TrimSuffix(input, ".input") + ".golden"
+			if *update {
+				if err := ioutil.WriteFile(golden, []byte(got), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := ioutil.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != string(want) {
+				t.Errorf("unexpected output: -want/+got:\n %s", cmp.Diff(string(want), got))
+			}
+
+			formatted, again := format(t, got)
+			if again != got {
+				t.Errorf("format is not idempotent: -want/+got:\n %s", cmp.Diff(got, again))
+			}
+			if !ast.Equal(file, formatted) {
+				t.Errorf("formatted source does not have the same meaning as the input:\n%s", got)
+			}
+		})
+	}
+}
+
+func TestFormat_BlankLinesWithComments(t *testing.T) {
+	src := `a = 1
+// b is the second value
+b = 2
+
+// c is the sum
+c = a + b
+`
+	file := iparser.ParseFileWithComments(token.NewFile("a.flux", len(src)), []byte(src))
+	if ast.Check(file) > 0 {
+		t.Fatal(ast.GetError(file))
+	}
+
+	// Comments are not formatted, but a comment directly
+	// above a statement must not be treated as a blank line.
+	want := `a = 1
+b = 2
+
+c = a + b`
+	if got := ast.Format(file); got != want {
+		t.Errorf("unexpected output: -want/+got:\n %s", cmp.Diff(want, got))
+	}
+}
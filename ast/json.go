@@ -42,13 +42,15 @@ func (f *File) UnmarshalJSON(data []byte) error {
 		*f = *(*File)(raw.Alias)
 	}
 
-	f.Body = make([]Statement, len(raw.Body))
-	for i, r := range raw.Body {
-		s, err := unmarshalStatement(r)
-		if err != nil {
-			return err
+	if raw.Body != nil {
+		f.Body = make([]Statement, len(raw.Body))
+		for i, r := range raw.Body {
+			s, err := unmarshalStatement(r)
+			if err != nil {
+				return err
+			}
+			f.Body[i] = s
 		}
-		f.Body[i] = s
 	}
 	return nil
 }
@@ -109,13 +111,15 @@ func (s *Block) UnmarshalJSON(data []byte) error {
 		*s = *(*Block)(raw.Alias)
 	}
 
-	s.Body = make([]Statement, len(raw.Body))
-	for i, r := range raw.Body {
-		stmt, err := unmarshalStatement(r)
-		if err != nil {
-			return err
+	if raw.Body != nil {
+		s.Body = make([]Statement, len(raw.Body))
+		for i, r := range raw.Body {
+			stmt, err := unmarshalStatement(r)
+			if err != nil {
+				return err
+			}
+			s.Body[i] = stmt
 		}
-		s.Body[i] = stmt
 	}
 	return nil
 }
@@ -297,6 +301,44 @@ func (s *MemberAssignment) UnmarshalJSON(data []byte) error {
 	s.Init = e
 	return nil
 }
+func (a *DestructuringAssignment) MarshalJSON() ([]byte, error) {
+	type Alias DestructuringAssignment
+	raw := struct {
+		Type string `json:"type"`
+		*Alias
+	}{
+		Type:  a.Type(),
+		Alias: (*Alias)(a),
+	}
+	return json.Marshal(raw)
+}
+func (a *DestructuringAssignment) UnmarshalJSON(data []byte) error {
+	type Alias DestructuringAssignment
+	raw := struct {
+		*Alias
+		Pattern json.RawMessage `json:"pattern"`
+		Init    json.RawMessage `json:"init"`
+	}{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if raw.Alias != nil {
+		*a = *(*DestructuringAssignment)(raw.Alias)
+	}
+
+	pattern, err := unmarshalExpression(raw.Pattern)
+	if err != nil {
+		return err
+	}
+	a.Pattern = pattern
+
+	init, err := unmarshalExpression(raw.Init)
+	if err != nil {
+		return err
+	}
+	a.Init = init
+	return nil
+}
 func (e *CallExpression) MarshalJSON() ([]byte, error) {
 	type Alias CallExpression
 	raw := struct {
@@ -328,13 +370,15 @@ func (e *CallExpression) UnmarshalJSON(data []byte) error {
 	}
 	e.Callee = callee
 
-	e.Arguments = make([]Expression, len(raw.Arguments))
-	for i, r := range raw.Arguments {
-		expr, err := unmarshalExpression(r)
-		if err != nil {
-			return err
+	if raw.Arguments != nil {
+		e.Arguments = make([]Expression, len(raw.Arguments))
+		for i, r := range raw.Arguments {
+			expr, err := unmarshalExpression(r)
+			if err != nil {
+				return err
+			}
+			e.Arguments[i] = expr
 		}
-		e.Arguments[i] = expr
 	}
 	return nil
 }
@@ -611,13 +655,15 @@ func (e *ArrayExpression) UnmarshalJSON(data []byte) error {
 		*e = *(*ArrayExpression)(raw.Alias)
 	}
 
-	e.Elements = make([]Expression, len(raw.Elements))
-	for i, r := range raw.Elements {
-		expr, err := unmarshalExpression(r)
-		if err != nil {
-			return err
+	if raw.Elements != nil {
+		e.Elements = make([]Expression, len(raw.Elements))
+		for i, r := range raw.Elements {
+			expr, err := unmarshalExpression(r)
+			if err != nil {
+				return err
+			}
+			e.Elements[i] = expr
 		}
-		e.Elements[i] = expr
 	}
 	return nil
 }
@@ -739,6 +785,95 @@ func (l *PipeLiteral) MarshalJSON() ([]byte, error) {
 	}
 	return json.Marshal(raw)
 }
+func (l *PipePlaceholder) MarshalJSON() ([]byte, error) {
+	type Alias PipePlaceholder
+	raw := struct {
+		Type string `json:"type"`
+		*Alias
+	}{
+		Type:  l.Type(),
+		Alias: (*Alias)(l),
+	}
+	return json.Marshal(raw)
+}
+func (e *StringExpression) MarshalJSON() ([]byte, error) {
+	type Alias StringExpression
+	raw := struct {
+		Type string `json:"type"`
+		*Alias
+	}{
+		Type:  e.Type(),
+		Alias: (*Alias)(e),
+	}
+	return json.Marshal(raw)
+}
+func (e *StringExpression) UnmarshalJSON(data []byte) error {
+	type Alias StringExpression
+	raw := struct {
+		*Alias
+		Parts []json.RawMessage `json:"parts"`
+	}{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if raw.Alias != nil {
+		*e = *(*StringExpression)(raw.Alias)
+	}
+
+	if raw.Parts != nil {
+		e.Parts = make([]StringExpressionPart, len(raw.Parts))
+		for i, r := range raw.Parts {
+			part, err := unmarshalStringExpressionPart(r)
+			if err != nil {
+				return err
+			}
+			e.Parts[i] = part
+		}
+	}
+	return nil
+}
+func (p *TextPart) MarshalJSON() ([]byte, error) {
+	type Alias TextPart
+	raw := struct {
+		Type string `json:"type"`
+		*Alias
+	}{
+		Type:  p.Type(),
+		Alias: (*Alias)(p),
+	}
+	return json.Marshal(raw)
+}
+func (p *InterpolatedPart) MarshalJSON() ([]byte, error) {
+	type Alias InterpolatedPart
+	raw := struct {
+		Type string `json:"type"`
+		*Alias
+	}{
+		Type:  p.Type(),
+		Alias: (*Alias)(p),
+	}
+	return json.Marshal(raw)
+}
+func (p *InterpolatedPart) UnmarshalJSON(data []byte) error {
+	type Alias InterpolatedPart
+	raw := struct {
+		*Alias
+		Expression json.RawMessage `json:"expression"`
+	}{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if raw.Alias != nil {
+		*p = *(*InterpolatedPart)(raw.Alias)
+	}
+
+	expr, err := unmarshalExpression(raw.Expression)
+	if err != nil {
+		return err
+	}
+	p.Expression = expr
+	return nil
+}
 func (l *StringLiteral) MarshalJSON() ([]byte, error) {
 	type Alias StringLiteral
 	raw := struct {
@@ -845,11 +980,14 @@ func (l *RegexpLiteral) MarshalJSON() ([]byte, error) {
 	raw := struct {
 		Type string `json:"type"`
 		*Alias
-		Value string `json:"value"`
+		Value *string `json:"value"`
 	}{
 		Type:  l.Type(),
 		Alias: (*Alias)(l),
-		Value: l.Value.String(),
+	}
+	if l.Value != nil {
+		value := l.Value.String()
+		raw.Value = &value
 	}
 	return json.Marshal(raw)
 }
@@ -857,7 +995,7 @@ func (l *RegexpLiteral) UnmarshalJSON(data []byte) error {
 	type Alias RegexpLiteral
 	raw := struct {
 		*Alias
-		Value string `json:"value"`
+		Value *string `json:"value"`
 	}{}
 	if err := json.Unmarshal(data, &raw); err != nil {
 		return err
@@ -867,11 +1005,13 @@ func (l *RegexpLiteral) UnmarshalJSON(data []byte) error {
 		*l = *(*RegexpLiteral)(raw.Alias)
 	}
 
-	value, err := regexp.Compile(raw.Value)
-	if err != nil {
-		return err
+	if raw.Value != nil {
+		value, err := regexp.Compile(*raw.Value)
+		if err != nil {
+			return err
+		}
+		l.Value = value
 	}
-	l.Value = value
 	return nil
 }
 func (l *DurationLiteral) MarshalJSON() ([]byte, error) {
@@ -963,6 +1103,20 @@ func unmarshalPropertyKey(msg json.RawMessage) (PropertyKey, error) {
 	}
 	return k, nil
 }
+func unmarshalStringExpressionPart(msg json.RawMessage) (StringExpressionPart, error) {
+	if checkNullMsg(msg) {
+		return nil, nil
+	}
+	n, err := unmarshalNode(msg)
+	if err != nil {
+		return nil, err
+	}
+	p, ok := n.(StringExpressionPart)
+	if !ok {
+		return nil, fmt.Errorf("node %q is not a string expression part", n.Type())
+	}
+	return p, nil
+}
 func unmarshalNode(msg json.RawMessage) (Node, error) {
 	if checkNullMsg(msg) {
 		return nil, nil
@@ -1005,6 +1159,8 @@ func unmarshalNode(msg json.RawMessage) (Node, error) {
 		node = new(VariableAssignment)
 	case "MemberAssignment":
 		node = new(MemberAssignment)
+	case "DestructuringAssignment":
+		node = new(DestructuringAssignment)
 	case "CallExpression":
 		node = new(CallExpression)
 	case "PipeExpression":
@@ -1029,6 +1185,14 @@ func unmarshalNode(msg json.RawMessage) (Node, error) {
 		node = new(Identifier)
 	case "PipeLiteral":
 		node = new(PipeLiteral)
+	case "PipePlaceholder":
+		node = new(PipePlaceholder)
+	case "StringExpression":
+		node = new(StringExpression)
+	case "TextPart":
+		node = new(TextPart)
+	case "InterpolatedPart":
+		node = new(InterpolatedPart)
 	case "StringLiteral":
 		node = new(StringLiteral)
 	case "BooleanLiteral":
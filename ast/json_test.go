@@ -10,6 +10,8 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/flux/ast"
 	"github.com/influxdata/flux/ast/asttest"
+	iparser "github.com/influxdata/flux/internal/parser"
+	"github.com/influxdata/flux/internal/token"
 )
 
 func TestJSONMarshal(t *testing.T) {
@@ -272,6 +274,17 @@ func TestJSONMarshal(t *testing.T) {
 			},
 			want: `{"type":"ObjectExpression","properties":[{"type":"Property","key":{"type":"Identifier","name":"a"},"value":{"type":"StringLiteral","value":"hello"}}]}`,
 		},
+		{
+			name: "object expression with",
+			node: &ast.ObjectExpression{
+				With: &ast.Identifier{Name: "r"},
+				Properties: []*ast.Property{{
+					Key:   &ast.Identifier{Name: "a"},
+					Value: &ast.StringLiteral{Value: "hello"},
+				}},
+			},
+			want: `{"type":"ObjectExpression","with":{"type":"Identifier","name":"r"},"properties":[{"type":"Property","key":{"type":"Identifier","name":"a"},"value":{"type":"StringLiteral","value":"hello"}}]}`,
+		},
 		{
 			name: "object expression with string literal key",
 			node: &ast.ObjectExpression{
@@ -401,3 +414,97 @@ func TestJSONMarshal(t *testing.T) {
 		})
 	}
 }
+
+func TestJSONRoundTrip(t *testing.T) {
+	// parse parses the source with comments attached and any errors annotated.
+	parse := func(src string) *ast.File {
+		file := iparser.ParseFileWithComments(token.NewFile("a.flux", len(src)), []byte(src))
+		ast.Check(file)
+		return file
+	}
+	// find returns the first node of the given type within the node.
+	find := func(typ string, node ast.Node) ast.Node {
+		var found ast.Node
+		ast.Walk(ast.CreateVisitor(func(n ast.Node) {
+			if found == nil && n.Type() == typ {
+				found = n
+			}
+		}), node)
+		if found == nil {
+			t.Fatalf("no node of type %s", typ)
+		}
+		return found
+	}
+
+	testCases := []struct {
+		name string
+		node ast.Node
+	}{
+		{name: "Package", node: &ast.Package{Path: "a/b", Package: "b", Files: []*ast.File{parse("package b\n// comment\nb = 1\n")}}},
+		{name: "File", node: parse("package a\nimport \"b\"\n// comment\na = 1 // trailing\n")},
+		{name: "File without body", node: parse("package a\n")},
+		{name: "PackageClause", node: find("PackageClause", parse("// doc\npackage a\n"))},
+		{name: "ImportDeclaration", node: find("ImportDeclaration", parse("import b \"path/b\"\n"))},
+		{name: "ImportDeclaration without alias", node: find("ImportDeclaration", parse("import \"path/b\"\n"))},
+		{name: "Block", node: find("Block", parse("f = () => {\n\ta = 1\n\treturn a\n}\n"))},
+		{name: "BadStatement", node: find("BadStatement", parse(")\n"))},
+		{name: "ExpressionStatement", node: find("ExpressionStatement", parse("f()\n"))},
+		{name: "ReturnStatement", node: find("ReturnStatement", parse("f = () => {\n\treturn 1\n}\n"))},
+		{name: "OptionStatement", node: find("OptionStatement", parse("option a = 1\n"))},
+		{name: "OptionStatement with member", node: find("OptionStatement", parse("option a.b = 1\n"))},
+		{name: "BuiltinStatement", node: find("BuiltinStatement", parse("builtin from\n"))},
+		{name: "TestStatement", node: find("TestStatement", parse("test t = () => ({input: 1})\n"))},
+		{name: "VariableAssignment", node: find("VariableAssignment", parse("a = 1\n"))},
+		{name: "MemberAssignment", node: find("MemberAssignment", parse("option a.b = 1\n"))},
+		{name: "DestructuringAssignment", node: find("DestructuringAssignment", parse("[a, b] = [1, 2]\n"))},
+		{name: "DestructuringAssignment with object", node: find("DestructuringAssignment", parse("{a, b: c} = r\n"))},
+		{name: "ArrayExpression", node: find("ArrayExpression", parse("[1, 2]\n"))},
+		{name: "empty ArrayExpression", node: find("ArrayExpression", parse("[]\n"))},
+		{name: "FunctionExpression", node: find("FunctionExpression", parse("(a, b=1, c=<-) => a + b\n"))},
+		{name: "BinaryExpression", node: find("BinaryExpression", parse("1 + 2 * 3\n"))},
+		{name: "BinaryExpression with errors", node: find("BinaryExpression", parse("a = 1 +\n"))},
+		{name: "CallExpression", node: find("CallExpression", parse("f(a: 1)\n"))},
+		{name: "CallExpression without arguments", node: find("CallExpression", parse("f()\n"))},
+		{name: "ConditionalExpression", node: find("ConditionalExpression", parse("if a then b else c\n"))},
+		{name: "LogicalExpression", node: find("LogicalExpression", parse("a and b or c\n"))},
+		{name: "MemberExpression", node: find("MemberExpression", parse("a.b\n"))},
+		{name: "MemberExpression with string", node: find("MemberExpression", parse("a[\"b\"]\n"))},
+		{name: "IndexExpression", node: find("IndexExpression", parse("a[0]\n"))},
+		{name: "PipeExpression", node: find("PipeExpression", parse("a |> f()\n"))},
+		{name: "ObjectExpression", node: find("ObjectExpression", parse("{a: 1, \"b\": 2}\n"))},
+		{name: "ObjectExpression with shorthand", node: find("ObjectExpression", parse("{a, b}\n"))},
+		{name: "UnaryExpression", node: find("UnaryExpression", parse("not a\n"))},
+		{name: "Property", node: find("Property", parse("{a: 1}\n"))},
+		{name: "Identifier", node: find("Identifier", parse("a\n"))},
+		{name: "BooleanLiteral", node: &ast.BooleanLiteral{BaseNode: ast.BaseNode{Comments: []ast.Comment{{Text: "// c"}}}, Value: true}},
+		{name: "DateTimeLiteral", node: find("DateTimeLiteral", parse("2018-05-22T19:53:00.000000001-07:00\n"))},
+		{name: "DurationLiteral", node: find("DurationLiteral", parse("1h30m\n"))},
+		{name: "FloatLiteral", node: find("FloatLiteral", parse("1.5\n"))},
+		{name: "IntegerLiteral", node: find("IntegerLiteral", parse("9223372036854775807\n"))},
+		{name: "PipeLiteral", node: find("PipeLiteral", parse("(t=<-) => t\n"))},
+		{name: "PipePlaceholder", node: find("PipePlaceholder", parse("a |> f(x: _)\n"))},
+		{name: "RegexpLiteral", node: find("RegexpLiteral", parse("/a\\/b/\n"))},
+		{name: "nil RegexpLiteral", node: &ast.RegexpLiteral{}},
+		{name: "StringExpression", node: find("StringExpression", parse("\"a ${b}\\n${c.d}\"\n"))},
+		{name: "TextPart", node: find("TextPart", parse("\"a ${b}\"\n"))},
+		{name: "InterpolatedPart", node: find("InterpolatedPart", parse("\"a ${b}\"\n"))},
+		{name: "StringLiteral", node: find("StringLiteral", parse("\"a\\nb\"\n"))},
+		{name: "UnsignedIntegerLiteral", node: &ast.UnsignedIntegerLiteral{Value: math.MaxUint64}},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.node)
+			if err != nil {
+				t.Fatal(err)
+			}
+			node, err := ast.UnmarshalNode(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !cmp.Equal(tc.node, node, asttest.CmpOptions...) {
+				t.Errorf("unexpected node after round trip: -want/+got:\n%s", cmp.Diff(tc.node, node, asttest.CmpOptions...))
+			}
+		})
+	}
+}
@@ -3,7 +3,8 @@ package ast
 import "fmt"
 
 // Walk recursively visits every children of a given `Node` given a `Visitor`.
-// It performs a pre-order visit of the AST (visit parent node, then visit children from left to right).
+// It performs a pre-order visit of the AST (visit parent node, then visit children in source order).
+// Together, `Visit` and `Done` signal when the walk enters and exits each node, such as the start and end of a `Block`.
 // If a call to `Visit` for a node returns a nil visitor, walk stops and doesn't visit the AST rooted at that node,
 // otherwise it uses the returned visitor to continue walking.
 // Once Walk has finished visiting a node (the node itself and its children), it invokes `Done` on the node's visitor.
@@ -168,6 +169,15 @@ func walk(v Visitor, node Node) {
 			walk(w, n.Member)
 			walk(w, n.Init)
 		}
+	case *DestructuringAssignment:
+		if n == nil {
+			return
+		}
+		w := v.Visit(n)
+		if w != nil {
+			walk(w, n.Pattern)
+			walk(w, n.Init)
+		}
 	case *CallExpression:
 		if n == nil {
 			return
@@ -238,6 +248,9 @@ func walk(v Visitor, node Node) {
 		}
 		w := v.Visit(n)
 		if w != nil {
+			if n.With != nil {
+				walk(w, n.With)
+			}
 			for _, p := range n.Properties {
 				walk(w, p)
 			}
@@ -249,8 +262,31 @@ func walk(v Visitor, node Node) {
 		w := v.Visit(n)
 		if w != nil {
 			walk(w, n.Test)
-			walk(w, n.Alternate)
 			walk(w, n.Consequent)
+			walk(w, n.Alternate)
+		}
+	case *StringExpression:
+		if n == nil {
+			return
+		}
+		w := v.Visit(n)
+		if w != nil {
+			for _, p := range n.Parts {
+				walk(w, p)
+			}
+		}
+	case *TextPart:
+		if n == nil {
+			return
+		}
+		v.Visit(n)
+	case *InterpolatedPart:
+		if n == nil {
+			return
+		}
+		w := v.Visit(n)
+		if w != nil {
+			walk(w, n.Expression)
 		}
 	case *ArrayExpression:
 		if n == nil {
@@ -292,6 +328,11 @@ func walk(v Visitor, node Node) {
 			return
 		}
 		v.Visit(n)
+	case *PipePlaceholder:
+		if n == nil {
+			return
+		}
+		v.Visit(n)
 	case *StringLiteral:
 		if n == nil {
 			return
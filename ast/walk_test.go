@@ -6,6 +6,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/flux/ast"
 	"github.com/influxdata/flux/ast/asttest"
+	"github.com/influxdata/flux/parser"
 )
 
 func TestWalk(t *testing.T) {
@@ -137,3 +138,137 @@ func (o *orderVisitor) Visit(node ast.Node) (w ast.Visitor) {
 	return o
 }
 func (o *orderVisitor) Done(node ast.Node) {}
+
+// enterExitVisitor records when the walk enters and exits each node.
+type enterExitVisitor struct {
+	events []string
+}
+
+func (v *enterExitVisitor) Visit(node ast.Node) ast.Visitor {
+	v.events = append(v.events, "enter "+node.Type())
+	return v
+}
+
+func (v *enterExitVisitor) Done(node ast.Node) {
+	v.events = append(v.events, "exit "+node.Type())
+}
+
+func TestWalk_EnterExit(t *testing.T) {
+	pkg := parser.ParseSource(`f = (r) => {
+	x = r + 1
+	return if x > 1 then x else 1
+}`)
+	v := new(enterExitVisitor)
+	ast.Walk(v, pkg)
+	want := []string{
+		"enter Package",
+		"enter File",
+		"enter VariableAssignment",
+		"enter Identifier",
+		"exit Identifier",
+		"enter FunctionExpression",
+		"enter Property",
+		"enter Identifier",
+		"exit Identifier",
+		"exit Property",
+		"enter Block",
+		"enter VariableAssignment",
+		"enter Identifier",
+		"exit Identifier",
+		"enter BinaryExpression",
+		"enter Identifier",
+		"exit Identifier",
+		"enter IntegerLiteral",
+		"exit IntegerLiteral",
+		"exit BinaryExpression",
+		"exit VariableAssignment",
+		"enter ReturnStatement",
+		"enter ConditionalExpression",
+		"enter BinaryExpression",
+		"enter Identifier",
+		"exit Identifier",
+		"enter IntegerLiteral",
+		"exit IntegerLiteral",
+		"exit BinaryExpression",
+		"enter Identifier",
+		"exit Identifier",
+		"enter IntegerLiteral",
+		"exit IntegerLiteral",
+		"exit ConditionalExpression",
+		"exit ReturnStatement",
+		"exit Block",
+		"exit FunctionExpression",
+		"exit VariableAssignment",
+		"exit File",
+		"exit Package",
+	}
+	if !cmp.Equal(want, v.events) {
+		t.Errorf("unexpected enter/exit sequence -want/+got:\n%s", cmp.Diff(want, v.events))
+	}
+}
+
+func TestWalk_AllNodes(t *testing.T) {
+	pkg := parser.ParseSource(`package foo
+import "bar"
+import baz "baz"
+option now = () => 2018-05-22T19:53:00Z
+option baz.x = 1
+builtin from
+test t = () => ({input: 1.5})
+a = [1, 2][0]
+b = {x: "x", y: /y/}.x
+c = (r, t=<-) => {
+	return r |> f()
+}
+d = if a then -b else 1h
+e = a + 1 > 2 and not b
+a.b = 1
+[g, h] = [1, 2]
+f()
+)
+`)
+	visited := make(map[string]bool)
+	ast.Walk(ast.CreateVisitor(func(node ast.Node) {
+		visited[node.Type()] = true
+	}), pkg)
+	for _, typ := range []string{
+		"Package",
+		"File",
+		"PackageClause",
+		"ImportDeclaration",
+		"Block",
+		"BadStatement",
+		"ExpressionStatement",
+		"ReturnStatement",
+		"OptionStatement",
+		"BuiltinStatement",
+		"TestStatement",
+		"VariableAssignment",
+		"MemberAssignment",
+		"DestructuringAssignment",
+		"ArrayExpression",
+		"FunctionExpression",
+		"BinaryExpression",
+		"CallExpression",
+		"ConditionalExpression",
+		"LogicalExpression",
+		"MemberExpression",
+		"IndexExpression",
+		"PipeExpression",
+		"ObjectExpression",
+		"UnaryExpression",
+		"Property",
+		"Identifier",
+		"DateTimeLiteral",
+		"DurationLiteral",
+		"FloatLiteral",
+		"IntegerLiteral",
+		"PipeLiteral",
+		"RegexpLiteral",
+		"StringLiteral",
+	} {
+		if !visited[typ] {
+			t.Errorf("walk did not visit node type %s", typ)
+		}
+	}
+}
@@ -44,6 +44,10 @@ func Eval(flux string, opts ...ScopeMutator) ([]values.Value, interpreter.Scope,
 
 // EvalAST accepts a Flux AST and evaluates it to produce a set of side effects (as a slice of values) and a scope.
 func EvalAST(astPkg *ast.Package, opts ...ScopeMutator) ([]values.Value, interpreter.Scope, error) {
+	return evalAST(astPkg, StdLib(), opts...)
+}
+
+func evalAST(astPkg *ast.Package, importer interpreter.Importer, opts ...ScopeMutator) ([]values.Value, interpreter.Scope, error) {
 	semPkg, err := semantic.New(astPkg)
 	if err != nil {
 		return nil, nil, err
@@ -56,9 +60,9 @@ func EvalAST(astPkg *ast.Package, opts ...ScopeMutator) ([]values.Value, interpr
 		opt(universe)
 	}
 
-	sideEffects, err := itrp.Eval(semPkg, universe, StdLib())
+	sideEffects, err := itrp.Eval(semPkg, universe, importer)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, semantic.AnnotateError(err, astPkg)
 	}
 
 	return sideEffects, universe, nil
@@ -261,29 +265,37 @@ func evalBuiltInPackages() error {
 		return err
 	}
 	for _, astPkg := range order {
-		if ast.Check(astPkg) > 0 {
-			err := ast.GetError(astPkg)
-			return errors.Wrapf(err, "failed to parse builtin package %q", astPkg.Path)
-		}
-		semPkg, err := semantic.New(astPkg)
-		if err != nil {
-			return errors.Wrapf(err, "failed to create semantic graph for builtin package %q", astPkg.Path)
-		}
-
 		pkg := stdlib.pkgs[astPkg.Path]
 		if pkg == nil {
-			return errors.Wrapf(err, "package does not exist %q", astPkg.Path)
+			return fmt.Errorf("package does not exist %q", astPkg.Path)
 		}
-
-		// Validate packages before evaluating them
-		if err := validatePackageBuiltins(pkg, astPkg); err != nil {
-			return errors.Wrapf(err, "package has invalid builtins %q", astPkg.Path)
+		if err := evalBuiltInPackage(astPkg, pkg, stdlib); err != nil {
+			return err
 		}
+	}
+	return nil
+}
 
-		itrp := interpreter.NewInterpreter()
-		if _, err := itrp.Eval(semPkg, preludeScope.Nest(pkg), stdlib); err != nil {
-			return errors.Wrapf(err, "failed to evaluate builtin package %q", astPkg.Path)
-		}
+// evalBuiltInPackage evaluates the builtin package into pkg,
+// which must already contain the values for any builtin statements.
+func evalBuiltInPackage(astPkg *ast.Package, pkg *interpreter.Package, importer interpreter.Importer) error {
+	if ast.Check(astPkg) > 0 {
+		err := ast.GetError(astPkg)
+		return errors.Wrapf(err, "failed to parse builtin package %q", astPkg.Path)
+	}
+	semPkg, err := semantic.New(astPkg)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create semantic graph for builtin package %q", astPkg.Path)
+	}
+
+	// Validate packages before evaluating them
+	if err := validatePackageBuiltins(pkg, astPkg); err != nil {
+		return errors.Wrapf(err, "package has invalid builtins %q", astPkg.Path)
+	}
+
+	itrp := interpreter.NewInterpreter()
+	if _, err := itrp.Eval(semPkg, preludeScope.Nest(pkg), importer); err != nil {
+		return errors.Wrapf(err, "failed to evaluate builtin package %q", astPkg.Path)
 	}
 	return nil
 }
@@ -565,8 +577,11 @@ type function struct {
 
 func (f *function) Type() semantic.Type {
 	// TODO(nathanielc): Update values.Value interface to use PolyTypes
-	t, _ := f.t.MonoType()
-	return t
+	t, ok := f.t.MonoType()
+	if ok {
+		return t
+	}
+	return semantic.Invalid
 }
 func (f *function) PolyType() semantic.PolyType {
 	return f.t
@@ -0,0 +1,24 @@
+package flux_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/flux"
+)
+
+func TestEval_TypeErrorSource(t *testing.T) {
+	script := `a = 1
+b = a + "s"
+`
+	_, _, err := flux.Eval(script)
+	if err == nil {
+		t.Fatal("expected type error")
+	}
+	want := `type error 2:5-2:12: int != string
+  |
+2 | b = a + "s"
+  |     ^^^^^^^`
+	if got := err.Error(); got != want {
+		t.Errorf("unexpected error -want/+got:\n\t- %q\n\t+ %q", want, got)
+	}
+}
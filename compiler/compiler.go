@@ -113,6 +113,17 @@ func compile(n semantic.Node, typeSol semantic.TypeSolution, builtIns Scope, fun
 			properties[p.Key.Key()] = node
 			propertyTypes[p.Key.Key()] = node.Type()
 		}
+		if n.With != nil {
+			with, err := compile(n.With, typeSol, builtIns, funcExprs)
+			if err != nil {
+				return nil, err
+			}
+			return &objEvaluator{
+				t:          monoType(typeSol.TypeOf(n)),
+				with:       with,
+				properties: properties,
+			}, nil
+		}
 		return &objEvaluator{
 			t:          semantic.NewObjectType(propertyTypes),
 			properties: properties,
@@ -272,6 +283,29 @@ func compile(n semantic.Node, typeSol semantic.TypeSolution, builtIns Scope, fun
 			consequent: c,
 			alternate:  a,
 		}, nil
+	case *semantic.StringExpression:
+		parts := make([]Evaluator, len(n.Parts))
+		for i, p := range n.Parts {
+			switch p := p.(type) {
+			case *semantic.TextPart:
+				parts[i] = &stringEvaluator{
+					t: semantic.String,
+					s: p.Value,
+				}
+			case *semantic.InterpolatedPart:
+				e, err := compile(p.Expression, typeSol, builtIns, funcExprs)
+				if err != nil {
+					return nil, err
+				}
+				parts[i] = e
+			default:
+				return nil, fmt.Errorf("unknown string expression part %T", p)
+			}
+		}
+		return &stringExpressionEvaluator{
+			t:     semantic.String,
+			parts: parts,
+		}, nil
 	case *semantic.BinaryExpression:
 		l, err := compile(n.Left, typeSol, builtIns, funcExprs)
 		if err != nil {
@@ -156,6 +156,34 @@ func TestCompileAndEval(t *testing.T) {
 			want:    values.NewInt(4),
 			wantErr: false,
 		},
+		{
+			name: "string interpolation",
+			// f = (r) => "r = ${r}!"
+			fn: &semantic.FunctionExpression{
+				Block: &semantic.FunctionBlock{
+					Parameters: &semantic.FunctionParameters{
+						List: []*semantic.FunctionParameter{
+							{Key: &semantic.Identifier{Name: "r"}},
+						},
+					},
+					Body: &semantic.StringExpression{
+						Parts: []semantic.StringExpressionPart{
+							&semantic.TextPart{Value: "r = "},
+							&semantic.InterpolatedPart{Expression: &semantic.IdentifierExpression{Name: "r"}},
+							&semantic.TextPart{Value: "!"},
+						},
+					},
+				},
+			},
+			inType: semantic.NewObjectType(map[string]semantic.Type{
+				"r": semantic.String,
+			}),
+			input: values.NewObjectWithValues(map[string]values.Value{
+				"r": values.NewString("a"),
+			}),
+			want:    values.NewString("r = a!"),
+			wantErr: false,
+		},
 		{
 			name: "call function",
 			// f = (r) => ((a,b) => a + b)(a:1, b:r)
@@ -484,6 +512,52 @@ func TestCompileAndEval(t *testing.T) {
 			}),
 			want: values.NewString("cats"),
 		},
+		{
+			name: "extend object",
+			// f = (r) => ({r with c: r.a + r.b}).b
+			fn: &semantic.FunctionExpression{
+				Block: &semantic.FunctionBlock{
+					Parameters: &semantic.FunctionParameters{
+						List: []*semantic.FunctionParameter{
+							{Key: &semantic.Identifier{Name: "r"}},
+						},
+					},
+					Body: &semantic.MemberExpression{
+						Object: &semantic.ObjectExpression{
+							With: &semantic.IdentifierExpression{Name: "r"},
+							Properties: []*semantic.Property{{
+								Key: &semantic.Identifier{Name: "c"},
+								Value: &semantic.BinaryExpression{
+									Operator: ast.AdditionOperator,
+									Left: &semantic.MemberExpression{
+										Object:   &semantic.IdentifierExpression{Name: "r"},
+										Property: "a",
+									},
+									Right: &semantic.MemberExpression{
+										Object:   &semantic.IdentifierExpression{Name: "r"},
+										Property: "b",
+									},
+								},
+							}},
+						},
+						Property: "b",
+					},
+				},
+			},
+			inType: semantic.NewObjectType(map[string]semantic.Type{
+				"r": semantic.NewObjectType(map[string]semantic.Type{
+					"a": semantic.Int,
+					"b": semantic.Int,
+				}),
+			}),
+			input: values.NewObjectWithValues(map[string]values.Value{
+				"r": values.NewObjectWithValues(map[string]values.Value{
+					"a": values.NewInt(1),
+					"b": values.NewInt(2),
+				}),
+			}),
+			want: values.NewInt(2),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -3,6 +3,7 @@ package compiler
 import (
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/influxdata/flux/ast"
 	"github.com/influxdata/flux/semantic"
@@ -486,6 +487,7 @@ func (e *declarationEvaluator) EvalFunction(scope Scope) (values.Function, error
 
 type objEvaluator struct {
 	t          semantic.Type
+	with       Evaluator
 	properties map[string]Evaluator
 }
 
@@ -522,6 +524,15 @@ func (e *objEvaluator) EvalArray(scope Scope) (values.Array, error) {
 }
 func (e *objEvaluator) EvalObject(scope Scope) (values.Object, error) {
 	obj := values.NewObject()
+	if e.with != nil {
+		with, err := e.with.EvalObject(scope)
+		if err != nil {
+			return nil, err
+		}
+		with.Range(func(k string, v values.Value) {
+			obj.Set(k, v)
+		})
+	}
 	for k, node := range e.properties {
 		v, err := eval(node, scope)
 		if err != nil {
@@ -987,6 +998,57 @@ func (e *stringEvaluator) EvalFunction(scope Scope) (values.Function, error) {
 	panic(values.UnexpectedKind(e.t.Nature(), semantic.Function))
 }
 
+type stringExpressionEvaluator struct {
+	t     semantic.Type
+	parts []Evaluator
+}
+
+func (e *stringExpressionEvaluator) Type() semantic.Type {
+	return e.t
+}
+
+func (e *stringExpressionEvaluator) EvalString(scope Scope) (string, error) {
+	var b strings.Builder
+	for _, p := range e.parts {
+		s, err := p.EvalString(scope)
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(s)
+	}
+	return b.String(), nil
+}
+func (e *stringExpressionEvaluator) EvalInt(scope Scope) (int64, error) {
+	panic(values.UnexpectedKind(e.t.Nature(), semantic.Int))
+}
+func (e *stringExpressionEvaluator) EvalUInt(scope Scope) (uint64, error) {
+	panic(values.UnexpectedKind(e.t.Nature(), semantic.UInt))
+}
+func (e *stringExpressionEvaluator) EvalFloat(scope Scope) (float64, error) {
+	panic(values.UnexpectedKind(e.t.Nature(), semantic.Float))
+}
+func (e *stringExpressionEvaluator) EvalBool(scope Scope) (bool, error) {
+	panic(values.UnexpectedKind(e.t.Nature(), semantic.Bool))
+}
+func (e *stringExpressionEvaluator) EvalTime(scope Scope) (values.Time, error) {
+	panic(values.UnexpectedKind(e.t.Nature(), semantic.Time))
+}
+func (e *stringExpressionEvaluator) EvalDuration(scope Scope) (values.Duration, error) {
+	panic(values.UnexpectedKind(e.t.Nature(), semantic.Duration))
+}
+func (e *stringExpressionEvaluator) EvalRegexp(scope Scope) (*regexp.Regexp, error) {
+	panic(values.UnexpectedKind(e.t.Nature(), semantic.Regexp))
+}
+func (e *stringExpressionEvaluator) EvalArray(scope Scope) (values.Array, error) {
+	panic(values.UnexpectedKind(e.t.Nature(), semantic.Array))
+}
+func (e *stringExpressionEvaluator) EvalObject(scope Scope) (values.Object, error) {
+	panic(values.UnexpectedKind(e.t.Nature(), semantic.Object))
+}
+func (e *stringExpressionEvaluator) EvalFunction(scope Scope) (values.Function, error) {
+	panic(values.UnexpectedKind(e.t.Nature(), semantic.Function))
+}
+
 type regexpEvaluator struct {
 	t semantic.Type
 	r *regexp.Regexp
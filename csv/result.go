@@ -2,6 +2,9 @@
 package csv
 
 import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
 	"encoding/csv"
 	"encoding/json"
 	"fmt"
@@ -71,9 +74,20 @@ type ResultDecoderConfig struct {
 	// MaxBufferCount is the maximum number of rows that will be buffered when decoding.
 	// If 0, then a value of 1000 will be used.
 	MaxBufferCount int
+	// DetectGzip indicates that the CSV data may be gzip compressed.
+	// The data is decompressed if it starts with the gzip magic bytes
+	// and is read as plain CSV otherwise.
+	DetectGzip bool
 }
 
 func (d *ResultDecoder) Decode(r io.Reader) (flux.Result, error) {
+	if d.c.DetectGzip {
+		zr, err := gunzipIfCompressed(r)
+		if err != nil {
+			return nil, err
+		}
+		r = zr
+	}
 	return newResultDecoder(newCSVReader(r), d.c, nil)
 }
 
@@ -94,13 +108,42 @@ func NewMultiResultDecoder(c ResultDecoderConfig) *MultiResultDecoder {
 }
 
 func (d *MultiResultDecoder) Decode(r io.ReadCloser) (flux.ResultIterator, error) {
+	var cr io.Reader = r
+	if d.c.DetectGzip {
+		zr, err := gunzipIfCompressed(r)
+		if err != nil {
+			r.Close()
+			return nil, err
+		}
+		cr = zr
+	}
 	return &resultIterator{
 		c:  d.c,
 		r:  r,
-		cr: newCSVReader(r),
+		cr: newCSVReader(cr),
 	}, nil
 }
 
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// gunzipIfCompressed returns a reader that decompresses r if it
+// starts with the gzip magic bytes, and otherwise reads r unchanged.
+func gunzipIfCompressed(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(len(gzipMagic))
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if !bytes.Equal(magic, gzipMagic) {
+		return br, nil
+	}
+	zr, err := gzip.NewReader(br)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read gzip compressed csv")
+	}
+	return zr, nil
+}
+
 // resultIterator iterates through the results encoded in r.
 type resultIterator struct {
 	c    ResultDecoderConfig
@@ -377,9 +420,10 @@ func readMetadata(r *csv.Reader, c ResultDecoderConfig, extraLine []string) (tab
 		}
 		cols[j].ColMeta.Label = label
 		cols[j].ColMeta.Type = t
+		cols[j].datatype = datatypes[j]
 		if t == flux.TTime {
 			switch desc {
-			case "RFC3339":
+			case "", "RFC3339":
 				cols[j].fmt = time.RFC3339
 			case "RFC3339Nano":
 				cols[j].fmt = time.RFC3339Nano
@@ -518,7 +562,7 @@ func (d *tableDecoder) advance(extraLine []string) (bool, error) {
 		// whatever this line is, it's not part of this table so goto DONE
 		if len(line) != d.meta.NumFields {
 			if len(line) > annotationIdx && line[annotationIdx] == "" {
-				return false, csv.ErrFieldCount
+				return false, d.fieldCountError(line)
 			}
 			goto DONE
 		}
@@ -565,6 +609,18 @@ DONE:
 	return false, nil
 }
 
+// fieldCountError reports a data row, which must be the last line read,
+// that does not have the number of fields declared by the table header.
+func (d *tableDecoder) fieldCountError(line []string) error {
+	id := d.id
+	if !d.initialized && len(line) > tableIdx {
+		id = line[tableIdx]
+	}
+	n, _ := d.r.FieldPos(0)
+	err := &csv.ParseError{StartLine: n, Line: n, Column: 1, Err: csv.ErrFieldCount}
+	return errors.Wrapf(err, "table %s of result %q has %d fields but the record has %d", id, d.meta.ResultID, d.meta.NumFields, len(line))
+}
+
 func (d *tableDecoder) init(line []string) error {
 	if len(line) != 0 {
 		d.id = line[tableIdx]
@@ -577,36 +633,44 @@ func (d *tableDecoder) init(line []string) error {
 	if len(line) != 0 {
 		record = line[recordStartIdx:]
 	}
-	keyCols := make([]flux.ColMeta, 0, len(d.meta.Cols))
-	keyValues := make([]values.Value, 0, len(d.meta.Cols))
-	for j, c := range d.meta.Cols {
-		if d.meta.Groups[j] {
+	key, err := groupKeyForRecord(d.meta, record)
+	if err != nil {
+		return err
+	}
+	d.builder = execute.NewColListTableBuilder(key, newUnlimitedAllocator())
+	for _, c := range d.meta.Cols {
+		_, err := d.builder.AddCol(c.ColMeta)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// groupKeyForRecord returns the group key of the table that contains the record.
+// If the record is nil, the group key is determined from the default values.
+func groupKeyForRecord(meta tableMetadata, record []string) (flux.GroupKey, error) {
+	keyCols := make([]flux.ColMeta, 0, len(meta.Cols))
+	keyValues := make([]values.Value, 0, len(meta.Cols))
+	for j, c := range meta.Cols {
+		if meta.Groups[j] {
 			var value values.Value
 			if record != nil && record[j] != "" {
 				// TODO: consider treatment of nullValue here
 				v, err := decodeValue(record[j], c)
 				if err != nil {
-					return err
+					return nil, err
 				}
 				value = v
 			} else {
-				value = d.meta.Defaults[j]
+				value = meta.Defaults[j]
 			}
 			keyCols = append(keyCols, c.ColMeta)
 			keyValues = append(keyValues, value)
 		}
 	}
-
-	key := execute.NewGroupKey(keyCols, keyValues)
-	d.builder = execute.NewColListTableBuilder(key, newUnlimitedAllocator())
-	for _, c := range d.meta.Cols {
-		_, err := d.builder.AddCol(c.ColMeta)
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return execute.NewGroupKey(keyCols, keyValues), nil
 }
 
 func (d *tableDecoder) appendRecord(record []string) error {
@@ -640,9 +704,43 @@ func (d *tableDecoder) Cols() []flux.ColMeta {
 	return d.builder.Cols()
 }
 
+func (d *tableDecoder) annotatedCols() []colMeta {
+	return d.meta.Cols
+}
+
 type colMeta struct {
 	flux.ColMeta
 	fmt string
+	// datatype is the datatype annotation from which the column was decoded, if any.
+	datatype string
+}
+
+// annotatedTable is implemented by tables decoded from annotated CSV,
+// so that encoding them again reproduces their datatype annotations.
+type annotatedTable interface {
+	annotatedCols() []colMeta
+}
+
+// encoderCols appends the columns of a table to the meta columns of the encoder.
+// Time values are written as RFC3339Nano so that no precision is lost,
+// unless the table was decoded with a custom time layout.
+func encoderCols(metaCols []colMeta, tbl flux.Table) []colMeta {
+	var annotated []colMeta
+	if t, ok := tbl.(annotatedTable); ok {
+		annotated = t.annotatedCols()
+	}
+	cols := metaCols
+	for j, c := range tbl.Cols() {
+		cm := colMeta{ColMeta: c}
+		if j < len(annotated) && annotated[j].ColMeta == c {
+			cm = annotated[j]
+		}
+		if c.Type == flux.TTime && (cm.fmt == "" || cm.fmt == time.RFC3339) {
+			cm.fmt = time.RFC3339Nano
+		}
+		cols = append(cols, cm)
+	}
+	return cols
 }
 
 func newUnlimitedAllocator() *memory.Allocator {
@@ -665,6 +763,12 @@ type ResultEncoderConfig struct {
 	// Delimiter is the character to delimite columns.
 	// It must not be \r, \n, or the Unicode replacement character (0xFFFD).
 	Delimiter rune
+
+	// Plain indicates that plain RFC 4180 CSV should be written instead of annotated CSV.
+	// Annotations are not written and the tables of a result are flattened under a single
+	// header row, with the result and table columns identifying the table of each record.
+	// A new header row, preceded by an empty line, is only written when the columns change.
+	Plain bool
 }
 
 func (c ResultEncoderConfig) MarshalJSON() ([]byte, error) {
@@ -672,10 +776,12 @@ func (c ResultEncoderConfig) MarshalJSON() ([]byte, error) {
 		Header      bool     `json:"header,omitempty"`
 		Delimiter   string   `json:"delimiter"`
 		Annotations []string `json:"annotations,omitempty"`
+		Plain       bool     `json:"plain,omitempty"`
 	}{
 		Delimiter:   string(c.Delimiter),
 		Annotations: c.Annotations,
 		Header:      !c.NoHeader,
+		Plain:       c.Plain,
 	}
 
 	return json.Marshal(request)
@@ -686,6 +792,7 @@ func (c *ResultEncoderConfig) UnmarshalJSON(b []byte) error {
 		Header      *bool    `json:"header,omitempty"`
 		Delimiter   string   `json:"delimiter"`
 		Annotations []string `json:"annotations,omitempty"`
+		Plain       bool     `json:"plain,omitempty"`
 	}{}
 
 	if err := json.Unmarshal(b, request); err != nil {
@@ -703,6 +810,7 @@ func (c *ResultEncoderConfig) UnmarshalJSON(b []byte) error {
 	}
 
 	c.Annotations = request.Annotations
+	c.Plain = request.Plain
 
 	return nil
 }
@@ -752,6 +860,9 @@ func wrapEncodingError(err error) error {
 }
 
 func (e *ResultEncoder) Encode(w io.Writer, result flux.Result) (int64, error) {
+	if e.c.Plain {
+		return e.encodePlain(w, result)
+	}
 	tableID := 0
 	tableIDStr := "0"
 	metaCols := []colMeta{
@@ -769,14 +880,7 @@ func (e *ResultEncoder) Encode(w io.Writer, result flux.Result) (int64, error) {
 	err := result.Tables().Do(func(tbl flux.Table) error {
 		e.written = true
 		// Update cols with table cols
-		cols := metaCols
-		for _, c := range tbl.Cols() {
-			cm := colMeta{ColMeta: c}
-			if c.Type == flux.TTime {
-				cm.fmt = time.RFC3339Nano
-			}
-			cols = append(cols, cm)
-		}
+		cols := encoderCols(metaCols, tbl)
 		// pre-allocate row slice
 		row := make([]string, len(cols))
 
@@ -855,6 +959,73 @@ func (e *ResultEncoder) Encode(w io.Writer, result flux.Result) (int64, error) {
 	return writeCounter.Count(), err
 }
 
+// plainRecordStartIdx is the index of the first table column in a plain CSV row.
+const plainRecordStartIdx = 2
+
+// encodePlain writes the result as plain CSV without annotations.
+func (e *ResultEncoder) encodePlain(w io.Writer, result flux.Result) (int64, error) {
+	tableID := 0
+	metaCols := []colMeta{
+		{ColMeta: flux.ColMeta{Label: resultLabel, Type: flux.TString}},
+		{ColMeta: flux.ColMeta{Label: tableLabel, Type: flux.TInt}},
+	}
+	writeCounter := &iocounter.Writer{Writer: w}
+	writer := e.csvWriter(writeCounter)
+
+	var lastCols []colMeta
+
+	resultName := result.Name()
+	err := result.Tables().Do(func(tbl flux.Table) error {
+		e.written = true
+		cols := encoderCols(metaCols, tbl)
+		row := make([]string, len(cols))
+
+		if lastCols == nil || !equalCols(cols, lastCols) {
+			if lastCols != nil {
+				// Write out empty line if not first table
+				writer.Write(nil)
+			}
+			if !e.c.NoHeader {
+				for j, c := range cols {
+					row[j] = c.Label
+				}
+				writer.Write(row)
+			}
+		}
+
+		row[0] = resultName
+		row[1] = strconv.Itoa(tableID)
+		err := tbl.Do(func(cr flux.ColReader) error {
+			record := row[plainRecordStartIdx:]
+			l := cr.Len()
+			for i := 0; i < l; i++ {
+				for j, c := range cols[plainRecordStartIdx:] {
+					v, err := encodeValueFrom(i, j, c, cr)
+					if err != nil {
+						return err
+					}
+					record[j] = v
+				}
+				writer.Write(row)
+			}
+			writer.Flush()
+			return writer.Error()
+		})
+		if err != nil {
+			return wrapEncodingError(err)
+		}
+
+		tableID++
+		lastCols = cols
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return wrapEncodingError(err)
+		}
+		return nil
+	})
+	return writeCounter.Count(), err
+}
+
 func (e *ResultEncoder) EncodeError(w io.Writer, err error) error {
 	writer := e.csvWriter(w)
 	if e.written {
@@ -862,6 +1033,13 @@ func (e *ResultEncoder) EncodeError(w io.Writer, err error) error {
 		writer.Write(nil)
 	}
 
+	if e.c.Plain {
+		writer.Write([]string{"error", "reference"})
+		writer.Write([]string{err.Error(), ""})
+		writer.Flush()
+		return writer.Error()
+	}
+
 	for _, anno := range e.c.Annotations {
 		switch anno {
 		case datatypeAnnotation:
@@ -951,6 +1129,10 @@ func writeDatatypes(writer *csv.Writer, row []string, cols []colMeta) error {
 			row[j] = commentPrefix + datatypeAnnotation
 			continue
 		}
+		if c.datatype != "" {
+			row[j] = c.datatype
+			continue
+		}
 		switch c.Type {
 		case flux.TBool:
 			row[j] = boolDatatype
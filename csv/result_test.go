@@ -2,6 +2,7 @@ package csv_test
 
 import (
 	"bytes"
+	"compress/gzip"
 	"io/ioutil"
 	"regexp"
 	"testing"
@@ -667,6 +668,153 @@ func TestResultDecoder(t *testing.T) {
 	}
 }
 
+func TestResultDecoder_Gzip(t *testing.T) {
+	decode := func(t *testing.T, data []byte) *executetest.Result {
+		t.Helper()
+		decoder := csv.NewResultDecoder(csv.ResultDecoderConfig{DetectGzip: true})
+		result, err := decoder.Decode(bytes.NewReader(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := &executetest.Result{
+			Nm: result.Name(),
+		}
+		if err := result.Tables().Do(func(tbl flux.Table) error {
+			cb, err := executetest.ConvertTable(tbl)
+			if err != nil {
+				return err
+			}
+			got.Tbls = append(got.Tbls, cb)
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+		got.Normalize()
+		return got
+	}
+
+	for _, tc := range symmetricalTestCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.skip {
+				t.Skip()
+			}
+			plain := decode(t, tc.encoded)
+			compressed := decode(t, gzipData(t, tc.encoded))
+			if !cmp.Equal(plain, compressed) {
+				t.Error("unexpected results -plain/+gzip", cmp.Diff(plain, compressed))
+			}
+
+			tc.result.Normalize()
+			if !cmp.Equal(tc.result, compressed) {
+				t.Error("unexpected results -want/+got", cmp.Diff(tc.result, compressed))
+			}
+		})
+	}
+}
+
+func TestResultDecoder_GzipCorrupt(t *testing.T) {
+	data := gzipData(t, toCRLF(`#datatype,string,long,double
+#group,false,false,false
+#default,_result,,
+,result,table,_value
+,,0,1.0
+`))
+	// Keep the gzip header but truncate the compressed stream.
+	data = data[:len(data)/2]
+
+	decoder := csv.NewResultDecoder(csv.ResultDecoderConfig{DetectGzip: true})
+	if _, err := decoder.Decode(bytes.NewReader(data)); err == nil {
+		t.Error("expected error decoding truncated gzip data")
+	}
+}
+
+func TestResultDecoder_FieldCount(t *testing.T) {
+	testCases := []struct {
+		name    string
+		encoded []byte
+		rows    int
+		err     error
+	}{
+		{
+			name: "valid rows",
+			encoded: toCRLF(`#datatype,string,long,string,double
+#group,false,false,true,false
+#default,_result,,,
+,result,table,host,_value
+,,0,A,1.0
+,,0,A,2.0
+,,1,B,3.0
+`),
+			rows: 3,
+		},
+		{
+			name: "short row",
+			encoded: toCRLF(`#datatype,string,long,string,double
+#group,false,false,true,false
+#default,_result,,,
+,result,table,host,_value
+,,0,A,1.0
+,,0,A
+,,0,A,3.0
+`),
+			err: errors.New(`table 0 of result "_result" has 5 fields but the record has 4: record on line 6: wrong number of fields`),
+		},
+		{
+			name: "long row",
+			encoded: toCRLF(`#datatype,string,long,string,double
+#group,false,false,true,false
+#default,_result,,,
+,result,table,host,_value
+,,0,A,1.0
+,,1,B,2.0
+,,1,B,3.0,4.0
+`),
+			err: errors.New(`table 1 of result "_result" has 5 fields but the record has 6: record on line 7: wrong number of fields`),
+		},
+		{
+			name: "bad first row",
+			encoded: toCRLF(`#datatype,string,long,string,double
+#group,false,false,true,false
+#default,_result,,,
+,result,table,host,_value
+,,0,A
+`),
+			err: errors.New(`table 0 of result "_result" has 5 fields but the record has 4: record on line 5: wrong number of fields`),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			decoder := csv.NewResultDecoder(csv.ResultDecoderConfig{})
+			result, err := decoder.Decode(bytes.NewReader(tc.encoded))
+			if err != nil {
+				t.Fatal(err)
+			}
+			rows := 0
+			err = result.Tables().Do(func(tbl flux.Table) error {
+				return tbl.Do(func(cr flux.ColReader) error {
+					rows += cr.Len()
+					return nil
+				})
+			})
+			if err != nil {
+				if tc.err == nil {
+					t.Fatal(err)
+				} else if got, want := err.Error(), tc.err.Error(); got != want {
+					t.Error("unexpected error -want/+got", cmp.Diff(want, got))
+				}
+				return
+			} else if tc.err != nil {
+				t.Fatal("expected error")
+			}
+			if rows != tc.rows {
+				t.Errorf("unexpected number of rows: want %d, got %d", tc.rows, rows)
+			}
+		})
+	}
+}
+
 func TestResultEncoder(t *testing.T) {
 	testCases := []TestCase{
 		// Add tests cases specific to encoding here
@@ -808,6 +956,200 @@ func TestResultEncoder(t *testing.T) {
 	}
 }
 
+func TestResultEncoder_RoundTrip(t *testing.T) {
+	testCases := []struct {
+		name    string
+		encoded []byte
+	}{
+		{
+			name: "each datatype",
+			encoded: toCRLF(`#datatype,string,long,dateTime,dateTime:RFC3339,dateTime:RFC3339Nano,string,long,unsignedLong,double,boolean
+#group,false,false,true,true,false,true,false,false,false,false
+#default,_result,,,,,,,,,
+,result,table,_start,_stop,_time,host,count,total,_value,ok
+,,0,2018-04-17T00:00:00Z,2018-04-17T00:05:00Z,2018-04-17T00:00:00.123456789Z,A,-1,18446744073709551615,42.5,true
+,,0,2018-04-17T00:00:00Z,2018-04-17T00:05:00Z,2018-04-17T00:00:01Z,A,,,,
+`),
+		},
+		{
+			name: "custom time layout",
+			encoded: toCRLF(`#datatype,string,long,dateTime:2006-01-02,long
+#group,false,false,false,false
+#default,_result,,,
+,result,table,day,_value
+,,0,2018-04-17,1
+,,0,2018-04-18,2
+`),
+		},
+		{
+			name: "annotations change between tables",
+			encoded: toCRLF(`#datatype,string,long,dateTime:RFC3339,string,long
+#group,false,false,false,true,false
+#default,_result,,,,
+,result,table,_time,host,_value
+,,0,2018-04-17T00:00:00Z,A,1
+,,0,2018-04-17T00:00:01.5Z,A,2
+
+#datatype,string,long,dateTime:RFC3339Nano,string,unsignedLong
+#group,false,false,false,true,false
+#default,_result,,,,
+,result,table,_time,host,_value
+,,1,2018-04-17T00:00:00.000000001Z,B,3
+`),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			decoder := csv.NewResultDecoder(csv.ResultDecoderConfig{})
+			result, err := decoder.Decode(bytes.NewReader(tc.encoded))
+			if err != nil {
+				t.Fatal(err)
+			}
+			encoder := csv.NewResultEncoder(csv.DefaultEncoderConfig())
+			var got bytes.Buffer
+			if _, err := encoder.Encode(&got, result); err != nil {
+				t.Fatal(err)
+			}
+			if g, w := got.String(), string(tc.encoded); g != w {
+				t.Errorf("unexpected encoding -want/+got:\n%s", diff.LineDiff(w, g))
+			}
+		})
+	}
+}
+
+func TestResultEncoder_Plain(t *testing.T) {
+	result := func() *executetest.Result {
+		return &executetest.Result{
+			Nm: "_result",
+			Tbls: []*executetest.Table{
+				{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "host", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{values.ConvertTime(time.Date(2018, 4, 17, 0, 0, 0, 0, time.UTC)), "A", 42.0},
+						{values.ConvertTime(time.Date(2018, 4, 17, 0, 0, 1, 0, time.UTC)), "A", nil},
+					},
+				},
+				{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "host", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{values.ConvertTime(time.Date(2018, 4, 17, 0, 0, 0, 0, time.UTC)), "B,C", 43.0},
+					},
+				},
+				{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "host", Type: flux.TString},
+						{Label: "_value", Type: flux.TInt},
+					},
+					Data: [][]interface{}{
+						{values.ConvertTime(time.Date(2018, 4, 17, 0, 0, 0, 0, time.UTC)), "D", int64(7)},
+					},
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		config  csv.ResultEncoderConfig
+		encoded []byte
+	}{
+		{
+			name:   "annotated",
+			config: csv.DefaultEncoderConfig(),
+			encoded: toCRLF(`#datatype,string,long,dateTime:RFC3339,string,double
+#group,false,false,false,true,false
+#default,_result,,,,
+,result,table,_time,host,_value
+,,0,2018-04-17T00:00:00Z,A,42
+,,0,2018-04-17T00:00:01Z,A,
+,,1,2018-04-17T00:00:00Z,"B,C",43
+
+#datatype,string,long,dateTime:RFC3339,string,long
+#group,false,false,false,true,false
+#default,_result,,,,
+,result,table,_time,host,_value
+,,2,2018-04-17T00:00:00Z,D,7
+`),
+		},
+		{
+			name:   "plain",
+			config: csv.ResultEncoderConfig{Plain: true},
+			encoded: toCRLF(`result,table,_time,host,_value
+_result,0,2018-04-17T00:00:00Z,A,42
+_result,0,2018-04-17T00:00:01Z,A,
+_result,1,2018-04-17T00:00:00Z,"B,C",43
+
+result,table,_time,host,_value
+_result,2,2018-04-17T00:00:00Z,D,7
+`),
+		},
+		{
+			name:   "plain ignores annotations",
+			config: csv.ResultEncoderConfig{Plain: true, Annotations: []string{"datatype", "group", "default"}},
+			encoded: toCRLF(`result,table,_time,host,_value
+_result,0,2018-04-17T00:00:00Z,A,42
+_result,0,2018-04-17T00:00:01Z,A,
+_result,1,2018-04-17T00:00:00Z,"B,C",43
+
+result,table,_time,host,_value
+_result,2,2018-04-17T00:00:00Z,D,7
+`),
+		},
+		{
+			name:   "plain without header",
+			config: csv.ResultEncoderConfig{Plain: true, NoHeader: true},
+			encoded: toCRLF(`_result,0,2018-04-17T00:00:00Z,A,42
+_result,0,2018-04-17T00:00:01Z,A,
+_result,1,2018-04-17T00:00:00Z,"B,C",43
+
+_result,2,2018-04-17T00:00:00Z,D,7
+`),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			encoder := csv.NewResultEncoder(tc.config)
+			var got bytes.Buffer
+			n, err := encoder.Encode(&got, result())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if g, w := got.String(), string(tc.encoded); g != w {
+				t.Errorf("unexpected encoding -want/+got:\n%s", diff.LineDiff(w, g))
+			}
+			if g, w := n, int64(len(tc.encoded)); g != w {
+				t.Errorf("unexpected encoding count -want/+got:\n%s", cmp.Diff(w, g))
+			}
+		})
+	}
+}
+
+func TestResultEncoder_PlainError(t *testing.T) {
+	encoder := csv.NewResultEncoder(csv.ResultEncoderConfig{Plain: true})
+	var got bytes.Buffer
+	if err := encoder.EncodeError(&got, errors.New("query failed")); err != nil {
+		t.Fatal(err)
+	}
+	if g, w := got.String(), string(toCRLF("error,reference\nquery failed,\n")); g != w {
+		t.Errorf("unexpected encoding -want/+got:\n%s", diff.LineDiff(w, g))
+	}
+}
+
 func TestMultiResultEncoder(t *testing.T) {
 	testCases := []struct {
 		name    string
@@ -1349,6 +1691,76 @@ func TestMultiResultDecoder(t *testing.T) {
 
 var crlfPattern = regexp.MustCompile(`\r?\n`)
 
+func TestMultiResultDecoder_Gzip(t *testing.T) {
+	encoded := toCRLF(`#datatype,string,long,dateTime:RFC3339,double
+#group,false,false,false,false
+#default,_result,,,
+,result,table,_time,_value
+,,0,2018-04-17T00:00:00Z,42.0
+,,1,2018-04-17T00:00:01Z,43.0
+
+#datatype,string,long,dateTime:RFC3339,long
+#group,false,false,false,false
+#default,mean,,,
+,result,table,_time,_value
+,,0,2018-04-17T00:00:00Z,7
+
+`)
+	decode := func(t *testing.T, data []byte) []*executetest.Result {
+		t.Helper()
+		decoder := csv.NewMultiResultDecoder(csv.ResultDecoderConfig{DetectGzip: true})
+		results, err := decoder.Decode(ioutil.NopCloser(bytes.NewReader(data)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []*executetest.Result
+		for results.More() {
+			result := results.Next()
+			res := &executetest.Result{
+				Nm: result.Name(),
+			}
+			if err := result.Tables().Do(func(tbl flux.Table) error {
+				cb, err := executetest.ConvertTable(tbl)
+				if err != nil {
+					return err
+				}
+				res.Tbls = append(res.Tbls, cb)
+				return nil
+			}); err != nil {
+				t.Fatal(err)
+			}
+			res.Normalize()
+			got = append(got, res)
+		}
+		if err := results.Err(); err != nil {
+			t.Fatal(err)
+		}
+		return got
+	}
+
+	plain := decode(t, encoded)
+	if len(plain) != 2 {
+		t.Fatalf("unexpected number of results: want 2 got %d", len(plain))
+	}
+	compressed := decode(t, gzipData(t, encoded))
+	if !cmp.Equal(plain, compressed) {
+		t.Error("unexpected results -plain/+gzip", cmp.Diff(plain, compressed))
+	}
+}
+
+func gzipData(t *testing.T, data []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
 func toCRLF(data string) []byte {
 	return []byte(crlfPattern.ReplaceAllString(data, "\r\n"))
 }
@@ -0,0 +1,152 @@
+package csv
+
+import (
+	"encoding/csv"
+	"io"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/values"
+	"github.com/pkg/errors"
+)
+
+// Handler receives the tables and records of annotated CSV as they are decoded.
+type Handler interface {
+	// Table is called before the records of each table, including tables without records.
+	Table(result string, key flux.GroupKey, cols []flux.ColMeta) error
+	// Row is called for each record of the current table with one value per column.
+	// The slice is reused between calls and must not be retained.
+	Row(vs []values.Value) error
+}
+
+// Decode reads all of the results in r and passes each table and record to h.
+// Unlike the ResultDecoder and MultiResultDecoder, no table is materialized,
+// so the memory used does not depend on the size of the input.
+// Decoding stops at the first error returned by h.
+func Decode(r io.Reader, h Handler) error {
+	return NewResultDecoder(ResultDecoderConfig{}).DecodeTo(r, h)
+}
+
+// DecodeTo reads all of the results in r and passes each table and record to h.
+// See Decode.
+func (d *ResultDecoder) DecodeTo(r io.Reader, h Handler) error {
+	if d.c.DetectGzip {
+		zr, err := gunzipIfCompressed(r)
+		if err != nil {
+			return err
+		}
+		r = zr
+	}
+	s := &streamDecoder{
+		c:  d.c,
+		cr: newCSVReader(r),
+		h:  h,
+	}
+	return s.decode()
+}
+
+type streamDecoder struct {
+	c  ResultDecoderConfig
+	cr *csv.Reader
+	h  Handler
+
+	vs []values.Value
+}
+
+func (s *streamDecoder) decode() error {
+	var extraLine []string
+	for {
+		meta, err := readMetadata(s.cr, s.c, extraLine)
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return errors.Wrap(err, "failed to read meta data")
+		}
+		extraLine, err = s.decodeTables(meta)
+		if err != nil {
+			return err
+		}
+		if extraLine == nil {
+			return nil
+		}
+	}
+}
+
+// decodeTables passes the tables that share the metadata to the handler.
+// It returns the first line that is not part of the tables, or nil at the end of the input.
+// Lines read by the csv.Reader are reused, so the returned line is a copy.
+func (s *streamDecoder) decodeTables(meta tableMetadata) ([]string, error) {
+	cols := make([]flux.ColMeta, len(meta.Cols))
+	for j, c := range meta.Cols {
+		cols[j] = c.ColMeta
+	}
+	if cap(s.vs) < len(meta.Cols) {
+		s.vs = make([]values.Value, len(meta.Cols))
+	}
+	s.vs = s.vs[:len(meta.Cols)]
+
+	var next []string
+	tableID, started := "", false
+	for {
+		line, err := s.cr.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		if len(line) != meta.NumFields {
+			if len(line) > annotationIdx && line[annotationIdx] == "" {
+				return nil, csv.ErrFieldCount
+			}
+			next = copyLine(line)
+			break
+		}
+		if line[annotationIdx] != "" {
+			next = copyLine(line)
+			break
+		}
+
+		record := line[recordStartIdx:]
+		if !started || (line[tableIdx] != "" && line[tableIdx] != tableID) {
+			key, err := groupKeyForRecord(meta, record)
+			if err != nil {
+				return nil, err
+			}
+			if err := s.h.Table(meta.ResultID, key, cols); err != nil {
+				return nil, err
+			}
+			tableID, started = line[tableIdx], true
+		}
+
+		for j, c := range meta.Cols {
+			if record[j] == "" {
+				s.vs[j] = meta.Defaults[j]
+				continue
+			}
+			v, err := decodeValue(record[j], c)
+			if err != nil {
+				return nil, err
+			}
+			s.vs[j] = v
+		}
+		if err := s.h.Row(s.vs); err != nil {
+			return nil, err
+		}
+	}
+
+	if !started {
+		// The table has no records, so its group key comes from the defaults.
+		if meta.TableID == "" {
+			return nil, errors.New("missing table ID")
+		}
+		key, err := groupKeyForRecord(meta, nil)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.h.Table(meta.ResultID, key, cols); err != nil {
+			return nil, err
+		}
+	}
+	return next, nil
+}
@@ -0,0 +1,300 @@
+package csv_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"runtime"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/csv"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+	"github.com/pkg/errors"
+)
+
+// tableHandler collects the decoded tables for comparison.
+type tableHandler struct {
+	results []*executetest.Result
+	current *executetest.Table
+}
+
+func (h *tableHandler) Table(result string, key flux.GroupKey, cols []flux.ColMeta) error {
+	if n := len(h.results); n == 0 || h.results[n-1].Nm != result {
+		h.results = append(h.results, &executetest.Result{Nm: result})
+	}
+	res := h.results[len(h.results)-1]
+	h.current = &executetest.Table{
+		GroupKey: key,
+		ColMeta:  cols,
+	}
+	res.Tbls = append(res.Tbls, h.current)
+	return nil
+}
+
+func (h *tableHandler) Row(vs []values.Value) error {
+	row := make([]interface{}, len(vs))
+	for j, v := range vs {
+		if v.IsNull() {
+			continue
+		}
+		switch v.Type() {
+		case semantic.Bool:
+			row[j] = v.Bool()
+		case semantic.Int:
+			row[j] = v.Int()
+		case semantic.UInt:
+			row[j] = v.UInt()
+		case semantic.Float:
+			row[j] = v.Float()
+		case semantic.String:
+			row[j] = v.Str()
+		case semantic.Time:
+			row[j] = v.Time()
+		default:
+			return fmt.Errorf("unexpected type %v", v.Type())
+		}
+	}
+	h.current.Data = append(h.current.Data, row)
+	return nil
+}
+
+func (h *tableHandler) normalized() ([]*executetest.Result, error) {
+	for _, res := range h.results {
+		for i, tbl := range res.Tbls {
+			t, err := executetest.ConvertTable(tbl)
+			if err != nil {
+				return nil, err
+			}
+			res.Tbls[i] = t
+		}
+		res.Normalize()
+	}
+	return h.results, nil
+}
+
+func TestDecode(t *testing.T) {
+	testCases := []struct {
+		name    string
+		encoded []byte
+	}{
+		{
+			name: "multiple results",
+			encoded: toCRLF(`#datatype,string,long,dateTime:RFC3339,string,double
+#group,false,false,false,true,false
+#default,_result,,,,
+,result,table,_time,host,_value
+,,0,2018-04-17T00:00:00Z,A,42
+,,0,2018-04-17T00:00:01Z,A,
+,,1,2018-04-17T00:00:00Z,B,43
+
+#datatype,string,long,dateTime:RFC3339,string,long
+#group,false,false,false,true,false
+#default,mean,,,,
+,result,table,_time,host,_value
+,,0,2018-04-17T00:00:00Z,A,7
+
+`),
+		},
+		{
+			name: "empty table",
+			encoded: toCRLF(`#datatype,string,long,dateTime:RFC3339,string,double
+#group,false,false,false,true,false
+#default,_result,0,,A,
+,result,table,_time,host,_value
+
+#datatype,string,long,dateTime:RFC3339,string,double
+#group,false,false,false,true,false
+#default,_result,,,,
+,result,table,_time,host,_value
+,,1,2018-04-17T00:00:00Z,B,43
+`),
+		},
+	}
+	for _, tc := range symmetricalTestCases {
+		if tc.skip || tc.result.Err != nil {
+			continue
+		}
+		testCases = append(testCases, struct {
+			name    string
+			encoded []byte
+		}{name: tc.name, encoded: tc.encoded})
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			decoder := csv.NewMultiResultDecoder(csv.ResultDecoderConfig{})
+			results, err := decoder.Decode(ioutil.NopCloser(bytes.NewReader(tc.encoded)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var want []*executetest.Result
+			for results.More() {
+				result := results.Next()
+				res := &executetest.Result{Nm: result.Name()}
+				if err := result.Tables().Do(func(tbl flux.Table) error {
+					cb, err := executetest.ConvertTable(tbl)
+					if err != nil {
+						return err
+					}
+					res.Tbls = append(res.Tbls, cb)
+					return nil
+				}); err != nil {
+					t.Fatal(err)
+				}
+				res.Normalize()
+				want = append(want, res)
+			}
+			if err := results.Err(); err != nil {
+				t.Fatal(err)
+			}
+			if len(want) == 0 {
+				t.Fatal("expected at least one result")
+			}
+
+			h := new(tableHandler)
+			if err := csv.Decode(bytes.NewReader(tc.encoded), h); err != nil {
+				t.Fatal(err)
+			}
+			got, err := h.normalized()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !cmp.Equal(want, got) {
+				t.Error("unexpected results -want/+got", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func TestDecode_Error(t *testing.T) {
+	encoded := toCRLF(`#datatype,string,string
+#group,true,true
+#default,,
+,error,reference
+,query failed,
+`)
+	err := csv.Decode(bytes.NewReader(encoded), new(tableHandler))
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if got, want := errors.Cause(err).Error(), "query failed"; got != want {
+		t.Errorf("unexpected error -want/+got:\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestDecode_HandlerError(t *testing.T) {
+	encoded := toCRLF(`#datatype,string,long,long
+#group,false,false,false
+#default,_result,,
+,result,table,_value
+,,0,1
+,,0,2
+`)
+	h := &countingHandler{
+		onRow: func(n int) error {
+			if n == 1 {
+				return errors.New("stop")
+			}
+			return nil
+		},
+	}
+	if err := csv.Decode(bytes.NewReader(encoded), h); err == nil || err.Error() != "stop" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if h.rows != 1 {
+		t.Errorf("unexpected number of rows: want 1 got %d", h.rows)
+	}
+}
+
+// countingHandler checks each row without retaining it.
+type countingHandler struct {
+	tables int
+	rows   int
+	sum    int64
+	first  *values.Value
+	reused bool
+	onRow  func(n int) error
+}
+
+func (h *countingHandler) Table(result string, key flux.GroupKey, cols []flux.ColMeta) error {
+	h.tables++
+	return nil
+}
+
+func (h *countingHandler) Row(vs []values.Value) error {
+	h.rows++
+	if h.first == nil {
+		h.first = &vs[0]
+		h.reused = true
+	} else if h.first != &vs[0] {
+		h.reused = false
+	}
+	h.sum += vs[len(vs)-1].Int()
+	if h.onRow != nil {
+		return h.onRow(h.rows)
+	}
+	return nil
+}
+
+func TestDecode_Large(t *testing.T) {
+	const (
+		tables       = 4
+		rowsPerTable = 50000
+	)
+	var buf bytes.Buffer
+	buf.WriteString("#datatype,string,long,dateTime:RFC3339,string,long\r\n")
+	buf.WriteString("#group,false,false,false,true,false\r\n")
+	buf.WriteString("#default,_result,,,,\r\n")
+	buf.WriteString(",result,table,_time,host,_value\r\n")
+	var wantSum int64
+	for i := 0; i < tables; i++ {
+		for j := 0; j < rowsPerTable; j++ {
+			fmt.Fprintf(&buf, ",,%d,2018-04-17T00:00:%02dZ,host%d,%d\r\n", i, j%60, i, j)
+			wantSum += int64(j)
+		}
+	}
+	if buf.Len() < 1<<21 {
+		t.Fatalf("fixture is too small: %d bytes", buf.Len())
+	}
+
+	var before, after runtime.MemStats
+	h := &countingHandler{
+		onRow: func(n int) error {
+			switch n {
+			case 1:
+				runtime.GC()
+				runtime.ReadMemStats(&before)
+			case tables * rowsPerTable:
+				runtime.GC()
+				runtime.ReadMemStats(&after)
+			}
+			return nil
+		},
+	}
+	if err := csv.Decode(bytes.NewReader(buf.Bytes()), h); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := h.tables, tables; got != want {
+		t.Errorf("unexpected number of tables: want %d got %d", want, got)
+	}
+	if got, want := h.rows, tables*rowsPerTable; got != want {
+		t.Errorf("unexpected number of rows: want %d got %d", want, got)
+	}
+	if got, want := h.sum, wantSum; got != want {
+		t.Errorf("unexpected sum: want %d got %d", want, got)
+	}
+	if !h.reused {
+		t.Error("expected the row slice to be reused between calls")
+	}
+	// The decoded rows are garbage once the handler returns,
+	// so the live heap should not grow with the size of the input.
+	if growth := int64(after.HeapAlloc) - int64(before.HeapAlloc); growth > int64(buf.Len()/4) {
+		t.Errorf("heap grew by %d bytes while decoding %d bytes", growth, buf.Len())
+	}
+}
@@ -1,6 +1,7 @@
 package execute
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/apache/arrow/go/arrow/array"
@@ -12,6 +13,7 @@ import (
 )
 
 type aggregateTransformation struct {
+	ctx   context.Context
 	d     Dataset
 	cache TableBuilderCache
 	agg   Aggregate
@@ -58,8 +60,11 @@ func (c *AggregateConfig) ReadArgs(args flux.Arguments) error {
 	return nil
 }
 
-func NewAggregateTransformation(d Dataset, c TableBuilderCache, agg Aggregate, config AggregateConfig) *aggregateTransformation {
+// NewAggregateTransformation creates a transformation that aggregates each table with agg.
+// The aggregation stops with the context error when ctx is canceled.
+func NewAggregateTransformation(ctx context.Context, d Dataset, c TableBuilderCache, agg Aggregate, config AggregateConfig) *aggregateTransformation {
 	return &aggregateTransformation{
+		ctx:    ctx,
 		d:      d,
 		cache:  c,
 		agg:    agg,
@@ -67,10 +72,10 @@ func NewAggregateTransformation(d Dataset, c TableBuilderCache, agg Aggregate, c
 	}
 }
 
-func NewAggregateTransformationAndDataset(id DatasetID, mode AccumulationMode, agg Aggregate, config AggregateConfig, a *memory.Allocator) (*aggregateTransformation, Dataset) {
+func NewAggregateTransformationAndDataset(ctx context.Context, id DatasetID, mode AccumulationMode, agg Aggregate, config AggregateConfig, a *memory.Allocator) (*aggregateTransformation, Dataset) {
 	cache := NewTableBuilderCache(a)
 	d := NewDataset(id, mode, cache)
-	return NewAggregateTransformation(d, cache, agg, config), d
+	return NewAggregateTransformation(ctx, d, cache, agg, config), d
 }
 
 func (t *aggregateTransformation) RetractTable(id DatasetID, key flux.GroupKey) error {
@@ -107,19 +112,7 @@ func (t *aggregateTransformation) Process(id DatasetID, tbl flux.Table) error {
 		if tbl.Key().HasCol(c.Label) {
 			return errors.New("cannot aggregate columns that are part of the group key")
 		}
-		var vf ValueFunc
-		switch c.Type {
-		case flux.TBool:
-			vf = t.agg.NewBoolAgg()
-		case flux.TInt:
-			vf = t.agg.NewIntAgg()
-		case flux.TUInt:
-			vf = t.agg.NewUIntAgg()
-		case flux.TFloat:
-			vf = t.agg.NewFloatAgg()
-		case flux.TString:
-			vf = t.agg.NewStringAgg()
-		}
+		vf := NewAggregateValueFunc(t.agg, c.Type)
 		if vf == nil {
 			return fmt.Errorf("unsupported aggregate column type %v", c.Type)
 		}
@@ -137,25 +130,14 @@ func (t *aggregateTransformation) Process(id DatasetID, tbl flux.Table) error {
 	}
 
 	if err := tbl.Do(func(cr flux.ColReader) error {
-		for j := range t.config.Columns {
-			vf := aggregates[j]
-
-			tj := tableColMap[j]
-			c := tbl.Cols()[tj]
-
-			switch c.Type {
-			case flux.TBool:
-				vf.(DoBoolAgg).DoBool(cr.Bools(tj))
-			case flux.TInt:
-				vf.(DoIntAgg).DoInt(cr.Ints(tj))
-			case flux.TUInt:
-				vf.(DoUIntAgg).DoUInt(cr.UInts(tj))
-			case flux.TFloat:
-				vf.(DoFloatAgg).DoFloat(cr.Floats(tj))
-			case flux.TString:
-				vf.(DoStringAgg).DoString(cr.Strings(tj))
-			default:
-				return fmt.Errorf("unsupported aggregate type %v", c.Type)
+		// Check for cancellation between buffers so that
+		// a large table does not keep the query running.
+		if err := t.ctx.Err(); err != nil {
+			return err
+		}
+		for j, vf := range aggregates {
+			if err := DoAggregate(vf, cr, tableColMap[j]); err != nil {
+				return err
 			}
 		}
 		return nil
@@ -163,43 +145,8 @@ func (t *aggregateTransformation) Process(id DatasetID, tbl flux.Table) error {
 		return err
 	}
 	for j, vf := range aggregates {
-		bj := builderColMap[j]
-
-		// If the value is null, append a null to the column.
-		if vf.IsNull() {
-			if err := builder.AppendNil(bj); err != nil {
-				return err
-			}
-			continue
-		}
-
-		// Append aggregated value
-		switch vf.Type() {
-		case flux.TBool:
-			v := vf.(BoolValueFunc).ValueBool()
-			if err := builder.AppendBool(bj, v); err != nil {
-				return err
-			}
-		case flux.TInt:
-			v := vf.(IntValueFunc).ValueInt()
-			if err := builder.AppendInt(bj, v); err != nil {
-				return err
-			}
-		case flux.TUInt:
-			v := vf.(UIntValueFunc).ValueUInt()
-			if err := builder.AppendUInt(bj, v); err != nil {
-				return err
-			}
-		case flux.TFloat:
-			v := vf.(FloatValueFunc).ValueFloat()
-			if err := builder.AppendFloat(bj, v); err != nil {
-				return err
-			}
-		case flux.TString:
-			v := vf.(StringValueFunc).ValueString()
-			if err := builder.AppendString(bj, v); err != nil {
-				return err
-			}
+		if err := AppendAggregateValue(vf, builder, builderColMap[j]); err != nil {
+			return err
 		}
 	}
 
@@ -216,6 +163,66 @@ func (t *aggregateTransformation) Finish(id DatasetID, err error) {
 	t.d.Finish(err)
 }
 
+// NewAggregateValueFunc creates the ValueFunc of agg for a column of type typ.
+// It returns nil if agg does not support the type.
+func NewAggregateValueFunc(agg Aggregate, typ flux.ColType) ValueFunc {
+	var vf ValueFunc
+	switch typ {
+	case flux.TBool:
+		vf = agg.NewBoolAgg()
+	case flux.TInt:
+		vf = agg.NewIntAgg()
+	case flux.TUInt:
+		vf = agg.NewUIntAgg()
+	case flux.TFloat:
+		vf = agg.NewFloatAgg()
+	case flux.TString:
+		vf = agg.NewStringAgg()
+	}
+	return vf
+}
+
+// DoAggregate adds the values of column j of cr to vf,
+// which must have been created for the type of the column.
+func DoAggregate(vf ValueFunc, cr flux.ColReader, j int) error {
+	switch typ := cr.Cols()[j].Type; typ {
+	case flux.TBool:
+		vf.(DoBoolAgg).DoBool(cr.Bools(j))
+	case flux.TInt:
+		vf.(DoIntAgg).DoInt(cr.Ints(j))
+	case flux.TUInt:
+		vf.(DoUIntAgg).DoUInt(cr.UInts(j))
+	case flux.TFloat:
+		vf.(DoFloatAgg).DoFloat(cr.Floats(j))
+	case flux.TString:
+		vf.(DoStringAgg).DoString(cr.Strings(j))
+	default:
+		return fmt.Errorf("unsupported aggregate type %v", typ)
+	}
+	return nil
+}
+
+// AppendAggregateValue appends the value of vf to column j of builder,
+// or a null if the value of vf is null.
+func AppendAggregateValue(vf ValueFunc, builder TableBuilder, j int) error {
+	if vf.IsNull() {
+		return builder.AppendNil(j)
+	}
+	switch vf.Type() {
+	case flux.TBool:
+		return builder.AppendBool(j, vf.(BoolValueFunc).ValueBool())
+	case flux.TInt:
+		return builder.AppendInt(j, vf.(IntValueFunc).ValueInt())
+	case flux.TUInt:
+		return builder.AppendUInt(j, vf.(UIntValueFunc).ValueUInt())
+	case flux.TFloat:
+		return builder.AppendFloat(j, vf.(FloatValueFunc).ValueFloat())
+	case flux.TString:
+		return builder.AppendString(j, vf.(StringValueFunc).ValueString())
+	}
+	return nil
+}
+
 type Aggregate interface {
 	NewBoolAgg() DoBoolAgg
 	NewIntAgg() DoIntAgg
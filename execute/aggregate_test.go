@@ -1,6 +1,7 @@
 package execute_test
 
 import (
+	"context"
 	"sort"
 	"testing"
 
@@ -555,7 +556,7 @@ func TestAggregate_Process(t *testing.T) {
 			c := execute.NewTableBuilderCache(executetest.UnlimitedAllocator)
 			c.SetTriggerSpec(plan.DefaultTriggerSpec)
 
-			agg := execute.NewAggregateTransformation(d, c, tc.agg, tc.config)
+			agg := execute.NewAggregateTransformation(context.Background(), d, c, tc.agg, tc.config)
 
 			parentID := executetest.RandomDatasetID()
 			for _, b := range tc.data {
@@ -581,3 +582,65 @@ func TestAggregate_Process(t *testing.T) {
 		})
 	}
 }
+
+// chunkedTable repeats the buffer of an underlying table a number of times
+// and calls onChunk after each buffer has been processed.
+type chunkedTable struct {
+	*executetest.Table
+	n       int
+	onChunk func(i int)
+}
+
+func (t *chunkedTable) Do(f func(flux.ColReader) error) error {
+	for i := 0; i < t.n; i++ {
+		if err := t.Table.Do(f); err != nil {
+			return err
+		}
+		t.onChunk(i)
+	}
+	return nil
+}
+
+func TestAggregate_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	d := executetest.NewDataset(executetest.RandomDatasetID())
+	c := execute.NewTableBuilderCache(executetest.UnlimitedAllocator)
+	c.SetTriggerSpec(plan.DefaultTriggerSpec)
+
+	agg := execute.NewAggregateTransformation(ctx, d, c, new(universe.SumAgg), execute.DefaultAggregateConfig)
+
+	data := make([][]interface{}, 1000)
+	for i := range data {
+		data[i] = []interface{}{execute.Time(0), execute.Time(100), execute.Time(i), float64(i)}
+	}
+	chunks := 0
+	tbl := &chunkedTable{
+		Table: &executetest.Table{
+			KeyCols: []string{"_start", "_stop"},
+			ColMeta: []flux.ColMeta{
+				{Label: "_start", Type: flux.TTime},
+				{Label: "_stop", Type: flux.TTime},
+				{Label: "_time", Type: flux.TTime},
+				{Label: "_value", Type: flux.TFloat},
+			},
+			Data: data,
+		},
+		n: 1000000,
+		onChunk: func(i int) {
+			chunks++
+			if i == 2 {
+				cancel()
+			}
+		},
+	}
+
+	err := agg.Process(executetest.RandomDatasetID(), tbl)
+	if err != context.Canceled {
+		t.Fatalf("unexpected error -want/+got:\n\t- %v\n\t+ %v", context.Canceled, err)
+	}
+	if want, got := 3, chunks; want != got {
+		t.Errorf("unexpected number of processed buffers -want/+got:\n\t- %d\n\t+ %d", want, got)
+	}
+}
@@ -0,0 +1,97 @@
+package executetest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/values"
+)
+
+// ConvertResult converts each table of the result into a Table.
+// The tables of a result can only be read once, so the converted
+// tables should be kept to look up several tables of the same result.
+func ConvertResult(result flux.Result) ([]*Table, error) {
+	var tables []*Table
+	if err := result.Tables().Do(func(tbl flux.Table) error {
+		t, err := ConvertTable(tbl)
+		if err != nil {
+			return err
+		}
+		tables = append(tables, t)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return tables, nil
+}
+
+// FindTable returns the only table whose group key has the given values.
+// The group key may contain columns that are not given, and a nil value
+// matches a null value in the group key.
+// It is an error if no table or more than one table matches.
+func FindTable(tables []*Table, key map[string]interface{}) (*Table, error) {
+	var found []*Table
+	for _, tbl := range tables {
+		if matchKey(tbl.Key(), key) {
+			found = append(found, tbl)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("no table found with group key %s among %d tables", formatKey(key), len(tables))
+	case 1:
+		return found[0], nil
+	default:
+		return nil, fmt.Errorf("%d tables found with group key %s, want exactly one", len(found), formatKey(key))
+	}
+}
+
+func matchKey(key flux.GroupKey, want map[string]interface{}) bool {
+	for label, v := range want {
+		if !key.HasCol(label) {
+			return false
+		}
+		got := key.LabelValue(label)
+		if v == nil {
+			if !got.IsNull() {
+				return false
+			}
+			continue
+		}
+		if got.IsNull() || !got.Equal(values.New(v)) {
+			return false
+		}
+	}
+	return true
+}
+
+// formatKey formats the group key values in the order of their labels.
+func formatKey(key map[string]interface{}) string {
+	labels := make([]string, 0, len(key))
+	for label := range key {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+
+	pairs := make([]string, len(labels))
+	for i, label := range labels {
+		pairs[i] = fmt.Sprintf("%s=%v", label, key[label])
+	}
+	return "{" + strings.Join(pairs, ", ") + "}"
+}
+
+// GetRecord returns the row of the table at index i
+// as a map from each column label to its value.
+// A null value is returned as nil.
+func GetRecord(tbl *Table, i int) (map[string]interface{}, error) {
+	if i < 0 || i >= len(tbl.Data) {
+		return nil, fmt.Errorf("record index %d out of range for table with group key %v and %d records", i, tbl.Key(), len(tbl.Data))
+	}
+	record := make(map[string]interface{}, len(tbl.ColMeta))
+	for j, c := range tbl.ColMeta {
+		record[c.Label] = tbl.Data[i][j]
+	}
+	return record, nil
+}
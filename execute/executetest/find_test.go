@@ -0,0 +1,115 @@
+package executetest_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	_ "github.com/influxdata/flux/builtin"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/lang"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/values"
+)
+
+func TestFindTable_GetRecord(t *testing.T) {
+	script := `
+import "csv"
+
+data = "
+#datatype,string,long,dateTime:RFC3339,string,string,double
+#group,false,false,false,true,true,false
+#default,_result,,,,,
+,result,table,_time,_measurement,host,_value
+,,0,2018-05-22T00:00:00Z,cpu,a,1.0
+,,0,2018-05-22T00:00:10Z,cpu,a,2.0
+,,1,2018-05-22T00:00:00Z,cpu,b,3.0
+,,1,2018-05-22T00:00:10Z,cpu,b,4.0
+,,2,2018-05-22T00:00:00Z,mem,a,5.0
+"
+
+csv.from(csv: data)
+    |> range(start: 2018-05-22T00:00:00Z, stop: 2018-05-22T00:01:00Z)
+    |> sort(columns: ["_value"], desc: true)
+`
+	program, err := lang.Compile(script, time.Unix(0, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := program.Start(context.Background(), &memory.Allocator{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer q.Done()
+
+	res, ok := <-q.Results()
+	if !ok {
+		t.Fatalf("query returned no results: %v", q.Err())
+	}
+	tables, err := executetest.ConvertResult(res)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tbl, err := executetest.FindTable(tables, map[string]interface{}{
+		"_measurement": "cpu",
+		"host":         "b",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := executetest.GetRecord(tbl, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{
+		"_start":       values.ConvertTime(time.Date(2018, 5, 22, 0, 0, 0, 0, time.UTC)),
+		"_stop":        values.ConvertTime(time.Date(2018, 5, 22, 0, 1, 0, 0, time.UTC)),
+		"_time":        values.ConvertTime(time.Date(2018, 5, 22, 0, 0, 10, 0, time.UTC)),
+		"_measurement": "cpu",
+		"host":         "b",
+		"_value":       4.0,
+	}
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected record -want/+got\n%s", cmp.Diff(want, got))
+	}
+
+	if _, err := executetest.GetRecord(tbl, 2); err == nil {
+		t.Error("expected an error for a record index out of range")
+	} else if want, got := `record index 2 out of range for table with group key {_start=2018-05-22T00:00:00.000000000Z,_stop=2018-05-22T00:01:00.000000000Z,_measurement=cpu,host=b} and 2 records`, err.Error(); want != got {
+		t.Errorf("unexpected error -want/+got\n\t- %s\n\t+ %s", want, got)
+	}
+
+	for _, tc := range []struct {
+		name    string
+		key     map[string]interface{}
+		wantErr string
+	}{
+		{
+			name:    "no match",
+			key:     map[string]interface{}{"host": "c"},
+			wantErr: `no table found with group key {host=c} among 3 tables`,
+		},
+		{
+			name:    "not a key column",
+			key:     map[string]interface{}{"_value": 1.0},
+			wantErr: `no table found with group key {_value=1} among 3 tables`,
+		},
+		{
+			name:    "several matches",
+			key:     map[string]interface{}{"host": "a"},
+			wantErr: `2 tables found with group key {host=a}, want exactly one`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := executetest.FindTable(tables, tc.key)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if want, got := tc.wantErr, err.Error(); want != got {
+				t.Errorf("unexpected error -want/+got\n\t- %s\n\t+ %s", want, got)
+			}
+		})
+	}
+}
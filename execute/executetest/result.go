@@ -60,25 +60,12 @@ func EqualResults(want, got []flux.Result) (bool, error) {
 		if w.Name() != g.Name() {
 			return false, fmt.Errorf("unexpected result name - want %s, got %s", w.Name(), g.Name())
 		}
-		var wt, gt []*Table
-		if err := w.Tables().Do(func(tbl flux.Table) error {
-			t, err := ConvertTable(tbl)
-			if err != nil {
-				return err
-			}
-			wt = append(wt, t)
-			return nil
-		}); err != nil {
+		wt, err := ConvertResult(w)
+		if err != nil {
 			return false, err
 		}
-		if err := g.Tables().Do(func(tbl flux.Table) error {
-			t, err := ConvertTable(tbl)
-			if err != nil {
-				return err
-			}
-			gt = append(gt, t)
-			return nil
-		}); err != nil {
+		gt, err := ConvertResult(g)
+		if err != nil {
 			return false, err
 		}
 		NormalizeTables(wt)
@@ -66,6 +66,11 @@ type executionState struct {
 
 	dispatcher *poolDispatcher
 	logger     *zap.Logger
+
+	// profiler is set when the dependencies contain a profiler,
+	// sourceOps then holds the profile of each source.
+	profiler  *Profiler
+	sourceOps []*operationProfile
 }
 
 func (e *executor) Execute(ctx context.Context, p *plan.Spec, a *memory.Allocator) (map[string]flux.Result, <-chan flux.Metadata, error) {
@@ -98,10 +103,12 @@ func (e *executor) createExecutionState(ctx context.Context, p *plan.Spec, a *me
 		// TODO(nathanielc): Have the planner specify the dispatcher throughput
 		dispatcher: newPoolDispatcher(10, e.logger),
 	}
+	es.profiler, _ = e.deps[ProfilerKey].(*Profiler)
 	v := &createExecutionNodeVisitor{
-		ctx:   ctx,
-		es:    es,
-		nodes: make(map[plan.Node]Node),
+		ctx:       ctx,
+		es:        es,
+		nodes:     make(map[plan.Node]Node),
+		uncounted: make(map[plan.Node]*operationProfile),
 	}
 
 	if err := p.BottomUpWalk(v.Visit); err != nil {
@@ -122,6 +129,20 @@ type createExecutionNodeVisitor struct {
 	ctx   context.Context
 	es    *executionState
 	nodes map[plan.Node]Node
+
+	// uncounted holds the profile of each node whose output
+	// is not yet counted because it has no consumer yet.
+	uncounted map[plan.Node]*operationProfile
+}
+
+// addTransformation adds t as a consumer of the output of node.
+// When profiling, the output of a node is counted through its first consumer.
+func (v *createExecutionNodeVisitor) addTransformation(node plan.Node, t Transformation) {
+	if op, ok := v.uncounted[node]; ok {
+		t = &countingTransformation{Transformation: t, op: op}
+		delete(v.uncounted, node)
+	}
+	v.nodes[node].AddTransformation(t)
 }
 
 func skipYields(pn plan.Node) plan.Node {
@@ -159,10 +180,16 @@ func (v *createExecutionNodeVisitor) Visit(node plan.Node) error {
 	if yieldSpec, ok := spec.(plan.YieldProcedureSpec); ok {
 		r := newResult(yieldSpec.YieldName())
 		v.es.results[yieldSpec.YieldName()] = r
-		v.nodes[skipYields(node)].AddTransformation(r)
+		v.addTransformation(skipYields(node), r)
 		return nil
 	}
 
+	var op *operationProfile
+	if v.es.profiler != nil {
+		op = v.es.profiler.operation(node.ID(), kind)
+		v.uncounted[node] = op
+	}
+
 	// Add explicit stream context if bounds are set on this node
 	var streamContext streamContext
 	if node.Bounds() != nil {
@@ -199,6 +226,9 @@ func (v *createExecutionNodeVisitor) Visit(node plan.Node) error {
 		}
 
 		v.es.sources = append(v.es.sources, source)
+		if op != nil {
+			v.es.sourceOps = append(v.es.sourceOps, op)
+		}
 		v.nodes[node] = source
 	} else {
 
@@ -216,6 +246,10 @@ func (v *createExecutionNodeVisitor) Visit(node plan.Node) error {
 			return err
 		}
 
+		if op != nil {
+			tr = &profiledTransformation{t: tr, op: op}
+		}
+
 		if ppn.TriggerSpec == nil {
 			ppn.TriggerSpec = plan.DefaultTriggerSpec
 		}
@@ -223,17 +257,16 @@ func (v *createExecutionNodeVisitor) Visit(node plan.Node) error {
 		v.nodes[node] = ds
 
 		for _, p := range nonYieldPredecessors(node) {
-			executionNode := v.nodes[p]
 			transport := newConsecutiveTransport(v.es.dispatcher, tr)
 			v.es.transports = append(v.es.transports, transport)
-			executionNode.AddTransformation(transport)
+			v.addTransformation(p, transport)
 		}
 
 		if plan.HasSideEffect(spec) && len(node.Successors()) == 0 {
 			name := string(node.ID())
 			r := newResult(name)
 			v.es.results[name] = r
-			v.nodes[skipYields(node)].AddTransformation(r)
+			v.addTransformation(skipYields(node), r)
 		}
 	}
 
@@ -248,7 +281,11 @@ func (es *executionState) abort(err error) {
 
 func (es *executionState) do(ctx context.Context) {
 	var wg sync.WaitGroup
-	for _, src := range es.sources {
+	for i, src := range es.sources {
+		var op *operationProfile
+		if es.profiler != nil {
+			op = es.sourceOps[i]
+		}
 		wg.Add(1)
 		go func(src Source) {
 			defer wg.Done()
@@ -277,6 +314,9 @@ func (es *executionState) do(ctx context.Context) {
 					}
 				}
 			}()
+			if op != nil {
+				defer op.since(time.Now())
+			}
 			src.Run(ctx)
 
 			if mdn, ok := src.(MetadataNode); ok {
@@ -0,0 +1,143 @@
+package execute
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/plan"
+)
+
+// ProfilerKey is the key for the profiler within the Dependencies.
+// The profiler must be a *Profiler. When it is set, the executor records
+// the time spent within and the output of each operation of a query.
+// When it is not set, the operations are not instrumented.
+const ProfilerKey = "profiler"
+
+// Profiler records the wall time spent within each operation of a query,
+// and the number of tables and rows each operation produces.
+// A profiler must only be used to execute a single query.
+type Profiler struct {
+	mu  sync.Mutex
+	ops []*operationProfile
+}
+
+// NewProfiler creates a profiler that may be added to the Dependencies with the ProfilerKey.
+func NewProfiler() *Profiler {
+	return new(Profiler)
+}
+
+// OperationProfile is the profile of a single operation of a query.
+type OperationProfile struct {
+	// ID is the id of the plan node of the operation.
+	ID plan.NodeID
+	// Kind is the procedure kind of the operation.
+	Kind plan.ProcedureKind
+	// Duration is the wall time spent within the operation.
+	// It does not include the time spent by the operations that consume its output.
+	Duration time.Duration
+	// Tables is the number of tables produced by the operation.
+	Tables int64
+	// Rows is the number of rows produced by the operation.
+	Rows int64
+}
+
+// Profile returns the profile of each operation in the order the operations were created,
+// which is such that an operation follows the operations that produce its input.
+// The table and row counts are complete once the results of the query have been read,
+// and the durations once the execution of the query has finished.
+func (p *Profiler) Profile() []OperationProfile {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	profile := make([]OperationProfile, len(p.ops))
+	for i, op := range p.ops {
+		profile[i] = OperationProfile{
+			ID:       op.id,
+			Kind:     op.kind,
+			Duration: time.Duration(atomic.LoadInt64(&op.duration)),
+			Tables:   atomic.LoadInt64(&op.tables),
+			Rows:     atomic.LoadInt64(&op.rows),
+		}
+	}
+	return profile
+}
+
+func (p *Profiler) operation(id plan.NodeID, kind plan.ProcedureKind) *operationProfile {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	op := &operationProfile{id: id, kind: kind}
+	p.ops = append(p.ops, op)
+	return op
+}
+
+// operationProfile accumulates the profile of an operation.
+// The counters are updated atomically since an operation
+// and the consumers of its output may run concurrently.
+type operationProfile struct {
+	id   plan.NodeID
+	kind plan.ProcedureKind
+
+	duration int64
+	tables   int64
+	rows     int64
+}
+
+func (op *operationProfile) since(start time.Time) {
+	atomic.AddInt64(&op.duration, int64(time.Since(start)))
+}
+
+// profiledTransformation records the time spent within each call to a transformation.
+type profiledTransformation struct {
+	t  Transformation
+	op *operationProfile
+}
+
+func (t *profiledTransformation) RetractTable(id DatasetID, key flux.GroupKey) error {
+	defer t.op.since(time.Now())
+	return t.t.RetractTable(id, key)
+}
+
+func (t *profiledTransformation) Process(id DatasetID, tbl flux.Table) error {
+	defer t.op.since(time.Now())
+	return t.t.Process(id, tbl)
+}
+
+func (t *profiledTransformation) UpdateWatermark(id DatasetID, mark Time) error {
+	defer t.op.since(time.Now())
+	return t.t.UpdateWatermark(id, mark)
+}
+
+func (t *profiledTransformation) UpdateProcessingTime(id DatasetID, pt Time) error {
+	defer t.op.since(time.Now())
+	return t.t.UpdateProcessingTime(id, pt)
+}
+
+func (t *profiledTransformation) Finish(id DatasetID, err error) {
+	defer t.op.since(time.Now())
+	t.t.Finish(id, err)
+}
+
+// countingTransformation counts the tables and rows an operation sends to one of its consumers.
+// The rows are counted as the consumer reads them.
+type countingTransformation struct {
+	Transformation
+	op *operationProfile
+}
+
+func (t *countingTransformation) Process(id DatasetID, tbl flux.Table) error {
+	atomic.AddInt64(&t.op.tables, 1)
+	return t.Transformation.Process(id, &countingTable{Table: tbl, op: t.op})
+}
+
+type countingTable struct {
+	flux.Table
+	op *operationProfile
+}
+
+func (t *countingTable) Do(f func(flux.ColReader) error) error {
+	return t.Table.Do(func(cr flux.ColReader) error {
+		atomic.AddInt64(&t.op.rows, int64(cr.Len()))
+		return f(cr)
+	})
+}
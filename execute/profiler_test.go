@@ -0,0 +1,154 @@
+package execute_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/plan/plantest"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/stdlib/universe"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestExecutor_Profiler(t *testing.T) {
+	cols := []flux.ColMeta{
+		{Label: "host", Type: flux.TString},
+		{Label: "_time", Type: flux.TTime},
+		{Label: "_value", Type: flux.TFloat},
+	}
+	spec := &plantest.PlanSpec{
+		Nodes: []plan.Node{
+			plan.CreatePhysicalNode("from-test", executetest.NewFromProcedureSpec(
+				[]*executetest.Table{
+					{
+						KeyCols: []string{"host"},
+						ColMeta: cols,
+						Data: [][]interface{}{
+							{"a", execute.Time(0), 1.0},
+							{"a", execute.Time(1), 2.0},
+							{"a", execute.Time(2), 3.0},
+							{"a", execute.Time(3), 4.0},
+						},
+					},
+					{
+						KeyCols: []string{"host"},
+						ColMeta: cols,
+						Data: [][]interface{}{
+							{"b", execute.Time(0), 1.0},
+							{"b", execute.Time(1), 5.0},
+						},
+					},
+				},
+			)),
+			plan.CreatePhysicalNode("filter", &universe.FilterProcedureSpec{
+				Fn: &semantic.FunctionExpression{
+					Block: &semantic.FunctionBlock{
+						Parameters: &semantic.FunctionParameters{
+							List: []*semantic.FunctionParameter{{Key: &semantic.Identifier{Name: "r"}}},
+						},
+						Body: &semantic.BinaryExpression{
+							Operator: ast.LessThanOperator,
+							Left: &semantic.MemberExpression{
+								Object:   &semantic.IdentifierExpression{Name: "r"},
+								Property: "_value",
+							},
+							Right: &semantic.FloatLiteral{Value: 2.5},
+						},
+					},
+				},
+			}),
+			plan.CreatePhysicalNode("sum", &universe.SumProcedureSpec{
+				AggregateConfig: execute.DefaultAggregateConfig,
+			}),
+			plan.CreatePhysicalNode("yield", executetest.NewYieldProcedureSpec("_result")),
+		},
+		Edges: [][2]int{
+			{0, 1},
+			{1, 2},
+			{2, 3},
+		},
+		Resources: flux.ResourceManagement{
+			ConcurrencyQuota: 1,
+			MemoryBytesQuota: math.MaxInt64,
+		},
+		Now: time.Now(),
+	}
+
+	profiler := execute.NewProfiler()
+	deps := execute.Dependencies{execute.ProfilerKey: profiler}
+	exe := execute.NewExecutor(deps, zaptest.NewLogger(t))
+	results, _, err := exe.Execute(context.Background(), plantest.CreatePlanSpec(spec), executetest.UnlimitedAllocator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range results {
+		if err := r.Tables().Do(func(tbl flux.Table) error {
+			_, err := executetest.ConvertTable(tbl)
+			return err
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := []execute.OperationProfile{
+		{ID: "from-test", Kind: executetest.FromTestKind, Tables: 2, Rows: 6},
+		{ID: "filter", Kind: universe.FilterKind, Tables: 2, Rows: 3},
+		{ID: "sum", Kind: universe.SumKind, Tables: 2, Rows: 2},
+	}
+	got := profiler.Profile()
+	if !cmp.Equal(want, got, cmpopts.IgnoreFields(execute.OperationProfile{}, "Duration")) {
+		t.Errorf("unexpected profile -want/+got:\n%s", cmp.Diff(want, got, cmpopts.IgnoreFields(execute.OperationProfile{}, "Duration")))
+	}
+	for _, op := range got {
+		if op.Duration < 0 {
+			t.Errorf("operation %s has a negative duration %v", op.ID, op.Duration)
+		}
+	}
+}
+
+func TestExecutor_ProfilerDisabled(t *testing.T) {
+	spec := &plantest.PlanSpec{
+		Nodes: []plan.Node{
+			plan.CreatePhysicalNode("from-test", executetest.NewFromProcedureSpec(
+				[]*executetest.Table{{
+					ColMeta: []flux.ColMeta{{Label: "_value", Type: flux.TFloat}},
+					Data:    [][]interface{}{{1.0}},
+				}},
+			)),
+			plan.CreatePhysicalNode("yield", executetest.NewYieldProcedureSpec("_result")),
+		},
+		Edges: [][2]int{{0, 1}},
+		Resources: flux.ResourceManagement{
+			ConcurrencyQuota: 1,
+			MemoryBytesQuota: math.MaxInt64,
+		},
+		Now: time.Now(),
+	}
+
+	profiler := execute.NewProfiler()
+	exe := execute.NewExecutor(execute.Dependencies{}, zaptest.NewLogger(t))
+	results, _, err := exe.Execute(context.Background(), plantest.CreatePlanSpec(spec), executetest.UnlimitedAllocator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range results {
+		if err := r.Tables().Do(func(tbl flux.Table) error {
+			_, err := executetest.ConvertTable(tbl)
+			return err
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := profiler.Profile(); len(got) != 0 {
+		t.Errorf("expected an empty profile for a profiler that is not a dependency, got %v", got)
+	}
+}
@@ -498,9 +498,17 @@ type TableBuilder interface {
 	// Sort the rows of the by the values of the columns in the order listed.
 	Sort(cols []string, desc bool)
 
+	// SortBy sorts the rows by the values of the columns in the order listed.
+	// Each column is sorted in descending order when the value of desc
+	// at the same index is true. The sort is stable.
+	SortBy(cols []string, desc []bool)
+
 	// Clear removes all rows, while preserving the column meta data.
 	ClearData()
 
+	// Truncate removes all rows after the first n rows, while preserving the column meta data.
+	Truncate(n int)
+
 	// Table returns the table that has been built.
 	// Further modifications of the builder will not effect the returned table.
 	Table() (flux.Table, error)
@@ -1245,18 +1253,41 @@ func (b *ColListTableBuilder) ClearData() {
 	b.nrows = 0
 }
 
+func (b *ColListTableBuilder) Truncate(n int) {
+	if n < 0 {
+		n = 0
+	}
+	if n >= b.nrows {
+		return
+	}
+	for _, c := range b.cols {
+		c.Truncate(n)
+	}
+	b.nrows = n
+}
+
 func (b *ColListTableBuilder) Sort(cols []string, desc bool) {
+	descs := make([]bool, len(cols))
+	for i := range descs {
+		descs[i] = desc
+	}
+	b.SortBy(cols, descs)
+}
+
+func (b *ColListTableBuilder) SortBy(cols []string, desc []bool) {
 	colIdxs := make([]int, 0, len(cols))
-	for _, label := range cols {
+	colDescs := make([]bool, 0, len(cols))
+	for i, label := range cols {
 		for j, c := range b.colMeta {
 			if c.Label == label {
 				colIdxs = append(colIdxs, j)
+				colDescs = append(colDescs, desc[i])
 				break
 			}
 		}
 	}
-	s := colListTableSorter{cols: colIdxs, desc: desc, b: b}
-	sort.Sort(s)
+	s := colListTableSorter{cols: colIdxs, desc: colDescs, b: b}
+	sort.Stable(s)
 }
 
 // ColListTable implements Table using list of columns.
@@ -1369,7 +1400,7 @@ func (t *ColListTable) GetRow(row int) values.Object {
 
 type colListTableSorter struct {
 	cols []int
-	desc bool
+	desc []bool
 	b    *ColListTableBuilder
 }
 
@@ -1379,7 +1410,7 @@ func (c colListTableSorter) Len() int {
 
 func (c colListTableSorter) Less(x int, y int) (less bool) {
 	var hasNil bool
-	for _, j := range c.cols {
+	for i, j := range c.cols {
 		if !c.b.cols[j].Equal(x, y) {
 			less = c.b.cols[j].Less(x, y)
 			// The Less function for an individual column always
@@ -1390,13 +1421,15 @@ func (c colListTableSorter) Less(x int, y int) (less bool) {
 			// are nil, then the columns are considered equal
 			// and we will never reach here.
 			hasNil = c.b.cols[j].IsNil(x) || c.b.cols[j].IsNil(y)
-			break
+			if c.desc[i] && !hasNil {
+				less = !less
+			}
+			return less
 		}
 	}
-	if c.desc && !hasNil {
-		less = !less
-	}
-	return
+	// All of the sort columns are equal so neither record
+	// is less than the other, regardless of the sort order.
+	return false
 }
 
 func (c colListTableSorter) Swap(x int, y int) {
@@ -1414,6 +1447,7 @@ type column interface {
 type columnBuilder interface {
 	Meta() flux.ColMeta
 	Clear()
+	Truncate(n int)
 	Copy() column
 	Len() int
 	IsNil(i int) bool
@@ -1437,6 +1471,15 @@ func (c *columnBuilderBase) IsNil(i int) bool {
 	return c.nils[i]
 }
 
+// truncateNils removes the nil markers of all rows after the first n rows.
+func (c *columnBuilderBase) truncateNils(n int) {
+	for i := range c.nils {
+		if i >= n {
+			delete(c.nils, i)
+		}
+	}
+}
+
 func (c *columnBuilderBase) SetNil(i int, isNil bool) {
 	if isNil {
 		c.nils[i] = isNil
@@ -1518,6 +1561,12 @@ func (c *boolColumnBuilder) Clear() {
 	c.data = c.data[0:0]
 }
 
+func (c *boolColumnBuilder) Truncate(n int) {
+	c.alloc.Free(len(c.data)-n, boolSize)
+	c.data = c.data[:n]
+	c.truncateNils(n)
+}
+
 func (c *boolColumnBuilder) Copy() column {
 	var data *array.Boolean
 	if len(c.nils) > 0 {
@@ -1599,6 +1648,12 @@ func (c *intColumnBuilder) Clear() {
 	c.data = c.data[0:0]
 }
 
+func (c *intColumnBuilder) Truncate(n int) {
+	c.alloc.Free(len(c.data)-n, int64Size)
+	c.data = c.data[:n]
+	c.truncateNils(n)
+}
+
 func (c *intColumnBuilder) Copy() column {
 	var data *array.Int64
 	if len(c.nils) > 0 {
@@ -1677,6 +1732,12 @@ func (c *uintColumnBuilder) Clear() {
 	c.data = c.data[0:0]
 }
 
+func (c *uintColumnBuilder) Truncate(n int) {
+	c.alloc.Free(len(c.data)-n, uint64Size)
+	c.data = c.data[:n]
+	c.truncateNils(n)
+}
+
 func (c *uintColumnBuilder) Copy() column {
 	var data *array.Uint64
 	if len(c.nils) > 0 {
@@ -1756,6 +1817,12 @@ func (c *floatColumnBuilder) Clear() {
 	c.data = c.data[0:0]
 }
 
+func (c *floatColumnBuilder) Truncate(n int) {
+	c.alloc.Free(len(c.data)-n, float64Size)
+	c.data = c.data[:n]
+	c.truncateNils(n)
+}
+
 func (c *floatColumnBuilder) Copy() column {
 	var data *array.Float64
 	if len(c.nils) > 0 {
@@ -1835,6 +1902,12 @@ func (c *stringColumnBuilder) Clear() {
 	c.data = c.data[0:0]
 }
 
+func (c *stringColumnBuilder) Truncate(n int) {
+	c.alloc.Free(len(c.data)-n, stringSize)
+	c.data = c.data[:n]
+	c.truncateNils(n)
+}
+
 func (c *stringColumnBuilder) Copy() column {
 	var data *array.Binary
 	if len(c.nils) > 0 {
@@ -1921,6 +1994,12 @@ func (c *timeColumnBuilder) Clear() {
 	c.data = c.data[0:0]
 }
 
+func (c *timeColumnBuilder) Truncate(n int) {
+	c.alloc.Free(len(c.data)-n, timeSize)
+	c.data = c.data[:n]
+	c.truncateNils(n)
+}
+
 func (c *timeColumnBuilder) Copy() column {
 	b := arrow.NewIntBuilder(c.alloc.Allocator)
 	b.Reserve(len(c.data))
@@ -2,7 +2,10 @@ package execute
 
 import (
 	"context"
+	"encoding/binary"
 	"fmt"
+	"math/rand"
+	"time"
 
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/memory"
@@ -38,6 +41,73 @@ type Administration interface {
 // The dependencies is opaque.
 type Dependencies map[string]interface{}
 
+// SeedKey is the key for the seed within the Dependencies.
+// The seed must be an int64 and is used by any transformation that produces
+// random values so that the results of a query are reproducible.
+const SeedKey = "seed"
+
+// Rand returns a source of random values for the dataset with the given id.
+// If the dependencies contain a seed, the source is deterministic for the
+// seed and the dataset, so the same query with the same seed always produces
+// the same output. Otherwise, the source is randomly seeded.
+func (d Dependencies) Rand(id DatasetID) *rand.Rand {
+	seed, ok := d[SeedKey].(int64)
+	if !ok {
+		return rand.New(rand.NewSource(rand.Int63()))
+	}
+	// Mix the dataset id into the seed so that each
+	// transformation receives a different sequence.
+	seed ^= int64(binary.BigEndian.Uint64(id[:8]) ^ binary.BigEndian.Uint64(id[8:]))
+	return rand.New(rand.NewSource(seed))
+}
+
+// ClockKey is the key for the clock within the Dependencies.
+// The clock must be a Clock and is read when a program starts to set the
+// now option, so a query can be evaluated against a fixed time.
+const ClockKey = "clock"
+
+// Clock provides the current time.
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc is an adapter to allow the use of an ordinary function as a Clock.
+type ClockFunc func() time.Time
+
+// Now calls f().
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+// Now returns the current time according to the clock within the dependencies.
+// If the dependencies do not contain a clock, the system time is returned.
+func (d Dependencies) Now() time.Time {
+	clock, ok := d[ClockKey].(Clock)
+	if !ok {
+		return time.Now()
+	}
+	return clock.Now()
+}
+
+// SecretServiceKey is the key for the secret service within the Dependencies.
+// The secret service must be a SecretService and is used by secrets.get
+// to load the value of a secret while a program is evaluated.
+const SecretServiceKey = "secrets"
+
+// SecretService loads the values of secrets for a program.
+type SecretService interface {
+	// LoadSecret returns the value of the secret with the given key.
+	// It returns an error if the secret does not exist.
+	LoadSecret(ctx context.Context, key string) (string, error)
+}
+
+// SecretService returns the secret service within the dependencies.
+// It reports false if the dependencies do not contain a secret service.
+func (d Dependencies) SecretService() (SecretService, bool) {
+	ss, ok := d[SecretServiceKey].(SecretService)
+	return ss, ok
+}
+
 type CreateTransformation func(id DatasetID, mode AccumulationMode, spec plan.ProcedureSpec, a Administration) (Transformation, Dataset, error)
 type CreateNewPlannerTransformation func(id DatasetID, mode AccumulationMode, spec plan.ProcedureSpec, a Administration) (Transformation, Dataset, error)
 
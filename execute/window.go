@@ -1,19 +1,32 @@
 package execute
 
+import (
+	"time"
+
+	"github.com/influxdata/flux/values"
+)
+
 type Window struct {
 	Every  Duration
 	Period Duration
 	Offset Duration
+
+	// Location is the time zone used to find the start of a month
+	// when Every is a number of calendar months. A nil Location is UTC.
+	Location *time.Location
 }
 
 // NewWindow creates a window with the given parameters,
 // and normalizes the offset to a small positive duration.
 func NewWindow(every, period, offset Duration) Window {
-	// Normalize the offset to a small positive duration
-	if offset < 0 {
-		offset += every * ((offset / -every) + 1)
-	} else if offset > every {
-		offset -= every * (offset / every)
+	// Normalize the offset to a small positive duration.
+	// Calendar windows find their boundaries from the offset directly.
+	if _, ok := every.Months(); !ok {
+		if offset < 0 {
+			offset += every * ((offset / -every) + 1)
+		} else if offset > every {
+			offset -= every * (offset / every)
+		}
 	}
 
 	return Window{
@@ -27,6 +40,10 @@ func NewWindow(every, period, offset Duration) Window {
 // that contains the given time t.  For underlapping windows that
 // do not contain time t, the window directly after time t will be returned.
 func (w Window) GetEarliestBounds(t Time) Bounds {
+	if months, ok := w.Every.Months(); ok {
+		return w.calendarBounds(w.earliestMonth(t, months))
+	}
+
 	// translate to not-offset coordinate
 	t = t.Add(-w.Offset)
 
@@ -52,6 +69,15 @@ func (w Window) GetOverlappingBounds(b Bounds) []Bounds {
 	c := (b.Duration() / w.Every) + (w.Period / w.Every)
 	bs := make([]Bounds, 0, c)
 
+	if months, ok := w.Every.Months(); ok {
+		stop := w.earliestMonth(b.Start, months)
+		for bi := w.calendarBounds(stop); bi.Start < b.Stop; bi = w.calendarBounds(stop) {
+			bs = append(bs, bi)
+			stop += months
+		}
+		return bs
+	}
+
 	bi := w.GetEarliestBounds(b.Start)
 	for bi.Start < b.Stop {
 		bs = append(bs, bi)
@@ -61,3 +87,57 @@ func (w Window) GetOverlappingBounds(b Bounds) []Bounds {
 
 	return bs
 }
+
+// earliestMonth returns the month, counted from January 1970,
+// at which the window returned by GetEarliestBounds for t stops.
+// Windows stop at the start of every months months shifted by the offset.
+func (w Window) earliestMonth(t Time, months int64) int64 {
+	offsetMonths, offset := splitMonths(w.Offset)
+	tm := t.Add(-offset).Time().In(w.location())
+	month := int64(tm.Year()-1970)*12 + int64(tm.Month()-time.January)
+	return floorDiv(month-offsetMonths, months)*months + offsetMonths + months
+}
+
+// calendarBounds returns the bounds of the calendar window that stops at the start of the given month.
+func (w Window) calendarBounds(stop int64) Bounds {
+	_, offset := splitMonths(w.Offset)
+	b := Bounds{
+		Stop: w.monthStart(stop).Add(offset),
+	}
+	if months, ok := w.Period.Months(); ok {
+		b.Start = w.monthStart(stop - months).Add(offset)
+	} else {
+		b.Start = b.Stop.Add(-w.Period)
+	}
+	return b
+}
+
+// monthStart returns the time at which a month, counted from January 1970, starts in the window's location.
+func (w Window) monthStart(month int64) Time {
+	return values.ConvertTime(time.Date(1970, time.January+time.Month(month), 1, 0, 0, 0, 0, w.location()))
+}
+
+func (w Window) location() *time.Location {
+	if w.Location == nil {
+		return time.UTC
+	}
+	return w.Location
+}
+
+// splitMonths splits a duration into a number of calendar months
+// and a fixed duration, only one of which is nonzero.
+func splitMonths(d Duration) (int64, Duration) {
+	if months, ok := d.Months(); ok {
+		return months, 0
+	}
+	return 0, d
+}
+
+// floorDiv returns x divided by y rounded towards negative infinity.
+func floorDiv(x, y int64) int64 {
+	q := x / y
+	if (x%y != 0) && ((x < 0) != (y < 0)) {
+		q--
+	}
+	return q
+}
@@ -5,9 +5,28 @@ import (
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux/ast"
 	"github.com/influxdata/flux/execute"
 )
 
+const month = execute.Duration(ast.MonthDuration)
+
+func mustParseTime(s string) execute.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		panic(err)
+	}
+	return execute.Time(t.UnixNano())
+}
+
+func mustLoadLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
+
 func TestNewWindow(t *testing.T) {
 	want := execute.Window{
 		Every:  execute.Duration(time.Minute),
@@ -139,6 +158,73 @@ func TestWindow_GetEarliestBounds(t *testing.T) {
 				Stop:  execute.Time(5*time.Minute + 30*time.Second),
 			},
 		},
+		{
+			name: "calendar month in leap year",
+			w:    execute.NewWindow(month, month, 0),
+			t:    mustParseTime("2020-02-29T12:00:00Z"),
+			want: execute.Bounds{
+				Start: mustParseTime("2020-02-01T00:00:00Z"),
+				Stop:  mustParseTime("2020-03-01T00:00:00Z"),
+			},
+		},
+		{
+			name: "calendar month on boundary",
+			w:    execute.NewWindow(month, month, 0),
+			t:    mustParseTime("2019-03-01T00:00:00Z"),
+			want: execute.Bounds{
+				Start: mustParseTime("2019-03-01T00:00:00Z"),
+				Stop:  mustParseTime("2019-04-01T00:00:00Z"),
+			},
+		},
+		{
+			name: "calendar month before epoch",
+			w:    execute.NewWindow(month, month, 0),
+			t:    mustParseTime("1969-12-15T00:00:00Z"),
+			want: execute.Bounds{
+				Start: mustParseTime("1969-12-01T00:00:00Z"),
+				Stop:  mustParseTime("1970-01-01T00:00:00Z"),
+			},
+		},
+		{
+			name: "calendar month with offset",
+			w:    execute.NewWindow(month, month, execute.Duration(time.Hour)),
+			t:    mustParseTime("2020-03-01T00:30:00Z"),
+			want: execute.Bounds{
+				Start: mustParseTime("2020-02-01T01:00:00Z"),
+				Stop:  mustParseTime("2020-03-01T01:00:00Z"),
+			},
+		},
+		{
+			name: "calendar year",
+			w:    execute.NewWindow(12*month, 12*month, 0),
+			t:    mustParseTime("2020-06-01T00:00:00Z"),
+			want: execute.Bounds{
+				Start: mustParseTime("2020-01-01T00:00:00Z"),
+				Stop:  mustParseTime("2021-01-01T00:00:00Z"),
+			},
+		},
+		{
+			name: "calendar month with fixed period",
+			w:    execute.NewWindow(month, execute.Duration(24*time.Hour), 0),
+			t:    mustParseTime("2020-02-29T12:00:00Z"),
+			want: execute.Bounds{
+				Start: mustParseTime("2020-02-29T00:00:00Z"),
+				Stop:  mustParseTime("2020-03-01T00:00:00Z"),
+			},
+		},
+		{
+			name: "calendar month across daylight saving time",
+			w: execute.Window{
+				Every:    month,
+				Period:   month,
+				Location: mustLoadLocation("America/New_York"),
+			},
+			t: mustParseTime("2019-03-15T12:00:00Z"),
+			want: execute.Bounds{
+				Start: mustParseTime("2019-03-01T05:00:00Z"),
+				Stop:  mustParseTime("2019-04-01T04:00:00Z"),
+			},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -264,6 +350,79 @@ func TestWindow_GetOverlappingBounds(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "calendar months across february",
+			w: execute.Window{
+				Every:  month,
+				Period: month,
+			},
+			b: execute.Bounds{
+				Start: mustParseTime("2020-01-15T00:00:00Z"),
+				Stop:  mustParseTime("2020-03-15T00:00:00Z"),
+			},
+			want: []execute.Bounds{
+				{
+					Start: mustParseTime("2020-01-01T00:00:00Z"),
+					Stop:  mustParseTime("2020-02-01T00:00:00Z"),
+				},
+				{
+					Start: mustParseTime("2020-02-01T00:00:00Z"),
+					Stop:  mustParseTime("2020-03-01T00:00:00Z"),
+				},
+				{
+					Start: mustParseTime("2020-03-01T00:00:00Z"),
+					Stop:  mustParseTime("2020-04-01T00:00:00Z"),
+				},
+			},
+		},
+		{
+			name: "calendar months across daylight saving time",
+			w: execute.Window{
+				Every:    month,
+				Period:   month,
+				Location: mustLoadLocation("America/New_York"),
+			},
+			b: execute.Bounds{
+				Start: mustParseTime("2019-02-10T00:00:00Z"),
+				Stop:  mustParseTime("2019-04-10T00:00:00Z"),
+			},
+			want: []execute.Bounds{
+				{
+					Start: mustParseTime("2019-02-01T05:00:00Z"),
+					Stop:  mustParseTime("2019-03-01T05:00:00Z"),
+				},
+				{
+					Start: mustParseTime("2019-03-01T05:00:00Z"),
+					Stop:  mustParseTime("2019-04-01T04:00:00Z"),
+				},
+				{
+					Start: mustParseTime("2019-04-01T04:00:00Z"),
+					Stop:  mustParseTime("2019-05-01T04:00:00Z"),
+				},
+			},
+		},
+		{
+			name: "calendar quarters with month offset",
+			w: execute.Window{
+				Every:  3 * month,
+				Period: 3 * month,
+				Offset: month,
+			},
+			b: execute.Bounds{
+				Start: mustParseTime("2020-01-15T00:00:00Z"),
+				Stop:  mustParseTime("2020-02-15T00:00:00Z"),
+			},
+			want: []execute.Bounds{
+				{
+					Start: mustParseTime("2019-11-01T00:00:00Z"),
+					Stop:  mustParseTime("2020-02-01T00:00:00Z"),
+				},
+				{
+					Start: mustParseTime("2020-02-01T00:00:00Z"),
+					Stop:  mustParseTime("2020-05-01T00:00:00Z"),
+				},
+			},
+		},
 	}
 
 	for _, tc := range testcases {
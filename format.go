@@ -3,6 +3,7 @@ package flux
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 )
 
 // TODO(nathanielc): Add better options for formatting plans as Graphviz dot format.
@@ -20,9 +21,14 @@ func Formatted(q *Spec, opts ...FormatOption) fmt.Formatter {
 
 func FmtJSON(f *formatter) { f.json = true }
 
+// FmtText formats the spec as a list of its operations, one per line, in the order of Spec.Walk.
+// Each line contains the operation ID, its kind, its parameters as JSON and the IDs of its parents.
+func FmtText(f *formatter) { f.text = true }
+
 type formatter struct {
 	q    *Spec
 	json bool
+	text bool
 }
 
 func (f formatter) Format(fs fmt.State, c rune) {
@@ -32,6 +38,8 @@ func (f formatter) Format(fs fmt.State, c rune) {
 	}
 	if f.json {
 		f.formatJSON(fs)
+	} else if f.text {
+		f.formatText(fs)
 	} else {
 		f.formatDAG(fs)
 	}
@@ -42,6 +50,25 @@ func (f formatter) formatJSON(fs fmt.State) {
 	e.Encode(f.q)
 }
 
+func (f formatter) formatText(fs fmt.State) {
+	_ = f.q.Walk(func(o *Operation) error {
+		params, err := json.Marshal(o.Spec)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(fs, "%s: %s %s", o.ID, o.Spec.Kind(), params)
+		if parents := f.q.Parents(o.ID); len(parents) > 0 {
+			ids := make([]string, len(parents))
+			for i, parent := range parents {
+				ids[i] = string(parent.ID)
+			}
+			fmt.Fprintf(fs, " <- %s", strings.Join(ids, ", "))
+		}
+		fmt.Fprintln(fs)
+		return nil
+	})
+}
+
 func (f formatter) formatDAG(fs fmt.State) {
 	fmt.Fprint(fs, "digraph QuerySpec {\n")
 	_ = f.q.Walk(func(o *Operation) error {
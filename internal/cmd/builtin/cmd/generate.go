@@ -2,17 +2,23 @@ package cmd
 
 import (
 	"bufio"
+	"bytes"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path"
 	"path/filepath"
 	"reflect"
+	"runtime"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/dave/jennifer/jen"
 	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/internal/pkg/syncutil"
 	"github.com/influxdata/flux/internal/token"
 	"github.com/influxdata/flux/parser"
 	"github.com/pkg/errors"
@@ -34,7 +40,11 @@ var (
 	pkgName,
 	rootDir,
 	importFile,
-	ignoreFile string
+	ignoreFile,
+	genFile string
+	parallelism int
+	single      bool
+	check       bool
 )
 
 func init() {
@@ -43,6 +53,11 @@ func init() {
 	generateCmd.Flags().StringVar(&rootDir, "root-dir", ".", "The root level directory for all packages.")
 	generateCmd.Flags().StringVar(&importFile, "import-file", "builtin_gen.go", "Location relative to root-dir to place a file to import all generated packages.")
 	generateCmd.Flags().StringVar(&ignoreFile, "ignoreFile-file", ".fluxignore", "Location relative to root-dir of file containing packages to ignore one per line.")
+	generateCmd.Flags().StringVar(&genFile, "gen-file", "flux_gen.go", "Name of the file to write the generated Go source to within each package directory.")
+	generateCmd.Flags().BoolVar(&single, "single", false, "Generate only the package in root-dir without recursing or writing the import file. The package import path is relative to the working directory.")
+	generateCmd.Flags().BoolVar(&single, "no-recurse", false, "Alias for --single.")
+	generateCmd.Flags().BoolVar(&check, "check", false, "Verify the generated files are up to date without writing them. Any out of date files are reported as an error.")
+	generateCmd.Flags().IntVar(&parallelism, "parallelism", runtime.NumCPU(), "Number of directories to generate concurrently. A value of 1 generates serially.")
 }
 
 func generate(cmd *cobra.Command, args []string) error {
@@ -50,94 +65,65 @@ func generate(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
-	var goPackages, testPackages []string
-	err = walkDirs(rootDir, func(dir string) error {
-		// Determine the absolute flux package path
-		fluxPath, err := filepath.Rel(rootDir, dir)
+	w := &fileWriter{check: check}
+	if err := generateFiles(w, ignored); err != nil {
+		return err
+	}
+	if len(w.stale) > 0 {
+		sort.Strings(w.stale)
+		return fmt.Errorf("generated files are out of date, regenerate them using the builtin command:\n\t%s", strings.Join(w.stale, "\n\t"))
+	}
+	return nil
+}
+
+// generateFiles generates the Go files for all packages, saving them with w.
+func generateFiles(w *fileWriter, ignored []string) error {
+	if single {
+		// The root directory is the package itself, so resolve its
+		// import path relative to the working directory, which is the
+		// root of all packages.
+		wd, err := os.Getwd()
 		if err != nil {
 			return err
 		}
-		if contains(fluxPath, ignored) {
-			return nil
-		}
-
-		fset := new(token.FileSet)
-		pkgs, err := parser.ParseDir(fset, dir)
+		dir, err := filepath.Abs(rootDir)
 		if err != nil {
 			return err
 		}
-		var fluxPkg, testPkg *ast.Package
-		switch len(pkgs) {
-		case 0:
-			return nil
-		case 1:
-			for k, v := range pkgs {
-				if strings.HasSuffix(k, "_test") {
-					testPkg = v
-				} else {
-					fluxPkg = v
-				}
-			}
-		case 2:
-			for k, v := range pkgs {
-				if strings.HasSuffix(k, "_test") {
-					testPkg = v
-					continue
-				}
-				fluxPkg = v
-			}
-			if fluxPkg == nil {
-				return fmt.Errorf("cannot have two Flux test packages in the same directory")
-			}
-			if testPkg == nil {
-				return fmt.Errorf("cannot have two distinct non-test Flux packages in the same directory")
-			}
-		default:
-			keys := make([]string, 0, len(pkgs))
-			for k := range pkgs {
-				keys = append(keys, k)
-			}
-			return fmt.Errorf("found more than 2 flux packages in directory %s; packages %v", dir, keys)
-		}
+		_, err = generateDir(w, wd, dir, ignored)
+		return err
+	}
 
-		if fluxPkg != nil {
-			if ast.Check(fluxPkg) > 0 {
-				return errors.Wrapf(ast.GetError(fluxPkg), "failed to parse package %q", fluxPkg.Package)
-			}
-			// Assign import path
-			fluxPkg.Path = fluxPath
-			// Track go import path
-			goPath := path.Join(pkgName, dir)
-			if goPath != pkgName {
-				goPackages = append(goPackages, goPath)
-			}
-			// Write the ast file
-			if err := generateFluxASTFile(dir, fluxPkg); err != nil {
-				return err
-			}
-		}
-		if testPkg != nil {
-			if ast.Check(testPkg) > 0 {
-				return errors.Wrapf(ast.GetError(testPkg), "failed to parse package %q", testPkg.Package)
-			}
-			// Track go import path
-			importPath := path.Join(pkgName, dir)
-			if importPath != pkgName {
-				testPackages = append(testPackages, importPath)
-			}
-			// Isolate tests files into their own package
-			packs := splitTestPackages(testPkg)
-			if err := generateTestASTFile(dir, testPkg.Package, packs); err != nil {
-				return err
-			}
-		}
+	var dirs []string
+	if err := walkDirs(rootDir, func(dir string) error {
+		dirs = append(dirs, dir)
 		return nil
-	})
-	if err != nil {
+	}); err != nil {
+		return err
+	}
+
+	// Generate each directory concurrently. Results are stored by
+	// directory index so the package lists retain the walk order
+	// regardless of which worker finishes first.
+	results := make([]dirResult, len(dirs))
+	if err := forEachDir(dirs, parallelism, func(i int, dir string) (err error) {
+		results[i], err = generateDir(w, rootDir, dir, ignored)
+		return err
+	}); err != nil {
 		return err
 	}
 
-	if err := generateTestPkgList(testPackages); err != nil {
+	var goPackages, testPackages []string
+	for _, r := range results {
+		if r.goPackage != "" {
+			goPackages = append(goPackages, r.goPackage)
+		}
+		if r.testPackage != "" {
+			testPackages = append(testPackages, r.testPackage)
+		}
+	}
+
+	if err := generateTestPkgList(w, testPackages); err != nil {
 		return err
 	}
 
@@ -145,10 +131,169 @@ func generate(cmd *cobra.Command, args []string) error {
 	f := jen.NewFile(path.Base(pkgName))
 	f.HeaderComment("// DO NOT EDIT: This file is autogenerated via the builtin command.")
 	f.Anon(goPackages...)
-	return f.Save(filepath.Join(rootDir, importFile))
+	return w.save(f, filepath.Join(rootDir, importFile))
+}
+
+// fileWriter saves generated files to disk.
+// In check mode, nothing is written and instead the files
+// that are missing or differ from their generated contents are recorded.
+type fileWriter struct {
+	check bool
+
+	mu    sync.Mutex
+	stale []string
+}
+
+func (w *fileWriter) save(f *jen.File, fn string) error {
+	if !w.check {
+		return f.Save(fn)
+	}
+	var buf bytes.Buffer
+	if err := f.Render(&buf); err != nil {
+		return err
+	}
+	existing, err := ioutil.ReadFile(fn)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err != nil || !bytes.Equal(existing, buf.Bytes()) {
+		w.mu.Lock()
+		w.stale = append(w.stale, fn)
+		w.mu.Unlock()
+	}
+	return nil
+}
+
+// dirResult records the Go import paths produced by generating a single directory.
+// An empty path indicates that no package of that kind was generated.
+type dirResult struct {
+	goPackage   string
+	testPackage string
 }
 
-func generateFluxASTFile(dir string, pkg *ast.Package) error {
+// forEachDir calls fn for each directory using up to n concurrent workers.
+// Once any call returns an error, no further directories are dispatched
+// and the first error is returned.
+func forEachDir(dirs []string, n int, fn func(i int, dir string) error) error {
+	if n < 1 {
+		n = 1
+	}
+	var (
+		wg       syncutil.WaitGroup
+		once     sync.Once
+		jobs     = make(chan int)
+		canceled = make(chan struct{})
+	)
+	for w := 0; w < n; w++ {
+		wg.Do(func() error {
+			for i := range jobs {
+				if err := fn(i, dirs[i]); err != nil {
+					once.Do(func() { close(canceled) })
+					return err
+				}
+			}
+			return nil
+		})
+	}
+
+DISPATCH:
+	for i := range dirs {
+		select {
+		case jobs <- i:
+		case <-canceled:
+			break DISPATCH
+		}
+	}
+	close(jobs)
+	return wg.Wait()
+}
+
+// generateDir parses the Flux packages within dir and writes out their generated Go files.
+// The import paths of the packages are determined relative to root.
+func generateDir(w *fileWriter, root, dir string, ignored []string) (dirResult, error) {
+	var result dirResult
+	// Determine the absolute flux package path
+	fluxPath, err := filepath.Rel(root, dir)
+	if err != nil {
+		return result, err
+	}
+	if contains(fluxPath, ignored) {
+		return result, nil
+	}
+
+	fset := new(token.FileSet)
+	pkgs, err := parser.ParseDirWithComments(fset, dir)
+	if err != nil {
+		return result, err
+	}
+	var fluxPkg, testPkg *ast.Package
+	switch len(pkgs) {
+	case 0:
+		return result, nil
+	case 1:
+		for k, v := range pkgs {
+			if strings.HasSuffix(k, "_test") {
+				testPkg = v
+			} else {
+				fluxPkg = v
+			}
+		}
+	case 2:
+		for k, v := range pkgs {
+			if strings.HasSuffix(k, "_test") {
+				testPkg = v
+				continue
+			}
+			fluxPkg = v
+		}
+		if fluxPkg == nil {
+			return result, fmt.Errorf("cannot have two Flux test packages in the same directory")
+		}
+		if testPkg == nil {
+			return result, fmt.Errorf("cannot have two distinct non-test Flux packages in the same directory")
+		}
+	default:
+		keys := make([]string, 0, len(pkgs))
+		for k := range pkgs {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		return result, fmt.Errorf("found more than 2 flux packages in directory %s; packages %v", dir, keys)
+	}
+
+	// Track go import path
+	goPath := path.Join(pkgName, filepath.ToSlash(fluxPath))
+	if fluxPkg != nil {
+		if ast.Check(fluxPkg) > 0 {
+			return result, errors.Wrapf(ast.GetError(fluxPkg), "failed to parse package %q", fluxPkg.Package)
+		}
+		// Assign import path
+		fluxPkg.Path = fluxPath
+		if goPath != pkgName {
+			result.goPackage = goPath
+		}
+		// Write the ast file
+		if err := generateFluxASTFile(w, dir, fluxPkg); err != nil {
+			return result, err
+		}
+	}
+	if testPkg != nil {
+		if ast.Check(testPkg) > 0 {
+			return result, errors.Wrapf(ast.GetError(testPkg), "failed to parse package %q", testPkg.Package)
+		}
+		if goPath != pkgName {
+			result.testPackage = goPath
+		}
+		// Isolate tests files into their own package
+		packs := splitTestPackages(testPkg)
+		if err := generateTestASTFile(w, dir, testPkg.Package, packs); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+func generateFluxASTFile(w *fileWriter, dir string, pkg *ast.Package) error {
 	file := jen.NewFile(pkg.Package)
 	file.HeaderComment("// DO NOT EDIT: This file is autogenerated via the builtin command.")
 	file.Func().Id("init").Call().Block(
@@ -156,15 +301,15 @@ func generateFluxASTFile(dir string, pkg *ast.Package) error {
 			Call(jen.Id("pkgAST")),
 	)
 	// Construct a value using reflection for the pkg AST
-	v, err := constructValue(reflect.ValueOf(pkg))
+	v, err := constructValue(reflect.ValueOf(pkg), "Package")
 	if err != nil {
 		return err
 	}
 	file.Var().Id("pkgAST").Op("=").Add(v)
-	return file.Save(filepath.Join(dir, "flux_gen.go"))
+	return w.save(file, filepath.Join(dir, genFile))
 }
 
-func generateTestPkgList(imports []string) error {
+func generateTestPkgList(w *fileWriter, imports []string) error {
 	stmts := make([]jen.Code, len(imports)+2)
 	// var pkgs []*ast.Package
 	stmts[0] = jen.
@@ -200,18 +345,18 @@ func generateTestPkgList(imports []string) error {
 		Qual("github.com/influxdata/flux/ast", "Package").
 		Block(stmts...).
 		Call()
-	return file.Save(filepath.Join(rootDir, "test_packages.go"))
+	return w.save(file, filepath.Join(rootDir, "test_packages.go"))
 }
 
-func generateTestASTFile(dir, pkg string, pkgs []*ast.Package) error {
+func generateTestASTFile(w *fileWriter, dir, pkg string, pkgs []*ast.Package) error {
 	file := jen.NewFile(pkg)
 	file.HeaderComment("// DO NOT EDIT: This file is autogenerated via the builtin command.")
-	v, err := constructValue(reflect.ValueOf(pkgs))
+	v, err := constructValue(reflect.ValueOf(pkgs), "FluxTestPackages")
 	if err != nil {
 		return err
 	}
 	file.Var().Id("FluxTestPackages").Op("=").Add(v)
-	return file.Save(filepath.Join(dir, "flux_test_gen.go"))
+	return w.save(file, filepath.Join(dir, "flux_test_gen.go"))
 }
 
 func splitTestPackages(pkg *ast.Package) []*ast.Package {
@@ -254,6 +399,12 @@ func contains(s string, list []string) bool {
 	return false
 }
 
+// ignoreMarker is the name of a file that, when present in a directory,
+// excludes that directory and all of its descendants from generation.
+// The marker is not checked in the root directory, where a file of the
+// same name may list the packages to ignore.
+const ignoreMarker = ".fluxignore"
+
 func walkDirs(path string, f func(dir string) error) error {
 	files, err := ioutil.ReadDir(path)
 	if err != nil {
@@ -265,7 +416,13 @@ func walkDirs(path string, f func(dir string) error) error {
 
 	for _, file := range files {
 		if file.IsDir() {
-			if err := walkDirs(filepath.Join(path, file.Name()), f); err != nil {
+			dir := filepath.Join(path, file.Name())
+			if ignore, err := hasIgnoreMarker(dir); err != nil {
+				return err
+			} else if ignore {
+				continue
+			}
+			if err := walkDirs(dir, f); err != nil {
 				return err
 			}
 		}
@@ -273,12 +430,23 @@ func walkDirs(path string, f func(dir string) error) error {
 	return nil
 }
 
+// hasIgnoreMarker reports whether dir contains the ignore marker file.
+func hasIgnoreMarker(dir string) (bool, error) {
+	if _, err := os.Stat(filepath.Join(dir, ignoreMarker)); err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
 // indirectType returns a code statement that represents the type expression
 // for the given type.
 func indirectType(typ reflect.Type) *jen.Statement {
 	switch typ.Kind() {
 	case reflect.Map:
-		c := jen.Index(indirectType(typ.Key()))
+		c := jen.Map(indirectType(typ.Key()))
 		c.Add(indirectType(typ.Elem()))
 		return c
 	case reflect.Ptr:
@@ -295,13 +463,15 @@ func indirectType(typ reflect.Type) *jen.Statement {
 }
 
 // constructValue returns a Code value for the given value.
-func constructValue(v reflect.Value) (jen.Code, error) {
+// The path describes the location of the value from the root value
+// and is used to identify the value in any error messages.
+func constructValue(v reflect.Value, path string) (jen.Code, error) {
 	switch v.Kind() {
 	case reflect.Array:
 		s := indirectType(v.Type())
 		values := make([]jen.Code, v.Len())
 		for i := 0; i < v.Len(); i++ {
-			val, err := constructValue(v.Index(i))
+			val, err := constructValue(v.Index(i), indexPath(path, i))
 			if err != nil {
 				return nil, err
 			}
@@ -316,7 +486,7 @@ func constructValue(v reflect.Value) (jen.Code, error) {
 		s := indirectType(v.Type())
 		values := make([]jen.Code, v.Len())
 		for i := 0; i < v.Len(); i++ {
-			val, err := constructValue(v.Index(i))
+			val, err := constructValue(v.Index(i), indexPath(path, i))
 			if err != nil {
 				return nil, err
 			}
@@ -328,13 +498,13 @@ func constructValue(v reflect.Value) (jen.Code, error) {
 		if v.IsNil() {
 			return jen.Nil(), nil
 		}
-		return constructValue(v.Elem())
+		return constructValue(v.Elem(), path)
 	case reflect.Ptr:
 		if v.IsNil() {
 			return jen.Nil(), nil
 		}
 		s := jen.Op("&")
-		val, err := constructValue(reflect.Indirect(v))
+		val, err := constructValue(reflect.Indirect(v), path)
 		if err != nil {
 			return nil, err
 		}
@@ -344,37 +514,43 @@ func constructValue(v reflect.Value) (jen.Code, error) {
 			return jen.Nil(), nil
 		}
 		s := indirectType(v.Type())
-		keys := v.MapKeys()
-		values := make(jen.Dict, v.Len())
+		// A jen.Dict would order the entries by their rendered source,
+		// so emit each key/value pair explicitly in sorted key order.
+		keys := sortedMapKeys(v)
+		values := make([]jen.Code, 0, 2*len(keys))
 		for _, k := range keys {
-			key, err := constructValue(k)
+			elemPath := fmt.Sprintf("%s[%v]", path, k.Interface())
+			key, err := constructValue(k, elemPath)
 			if err != nil {
 				return nil, err
 			}
-			val, err := constructValue(v.MapIndex(k))
+			val, err := constructValue(v.MapIndex(k), elemPath)
 			if err != nil {
 				return nil, err
 			}
-			values[key] = val
+			values = append(values, jen.Line().Add(key).Op(":").Add(val))
 		}
-		s.Values(values)
+		if len(values) > 0 {
+			values = append(values, jen.Line())
+		}
+		s.Values(values...)
 		return s, nil
 	case reflect.Struct:
 		switch v.Type().Name() {
 		case "DateTimeLiteral":
 			lit := v.Interface().(ast.DateTimeLiteral)
 			fmtTime := lit.Value.Format(time.RFC3339Nano)
-			return constructStructValue(v, map[string]*jen.Statement{
+			return constructStructValue(v, path, map[string]*jen.Statement{
 				"Value": jen.Qual("github.com/influxdata/flux/internal/parser", "MustParseTime").Call(jen.Lit(fmtTime)),
 			})
 		case "RegexpLiteral":
 			lit := v.Interface().(ast.RegexpLiteral)
 			regexString := lit.Value.String()
-			return constructStructValue(v, map[string]*jen.Statement{
+			return constructStructValue(v, path, map[string]*jen.Statement{
 				"Value": jen.Qual("regexp", "MustCompile").Call(jen.Lit(regexString)),
 			})
 		}
-		return constructStructValue(v, nil)
+		return constructStructValue(v, path, nil)
 	case reflect.Bool,
 		reflect.Int,
 		reflect.Int8,
@@ -396,11 +572,40 @@ func constructValue(v reflect.Value) (jen.Code, error) {
 		cv := v.Convert(typ)
 		return jen.Lit(cv.Interface()), nil
 	default:
-		return nil, fmt.Errorf("unsupport value kind %v", v.Kind())
+		return nil, fmt.Errorf("unsupported value kind %v at %s", v.Kind(), path)
+	}
+}
+
+// indexPath returns the path of the element at index i of the value at path.
+func indexPath(path string, i int) string {
+	return path + "[" + strconv.Itoa(i) + "]"
+}
+
+// sortedMapKeys returns the keys of the map value in a deterministic order.
+// String keys are sorted lexically and numeric keys are sorted numerically.
+// Keys of any other kind are sorted by their string representation.
+func sortedMapKeys(v reflect.Value) []reflect.Value {
+	keys := v.MapKeys()
+	var less func(i, j int) bool
+	switch v.Type().Key().Kind() {
+	case reflect.String:
+		less = func(i, j int) bool { return keys[i].String() < keys[j].String() }
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		less = func(i, j int) bool { return keys[i].Int() < keys[j].Int() }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		less = func(i, j int) bool { return keys[i].Uint() < keys[j].Uint() }
+	case reflect.Float32, reflect.Float64:
+		less = func(i, j int) bool { return keys[i].Float() < keys[j].Float() }
+	default:
+		less = func(i, j int) bool {
+			return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+		}
 	}
+	sort.SliceStable(keys, less)
+	return keys
 }
 
-func constructStructValue(v reflect.Value, replace map[string]*jen.Statement) (*jen.Statement, error) {
+func constructStructValue(v reflect.Value, path string, replace map[string]*jen.Statement) (*jen.Statement, error) {
 	typ := v.Type()
 	s := indirectType(typ)
 	values := make(jen.Dict, v.NumField())
@@ -415,7 +620,7 @@ func constructStructValue(v reflect.Value, replace map[string]*jen.Statement) (*
 			values[jen.Id(name)] = s
 			continue
 		}
-		val, err := constructValue(field)
+		val, err := constructValue(field, path+"."+name)
 		if err != nil {
 			return nil, err
 		}
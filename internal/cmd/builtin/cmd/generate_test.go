@@ -0,0 +1,484 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/parser"
+)
+
+// fixture is a set of Flux source files keyed by their slash separated path
+// relative to the root directory.
+var fixture = map[string]string{
+	"root.flux":          "package root\n\nx = 1\n",
+	"a/a.flux":           "package a\n\na = 1\n",
+	"a/a_test.flux":      "package a_test\n\nt = 1\n",
+	"a/b/b.flux":         "package b\n\nb = \"b\"\n",
+	"a/b/c/c.flux":       "package c\n\nc = 1.0\n",
+	"d/d.flux":           "package d\n\nf = (r) => r + 1\n",
+	"e/e.flux":           "package e\n\ne = [1, 2, 3]\n",
+	"e/f/f.flux":         "package f\n\nf = {a: 1, b: 2}\n",
+	"g/g_test.flux":      "package g_test\n\ng = 1\n",
+	"h/h.flux":           "package h\n\nh = 1s\n",
+	"h/nofluxfiles.json": "{}",
+}
+
+func writeFixture(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "builtin-generate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, src := range files {
+		fp := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(fp), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(fp, []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+// readGenerated returns the contents of all Go files within the root directory
+// keyed by their slash separated path relative to the root directory.
+func readGenerated(t *testing.T, root string) map[string]string {
+	t.Helper()
+	files := make(map[string]string)
+	if err := filepath.Walk(root, func(fp string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() || !strings.HasSuffix(fp, ".go") {
+			return nil
+		}
+		data, err := ioutil.ReadFile(fp)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, fp)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = string(data)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	return files
+}
+
+// runGenerate runs the generate command against root with the given parallelism.
+func runGenerate(root string, n int) error {
+	return runGenerateWith(root, func() { parallelism = n })
+}
+
+// runGenerateWith runs the generate command against root after calling
+// setFlags to override the default flag values.
+// All flags are restored once the command completes.
+func runGenerateWith(root string, setFlags func()) error {
+	defer func(pkg, root, imp, ignore, gen string, n int, s, c bool) {
+		pkgName, rootDir, importFile, ignoreFile, genFile, parallelism, single, check = pkg, root, imp, ignore, gen, n, s, c
+	}(pkgName, rootDir, importFile, ignoreFile, genFile, parallelism, single, check)
+
+	pkgName = "example.com/stdlib"
+	rootDir = root
+	importFile = "packages.go"
+	ignoreFile = filepath.Join(root, ".fluxignore")
+	genFile = "flux_gen.go"
+	parallelism = 1
+	single = false
+	check = false
+	setFlags()
+	return generate(nil, nil)
+}
+
+func TestGenerate_ParallelMatchesSerial(t *testing.T) {
+	serialDir := writeFixture(t, fixture)
+	defer os.RemoveAll(serialDir)
+	if err := runGenerate(serialDir, 1); err != nil {
+		t.Fatal(err)
+	}
+	want := readGenerated(t, serialDir)
+
+	for _, n := range []int{2, 4, 16} {
+		parallelDir := writeFixture(t, fixture)
+		defer os.RemoveAll(parallelDir)
+		if err := runGenerate(parallelDir, n); err != nil {
+			t.Fatal(err)
+		}
+		got := readGenerated(t, parallelDir)
+		if !cmp.Equal(want, got) {
+			t.Errorf("unexpected generated files with parallelism %d -want/+got:\n%s", n, cmp.Diff(want, got))
+		}
+	}
+
+	imports := want["packages.go"]
+	for _, pkg := range []string{"a", "a/b", "a/b/c", "d", "e", "e/f", "h"} {
+		if !strings.Contains(imports, `"example.com/stdlib/`+pkg+`"`) {
+			t.Errorf("import file is missing package %q:\n%s", pkg, imports)
+		}
+	}
+}
+
+func TestGenerate_ParallelError(t *testing.T) {
+	files := make(map[string]string, len(fixture)+1)
+	for k, v := range fixture {
+		files[k] = v
+	}
+	files["e/f/bad.flux"] = "package f\n\nf = )\n"
+	dir := writeFixture(t, files)
+	defer os.RemoveAll(dir)
+
+	err := runGenerate(dir, 4)
+	if err == nil {
+		t.Fatal("expected error from malformed package")
+	}
+	if want := `failed to parse package "f"`; !strings.Contains(err.Error(), want) {
+		t.Errorf("unexpected error -want/+got:\n\t- %s\n\t+ %v", want, err)
+	}
+}
+
+func TestConstructValue_MapOrder(t *testing.T) {
+	testCases := []struct {
+		name string
+		v    interface{}
+		want string
+	}{
+		{
+			name: "string keys",
+			v:    map[string]int{"b": 2, "c": 3, "a": 1, "aa": 4, "B": 5},
+			want: "map[string]int{\n\t\"B\":  5,\n\t\"a\":  1,\n\t\"aa\": 4,\n\t\"b\":  2,\n\t\"c\":  3,\n}",
+		},
+		{
+			name: "int keys",
+			v:    map[int]string{10: "ten", 2: "two", -1: "neg", 1: "one"},
+			want: "map[int]string{\n\t-1: \"neg\",\n\t1:  \"one\",\n\t2:  \"two\",\n\t10: \"ten\",\n}",
+		},
+		{
+			name: "bool keys",
+			v:    map[bool]int{true: 1, false: 0},
+			want: "map[bool]int{\n\tfalse: 0,\n\ttrue:  1,\n}",
+		},
+		{
+			name: "empty",
+			v:    map[string]int{},
+			want: "map[string]int{}",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			render := func() string {
+				c, err := constructValue(reflect.ValueOf(tc.v), "v")
+				if err != nil {
+					t.Fatal(err)
+				}
+				f := jen.NewFile("p")
+				f.Var().Id("v").Op("=").Add(c)
+				return f.GoString()
+			}
+			first := render()
+			for i := 0; i < 10; i++ {
+				if got := render(); got != first {
+					t.Fatalf("rendered code is not stable -want/+got:\n%s", cmp.Diff(first, got))
+				}
+			}
+			if !strings.Contains(first, "var v = "+tc.want+"\n") {
+				t.Errorf("unexpected rendered code -want/+got:\n%s", cmp.Diff(tc.want, first))
+			}
+		})
+	}
+}
+
+func TestConstructValue_StringExpressionLocations(t *testing.T) {
+	pkg := parser.ParseSource(`s = "a ${b} c"`)
+	if ast.Check(pkg) > 0 {
+		t.Fatal(ast.GetError(pkg))
+	}
+	expr := pkg.Files[0].Body[0].(*ast.VariableAssignment).Init.(*ast.StringExpression)
+
+	// render renders the value and removes all whitespace so that
+	// the rendering of a nested value can be found within its parent.
+	render := func(v interface{}) string {
+		c, err := constructValue(reflect.ValueOf(v), "v")
+		if err != nil {
+			t.Fatal(err)
+		}
+		f := jen.NewFile("p")
+		f.Var().Id("v").Op("=").Add(c)
+		return strings.Join(strings.Fields(f.GoString()), "")
+	}
+	got := render(expr)
+
+	// The location of every part, and of the interpolated
+	// expression, must be rendered in source order.
+	nodes := []ast.Node{
+		expr.Parts[0],
+		expr.Parts[1],
+		expr.Parts[1].(*ast.InterpolatedPart).Expression,
+		expr.Parts[2],
+	}
+	offset := 0
+	for _, n := range nodes {
+		loc := render(n.Location())
+		loc = loc[strings.Index(loc, "ast.SourceLocation{"):]
+		i := strings.Index(got[offset:], loc)
+		if i < 0 {
+			t.Fatalf("rendered code is missing the location %v of %s:\n%s", n.Location(), n.Type(), got)
+		}
+		offset += i + len(loc)
+	}
+}
+
+func TestGenerate_Single(t *testing.T) {
+	files := make(map[string]string, len(fixture)+2)
+	for k, v := range fixture {
+		files[k] = v
+	}
+	files["packages.go"] = "package stdlib\n"
+	files["test_packages.go"] = "package stdlib\n"
+	root := writeFixture(t, files)
+	defer os.RemoveAll(root)
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(root); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	if err := runGenerateWith(filepath.Join("a", "b"), func() { single = true }); err != nil {
+		t.Fatal(err)
+	}
+	got := readGenerated(t, root)
+	if len(got) != 3 {
+		t.Errorf("expected only one generated file, got %d files", len(got)-2)
+	}
+	gen, ok := got["a/b/flux_gen.go"]
+	if !ok {
+		t.Fatal("expected a/b/flux_gen.go to be generated")
+	}
+	if want := `Path:    "a/b",`; !strings.Contains(gen, want) {
+		t.Errorf("expected generated package to have import path a/b:\n%s", gen)
+	}
+	for _, fn := range []string{"packages.go", "test_packages.go"} {
+		if got[fn] != files[fn] {
+			t.Errorf("expected %s to be untouched -want/+got:\n%s", fn, cmp.Diff(files[fn], got[fn]))
+		}
+	}
+
+	// Parse errors must still be reported.
+	if err := ioutil.WriteFile(filepath.Join(root, "a", "b", "bad.flux"), []byte("package b\n\nb = )\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := runGenerateWith(filepath.Join("a", "b"), func() { single = true }); err == nil {
+		t.Error("expected error from malformed package")
+	}
+}
+
+func TestGenerate_IgnoreMarker(t *testing.T) {
+	files := make(map[string]string, len(fixture)+3)
+	for k, v := range fixture {
+		files[k] = v
+	}
+	files["a/b/.fluxignore"] = ""
+	files["a/b/bad.flux"] = "package b\n\nb = )\n"
+	files["a/b/c/bad.flux"] = "package c\n\nc = )\n"
+	root := writeFixture(t, files)
+	defer os.RemoveAll(root)
+
+	if err := runGenerate(root, 1); err != nil {
+		t.Fatal(err)
+	}
+	got := readGenerated(t, root)
+	for _, fn := range []string{"a/b/flux_gen.go", "a/b/c/flux_gen.go"} {
+		if _, ok := got[fn]; ok {
+			t.Errorf("expected ignored file %s to not be generated", fn)
+		}
+	}
+	for _, fn := range []string{"a/flux_gen.go", "e/f/flux_gen.go"} {
+		if _, ok := got[fn]; !ok {
+			t.Errorf("expected file %s to be generated", fn)
+		}
+	}
+	imports := got["packages.go"]
+	for _, pkg := range []string{"a/b", "a/b/c"} {
+		if strings.Contains(imports, `"example.com/stdlib/`+pkg+`"`) {
+			t.Errorf("import file contains ignored package %q:\n%s", pkg, imports)
+		}
+	}
+	if !strings.Contains(imports, `"example.com/stdlib/a"`) {
+		t.Errorf("import file is missing package %q:\n%s", "a", imports)
+	}
+}
+
+func TestConstructValue_UnsupportedKind(t *testing.T) {
+	type node struct {
+		Name   string
+		Values []interface{}
+		Attrs  map[string]interface{}
+		Child  *node
+	}
+	testCases := []struct {
+		name string
+		v    interface{}
+		want string
+	}{
+		{
+			name: "slice element",
+			v:    &node{Values: []interface{}{1, "a", make(chan int)}},
+			want: "unsupported value kind chan at Package.Values[2]",
+		},
+		{
+			name: "map value",
+			v: &node{
+				Child: &node{
+					Attrs: map[string]interface{}{
+						"ok": 1,
+						"fn": func() {},
+					},
+				},
+			},
+			want: "unsupported value kind func at Package.Child.Attrs[fn]",
+		},
+		{
+			name: "nested",
+			v: &node{
+				Values: []interface{}{
+					&node{Child: &node{Values: []interface{}{func() {}}}},
+				},
+			},
+			want: "unsupported value kind func at Package.Values[0].Child.Values[0]",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := constructValue(reflect.ValueOf(tc.v), "Package")
+			if err == nil {
+				t.Fatal("expected error for unsupported value kind")
+			}
+			if got := err.Error(); got != tc.want {
+				t.Errorf("unexpected error -want/+got:\n\t- %s\n\t+ %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestGenerate_Check(t *testing.T) {
+	root := writeFixture(t, fixture)
+	defer os.RemoveAll(root)
+	if err := runGenerate(root, 1); err != nil {
+		t.Fatal(err)
+	}
+	want := readGenerated(t, root)
+
+	// A freshly generated tree is up to date.
+	if err := runGenerateWith(root, func() { check = true }); err != nil {
+		t.Fatalf("unexpected error checking up to date files: %v", err)
+	}
+
+	// Modify a package, add a new package, and remove a package.
+	writeFile := func(name, src string) {
+		if err := ioutil.WriteFile(filepath.Join(root, filepath.FromSlash(name)), []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile("a/a.flux", "package a\n\na = 2\n")
+	if err := os.Mkdir(filepath.Join(root, "n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	writeFile("n/n.flux", "package n\n\nn = 1\n")
+	if err := os.Remove(filepath.Join(root, "h", "h.flux")); err != nil {
+		t.Fatal(err)
+	}
+
+	err := runGenerateWith(root, func() { check = true })
+	if err == nil {
+		t.Fatal("expected error checking out of date files")
+	}
+	reported := make(map[string]bool)
+	for _, line := range strings.Split(err.Error(), "\n")[1:] {
+		reported[strings.TrimSpace(line)] = true
+	}
+	for _, fn := range []string{"a/flux_gen.go", "n/flux_gen.go", "packages.go"} {
+		if fp := filepath.Join(root, filepath.FromSlash(fn)); !reported[fp] {
+			t.Errorf("expected %s to be reported as out of date: %v", fp, err)
+		}
+	}
+	for _, fn := range []string{"a/b/flux_gen.go", "a/flux_test_gen.go", "test_packages.go"} {
+		if fp := filepath.Join(root, filepath.FromSlash(fn)); reported[fp] {
+			t.Errorf("expected %s to not be reported as out of date: %v", fp, err)
+		}
+	}
+
+	// Nothing should have been written.
+	if got := readGenerated(t, root); !cmp.Equal(want, got) {
+		t.Errorf("check mode modified generated files -want/+got:\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestGenerate_Comments(t *testing.T) {
+	root := writeFixture(t, map[string]string{
+		"a/a.flux": "package a\n\n// inc increments a value.\ninc = (v) => v + 1 // trailing\n",
+	})
+	defer os.RemoveAll(root)
+	if err := runGenerate(root, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	// Compare with whitespace collapsed so the assertions
+	// do not depend on the indentation of the generated code.
+	gen := strings.Join(strings.Fields(readGenerated(t, root)["a/flux_gen.go"]), " ")
+	for _, want := range []string{
+		`Comments: []ast.Comment{ast.Comment{ Loc: &ast.SourceLocation{ End: ast.Position{ Column: 27, Line: 3, }, File: "a.flux", Source: "", Start: ast.Position{ Column: 1, Line: 3, }, }, Text: "// inc increments a value.", }`,
+		`ast.Comment{ Loc: &ast.SourceLocation{ End: ast.Position{ Column: 31, Line: 4, }, File: "a.flux", Source: "", Start: ast.Position{ Column: 20, Line: 4, }, }, Text: "// trailing", }`,
+	} {
+		if !strings.Contains(gen, want) {
+			t.Errorf("generated file is missing comment %q:\n%s", want, gen)
+		}
+	}
+}
+
+func TestGenerate_GenFile(t *testing.T) {
+	root := writeFixture(t, fixture)
+	defer os.RemoveAll(root)
+	if err := runGenerateWith(root, func() { genFile = "fork_gen.go" }); err != nil {
+		t.Fatal(err)
+	}
+
+	got := readGenerated(t, root)
+	for _, dir := range []string{"a", "a/b", "a/b/c", "d", "e", "e/f", "h"} {
+		if _, ok := got[dir+"/fork_gen.go"]; !ok {
+			t.Errorf("expected %s/fork_gen.go to be generated", dir)
+		}
+		if _, ok := got[dir+"/flux_gen.go"]; ok {
+			t.Errorf("expected %s/flux_gen.go to not be generated", dir)
+		}
+		if !strings.Contains(got["packages.go"], `"example.com/stdlib/`+dir+`"`) {
+			t.Errorf("import file is missing package %q:\n%s", dir, got["packages.go"])
+		}
+	}
+	if _, ok := got["a/flux_test_gen.go"]; !ok {
+		t.Error("expected a/flux_test_gen.go to be generated")
+	}
+
+	// Check mode uses the same file name.
+	if err := runGenerateWith(root, func() {
+		genFile = "fork_gen.go"
+		check = true
+	}); err != nil {
+		t.Errorf("unexpected error checking up to date files: %v", err)
+	}
+}
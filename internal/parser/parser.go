@@ -2,7 +2,9 @@ package parser
 
 import (
 	"fmt"
+	"reflect"
 	"strconv"
+	"strings"
 
 	"github.com/influxdata/flux/ast"
 	"github.com/influxdata/flux/internal/scanner"
@@ -31,18 +33,41 @@ func ParseFile(f *token.File, src []byte) *ast.File {
 		s: &scannerSkipComments{
 			Scanner: scanner.New(f, src),
 		},
-		src:    src,
+		src:    string(src),
 		blocks: make(map[token.Token]int),
 	}
 	return p.parseFile(f.Name())
 }
 
-// scannerSkipComments is a temporary Scanner used for stripping comments
-// from the input stream. We want to attach comments to nodes within the
-// AST, but first we want to have feature parity with the old parser so
-// the easiest method is just to strip comments at the moment.
+// ParseFileWithComments parses Flux source and produces an ast.File.
+// Comments within the source are attached to the nearest node within the AST.
+func ParseFileWithComments(f *token.File, src []byte) *ast.File {
+	s := &scannerSkipComments{
+		Scanner: scanner.New(f, src),
+		collect: true,
+	}
+	p := &parser{
+		s:      s,
+		src:    string(src),
+		blocks: make(map[token.Token]int),
+	}
+	file := p.parseFile(f.Name())
+	attachComments(file, s.comments)
+	return file
+}
+
+// scannerSkipComments is a Scanner used for stripping comments
+// from the input stream. If collect is set, the stripped comments
+// are recorded so they can be attached to the AST after parsing.
 type scannerSkipComments struct {
 	Scanner
+
+	collect  bool
+	comments []ast.Comment
+	// next is the position after the last recorded comment.
+	// Comments before this position have already been recorded
+	// and are being scanned again after a call to Unread.
+	next token.Pos
 }
 
 func (s *scannerSkipComments) Scan() (pos token.Pos, tok token.Token, lit string) {
@@ -51,6 +76,7 @@ func (s *scannerSkipComments) Scan() (pos token.Pos, tok token.Token, lit string
 		if tok != token.COMMENT {
 			return pos, tok, lit
 		}
+		s.record(pos, lit)
 	}
 }
 
@@ -60,12 +86,84 @@ func (s *scannerSkipComments) ScanWithRegex() (pos token.Pos, tok token.Token, l
 		if tok != token.COMMENT {
 			return pos, tok, lit
 		}
+		s.record(pos, lit)
+	}
+}
+
+func (s *scannerSkipComments) record(pos token.Pos, lit string) {
+	if !s.collect || pos < s.next {
+		return
+	}
+	text := strings.TrimRight(lit, "\r\n")
+	end := pos + token.Pos(len(text))
+	s.comments = append(s.comments, ast.Comment{
+		Text: text,
+		Loc: &ast.SourceLocation{
+			File:  s.File().Name(),
+			Start: s.File().Position(pos),
+			End:   s.File().Position(end),
+		},
+	})
+	s.next = end
+}
+
+// attachComments attaches each comment to the nearest node in the file.
+// A comment that follows a node on the same line is attached to the
+// outermost node that ends closest to the comment. Any other comment is
+// attached to the outermost node that starts closest after the comment.
+// Comments that are not followed by any node are attached to the file.
+func attachComments(file *ast.File, comments []ast.Comment) {
+	if len(comments) == 0 {
+		return
+	}
+	// Nodes are collected in pre-order so that parent nodes
+	// come before any children that share the same location.
+	var nodes []ast.Node
+	ast.Walk(ast.CreateVisitor(func(n ast.Node) {
+		if n == file || !n.Location().IsValid() {
+			return
+		}
+		nodes = append(nodes, n)
+	}), file)
+
+	for _, c := range comments {
+		var nearest ast.Node
+		for _, n := range nodes {
+			loc := n.Location()
+			if loc.End.Line != c.Loc.Start.Line || c.Loc.Start.Less(loc.End) {
+				continue
+			}
+			if nearest == nil || nearest.Location().End.Less(loc.End) {
+				nearest = n
+			}
+		}
+		if nearest == nil {
+			for _, n := range nodes {
+				loc := n.Location()
+				if loc.Start.Less(c.Loc.End) {
+					continue
+				}
+				if nearest == nil || loc.Start.Less(nearest.Location().Start) {
+					nearest = n
+				}
+			}
+		}
+		bnode := &file.BaseNode
+		if nearest != nil {
+			bnode = baseNodeOf(nearest)
+		}
+		bnode.Comments = append(bnode.Comments, c)
 	}
 }
 
+// baseNodeOf returns a pointer to the BaseNode embedded within the node.
+func baseNodeOf(n ast.Node) *ast.BaseNode {
+	return reflect.ValueOf(n).Elem().FieldByName("BaseNode").Addr().Interface().(*ast.BaseNode)
+}
+
 type parser struct {
 	s        Scanner
-	src      []byte
+	src      string // the source of each node is sliced from src to avoid copies
 	pos      token.Pos
 	tok      token.Token
 	lit      string
@@ -75,6 +173,10 @@ type parser struct {
 	// blocks maintains a count of the end tokens for nested blocks
 	// that we have entered.
 	blocks map[token.Token]int
+
+	// placeholders maintains a count of the pipe placeholders
+	// within the call of each pipe expression that we have entered.
+	placeholders []int
 }
 
 func (p *parser) parseFile(fname string) *ast.File {
@@ -167,10 +269,11 @@ func (p *parser) parseStatement() ast.Statement {
 		return p.parseTestStatement()
 	case token.RETURN:
 		return p.parseReturnStatement()
+	case token.LBRACK, token.LBRACE:
+		return p.parsePatternStatement()
 	case token.INT, token.FLOAT, token.STRING, token.DIV,
 		token.TIME, token.DURATION, token.PIPE_RECEIVE,
-		token.LPAREN, token.LBRACK, token.LBRACE,
-		token.ADD, token.SUB, token.NOT, token.IF:
+		token.LPAREN, token.ADD, token.SUB, token.NOT, token.IF:
 		return p.parseExpressionStatement()
 	default:
 		p.consume()
@@ -288,7 +391,7 @@ func (p *parser) parseIdentStatement() ast.Statement {
 			Init: expr,
 		}
 	default:
-		expr := p.parseExpressionSuffix(id)
+		expr := p.parseExpressionSuffix(p.identExpression(id))
 		loc := expr.Location()
 		return &ast.ExpressionStatement{
 			Expression: expr,
@@ -302,6 +405,65 @@ func (p *parser) parseAssignStatement() ast.Expression {
 	return p.parseExpression()
 }
 
+// parsePatternStatement parses a statement that starts with an array or object literal.
+// The statement is a destructuring assignment if the literal is followed by an assignment.
+func (p *parser) parsePatternStatement() ast.Statement {
+	expr := p.parseExpression()
+	if _, tok, _ := p.peek(); tok != token.ASSIGN {
+		stmt := &ast.ExpressionStatement{
+			Expression: expr,
+		}
+		if expr != nil {
+			loc := expr.Location()
+			stmt.BaseNode = p.baseNode(&loc)
+		} else {
+			stmt.BaseNode = p.baseNode(nil)
+		}
+		return stmt
+	}
+	checkPattern(expr)
+	init := p.parseAssignStatement()
+	return &ast.DestructuringAssignment{
+		BaseNode: p.baseNode(p.sourceLocation(
+			locStart(expr),
+			locEnd(init),
+		)),
+		Pattern: expr,
+		Init:    init,
+	}
+}
+
+// checkPattern adds an error to each part of a destructuring pattern that does not bind an identifier.
+func checkPattern(pattern ast.Expression) {
+	invalid := func(n ast.Node, msg string) {
+		bnode := baseNodeOf(n)
+		bnode.Errors = append(bnode.Errors, ast.Error{Msg: msg})
+	}
+	switch pattern := pattern.(type) {
+	case *ast.ArrayExpression:
+		for _, e := range pattern.Elements {
+			if _, ok := e.(*ast.Identifier); !ok {
+				invalid(e, fmt.Sprintf("cannot assign to %s, array pattern elements must be identifiers", e.Type()))
+			}
+		}
+	case *ast.ObjectExpression:
+		if pattern.With != nil {
+			invalid(pattern, "object pattern cannot use with")
+		}
+		for _, prop := range pattern.Properties {
+			if prop.Value == nil {
+				if _, ok := prop.Key.(*ast.Identifier); !ok {
+					invalid(prop, "object pattern property without a value must have an identifier key")
+				}
+			} else if _, ok := prop.Value.(*ast.Identifier); !ok {
+				invalid(prop.Value, fmt.Sprintf("cannot assign to %s, object pattern values must be identifiers", prop.Value.Type()))
+			}
+		}
+	default:
+		invalid(pattern, fmt.Sprintf("cannot assign to %s", pattern.Type()))
+	}
+}
+
 func (p *parser) parseReturnStatement() *ast.ReturnStatement {
 	pos, _ := p.expect(token.RETURN)
 	expr := p.parseExpression()
@@ -678,7 +840,9 @@ func (p *parser) parsePipeExpressionSuffix(expr *ast.Expression) func() bool {
 			return false
 		}
 		// todo(jsternberg): this is not correct.
+		p.placeholders = append(p.placeholders, 0)
 		rhs := p.parseUnaryExpression()
+		p.placeholders = p.placeholders[:len(p.placeholders)-1]
 		call, ok := rhs.(*ast.CallExpression)
 		if !ok && rhs != nil {
 			// We did not parse a call expression, but we still have something
@@ -763,7 +927,7 @@ func (p *parser) parsePostfixOperatorSuffix(expr *ast.Expression) func() bool {
 }
 
 func (p *parser) parsePostfixOperator(expr *ast.Expression) bool {
-	switch _, tok, _ := p.peek(); tok {
+	switch pos, tok, _ := p.peek(); tok {
 	case token.DOT:
 		*expr = p.parseDotExpression(*expr)
 		return true
@@ -771,6 +935,11 @@ func (p *parser) parsePostfixOperator(expr *ast.Expression) bool {
 		*expr = p.parseCallExpression(*expr)
 		return true
 	case token.LBRACK:
+		// A bracket on a new line starts an array pattern
+		// of a destructuring assignment, not an index.
+		if end := locEnd(*expr); end.Line > 0 && p.s.File().Position(pos).Line > end.Line {
+			return false
+		}
 		*expr = p.parseIndexExpression(*expr)
 		return true
 	}
@@ -842,13 +1011,13 @@ func (p *parser) parseIndexExpression(callee ast.Expression) ast.Expression {
 func (p *parser) parsePrimaryExpression() ast.Expression {
 	switch _, tok, _ := p.peekWithRegex(); tok {
 	case token.IDENT:
-		return p.parseIdentifier()
+		return p.identExpression(p.parseIdentifier())
 	case token.INT:
 		return p.parseIntLiteral()
 	case token.FLOAT:
 		return p.parseFloatLiteral()
 	case token.STRING:
-		return p.parseStringLiteral()
+		return p.parseStringExpression()
 	case token.REGEX:
 		return p.parseRegexpLiteral()
 	case token.TIME:
@@ -876,10 +1045,40 @@ func (p *parser) parseIdentifier() *ast.Identifier {
 	}
 }
 
+// identExpression returns the expression for an identifier that is used as a value.
+// The identifier _ is a placeholder for the value piped into the nearest pipe expression
+// whose call contains it, and each such call may contain at most one placeholder.
+func (p *parser) identExpression(id *ast.Identifier) ast.Expression {
+	if id.Name != "_" {
+		return id
+	}
+	placeholder := &ast.PipePlaceholder{
+		BaseNode: id.BaseNode,
+	}
+	n := len(p.placeholders)
+	if n == 0 {
+		placeholder.Errors = append(placeholder.Errors, ast.Error{
+			Msg: "pipe placeholder _ must be used within the call of a pipe expression",
+		})
+		return placeholder
+	}
+	if p.placeholders[n-1] > 0 {
+		placeholder.Errors = append(placeholder.Errors, ast.Error{
+			Msg: "pipe placeholder _ is ambiguous, the call of a pipe expression may only contain one placeholder",
+		})
+	}
+	p.placeholders[n-1]++
+	return placeholder
+}
+
 func (p *parser) parseIntLiteral() *ast.IntegerLiteral {
 	pos, lit := p.expect(token.INT)
-	// todo(jsternberg): handle errors.
-	value, _ := strconv.ParseInt(lit, 10, 64)
+	value, err := ParseInt(lit)
+	if err != nil {
+		p.errs = append(p.errs, ast.Error{
+			Msg: err.Error(),
+		})
+	}
 	return &ast.IntegerLiteral{
 		Value:    value,
 		BaseNode: p.posRange(pos, len(lit)),
@@ -896,6 +1095,119 @@ func (p *parser) parseFloatLiteral() *ast.FloatLiteral {
 	}
 }
 
+// parseStringExpression parses a string that is used as a value.
+// A string that contains an interpolation, `${expr}`, is parsed as a
+// StringExpression whose parts each keep their location within the file.
+// Otherwise the string is parsed as a StringLiteral, as is a raw
+// string since it does not support interpolation.
+func (p *parser) parseStringExpression() ast.Expression {
+	pos, lit := p.expect(token.STRING)
+	if strings.HasPrefix(lit, "`") || !strings.Contains(lit, "${") {
+		value, _ := ParseString(lit)
+		return &ast.StringLiteral{
+			Value:    value,
+			BaseNode: p.posRange(pos, len(lit)),
+		}
+	}
+
+	expr := &ast.StringExpression{
+		BaseNode: p.posRange(pos, len(lit)),
+	}
+	// Scan the literal between the quotes for interpolations.
+	// Escape sequences are skipped so that an escaped character
+	// cannot start or end an interpolation.
+	text, end := 1, len(lit)-1
+	for i := text; i < end; {
+		switch {
+		case lit[i] == '\\':
+			i += 2
+		case lit[i] == '$' && i+1 < end && lit[i+1] == '{':
+			if text < i {
+				expr.Parts = append(expr.Parts, p.parseTextPart(pos, lit, text, i))
+			}
+			part, next := p.parseInterpolatedPart(pos, lit, i)
+			expr.Parts = append(expr.Parts, part)
+			i, text = next, next
+		default:
+			i++
+		}
+	}
+	if text < end {
+		expr.Parts = append(expr.Parts, p.parseTextPart(pos, lit, text, end))
+	}
+	return expr
+}
+
+// parseTextPart parses the text between the start and end offsets
+// of the string literal that begins at pos.
+func (p *parser) parseTextPart(pos token.Pos, lit string, start, end int) *ast.TextPart {
+	var builder strings.Builder
+	builder.Grow(end - start)
+	for i := start; i < end; {
+		width, err := writeNextUnescapedRune(lit[i:end], &builder)
+		if err != nil || width == 0 {
+			break
+		}
+		i += width
+	}
+	return &ast.TextPart{
+		Value:    builder.String(),
+		BaseNode: p.position(pos+token.Pos(start), pos+token.Pos(end)),
+	}
+}
+
+// parseInterpolatedPart parses the interpolation that starts with `${`
+// at the start offset of the string literal that begins at pos.
+// It returns the part and the offset directly after its closing brace.
+func (p *parser) parseInterpolatedPart(pos token.Pos, lit string, start int) (*ast.InterpolatedPart, int) {
+	// Find the closing brace that matches the opening brace.
+	end, depth := start+2, 1
+	for ; end < len(lit)-1; end++ {
+		if lit[end] == '{' {
+			depth++
+		} else if lit[end] == '}' {
+			if depth--; depth == 0 {
+				break
+			}
+		}
+	}
+	next := end + 1
+	if depth > 0 {
+		next = end
+		p.errs = append(p.errs, ast.Error{
+			Msg: "expected } to end the string interpolation",
+		})
+	}
+
+	// The expression is parsed by a parser that scans the same file
+	// between the braces so its nodes have their positions within the file.
+	offset := int(pos) - p.s.File().Base()
+	sub := &parser{
+		s: &scannerSkipComments{
+			Scanner: scanner.NewRange(p.s.File(), []byte(p.src), offset+start+2, offset+end),
+		},
+		src:          p.src,
+		blocks:       make(map[token.Token]int),
+		placeholders: p.placeholders,
+	}
+	part := &ast.InterpolatedPart{
+		Expression: sub.parseExpression(),
+	}
+	if part.Expression == nil {
+		sub.errs = append(sub.errs, ast.Error{
+			Msg: "expected an expression in the string interpolation",
+		})
+	}
+	if _, tok, lit := sub.scan(); tok != token.EOF {
+		sub.errs = append(sub.errs, ast.Error{
+			Msg: fmt.Sprintf("unexpected %s (%q) in the string interpolation", tok, lit),
+		})
+	}
+	p.errs = append(p.errs, sub.errs...)
+	part.BaseNode = p.position(pos+token.Pos(start), pos+token.Pos(next))
+	return part, next
+}
+
 func (p *parser) parseStringLiteral() *ast.StringLiteral {
 	pos, lit := p.expect(token.STRING)
 	value, _ := ParseString(lit)
@@ -958,11 +1270,39 @@ func (p *parser) parseArrayLiteral() ast.Expression {
 
 func (p *parser) parseObjectLiteral() ast.Expression {
 	start, _ := p.open(token.LBRACE, token.RBRACE)
-	properties := p.parsePropertyList()
+	obj := p.parseObjectBody()
 	end, rbrace := p.close(token.RBRACE)
+	obj.BaseNode = p.position(start, end+token.Pos(len(rbrace)))
+	return obj
+}
+
+func (p *parser) parseObjectBody() *ast.ObjectExpression {
+	if _, tok, _ := p.peek(); tok != token.IDENT {
+		return &ast.ObjectExpression{
+			Properties: p.parsePropertyList(),
+		}
+	}
+	ident := p.parseIdentifier()
+	return p.parseObjectBodySuffix(ident)
+}
+
+func (p *parser) parseObjectBodySuffix(ident *ast.Identifier) *ast.ObjectExpression {
+	// The with keyword is only recognized directly after the
+	// first identifier so it remains a valid property name.
+	if _, tok, lit := p.peek(); tok == token.IDENT && lit == "with" {
+		p.consume()
+		return &ast.ObjectExpression{
+			With:       ident,
+			Properties: p.parsePropertyList(),
+		}
+	}
+	properties := []*ast.Property{p.parseIdentPropertySuffix(ident)}
+	if _, tok, _ := p.peek(); tok == token.COMMA {
+		p.consume()
+	}
+	properties = append(properties, p.parsePropertyList()...)
 	return &ast.ObjectExpression{
 		Properties: properties,
-		BaseNode:   p.position(start, end+token.Pos(len(rbrace))),
 	}
 }
 
@@ -997,7 +1337,7 @@ func (p *parser) parseParenIdentExpression(lparen token.Pos, key *ast.Identifier
 				BaseNode: p.baseNode(&loc),
 			}})
 		}
-		return key
+		return p.identExpression(key)
 	case token.ASSIGN:
 		p.consume()
 		value := p.parseExpression()
@@ -1026,7 +1366,7 @@ func (p *parser) parseParenIdentExpression(lparen token.Pos, key *ast.Identifier
 		p.close(token.RPAREN)
 		return p.parseFunctionExpression(lparen, params)
 	default:
-		expr := p.parseExpressionSuffix(key)
+		expr := p.parseExpressionSuffix(p.identExpression(key))
 		for p.more() {
 			rhs := p.parseExpression()
 			if rhs == nil {
@@ -1089,6 +1429,10 @@ func (p *parser) parseStringProperty() *ast.Property {
 
 func (p *parser) parseIdentProperty() *ast.Property {
 	key := p.parseIdentifier()
+	return p.parseIdentPropertySuffix(key)
+}
+
+func (p *parser) parseIdentPropertySuffix(key *ast.Identifier) *ast.Property {
 	loc := key.Location()
 	property := &ast.Property{
 		Key:      key,
@@ -1101,6 +1445,9 @@ func (p *parser) parseIdentProperty() *ast.Property {
 			locStart(key),
 			locEnd(property.Value),
 		)
+	} else if key.Name == "_" {
+		// The shorthand property _ passes the piped value.
+		property.Value = p.identExpression(key)
 	}
 	return property
 }
@@ -1330,7 +1677,7 @@ func (p *parser) loc(start, end token.Pos) *ast.SourceLocation {
 		File:   p.s.File().Name(),
 		Start:  p.s.File().Position(start),
 		End:    p.s.File().Position(end),
-		Source: string(p.src[soffset:eoffset]),
+		Source: p.src[soffset:eoffset],
 	}
 }
 
@@ -1348,20 +1695,24 @@ func (p *parser) posRange(start token.Pos, sz int) ast.BaseNode {
 
 // sourceLocation constructs an ast.SourceLocation from two
 // ast.Position values.
+//
+// If only one of the positions is known, the location is reduced to
+// that position so that any errors on the node can still be located.
 func (p *parser) sourceLocation(start, end ast.Position) *ast.SourceLocation {
-	soffset := p.s.File().Offset(start)
-	if soffset == -1 {
-		return nil
-	}
-	eoffset := p.s.File().Offset(end)
-	if eoffset == -1 {
+	soffset, eoffset := p.s.File().Offset(start), p.s.File().Offset(end)
+	switch {
+	case soffset == -1 && eoffset == -1:
 		return nil
+	case soffset == -1:
+		start, soffset = end, eoffset
+	case eoffset == -1:
+		end, eoffset = start, soffset
 	}
 	return &ast.SourceLocation{
 		File:   p.s.File().Name(),
 		Start:  start,
 		End:    end,
-		Source: string(p.src[soffset:eoffset]),
+		Source: p.src[soffset:eoffset],
 	}
 }
 
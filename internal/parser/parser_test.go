@@ -12,6 +12,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/ast/asttest"
 	"github.com/influxdata/flux/internal/parser"
 	"github.com/influxdata/flux/internal/token"
 )
@@ -590,6 +591,186 @@ import "path/bar"
 				},
 			},
 		},
+		{
+			name: "hexadecimal integer literal",
+			raw:  `0xFF`,
+			want: &ast.File{
+				BaseNode: base("1:1", "1:5"),
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						BaseNode: base("1:1", "1:5"),
+						Expression: &ast.IntegerLiteral{
+							BaseNode: base("1:1", "1:5"),
+							Value:    255,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "uppercase hexadecimal integer literal",
+			raw:  `0XdeadBEEF`,
+			want: &ast.File{
+				BaseNode: base("1:1", "1:11"),
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						BaseNode: base("1:1", "1:11"),
+						Expression: &ast.IntegerLiteral{
+							BaseNode: base("1:1", "1:11"),
+							Value:    3735928559,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "binary integer literal",
+			raw:  `0b1010`,
+			want: &ast.File{
+				BaseNode: base("1:1", "1:7"),
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						BaseNode: base("1:1", "1:7"),
+						Expression: &ast.IntegerLiteral{
+							BaseNode: base("1:1", "1:7"),
+							Value:    10,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "integer literal with underscores",
+			raw:  `0b_1111_0000`,
+			want: &ast.File{
+				BaseNode: base("1:1", "1:13"),
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						BaseNode: base("1:1", "1:13"),
+						Expression: &ast.IntegerLiteral{
+							BaseNode: base("1:1", "1:13"),
+							Value:    240,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "largest hexadecimal integer literal",
+			raw:  `0x7FFF_FFFF_FFFF_FFFF`,
+			want: &ast.File{
+				BaseNode: base("1:1", "1:22"),
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						BaseNode: base("1:1", "1:22"),
+						Expression: &ast.IntegerLiteral{
+							BaseNode: base("1:1", "1:22"),
+							Value:    9223372036854775807,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "hexadecimal integer literal overflow",
+			raw:  `0x8000000000000000`,
+			want: &ast.File{
+				BaseNode: base("1:1", "1:19"),
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						BaseNode: base("1:1", "1:19"),
+						Expression: &ast.IntegerLiteral{
+							BaseNode: ast.BaseNode{
+								Loc: loc("1:1", "1:19"),
+								Errors: []ast.Error{
+									{Msg: `integer literal "0x8000000000000000" overflows a 64-bit integer`},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "decimal integer literal overflow",
+			raw:  `9223372036854775808`,
+			want: &ast.File{
+				BaseNode: base("1:1", "1:20"),
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						BaseNode: base("1:1", "1:20"),
+						Expression: &ast.IntegerLiteral{
+							BaseNode: ast.BaseNode{
+								Loc: loc("1:1", "1:20"),
+								Errors: []ast.Error{
+									{Msg: `integer literal "9223372036854775808" overflows a 64-bit integer`},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "binary integer literal with invalid digit",
+			raw:  `0b102`,
+			want: &ast.File{
+				BaseNode: base("1:1", "1:6"),
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						BaseNode: base("1:1", "1:6"),
+						Expression: &ast.IntegerLiteral{
+							BaseNode: ast.BaseNode{
+								Loc: loc("1:1", "1:6"),
+								Errors: []ast.Error{
+									{Msg: `invalid integer literal "0b102"`},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "hexadecimal integer literal without digits",
+			raw:  `0x`,
+			want: &ast.File{
+				BaseNode: base("1:1", "1:3"),
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						BaseNode: base("1:1", "1:3"),
+						Expression: &ast.IntegerLiteral{
+							BaseNode: ast.BaseNode{
+								Loc: loc("1:1", "1:3"),
+								Errors: []ast.Error{
+									{Msg: `invalid integer literal "0x"`},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "integer literal with trailing underscore",
+			raw:  `0xF_`,
+			want: &ast.File{
+				BaseNode: base("1:1", "1:5"),
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						BaseNode: base("1:1", "1:5"),
+						Expression: &ast.IntegerLiteral{
+							BaseNode: ast.BaseNode{
+								Loc: loc("1:1", "1:5"),
+								Errors: []ast.Error{
+									{Msg: `invalid integer literal "0xF_"`},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "regex match operators",
 			raw:  `"a" =~ /.*/ and "b" !~ /c$/`,
@@ -1016,6 +1197,382 @@ import "path/bar"
 				},
 			},
 		},
+		{
+			name: "pipe placeholder",
+			raw:  `a |> f(x: _)`,
+			want: &ast.File{
+				BaseNode: base("1:1", "1:13"),
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						BaseNode: base("1:1", "1:13"),
+						Expression: &ast.PipeExpression{
+							BaseNode: base("1:1", "1:13"),
+							Argument: &ast.Identifier{
+								BaseNode: base("1:1", "1:2"),
+								Name:     "a",
+							},
+							Call: &ast.CallExpression{
+								BaseNode: base("1:6", "1:13"),
+								Callee: &ast.Identifier{
+									BaseNode: base("1:6", "1:7"),
+									Name:     "f",
+								},
+								Arguments: []ast.Expression{
+									&ast.ObjectExpression{
+										BaseNode: base("1:8", "1:12"),
+										Properties: []*ast.Property{
+											{
+												BaseNode: base("1:8", "1:12"),
+												Key: &ast.Identifier{
+													BaseNode: base("1:8", "1:9"),
+													Name:     "x",
+												},
+												Value: &ast.PipePlaceholder{
+													BaseNode: base("1:11", "1:12"),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "pipe placeholder in nested pipe expression",
+			raw:  `a |> f(x: b |> g(y: _), z: _)`,
+			want: &ast.File{
+				BaseNode: base("1:1", "1:30"),
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						BaseNode: base("1:1", "1:30"),
+						Expression: &ast.PipeExpression{
+							BaseNode: base("1:1", "1:30"),
+							Argument: &ast.Identifier{
+								BaseNode: base("1:1", "1:2"),
+								Name:     "a",
+							},
+							Call: &ast.CallExpression{
+								BaseNode: base("1:6", "1:30"),
+								Callee: &ast.Identifier{
+									BaseNode: base("1:6", "1:7"),
+									Name:     "f",
+								},
+								Arguments: []ast.Expression{
+									&ast.ObjectExpression{
+										BaseNode: base("1:8", "1:29"),
+										Properties: []*ast.Property{
+											{
+												BaseNode: base("1:8", "1:23"),
+												Key: &ast.Identifier{
+													BaseNode: base("1:8", "1:9"),
+													Name:     "x",
+												},
+												Value: &ast.PipeExpression{
+													BaseNode: base("1:11", "1:23"),
+													Argument: &ast.Identifier{
+														BaseNode: base("1:11", "1:12"),
+														Name:     "b",
+													},
+													Call: &ast.CallExpression{
+														BaseNode: base("1:16", "1:23"),
+														Callee: &ast.Identifier{
+															BaseNode: base("1:16", "1:17"),
+															Name:     "g",
+														},
+														Arguments: []ast.Expression{
+															&ast.ObjectExpression{
+																BaseNode: base("1:18", "1:22"),
+																Properties: []*ast.Property{
+																	{
+																		BaseNode: base("1:18", "1:22"),
+																		Key: &ast.Identifier{
+																			BaseNode: base("1:18", "1:19"),
+																			Name:     "y",
+																		},
+																		Value: &ast.PipePlaceholder{
+																			BaseNode: base("1:21", "1:22"),
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+											{
+												BaseNode: base("1:25", "1:29"),
+												Key: &ast.Identifier{
+													BaseNode: base("1:25", "1:26"),
+													Name:     "z",
+												},
+												Value: &ast.PipePlaceholder{
+													BaseNode: base("1:28", "1:29"),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "multiple pipe placeholders",
+			raw:  `a |> f(x: _, y: _)`,
+			want: &ast.File{
+				BaseNode: base("1:1", "1:19"),
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						BaseNode: base("1:1", "1:19"),
+						Expression: &ast.PipeExpression{
+							BaseNode: base("1:1", "1:19"),
+							Argument: &ast.Identifier{
+								BaseNode: base("1:1", "1:2"),
+								Name:     "a",
+							},
+							Call: &ast.CallExpression{
+								BaseNode: base("1:6", "1:19"),
+								Callee: &ast.Identifier{
+									BaseNode: base("1:6", "1:7"),
+									Name:     "f",
+								},
+								Arguments: []ast.Expression{
+									&ast.ObjectExpression{
+										BaseNode: base("1:8", "1:18"),
+										Properties: []*ast.Property{
+											{
+												BaseNode: base("1:8", "1:12"),
+												Key: &ast.Identifier{
+													BaseNode: base("1:8", "1:9"),
+													Name:     "x",
+												},
+												Value: &ast.PipePlaceholder{
+													BaseNode: base("1:11", "1:12"),
+												},
+											},
+											{
+												BaseNode: base("1:14", "1:18"),
+												Key: &ast.Identifier{
+													BaseNode: base("1:14", "1:15"),
+													Name:     "y",
+												},
+												Value: &ast.PipePlaceholder{
+													BaseNode: ast.BaseNode{
+														Loc: loc("1:17", "1:18"),
+														Errors: []ast.Error{
+															{Msg: "pipe placeholder _ is ambiguous, the call of a pipe expression may only contain one placeholder"},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "pipe placeholder outside of pipe expression",
+			raw:  `f(x: _)`,
+			want: &ast.File{
+				BaseNode: base("1:1", "1:8"),
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						BaseNode: base("1:1", "1:8"),
+						Expression: &ast.CallExpression{
+							BaseNode: base("1:1", "1:8"),
+							Callee: &ast.Identifier{
+								BaseNode: base("1:1", "1:2"),
+								Name:     "f",
+							},
+							Arguments: []ast.Expression{
+								&ast.ObjectExpression{
+									BaseNode: base("1:3", "1:7"),
+									Properties: []*ast.Property{
+										{
+											BaseNode: base("1:3", "1:7"),
+											Key: &ast.Identifier{
+												BaseNode: base("1:3", "1:4"),
+												Name:     "x",
+											},
+											Value: &ast.PipePlaceholder{
+												BaseNode: ast.BaseNode{
+													Loc: loc("1:6", "1:7"),
+													Errors: []ast.Error{
+														{Msg: "pipe placeholder _ must be used within the call of a pipe expression"},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "string interpolation",
+			raw:  `"a ${b}\n c ${d + 1}!"`,
+			want: &ast.File{
+				BaseNode: base("1:1", "1:23"),
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						BaseNode: base("1:1", "1:23"),
+						Expression: &ast.StringExpression{
+							BaseNode: base("1:1", "1:23"),
+							Parts: []ast.StringExpressionPart{
+								&ast.TextPart{
+									BaseNode: base("1:2", "1:4"),
+									Value:    "a ",
+								},
+								&ast.InterpolatedPart{
+									BaseNode: base("1:4", "1:8"),
+									Expression: &ast.Identifier{
+										BaseNode: base("1:6", "1:7"),
+										Name:     "b",
+									},
+								},
+								&ast.TextPart{
+									BaseNode: base("1:8", "1:13"),
+									Value:    "\n c ",
+								},
+								&ast.InterpolatedPart{
+									BaseNode: base("1:13", "1:21"),
+									Expression: &ast.BinaryExpression{
+										BaseNode: base("1:15", "1:20"),
+										Operator: ast.AdditionOperator,
+										Left: &ast.Identifier{
+											BaseNode: base("1:15", "1:16"),
+											Name:     "d",
+										},
+										Right: &ast.IntegerLiteral{
+											BaseNode: base("1:19", "1:20"),
+											Value:    1,
+										},
+									},
+								},
+								&ast.TextPart{
+									BaseNode: base("1:21", "1:22"),
+									Value:    "!",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "string interpolation across lines",
+			raw: `x = "line
+${a.b} done"`,
+			want: &ast.File{
+				BaseNode: base("1:1", "2:13"),
+				Body: []ast.Statement{
+					&ast.VariableAssignment{
+						BaseNode: base("1:1", "2:13"),
+						ID: &ast.Identifier{
+							BaseNode: base("1:1", "1:2"),
+							Name:     "x",
+						},
+						Init: &ast.StringExpression{
+							BaseNode: base("1:5", "2:13"),
+							Parts: []ast.StringExpressionPart{
+								&ast.TextPart{
+									BaseNode: base("1:6", "2:1"),
+									Value:    "line\n",
+								},
+								&ast.InterpolatedPart{
+									BaseNode: base("2:1", "2:7"),
+									Expression: &ast.MemberExpression{
+										BaseNode: base("2:3", "2:6"),
+										Object: &ast.Identifier{
+											BaseNode: base("2:3", "2:4"),
+											Name:     "a",
+										},
+										Property: &ast.Identifier{
+											BaseNode: base("2:5", "2:6"),
+											Name:     "b",
+										},
+									},
+								},
+								&ast.TextPart{
+									BaseNode: base("2:7", "2:12"),
+									Value:    " done",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "string interpolation with extra tokens",
+			raw:  `"${a b}"`,
+			want: &ast.File{
+				BaseNode: base("1:1", "1:9"),
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						BaseNode: base("1:1", "1:9"),
+						Expression: &ast.StringExpression{
+							BaseNode: base("1:1", "1:9"),
+							Parts: []ast.StringExpressionPart{
+								&ast.InterpolatedPart{
+									BaseNode: ast.BaseNode{
+										Loc: loc("1:2", "1:8"),
+										Errors: []ast.Error{
+											{Msg: `unexpected IDENT ("b") in the string interpolation`},
+										},
+									},
+									Expression: &ast.Identifier{
+										BaseNode: base("1:4", "1:5"),
+										Name:     "a",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "unterminated string interpolation",
+			raw:  `"${a"`,
+			want: &ast.File{
+				BaseNode: base("1:1", "1:6"),
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						BaseNode: base("1:1", "1:6"),
+						Expression: &ast.StringExpression{
+							BaseNode: base("1:1", "1:6"),
+							Parts: []ast.StringExpressionPart{
+								&ast.InterpolatedPart{
+									BaseNode: ast.BaseNode{
+										Loc: loc("1:2", "1:5"),
+										Errors: []ast.Error{
+											{Msg: "expected } to end the string interpolation"},
+										},
+									},
+									Expression: &ast.Identifier{
+										BaseNode: base("1:4", "1:5"),
+										Name:     "a",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "two variables for two froms",
 			raw: `howdy = from()
@@ -1223,8 +1780,133 @@ import "path/bar"
 										Value:    "a",
 									},
 									Value: &ast.IntegerLiteral{
-										BaseNode: base("1:11", "1:13"),
-										Value:    10,
+										BaseNode: base("1:11", "1:13"),
+										Value:    10,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "object with mixed keys",
+			raw:  `x = {"a": 10, b: 11}`,
+			want: &ast.File{
+				BaseNode: base("1:1", "1:21"),
+				Body: []ast.Statement{
+					&ast.VariableAssignment{
+						BaseNode: base("1:1", "1:21"),
+						ID: &ast.Identifier{
+							BaseNode: base("1:1", "1:2"),
+							Name:     "x",
+						},
+						Init: &ast.ObjectExpression{
+							BaseNode: base("1:5", "1:21"),
+							Properties: []*ast.Property{
+								&ast.Property{
+									BaseNode: base("1:6", "1:13"),
+									Key: &ast.StringLiteral{
+										BaseNode: base("1:6", "1:9"),
+										Value:    "a",
+									},
+									Value: &ast.IntegerLiteral{
+										BaseNode: base("1:11", "1:13"),
+										Value:    10,
+									},
+								},
+								&ast.Property{
+									BaseNode: base("1:15", "1:20"),
+									Key: &ast.Identifier{
+										BaseNode: base("1:15", "1:16"),
+										Name:     "b",
+									},
+									Value: &ast.IntegerLiteral{
+										BaseNode: base("1:18", "1:20"),
+										Value:    11,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "object with",
+			raw:  `x = {r with a: 1, b}`,
+			want: &ast.File{
+				BaseNode: base("1:1", "1:21"),
+				Body: []ast.Statement{
+					&ast.VariableAssignment{
+						BaseNode: base("1:1", "1:21"),
+						ID: &ast.Identifier{
+							BaseNode: base("1:1", "1:2"),
+							Name:     "x",
+						},
+						Init: &ast.ObjectExpression{
+							BaseNode: base("1:5", "1:21"),
+							With: &ast.Identifier{
+								BaseNode: base("1:6", "1:7"),
+								Name:     "r",
+							},
+							Properties: []*ast.Property{
+								&ast.Property{
+									BaseNode: base("1:13", "1:17"),
+									Key: &ast.Identifier{
+										BaseNode: base("1:13", "1:14"),
+										Name:     "a",
+									},
+									Value: &ast.IntegerLiteral{
+										BaseNode: base("1:16", "1:17"),
+										Value:    1,
+									},
+								},
+								&ast.Property{
+									BaseNode: base("1:19", "1:20"),
+									Key: &ast.Identifier{
+										BaseNode: base("1:19", "1:20"),
+										Name:     "b",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "object with property named with",
+			raw:  `x = {with, a: 1}`,
+			want: &ast.File{
+				BaseNode: base("1:1", "1:17"),
+				Body: []ast.Statement{
+					&ast.VariableAssignment{
+						BaseNode: base("1:1", "1:17"),
+						ID: &ast.Identifier{
+							BaseNode: base("1:1", "1:2"),
+							Name:     "x",
+						},
+						Init: &ast.ObjectExpression{
+							BaseNode: base("1:5", "1:17"),
+							Properties: []*ast.Property{
+								&ast.Property{
+									BaseNode: base("1:6", "1:10"),
+									Key: &ast.Identifier{
+										BaseNode: base("1:6", "1:10"),
+										Name:     "with",
+									},
+								},
+								&ast.Property{
+									BaseNode: base("1:12", "1:16"),
+									Key: &ast.Identifier{
+										BaseNode: base("1:12", "1:13"),
+										Name:     "a",
+									},
+									Value: &ast.IntegerLiteral{
+										BaseNode: base("1:15", "1:16"),
+										Value:    1,
 									},
 								},
 							},
@@ -1234,40 +1916,41 @@ import "path/bar"
 			},
 		},
 		{
-			name: "object with mixed keys",
-			raw:  `x = {"a": 10, b: 11}`,
+			name: "object without commas",
+			raw: `x = {a: 1
+b: 2}`,
 			want: &ast.File{
-				BaseNode: base("1:1", "1:21"),
+				BaseNode: base("1:1", "2:6"),
 				Body: []ast.Statement{
 					&ast.VariableAssignment{
-						BaseNode: base("1:1", "1:21"),
+						BaseNode: base("1:1", "2:6"),
 						ID: &ast.Identifier{
 							BaseNode: base("1:1", "1:2"),
 							Name:     "x",
 						},
 						Init: &ast.ObjectExpression{
-							BaseNode: base("1:5", "1:21"),
+							BaseNode: base("1:5", "2:6"),
 							Properties: []*ast.Property{
 								&ast.Property{
-									BaseNode: base("1:6", "1:13"),
-									Key: &ast.StringLiteral{
-										BaseNode: base("1:6", "1:9"),
-										Value:    "a",
+									BaseNode: base("1:6", "1:10"),
+									Key: &ast.Identifier{
+										BaseNode: base("1:6", "1:7"),
+										Name:     "a",
 									},
 									Value: &ast.IntegerLiteral{
-										BaseNode: base("1:11", "1:13"),
-										Value:    10,
+										BaseNode: base("1:9", "1:10"),
+										Value:    1,
 									},
 								},
 								&ast.Property{
-									BaseNode: base("1:15", "1:20"),
+									BaseNode: base("2:1", "2:5"),
 									Key: &ast.Identifier{
-										BaseNode: base("1:15", "1:16"),
+										BaseNode: base("2:1", "2:2"),
 										Name:     "b",
 									},
 									Value: &ast.IntegerLiteral{
-										BaseNode: base("1:18", "1:20"),
-										Value:    11,
+										BaseNode: base("2:4", "2:5"),
+										Value:    2,
 									},
 								},
 							},
@@ -1311,6 +1994,154 @@ import "path/bar"
 				},
 			},
 		},
+		{
+			name: "array destructuring",
+			raw:  `[a, b] = [1, 2]`,
+			want: &ast.File{
+				BaseNode: base("1:1", "1:16"),
+				Body: []ast.Statement{
+					&ast.DestructuringAssignment{
+						BaseNode: base("1:1", "1:16"),
+						Pattern: &ast.ArrayExpression{
+							BaseNode: base("1:1", "1:7"),
+							Elements: []ast.Expression{
+								&ast.Identifier{
+									BaseNode: base("1:2", "1:3"),
+									Name:     "a",
+								},
+								&ast.Identifier{
+									BaseNode: base("1:5", "1:6"),
+									Name:     "b",
+								},
+							},
+						},
+						Init: &ast.ArrayExpression{
+							BaseNode: base("1:10", "1:16"),
+							Elements: []ast.Expression{
+								&ast.IntegerLiteral{
+									BaseNode: base("1:11", "1:12"),
+									Value:    1,
+								},
+								&ast.IntegerLiteral{
+									BaseNode: base("1:14", "1:15"),
+									Value:    2,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "object destructuring",
+			raw:  `{a, b: c} = r`,
+			want: &ast.File{
+				BaseNode: base("1:1", "1:14"),
+				Body: []ast.Statement{
+					&ast.DestructuringAssignment{
+						BaseNode: base("1:1", "1:14"),
+						Pattern: &ast.ObjectExpression{
+							BaseNode: base("1:1", "1:10"),
+							Properties: []*ast.Property{
+								{
+									BaseNode: base("1:2", "1:3"),
+									Key: &ast.Identifier{
+										BaseNode: base("1:2", "1:3"),
+										Name:     "a",
+									},
+								},
+								{
+									BaseNode: base("1:5", "1:9"),
+									Key: &ast.Identifier{
+										BaseNode: base("1:5", "1:6"),
+										Name:     "b",
+									},
+									Value: &ast.Identifier{
+										BaseNode: base("1:8", "1:9"),
+										Name:     "c",
+									},
+								},
+							},
+						},
+						Init: &ast.Identifier{
+							BaseNode: base("1:13", "1:14"),
+							Name:     "r",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "array destructuring with non identifier element",
+			raw:  `[a, 1] = r`,
+			want: &ast.File{
+				BaseNode: base("1:1", "1:11"),
+				Body: []ast.Statement{
+					&ast.DestructuringAssignment{
+						BaseNode: base("1:1", "1:11"),
+						Pattern: &ast.ArrayExpression{
+							BaseNode: base("1:1", "1:7"),
+							Elements: []ast.Expression{
+								&ast.Identifier{
+									BaseNode: base("1:2", "1:3"),
+									Name:     "a",
+								},
+								&ast.IntegerLiteral{
+									BaseNode: ast.BaseNode{
+										Loc: loc("1:5", "1:6"),
+										Errors: []ast.Error{
+											{Msg: "cannot assign to IntegerLiteral, array pattern elements must be identifiers"},
+										},
+									},
+									Value: 1,
+								},
+							},
+						},
+						Init: &ast.Identifier{
+							BaseNode: base("1:10", "1:11"),
+							Name:     "r",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "array destructuring on the line after an expression",
+			raw: `x = a
+[b] = x`,
+			want: &ast.File{
+				BaseNode: base("1:1", "2:8"),
+				Body: []ast.Statement{
+					&ast.VariableAssignment{
+						BaseNode: base("1:1", "1:6"),
+						ID: &ast.Identifier{
+							BaseNode: base("1:1", "1:2"),
+							Name:     "x",
+						},
+						Init: &ast.Identifier{
+							BaseNode: base("1:5", "1:6"),
+							Name:     "a",
+						},
+					},
+					&ast.DestructuringAssignment{
+						BaseNode: base("2:1", "2:8"),
+						Pattern: &ast.ArrayExpression{
+							BaseNode: base("2:1", "2:4"),
+							Elements: []ast.Expression{
+								&ast.Identifier{
+									BaseNode: base("2:2", "2:3"),
+									Name:     "b",
+								},
+							},
+						},
+						Init: &ast.Identifier{
+							BaseNode: base("2:7", "2:8"),
+							Name:     "x",
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "index expression",
 			raw:  `a[3]`,
@@ -4820,6 +5651,58 @@ string"
 				},
 			},
 		},
+		{
+			name: "multiline raw string",
+			raw:  "`SELECT *\n  FROM \"cpu\"\n  WHERE host = 'a'`",
+			want: &ast.File{
+				BaseNode: base("1:1", "3:20"),
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						BaseNode: base("1:1", "3:20"),
+						Expression: &ast.StringLiteral{
+							BaseNode: base("1:1", "3:20"),
+							Value:    "SELECT *\n  FROM \"cpu\"\n  WHERE host = 'a'",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "raw string with backslashes",
+			raw:  "`^\\d+\\.\\d*\\n$`",
+			want: &ast.File{
+				BaseNode: base("1:1", "1:15"),
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						BaseNode: base("1:1", "1:15"),
+						Expression: &ast.StringLiteral{
+							BaseNode: base("1:1", "1:15"),
+							Value:    `^\d+\.\d*\n$`,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "raw string with backticks",
+			raw:  "x = `a `` b ${c}`",
+			want: &ast.File{
+				BaseNode: base("1:1", "1:18"),
+				Body: []ast.Statement{
+					&ast.VariableAssignment{
+						BaseNode: base("1:1", "1:18"),
+						ID: &ast.Identifier{
+							BaseNode: base("1:1", "1:2"),
+							Name:     "x",
+						},
+						Init: &ast.StringLiteral{
+							BaseNode: base("1:5", "1:18"),
+							Value:    "a ` b ${c}",
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "illegal statement token",
 			raw:  `@ ident`,
@@ -4881,10 +5764,13 @@ string"
 			want: &ast.File{
 				// TODO(jsternberg): Parens aren't recorded correctly
 				// in the source and are mostly ignored.
+				BaseNode: base("1:1", "1:4"),
 				Body: []ast.Statement{
 					&ast.ExpressionStatement{
+						BaseNode: base("1:4", "1:4"),
 						Expression: &ast.BinaryExpression{
 							BaseNode: ast.BaseNode{
+								Loc: loc("1:4", "1:4"),
 								Errors: []ast.Error{
 									{Msg: "missing left hand side of expression"},
 								},
@@ -4905,10 +5791,13 @@ string"
 			want: &ast.File{
 				// TODO(jsternberg): Parens aren't recorded correctly
 				// in the source and are mostly ignored.
+				BaseNode: base("1:1", "1:2"),
 				Body: []ast.Statement{
 					&ast.ExpressionStatement{
+						BaseNode: base("1:2", "1:2"),
 						Expression: &ast.BinaryExpression{
 							BaseNode: ast.BaseNode{
+								Loc: loc("1:2", "1:2"),
 								Errors: []ast.Error{
 									{Msg: "missing right hand side of expression"},
 								},
@@ -4929,6 +5818,7 @@ string"
 			want: &ast.File{
 				// TODO(jsternberg): Parens aren't recorded correctly
 				// in the source and are mostly ignored.
+				BaseNode: base("1:1", "1:1"),
 				Body: []ast.Statement{
 					&ast.ExpressionStatement{
 						BaseNode: ast.BaseNode{
@@ -4949,10 +5839,13 @@ string"
 			name: "missing arrow in function expression",
 			raw:  `(a, b) a + b`,
 			want: &ast.File{
+				BaseNode: base("1:1", "1:1"),
 				Body: []ast.Statement{
 					&ast.ExpressionStatement{
+						BaseNode: base("1:1", "1:1"),
 						Expression: &ast.FunctionExpression{
 							BaseNode: ast.BaseNode{
+								Loc: loc("1:1", "1:1"),
 								Errors: []ast.Error{
 									{Msg: `expected ARROW, got IDENT ("a") at 1:8`},
 									{Msg: `expected ARROW, got ADD ("+") at 1:10`},
@@ -5103,3 +5996,202 @@ func mustParseTime(s string) time.Time {
 	}
 	return ts
 }
+
+func TestParseFileWithComments(t *testing.T) {
+	src := `// Package foo does things.
+package foo
+
+// add adds one.
+// It is a function.
+add = (r) => r + 1 // inline
+x = {
+	// a is the first field
+	a: 1,
+}
+// trailing comment at the end of the file`
+
+	comment := func(text, start, end string) ast.Comment {
+		l := loc(start, end)
+		l.File = "foo.flux"
+		return ast.Comment{Text: text, Loc: l}
+	}
+	commentsOf := func(n ast.Node) []ast.Comment {
+		return reflect.ValueOf(n).Elem().FieldByName("Comments").Interface().([]ast.Comment)
+	}
+
+	fset := token.NewFile("foo.flux", len(src))
+	file := parser.ParseFileWithComments(fset, []byte(src))
+
+	obj := file.Body[1].(*ast.VariableAssignment).Init.(*ast.ObjectExpression)
+	for _, tt := range []struct {
+		name string
+		node ast.Node
+		want []ast.Comment
+	}{
+		{
+			name: "package clause",
+			node: file.Package,
+			want: []ast.Comment{comment("// Package foo does things.", "1:1", "1:28")},
+		},
+		{
+			name: "function declaration",
+			node: file.Body[0],
+			want: []ast.Comment{
+				comment("// add adds one.", "4:1", "4:17"),
+				comment("// It is a function.", "5:1", "5:21"),
+				comment("// inline", "6:20", "6:29"),
+			},
+		},
+		{
+			name: "property",
+			node: obj.Properties[0],
+			want: []ast.Comment{comment("// a is the first field", "8:2", "8:25")},
+		},
+		{
+			name: "file",
+			node: file,
+			want: []ast.Comment{comment("// trailing comment at the end of the file", "11:1", "11:43")},
+		},
+		{
+			name: "function body",
+			node: file.Body[0].(*ast.VariableAssignment).Init,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := commentsOf(tt.node); !cmp.Equal(tt.want, got, CompareOptions...) {
+				t.Errorf("unexpected comments -want/+got:\n%s", cmp.Diff(tt.want, got, CompareOptions...))
+			}
+		})
+	}
+
+	// The comments should not change the parsed AST.
+	want := parser.ParseFile(token.NewFile("foo.flux", len(src)), []byte(src))
+	ast.Walk(ast.CreateVisitor(func(n ast.Node) {
+		reflect.ValueOf(n).Elem().FieldByName("Comments").Set(reflect.Zero(reflect.TypeOf([]ast.Comment{})))
+	}), file)
+	if !cmp.Equal(want, file, CompareOptions...) {
+		t.Errorf("unexpected file -want/+got:\n%s", cmp.Diff(want, file, CompareOptions...))
+	}
+}
+
+func TestParseFile_MultipleErrors(t *testing.T) {
+	src := `a = 1
+b = @
+c = 2
+d = (1 +)
+e = 3
+f = g |> 4
+h = 5
+`
+	f := token.NewFile("a.flux", len(src))
+	file := parser.ParseFile(f, []byte(src))
+
+	// Each of the statements between the errors must still be parsed.
+	var names []string
+	for _, stmt := range file.Body {
+		if va, ok := stmt.(*ast.VariableAssignment); ok {
+			names = append(names, va.ID.Name)
+		}
+	}
+	if want := []string{"a", "b", "c", "d", "e", "f", "h"}; !cmp.Equal(want, names) {
+		t.Errorf("unexpected assignments -want/+got:\n%s", cmp.Diff(want, names))
+	}
+
+	if got, want := ast.Check(file), 3; got != want {
+		t.Errorf("unexpected error count: want %d got %d", want, got)
+	}
+
+	type nodeError struct {
+		Type  string
+		Start ast.Position
+		Msg   string
+	}
+	var got []nodeError
+	for _, err := range ast.GetNodeErrors(file) {
+		got = append(got, nodeError{
+			Type:  err.Node.Type(),
+			Start: err.Location().Start,
+			Msg:   err.Err.Msg,
+		})
+	}
+	want := []nodeError{
+		{
+			Type:  "BadStatement",
+			Start: ast.Position{Line: 2, Column: 5},
+			Msg:   "invalid statement a.flux@2:5-2:6: @",
+		},
+		{
+			Type:  "BinaryExpression",
+			Start: ast.Position{Line: 4, Column: 6},
+			Msg:   "missing right hand side of expression",
+		},
+		{
+			Type:  "CallExpression",
+			Start: ast.Position{Line: 6, Column: 10},
+			Msg:   "pipe destination must be a function call",
+		},
+	}
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected errors -want/+got:\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestParseFile_TrailingComma(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		raw  string
+		want string
+	}{
+		{
+			name: "array",
+			raw:  "a = [1, 2,]",
+			want: "a = [1, 2]",
+		},
+		{
+			name: "multiline array",
+			raw:  "a = [\n\t1,\n\t2,\n]",
+			want: "a = [1, 2]",
+		},
+		{
+			name: "record",
+			raw:  "a = {x: 1, y: 2,}",
+			want: "a = {x: 1, y: 2}",
+		},
+		{
+			name: "record with",
+			raw:  "a = {r with x: 1,}",
+			want: "a = {r with x: 1}",
+		},
+		{
+			name: "record string keys",
+			raw:  `a = {"x": 1, "y": 2,}`,
+			want: `a = {"x": 1, "y": 2}`,
+		},
+		{
+			name: "call",
+			raw:  "f(x: 1, y: 2,)",
+			want: "f(x: 1, y: 2)",
+		},
+		{
+			name: "multiline call",
+			raw:  "f(\n\tx: 1,\n\ty: 2,\n)",
+			want: "f(x: 1, y: 2)",
+		},
+		{
+			name: "function parameters",
+			raw:  "f = (a, b=1,) => a + b",
+			want: "f = (a, b=1) => a + b",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parser.ParseFile(token.NewFile("", len(tt.raw)), []byte(tt.raw))
+			if ast.Check(got) > 0 {
+				t.Fatalf("unexpected error: %s", ast.GetError(got))
+			}
+			want := parser.ParseFile(token.NewFile("", len(tt.want)), []byte(tt.want))
+			if !cmp.Equal(want, got, asttest.IgnoreBaseNodeOptions...) {
+				t.Errorf("unexpected file -want/+got:\n%s", cmp.Diff(want, got, asttest.IgnoreBaseNodeOptions...))
+			}
+		})
+	}
+}
@@ -31,6 +31,37 @@ func MustParseTime(lit string) time.Time {
 	return ts
 }
 
+// ParseInt will parse an integer literal into an int64.
+// The literal is either decimal, or hexadecimal with a 0x prefix,
+// or binary with a 0b prefix. The digits of a hexadecimal or binary
+// literal may be separated by single underscores.
+func ParseInt(lit string) (int64, error) {
+	base, digits := 10, lit
+	if len(lit) > 1 && lit[0] == '0' {
+		switch lit[1] {
+		case 'x', 'X':
+			base, digits = 16, lit[2:]
+		case 'b', 'B':
+			base, digits = 2, lit[2:]
+		}
+	}
+	if base != 10 {
+		digits = strings.TrimPrefix(digits, "_")
+		if digits == "" || strings.HasSuffix(digits, "_") || strings.Contains(digits, "__") {
+			return 0, fmt.Errorf("invalid integer literal %q", lit)
+		}
+		digits = strings.Replace(digits, "_", "", -1)
+	}
+	v, err := strconv.ParseInt(digits, base, 64)
+	if err != nil {
+		if err.(*strconv.NumError).Err == strconv.ErrRange {
+			return 0, fmt.Errorf("integer literal %q overflows a 64-bit integer", lit)
+		}
+		return 0, fmt.Errorf("invalid integer literal %q", lit)
+	}
+	return v, nil
+}
+
 // ParseDuration will convert a string into components of the duration.
 func ParseDuration(lit string) ([]ast.Duration, error) {
 	var values []ast.Duration
@@ -80,7 +111,12 @@ func ParseDuration(lit string) ([]ast.Duration, error) {
 }
 
 // ParseString removes quotes and unescapes the string literal.
+// A raw string literal, enclosed in backticks, is not unescaped
+// except that a doubled backtick is replaced by a single backtick.
 func ParseString(lit string) (string, error) {
+	if len(lit) >= 2 && lit[0] == '`' && lit[len(lit)-1] == '`' {
+		return strings.Replace(lit[1:len(lit)-1], "``", "`", -1), nil
+	}
 	if len(lit) < 2 || lit[0] != '"' || lit[len(lit)-1] != '"' {
 		return "", fmt.Errorf("invalid syntax")
 	}
@@ -31,10 +31,21 @@ func New(f *token.File, data []byte) *Scanner {
 // Init initializes the Scanner to scan the data in the byte array.
 func (s *Scanner) Init(f *token.File, data []byte) {
 	s.f = f
+	s.f.SetSource(data)
 	s.p, s.pe, s.eof = 0, len(data), len(data)
 	s.data = data
 }
 
+// NewRange will construct a new Scanner that only scans the data
+// between the start and end offsets. Positions are still reported
+// relative to the beginning of the data so that tokens within the
+// range have the same positions they have in the whole file.
+func NewRange(f *token.File, data []byte, start, end int) *Scanner {
+	s := New(f, data)
+	s.p, s.pe, s.eof = start, end, end
+	return s
+}
+
 // File returns the file being processed by the Scanner.
 func (s *Scanner) File() *token.File {
 	return s.f
@@ -71,6 +82,9 @@ func (s *Scanner) Unread() {
 func (s *Scanner) scan(cs int) (pos token.Pos, tok token.Token, lit string) {
 	s.reset, s.token, s.checkpoint = s.p, token.ILLEGAL, -1
 	if es := s.exec(cs); es == flux_error {
+		if s.data[s.ts] == '`' && s.scanRawString() {
+			return s.f.Pos(s.ts), token.STRING, string(s.data[s.ts:s.te])
+		}
 		// Execution failed meaning we hit a pattern that we don't support and
 		// doesn't produce a token. Use the unicode library to decode the next character
 		// in the sequence so we don't break up any unicode tokens.
@@ -85,7 +99,62 @@ func (s *Scanner) scan(cs int) (pos token.Pos, tok token.Token, lit string) {
 		s.p = s.ts + size
 		return s.f.Pos(s.ts), token.ILLEGAL, string(s.data[s.ts : s.ts+size])
 	} else if s.token == token.ILLEGAL && s.p == s.eof {
-		return s.f.Pos(len(s.data)), token.EOF, ""
+		return s.f.Pos(s.eof), token.EOF, ""
+	}
+	if s.token == token.INT {
+		s.scanRadixPrefix()
 	}
 	return s.f.Pos(s.ts), s.token, string(s.data[s.ts:s.te])
 }
+
+// scanRadixPrefix extends an integer literal of "0" that is followed by
+// a hexadecimal (0x) or binary (0b) prefix to include the letters, digits
+// and underscores that follow the prefix. The digits are validated when
+// the literal is parsed so that a malformed literal is reported as a
+// single invalid integer rather than as a series of unrelated tokens.
+func (s *Scanner) scanRadixPrefix() {
+	if s.te-s.ts != 1 || s.data[s.ts] != '0' || s.te >= s.eof {
+		return
+	}
+	switch s.data[s.te] {
+	case 'x', 'X', 'b', 'B':
+	default:
+		return
+	}
+	end := s.te + 1
+	for ; end < s.eof; end++ {
+		if ch := s.data[end]; ch != '_' && !isASCIIAlnum(ch) {
+			break
+		}
+	}
+	s.te, s.p = end, end
+}
+
+// scanRawString scans a raw string literal that starts with a backtick at
+// the start of the current token. The literal ends at the next backtick
+// that is not doubled, since a doubled backtick is how a raw string
+// includes a backtick. The literal may span several lines. If the literal
+// is not terminated, nothing is consumed and false is returned.
+func (s *Scanner) scanRawString() bool {
+	for end := s.ts + 1; end < s.pe; end++ {
+		if s.data[end] != '`' {
+			continue
+		}
+		if end+1 < s.pe && s.data[end+1] == '`' {
+			end++
+			continue
+		}
+		for i := s.ts + 1; i < end; i++ {
+			if s.data[i] == '\n' {
+				s.f.AddLine(i + 1)
+			}
+		}
+		s.te, s.p = end+1, end+1
+		return true
+	}
+	return false
+}
+
+func isASCIIAlnum(ch byte) bool {
+	return '0' <= ch && ch <= '9' || 'a' <= ch && ch <= 'z' || 'A' <= ch && ch <= 'Z'
+}
@@ -25,6 +25,10 @@ var common = []TokenPattern{
 	{s: "0", tok: token.INT, lit: "0"},
 	{s: "42", tok: token.INT, lit: "42"},
 	{s: "317316873", tok: token.INT, lit: "317316873"},
+	{s: "0xFF", tok: token.INT, lit: "0xFF"},
+	{s: "0XdeadBEEF", tok: token.INT, lit: "0XdeadBEEF"},
+	{s: "0b1010", tok: token.INT, lit: "0b1010"},
+	{s: "0B_1111_0000", tok: token.INT, lit: "0B_1111_0000"},
 	{s: "0.", tok: token.FLOAT, lit: "0."},
 	{s: "72.40", tok: token.FLOAT, lit: "72.40"},
 	{s: "072.40", tok: token.FLOAT, lit: "072.40"},
@@ -44,6 +48,10 @@ var common = []TokenPattern{
 	{s: `"string with backslash \\"`, tok: token.STRING, lit: `"string with backslash \\"`},
 	{s: `"日本語"`, tok: token.STRING, lit: `"日本語"`},
 	{s: `"\xe6\x97\xa5\xe6\x9c\xac\xe8\xaa\x9e"`, tok: token.STRING, lit: `"\xe6\x97\xa5\xe6\x9c\xac\xe8\xaa\x9e"`},
+	{s: "`raw string`", tok: token.STRING, lit: "`raw string`"},
+	{s: "`raw \\d+ \"string\"`", tok: token.STRING, lit: "`raw \\d+ \"string\"`"},
+	{s: "`multiline\nraw string`", tok: token.STRING, lit: "`multiline\nraw string`"},
+	{s: "`raw `` backtick`", tok: token.STRING, lit: "`raw `` backtick`"},
 	{s: `a`, tok: token.IDENT, lit: `a`},
 	{s: `_x`, tok: token.IDENT, lit: `_x`},
 	{s: `longIdentifierName`, tok: token.IDENT, lit: `longIdentifierName`},
@@ -256,6 +264,18 @@ func TestScanner_MultipleTokens(t *testing.T) {
 				token.INT,
 			},
 		},
+		{
+			name: "hexadecimal and binary integers",
+			s:    `0xFF&0b1+0 x`,
+			want: []token.Token{
+				token.INT,
+				token.ILLEGAL,
+				token.INT,
+				token.ADD,
+				token.INT,
+				token.IDENT,
+			},
+		},
 		{
 			name: "function chain",
 			s:    `from(bucket: "telegraf") |> range(start: -5m) |> last()`,
@@ -345,6 +365,7 @@ func TestScanner_IllegalToken(t *testing.T) {
 		{name: "Ascii", ch: fmt.Sprintf("%c", '@')},
 		{name: "Multibyte", ch: fmt.Sprintf("%c", '£')},
 		{name: "Invalid", ch: string([]byte{0xa2})},
+		{name: "Unterminated raw string", ch: "`"},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			src := []byte(tt.ch + ` x = 5`)
@@ -433,6 +454,14 @@ line3`,
 				{Token: token.IDENT, Line: 3, Column: 1},
 			},
 		},
+		{
+			name: "multiline raw string",
+			s:    "`hello\nworld`\nline3",
+			want: []Position{
+				{Token: token.STRING, Line: 1, Column: 1},
+				{Token: token.IDENT, Line: 3, Column: 1},
+			},
+		},
 		{
 			name: "simple",
 			s: `from(bucket: "telegraf") |>
@@ -10,6 +10,7 @@ import (
 
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/interpreter"
 	"github.com/influxdata/flux/semantic"
 	"github.com/influxdata/flux/values"
 	"github.com/opentracing/opentracing-go"
@@ -31,9 +32,20 @@ func FromScript(ctx context.Context, script string, now time.Time) (*flux.Spec,
 
 // FromAST returns a spec from an AST.
 func FromAST(ctx context.Context, astPkg *ast.Package, now time.Time) (*flux.Spec, error) {
+	return fromAST(ctx, flux.EvalAST, astPkg, now)
+}
+
+// FromRuntimeAST returns a spec from an AST that is evaluated with the runtime.
+func FromRuntimeAST(ctx context.Context, rt *flux.Runtime, astPkg *ast.Package, now time.Time) (*flux.Spec, error) {
+	return fromAST(ctx, rt.EvalAST, astPkg, now)
+}
+
+type evalASTFunc func(astPkg *ast.Package, opts ...flux.ScopeMutator) ([]values.Value, interpreter.Scope, error)
+
+func fromAST(ctx context.Context, eval evalASTFunc, astPkg *ast.Package, now time.Time) (*flux.Spec, error) {
 	s, _ := opentracing.StartSpanFromContext(ctx, "eval")
 
-	sideEffects, scope, err := flux.EvalAST(astPkg, flux.SetOption(nowOption, generateNowFunc(now)))
+	sideEffects, scope, err := eval(astPkg, flux.SetOption(nowOption, generateNowFunc(now)))
 	if err != nil {
 		return nil, err
 	}
@@ -128,6 +140,10 @@ func toSpec(functionCalls []values.Value, now time.Time) (*flux.Spec, error) {
 		lookup: make(map[*flux.TableObject]flux.OperationID),
 	}
 
+	if err := linkBuffers(functionCalls); err != nil {
+		return nil, err
+	}
+
 	spec := &flux.Spec{Now: now}
 	seen := make(map[*flux.TableObject]bool)
 	objs := make([]*flux.TableObject, 0, len(functionCalls))
@@ -177,3 +193,72 @@ func buildSpec(t *flux.TableObject, ider flux.IDer, spec *flux.Spec, visited map
 	visited[t] = true
 	spec.Operations = append(spec.Operations, t.Operation(ider))
 }
+
+// linkBuffers adds the writer of each buffer as the parent of the table objects that read it.
+// Buffers are only visible within the side effects of a single evaluation.
+func linkBuffers(functionCalls []values.Value) error {
+	var (
+		writers = make(map[string]*flux.TableObject)
+		readers []*flux.TableObject
+		dup     string
+	)
+	visited := make(map[*flux.TableObject]bool)
+	var visit func(t *flux.TableObject)
+	visit = func(t *flux.TableObject) {
+		if visited[t] {
+			return
+		}
+		visited[t] = true
+		t.Parents.Range(func(i int, v values.Value) {
+			visit(v.(*flux.TableObject))
+		})
+		switch s := t.Spec.(type) {
+		case flux.BufferWriter:
+			if _, ok := writers[s.WriteBuffer()]; ok {
+				dup = s.WriteBuffer()
+			}
+			writers[s.WriteBuffer()] = t
+		case flux.BufferReader:
+			readers = append(readers, t)
+		}
+	}
+	for _, call := range functionCalls {
+		if t, ok := call.(*flux.TableObject); ok {
+			visit(t)
+		}
+	}
+	if dup != "" {
+		return fmt.Errorf("buffer %q is written more than once", dup)
+	}
+
+	for _, r := range readers {
+		name := r.Spec.(flux.BufferReader).ReadBuffer()
+		w, ok := writers[name]
+		if !ok {
+			return fmt.Errorf("buffer %q is read but never written", name)
+		}
+		if isAncestor(r, w, make(map[*flux.TableObject]bool)) {
+			return fmt.Errorf("buffer %q is read by its own input", name)
+		}
+		r.Parents.Append(w)
+	}
+	return nil
+}
+
+// isAncestor reports whether a is t or one of the ancestors of t.
+func isAncestor(a, t *flux.TableObject, visited map[*flux.TableObject]bool) bool {
+	if a == t {
+		return true
+	}
+	if visited[t] {
+		return false
+	}
+	visited[t] = true
+	found := false
+	t.Parents.Range(func(i int, v values.Value) {
+		if !found {
+			found = isAncestor(a, v.(*flux.TableObject), visited)
+		}
+	})
+	return found
+}
@@ -1,15 +1,22 @@
 package token
 
 import (
+	"unicode/utf8"
+
 	"github.com/influxdata/flux/ast"
 )
 
 type FileSet struct {
 	files []*File
+
+	// RuneColumns configures the files added to the set to report
+	// columns as a count of runes instead of a count of bytes.
+	RuneColumns bool
 }
 
 func (f *FileSet) AddFile(filename string, size int) *File {
 	file := NewFile(filename, size)
+	file.runeColumns = f.RuneColumns
 	f.files = append(f.files, file)
 	return file
 }
@@ -18,6 +25,11 @@ type File struct {
 	name  string
 	lines []int // lines contains the offset of the first character for each line (the first entry is always 0)
 	sz    int
+
+	// src is the source for the file. It is only needed
+	// to translate between offsets and rune columns.
+	src         []byte
+	runeColumns bool
 }
 
 func NewFile(name string, sz int) *File {
@@ -28,7 +40,14 @@ func NewFile(name string, sz int) *File {
 	}
 }
 
+// AddLine records the offset of the first character of a new line.
+// Offsets at or before the last recorded line are ignored so that
+// scanning the same section of the file again does not add the
+// lines within it twice.
 func (f *File) AddLine(offset int) {
+	if offset <= f.lines[len(f.lines)-1] {
+		return
+	}
 	f.lines = append(f.lines, offset)
 }
 
@@ -36,13 +55,33 @@ func (f *File) Name() string {
 	return f.name
 }
 
+// SetSource sets the source for the file.
+// The scanner sets the source when it is initialized with the file.
+func (f *File) SetSource(src []byte) {
+	f.src = src
+}
+
+// SetRuneColumns configures the file to report columns as a count
+// of runes instead of a count of bytes. This requires the source
+// for the file to have been set.
+func (f *File) SetRuneColumns(runeColumns bool) {
+	f.runeColumns = runeColumns
+}
+
 // Offset returns the offset for the given line/column.
 func (f *File) Offset(pos ast.Position) int {
 	if pos.Line == 0 || pos.Column == 0 {
 		return -1
 	}
 	offset := f.lines[pos.Line-1]
-	return offset + pos.Column - 1
+	if !f.runeColumns {
+		return offset + pos.Column - 1
+	}
+	for i := 1; i < pos.Column && offset < len(f.src); i++ {
+		_, size := utf8.DecodeRune(f.src[offset:])
+		offset += size
+	}
+	return offset
 }
 
 func (f *File) Base() int {
@@ -60,9 +99,13 @@ func (f *File) Pos(offset int) Pos {
 func (f *File) Position(pos Pos) ast.Position {
 	offset := int(pos) - 1
 	i := searchInts(f.lines, offset)
+	column := offset - f.lines[i] + 1
+	if f.runeColumns && offset <= len(f.src) {
+		column = utf8.RuneCount(f.src[f.lines[i]:offset]) + 1
+	}
 	return ast.Position{
 		Line:   i + 1,
-		Column: offset - f.lines[i] + 1,
+		Column: column,
 	}
 }
 
@@ -3,6 +3,7 @@ package interpreter
 import (
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/influxdata/flux/ast"
 	"github.com/influxdata/flux/semantic"
@@ -10,20 +11,34 @@ import (
 	"github.com/pkg/errors"
 )
 
+// DefaultMaxCallDepth is the number of nested function calls an interpreter
+// allows before it stops the evaluation with an error.
+const DefaultMaxCallDepth = 1000
+
 type Interpreter struct {
 	types       map[semantic.Node]semantic.Type
 	polyTypes   map[semantic.Node]semantic.PolyType
 	sideEffects []values.Value
 	pkg         string
+
+	callDepth    int
+	maxCallDepth int
 }
 
 func NewInterpreter() *Interpreter {
 	return &Interpreter{
-		types:     make(map[semantic.Node]semantic.Type),
-		polyTypes: make(map[semantic.Node]semantic.PolyType),
+		types:        make(map[semantic.Node]semantic.Type),
+		polyTypes:    make(map[semantic.Node]semantic.PolyType),
+		maxCallDepth: DefaultMaxCallDepth,
 	}
 }
 
+// SetMaxCallDepth sets the number of nested function calls allowed
+// before evaluation fails. A depth of zero or less removes the limit.
+func (itrp *Interpreter) SetMaxCallDepth(depth int) {
+	itrp.maxCallDepth = depth
+}
+
 // Eval evaluates the expressions composing a Flux package and returns any side effects that occured.
 func (itrp *Interpreter) Eval(node semantic.Node, scope Scope, importer Importer) ([]values.Value, error) {
 	var n = node
@@ -159,6 +174,8 @@ func (itrp *Interpreter) doStatement(stmt semantic.Statement, scope Scope) (valu
 		return itrp.doVariableAssignment(s, scope)
 	case *semantic.MemberAssignment:
 		return itrp.doMemberAssignment(s, scope)
+	case *semantic.DestructuringAssignment:
+		return itrp.doDestructuringAssignment(s, scope)
 	case *semantic.ExpressionStatement:
 		v, err := itrp.doExpression(s.Expression, scope)
 		if err != nil {
@@ -208,6 +225,38 @@ func (itrp *Interpreter) doMemberAssignment(a *semantic.MemberAssignment, scope
 	return object, nil
 }
 
+func (itrp *Interpreter) doDestructuringAssignment(a *semantic.DestructuringAssignment, scope Scope) (values.Value, error) {
+	value, err := itrp.doExpression(a.Init, scope)
+	if err != nil {
+		return nil, err
+	}
+	if len(a.Keys) == 0 {
+		if typ := value.Type().Nature(); typ != semantic.Array {
+			return nil, fmt.Errorf("cannot destructure %v as an array", typ)
+		}
+		arr := value.Array()
+		if arr.Len() != len(a.Identifiers) {
+			return nil, fmt.Errorf("cannot destructure array of length %d into %d identifiers", arr.Len(), len(a.Identifiers))
+		}
+		for i, id := range a.Identifiers {
+			scope.Set(id.Name, arr.Get(i))
+		}
+		return value, nil
+	}
+	if typ := value.Type().Nature(); typ != semantic.Object {
+		return nil, fmt.Errorf("cannot destructure %v as an object", typ)
+	}
+	obj := value.Object()
+	for i, id := range a.Identifiers {
+		v, ok := obj.Get(a.Keys[i])
+		if !ok {
+			return nil, fmt.Errorf("cannot destructure missing property %q", a.Keys[i])
+		}
+		scope.Set(id.Name, v)
+	}
+	return value, nil
+}
+
 func (itrp *Interpreter) doAssignment(a semantic.Assignment, scope Scope) (values.Value, error) {
 	switch a := a.(type) {
 	case *semantic.NativeVariableAssignment:
@@ -234,6 +283,11 @@ func (itrp *Interpreter) doExpression(expr semantic.Expression, scope Scope) (va
 	case *semantic.CallExpression:
 		v, err := itrp.doCall(e, scope)
 		if err != nil {
+			// Every nested call would wrap the error again,
+			// so report where the limit was reached as is.
+			if _, ok := err.(*CallDepthError); ok {
+				return nil, err
+			}
 			// Determine function name
 			return nil, errors.Wrapf(err, "error calling function %q", functionName(e))
 		}
@@ -361,6 +415,26 @@ func (itrp *Interpreter) doExpression(expr semantic.Expression, scope Scope) (va
 		} else {
 			return itrp.doExpression(e.Alternate, scope)
 		}
+	case *semantic.StringExpression:
+		var b strings.Builder
+		for _, p := range e.Parts {
+			switch p := p.(type) {
+			case *semantic.TextPart:
+				b.WriteString(p.Value)
+			case *semantic.InterpolatedPart:
+				v, err := itrp.doExpression(p.Expression, scope)
+				if err != nil {
+					return nil, err
+				}
+				if v.Type() != semantic.String {
+					return nil, fmt.Errorf("interpolated expression must be a string, got %v", v.Type())
+				}
+				b.WriteString(v.Str())
+			default:
+				return nil, fmt.Errorf("unsupported string expression part %T", p)
+			}
+		}
+		return values.NewString(b.String()), nil
 	case *semantic.FunctionExpression:
 		// Capture type information
 		types := make(map[semantic.Node]semantic.Type)
@@ -388,6 +462,11 @@ func (itrp *Interpreter) doArray(a *semantic.ArrayExpression, scope Scope) (valu
 	elements := make([]values.Value, len(a.Elements))
 	arrayType, ok := itrp.types[a]
 	if !ok {
+		// An empty array whose element type is never constrained
+		// has no monotype, give it elements of the nil type.
+		if len(a.Elements) == 0 {
+			return values.NewArrayWithBacking(semantic.Nil, elements), nil
+		}
 		return nil, fmt.Errorf("expecting array type")
 	}
 	elementType := arrayType.ElementType()
@@ -403,14 +482,28 @@ func (itrp *Interpreter) doArray(a *semantic.ArrayExpression, scope Scope) (valu
 
 func (itrp *Interpreter) doObject(m *semantic.ObjectExpression, scope Scope) (values.Value, error) {
 	obj := values.NewObject()
+	if m.With != nil {
+		with, err := itrp.doExpression(m.With, scope)
+		if err != nil {
+			return nil, err
+		}
+		if with.Type().Nature() != semantic.Object {
+			return nil, fmt.Errorf("cannot extend %q, value is not an object", m.With.Name)
+		}
+		with.Object().Range(func(k string, v values.Value) {
+			obj.Set(k, v)
+		})
+	}
+	keys := make(map[string]bool, len(m.Properties))
 	for _, p := range m.Properties {
 		v, err := itrp.doExpression(p.Value, scope)
 		if err != nil {
 			return nil, err
 		}
-		if _, ok := obj.Get(p.Key.Key()); ok {
+		if keys[p.Key.Key()] {
 			return nil, fmt.Errorf("duplicate key in object: %q", p.Key.Key())
 		}
+		keys[p.Key.Key()] = true
 		obj.Set(p.Key.Key(), v)
 	}
 	return obj, nil
@@ -467,6 +560,16 @@ type functionType interface {
 }
 
 func (itrp *Interpreter) doCall(call *semantic.CallExpression, scope Scope) (values.Value, error) {
+	// Stop runaway recursion before it exhausts the stack.
+	if itrp.maxCallDepth > 0 && itrp.callDepth >= itrp.maxCallDepth {
+		return nil, &CallDepthError{
+			Loc:      call.Location(),
+			MaxDepth: itrp.maxCallDepth,
+		}
+	}
+	itrp.callDepth++
+	defer func() { itrp.callDepth-- }()
+
 	callee, err := itrp.doExpression(call.Callee, scope)
 	if err != nil {
 		return nil, err
@@ -509,6 +612,19 @@ func (itrp *Interpreter) doCall(call *semantic.CallExpression, scope Scope) (val
 	return value, nil
 }
 
+// CallDepthError is returned when the nested function calls
+// of a program exceed the maximum call depth of the interpreter.
+type CallDepthError struct {
+	// Loc is the location of the call that exceeded the limit.
+	Loc ast.SourceLocation
+	// MaxDepth is the maximum call depth of the interpreter.
+	MaxDepth int
+}
+
+func (e *CallDepthError) Error() string {
+	return fmt.Sprintf("error calling function at %v: maximum call depth of %d exceeded", e.Loc, e.MaxDepth)
+}
+
 func (itrp *Interpreter) doArguments(args *semantic.ObjectExpression, scope Scope, pipeArgument string, pipe semantic.Expression) (values.Object, error) {
 	obj := values.NewObject()
 	if pipe == nil && (args == nil || len(args.Properties) == 0) {
@@ -651,8 +767,9 @@ func (f function) doCall(args Arguments) (values.Value, error) {
 						if !ok {
 							// Use default value
 							var err error
-							// evaluate default expressions outside the block scope
-							v, err = f.itrp.doExpression(d.Value, f.scope)
+							// evaluate default expressions in the block scope,
+							// where only the parameters before it are defined
+							v, err = f.itrp.doExpression(d.Value, blockScope)
 							if err != nil {
 								return nil, err
 							}
@@ -787,6 +904,12 @@ func (f function) resolveIdentifiers(n semantic.Node) (semantic.Node, error) {
 			return nil, err
 		}
 		n.Init = node.(semantic.Expression)
+	case *semantic.DestructuringAssignment:
+		node, err := f.resolveIdentifiers(n.Init)
+		if err != nil {
+			return nil, err
+		}
+		n.Init = node.(semantic.Expression)
 	case *semantic.CallExpression:
 		node, err := f.resolveIdentifiers(n.Arguments)
 		if err != nil {
@@ -848,6 +971,29 @@ func (f function) resolveIdentifiers(n semantic.Node) (semantic.Node, error) {
 		}
 		n.Index = node.(semantic.Expression)
 	case *semantic.ObjectExpression:
+		if n.With != nil {
+			node, err := f.resolveIdentifiers(n.With)
+			if err != nil {
+				return nil, err
+			}
+			switch with := node.(type) {
+			case *semantic.IdentifierExpression:
+				n.With = with
+			case *semantic.ObjectExpression:
+				// The extended object is not a parameter so its
+				// properties are known and can be inlined.
+				n.With = nil
+				properties := make([]*semantic.Property, 0, len(with.Properties)+len(n.Properties))
+				for _, p := range with.Properties {
+					if !hasProperty(n, p.Key.Key()) {
+						properties = append(properties, p)
+					}
+				}
+				n.Properties = append(properties, n.Properties...)
+			default:
+				return nil, fmt.Errorf("cannot extend %q, value is not an object", n.With.Name)
+			}
+		}
 		for i, p := range n.Properties {
 			node, err := f.resolveIdentifiers(p)
 			if err != nil {
@@ -873,6 +1019,16 @@ func (f function) resolveIdentifiers(n semantic.Node) (semantic.Node, error) {
 			return nil, err
 		}
 		n.Consequent = node.(semantic.Expression)
+	case *semantic.StringExpression:
+		for _, p := range n.Parts {
+			if p, ok := p.(*semantic.InterpolatedPart); ok {
+				node, err := f.resolveIdentifiers(p.Expression)
+				if err != nil {
+					return nil, err
+				}
+				p.Expression = node.(semantic.Expression)
+			}
+		}
 	case *semantic.Property:
 		node, err := f.resolveIdentifiers(n.Value)
 		if err != nil {
@@ -883,6 +1039,15 @@ func (f function) resolveIdentifiers(n semantic.Node) (semantic.Node, error) {
 	return n, nil
 }
 
+func hasProperty(obj *semantic.ObjectExpression, key string) bool {
+	for _, p := range obj.Properties {
+		if p.Key.Key() == key {
+			return true
+		}
+	}
+	return false
+}
+
 func resolveValue(v values.Value) (semantic.Node, error) {
 	switch k := v.Type().Nature(); k {
 	case semantic.String:
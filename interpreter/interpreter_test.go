@@ -154,6 +154,19 @@ func TestEval(t *testing.T) {
 				values.NewBool(false),
 			},
 		},
+		{
+			name: "hexadecimal and binary integers",
+			query: `
+			0xFF == 255
+			0b1010 + 0x_0F
+			0XdeadBEEF
+			`,
+			want: []values.Value{
+				values.NewBool(true),
+				values.NewInt(25),
+				values.NewInt(3735928559),
+			},
+		},
 		{
 			name: "logical expressions short circuit",
 			query: `
@@ -202,6 +215,24 @@ func TestEval(t *testing.T) {
             addN(r:3,n:1) == 4 or fail()
 			`,
 		},
+		{
+			name: "function with default param referencing an earlier param",
+			query: `
+			n = 10
+            add = (r, n=r + 1, m=n * 2) => r + n + m
+            add(r:1) == 7 or fail()
+            add(r:1, n:3) == 10 or fail()
+            add(r:1, n:3, m:0) == 4 or fail()
+			`,
+		},
+		{
+			name: "function with default param referencing a later param",
+			query: `
+            add = (n=r + 1, r) => r + n
+            add(r:1)
+			`,
+			wantErr: true,
+		},
 		{
 			name: "scope closing",
 			query: `
@@ -273,6 +304,58 @@ func TestEval(t *testing.T) {
 				values.NewBool(true),
 			},
 		},
+		{
+			name: "pipe placeholder",
+			query: `
+			add = (a=<-,b) => a + b
+			1 |> add(b: _ * 2) == 3 or fail()
+			`,
+		},
+		{
+			name: "pipe placeholder in nested call",
+			query: `
+			add = (a=<-,b) => a + b
+			times = (x, y) => x * y
+			2 |> add(b: times(x: _, y: 10)) == 22 or fail()
+			`,
+		},
+		{
+			name: "pipe placeholder in nested pipe expression",
+			query: `
+			add = (a=<-,b) => a + b
+			1 |> add(b: 10 |> add(b: _)) == 21 or fail()
+			`,
+		},
+		{
+			name: "pipe placeholder in function",
+			query: `
+			add = (a=<-,b) => a + b
+			3 |> add(b: ((r) => r + _)(r: 1)) == 7 or fail()
+			`,
+		},
+		{
+			name: "pipe placeholder in pipe chain",
+			query: `
+			add = (a=<-,b) => a + b
+			1 |> add(b: 2) |> add(b: _) == 6 or fail()
+			`,
+		},
+		{
+			name: "string interpolation",
+			query: `
+			name = "world"
+			obj = {greeting: "hello"}
+			"${obj.greeting} ${name}!" == "hello world!" or fail()
+			`,
+		},
+		{
+			name: "string interpolation with non string",
+			query: `
+			n = 1
+			"n = ${n}"
+			`,
+			wantErr: true,
+		},
 		{
 			name: "regex match",
 			query: `
@@ -370,6 +453,47 @@ func TestEval(t *testing.T) {
 			`,
 			wantErr: true,
 		},
+		{
+			name: "array destructuring",
+			query: `
+				[a, b, c] = [1, 2, 3]
+				a == 1 or fail()
+				b == 2 or fail()
+				c == 3 or fail()
+			`,
+		},
+		{
+			name: "array destructuring in function block",
+			query: `
+				f = (arr) => {
+					[x, y] = arr
+					return x + y
+				}
+				f(arr: [1, 2]) == 3 or fail()
+			`,
+		},
+		{
+			name: "array destructuring length mismatch",
+			query: `
+				[a, b] = [1, 2, 3]
+			`,
+			wantErr: true,
+		},
+		{
+			name: "object destructuring",
+			query: `
+				{a, b: x} = {a: 1, b: "b", c: 2.0}
+				a == 1 or fail()
+				x == "b" or fail()
+			`,
+		},
+		{
+			name: "object destructuring missing property",
+			query: `
+				{a, d} = {a: 1, b: "b"}
+			`,
+			wantErr: true,
+		},
 		{
 			name: "short circuit logical and",
 			query: `
@@ -436,6 +560,49 @@ func TestEval(t *testing.T) {
 				}),
 			},
 		},
+		{
+			name: "object with",
+			query: `
+				o = {a: 1, b: 2}
+				{o with b: "two", c: 3}
+			`,
+			want: []values.Value{
+				values.NewObjectWithValues(map[string]values.Value{
+					"a": values.NewInt(1),
+					"b": values.NewString("two"),
+					"c": values.NewInt(3),
+				}),
+			},
+		},
+		{
+			name: "object with in polymorphic function",
+			query: `
+				f = (r) => ({r with c: r.a + r.b})
+				f(r: {a: 1, b: 2, d: "x"})
+				f(r: {a: 1.5, b: 2.5})
+			`,
+			want: []values.Value{
+				values.NewObjectWithValues(map[string]values.Value{
+					"a": values.NewInt(1),
+					"b": values.NewInt(2),
+					"c": values.NewInt(3),
+					"d": values.NewString("x"),
+				}),
+				values.NewObjectWithValues(map[string]values.Value{
+					"a": values.NewFloat(1.5),
+					"b": values.NewFloat(2.5),
+					"c": values.NewFloat(4),
+				}),
+			},
+		},
+		{
+			name: "object with missing property",
+			query: `
+				f = (r) => ({r with c: r.a + r.b})
+				f(r: {a: 1})
+			`,
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -447,6 +614,10 @@ func TestEval(t *testing.T) {
 			}
 			graph, err := semantic.New(pkg)
 			if err != nil {
+				if tc.wantErr {
+					// Some errors, such as duplicate arguments, are reported by the semantic checks.
+					return
+				}
 				t.Fatal(err)
 			}
 
@@ -735,6 +906,50 @@ func TestInterpreter_MultiPhaseInterpretation(t *testing.T) {
 	}
 }
 
+func TestInterpreter_MaxCallDepth(t *testing.T) {
+	testCases := []struct {
+		name     string
+		maxDepth int
+		wantErr  string
+	}{
+		{
+			name:    "default",
+			wantErr: "error calling function at 1:12-1:23: maximum call depth of 1000 exceeded",
+		},
+		{
+			name:     "custom",
+			maxDepth: 10,
+			wantErr:  "error calling function at 1:12-1:23: maximum call depth of 10 exceeded",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			itrp := interpreter.NewInterpreter()
+			if tc.maxDepth > 0 {
+				itrp.SetMaxCallDepth(tc.maxDepth)
+			}
+			scope := testScope.Copy()
+
+			// Redefine f in a later phase so that it calls itself without end.
+			if _, err := interptest.Eval(itrp, scope, nil, `f = (n) => n`); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := interptest.Eval(itrp, scope, nil, `f = (n) => f(n: n + 1)`); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err := interptest.Eval(itrp, scope, nil, `f(n: 0)`)
+			if err == nil {
+				t.Fatal("expected error from recursive function")
+			}
+			if got, want := err.Error(), tc.wantErr; got != want {
+				t.Errorf("unexpected error -want/+got:\n\t- %q\n\t+ %q", want, got)
+			}
+		})
+	}
+}
+
 func TestResolver(t *testing.T) {
 	var got semantic.Expression
 	f := &function{
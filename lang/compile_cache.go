@@ -0,0 +1,154 @@
+package lang
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/internal/spec"
+)
+
+// CompileCache compiles Flux scripts and caches the result of evaluating
+// them so that compiling the same script again skips parsing and evaluation.
+//
+// The cache is keyed by a hash of the script, the now time and the runtime
+// given with WithRuntime, since those determine the result of evaluation.
+// The plan is built for every compilation, so the planner options do not
+// need to match for a script to be found in the cache.
+// When the cache is full, the least recently used script is evicted.
+//
+// A CompileCache is safe for concurrent use.
+type CompileCache struct {
+	size int
+
+	mu      sync.Mutex
+	entries map[compileCacheKey]*list.Element
+	lru     *list.List
+	stats   CompileCacheStats
+}
+
+// CompileCacheStats counts how the scripts compiled with a CompileCache were found.
+type CompileCacheStats struct {
+	// Hits is the number of compilations that reused a cached script.
+	Hits int64
+	// Misses is the number of compilations that evaluated the script.
+	Misses int64
+	// Evictions is the number of scripts removed to make room for another.
+	Evictions int64
+}
+
+type compileCacheKey struct {
+	hash    [sha256.Size]byte
+	now     int64
+	runtime *flux.Runtime
+}
+
+type compileCacheEntry struct {
+	key  compileCacheKey
+	spec *flux.Spec
+}
+
+// NewCompileCache creates a cache that holds at most size scripts.
+// If size is zero or negative, caching is disabled and every
+// compilation evaluates the script.
+func NewCompileCache(size int) *CompileCache {
+	return &CompileCache{
+		size:    size,
+		entries: make(map[compileCacheKey]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+// Compile evaluates a Flux script and produces a flux.Program, reusing
+// the result of a previous evaluation of the same script if it is cached.
+// Unlike Compile, errors in evaluating the script are returned immediately
+// instead of when the program is started.
+// now parameter must be non-zero, that is the default now time should be set before compiling.
+func (c *CompileCache) Compile(ctx context.Context, q string, now time.Time, opts ...CompileOption) (*Program, error) {
+	o := applyOptions(opts...)
+	key := compileCacheKey{
+		hash:    sha256.Sum256([]byte(q)),
+		now:     now.UnixNano(),
+		runtime: o.runtime,
+	}
+
+	s, ok := c.get(key)
+	if !ok {
+		astPkg, err := flux.Parse(q)
+		if err != nil {
+			return nil, err
+		}
+		if o.runtime != nil {
+			s, err = spec.FromRuntimeAST(ctx, o.runtime, astPkg, now)
+		} else {
+			s, err = spec.FromAST(ctx, astPkg, now)
+		}
+		if err != nil {
+			return nil, err
+		}
+		c.add(key, s)
+	}
+	if o.verbose {
+		log.Println("Query Spec: ", flux.Formatted(s, flux.FmtJSON))
+	}
+
+	ps, err := buildPlan(s, o)
+	if err != nil {
+		return nil, err
+	}
+	return &Program{
+		opts:     o,
+		PlanSpec: ps,
+	}, nil
+}
+
+// Stats returns the counts of the compilations made with the cache.
+func (c *CompileCache) Stats() CompileCacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// Len returns the number of cached scripts.
+func (c *CompileCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+func (c *CompileCache) get(key compileCacheKey) (*flux.Spec, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		c.stats.Misses++
+		return nil, false
+	}
+	c.stats.Hits++
+	c.lru.MoveToFront(e)
+	return e.Value.(*compileCacheEntry).spec, true
+}
+
+func (c *CompileCache) add(key compileCacheKey, s *flux.Spec) {
+	if c.size <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[key]; ok {
+		// Another compilation of the same script finished first.
+		c.lru.MoveToFront(e)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(&compileCacheEntry{key: key, spec: s})
+	for c.lru.Len() > c.size {
+		e := c.lru.Back()
+		c.lru.Remove(e)
+		delete(c.entries, e.Value.(*compileCacheEntry).key)
+		c.stats.Evictions++
+	}
+}
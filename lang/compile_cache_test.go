@@ -0,0 +1,77 @@
+package lang_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/influxdata/flux/lang"
+)
+
+func TestCompileCache(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1, 0)
+	q := `from(bucket: "foo") |> range(start: -1h)`
+
+	c := lang.NewCompileCache(2)
+	if _, err := c.Compile(ctx, q, now); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Compile(ctx, q, now); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := c.Stats(), (lang.CompileCacheStats{Hits: 1, Misses: 1}); got != want {
+		t.Fatalf("unexpected stats after repeated script -want/+got\n\t- %+v\n\t+ %+v", want, got)
+	}
+
+	// A different script, or the same script with a different now, must be evaluated again.
+	if _, err := c.Compile(ctx, q+` |> yield()`, now); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Compile(ctx, q, now.Add(time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := c.Stats(), (lang.CompileCacheStats{Hits: 1, Misses: 3, Evictions: 1}); got != want {
+		t.Fatalf("unexpected stats after changed scripts -want/+got\n\t- %+v\n\t+ %+v", want, got)
+	}
+	if got, want := c.Len(), 2; got != want {
+		t.Fatalf("unexpected cache length: got %d, want %d", got, want)
+	}
+
+	// The first script was the least recently used and has been evicted.
+	if _, err := c.Compile(ctx, q, now); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := c.Stats().Misses, int64(4); got != want {
+		t.Fatalf("expected evicted script to miss: got %d misses, want %d", got, want)
+	}
+}
+
+func TestCompileCache_Disabled(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1, 0)
+	q := `from(bucket: "foo") |> range(start: -1h)`
+
+	c := lang.NewCompileCache(0)
+	for i := 0; i < 2; i++ {
+		if _, err := c.Compile(ctx, q, now); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, want := c.Stats(), (lang.CompileCacheStats{Misses: 2}); got != want {
+		t.Fatalf("unexpected stats -want/+got\n\t- %+v\n\t+ %+v", want, got)
+	}
+	if got := c.Len(); got != 0 {
+		t.Fatalf("expected no cached scripts, got %d", got)
+	}
+}
+
+func TestCompileCache_Error(t *testing.T) {
+	c := lang.NewCompileCache(2)
+	if _, err := c.Compile(context.Background(), `x = from(bucket: "foo")`, time.Unix(1, 0)); err == nil {
+		t.Fatal("expected error compiling script without results")
+	}
+	if got := c.Len(); got != 0 {
+		t.Fatalf("expected failed script not to be cached, got %d", got)
+	}
+}
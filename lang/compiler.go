@@ -2,6 +2,7 @@ package lang
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/influxdata/flux/internal/spec"
 	"github.com/influxdata/flux/memory"
 	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/stdlib/secrets"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 )
@@ -41,7 +43,9 @@ func AddCompilerMappings(mappings flux.CompilerMappings) error {
 type CompileOption func(*compileOptions)
 
 type compileOptions struct {
-	verbose bool
+	verbose   bool
+	runtime   *flux.Runtime
+	forbidden map[string]bool
 
 	planOptions struct {
 		logical  []plan.LogicalOption
@@ -60,6 +64,14 @@ func WithPhysPlanOpts(popts ...plan.PhysicalOption) CompileOption {
 	}
 }
 
+// WithRuntime evaluates the program with the runtime so that
+// any packages registered with the runtime may be imported.
+func WithRuntime(rt *flux.Runtime) CompileOption {
+	return func(o *compileOptions) {
+		o.runtime = rt
+	}
+}
+
 func defaultOptions() *compileOptions {
 	o := new(compileOptions)
 	return o
@@ -83,17 +95,23 @@ func Verbose(v bool) CompileOption {
 }
 
 // Compile evaluates a Flux script producing a flux.Program.
-// now parameter must be non-zero, that is the default now time should be set before compiling.
+// If now is zero, the now time is read from the clock within the
+// executor dependencies when the program is started.
 func Compile(q string, now time.Time, opts ...CompileOption) (*AstProgram, error) {
 	astPkg, err := flux.Parse(q)
 	if err != nil {
 		return nil, err
 	}
-	return CompileAST(astPkg, now, opts...), nil
+	p := CompileAST(astPkg, now, opts...)
+	if err := checkForbidden(astPkg, p.opts.forbidden); err != nil {
+		return nil, err
+	}
+	return p, nil
 }
 
 // CompileAST evaluates a Flux AST and produces a flux.Program.
-// now parameter must be non-zero, that is the default now time should be set before compiling.
+// If now is zero, the now time is read from the clock within the
+// executor dependencies when the program is started.
 func CompileAST(astPkg *ast.Package, now time.Time, opts ...CompileOption) *AstProgram {
 	return &AstProgram{
 		Program: &Program{
@@ -157,7 +175,8 @@ type FluxCompiler struct {
 
 func (c FluxCompiler) Compile(ctx context.Context) (flux.Program, error) {
 	// Ignore context, it will be provided upon Program Start.
-	return Compile(c.Query, time.Now())
+	// The now time is read from the executor dependencies upon Program Start.
+	return Compile(c.Query, time.Time{})
 }
 
 func (c FluxCompiler) CompilerType() flux.CompilerType {
@@ -171,12 +190,9 @@ type ASTCompiler struct {
 }
 
 func (c ASTCompiler) Compile(ctx context.Context) (flux.Program, error) {
-	now := c.Now
-	if now.IsZero() {
-		now = time.Now()
-	}
 	// Ignore context, it will be provided upon Program Start.
-	return CompileAST(c.AST, now), nil
+	// If Now is not set, it is read from the executor dependencies upon Program Start.
+	return CompileAST(c.AST, c.Now), nil
 }
 
 func (ASTCompiler) CompilerType() flux.CompilerType {
@@ -292,10 +308,10 @@ func (p *AstProgram) Start(ctx context.Context, alloc *memory.Allocator) (flux.Q
 		p.opts = defaultOptions()
 	}
 
-	if p.Now.IsZero() {
-		p.Now = time.Now()
+	if err := checkForbidden(p.Ast, p.opts.forbidden); err != nil {
+		return nil, err
 	}
-	s, err := spec.FromAST(ctx, p.Ast, p.Now)
+	s, err := p.getSpec(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "error in evaluating AST while starting program")
 	}
@@ -310,3 +326,53 @@ func (p *AstProgram) Start(ctx context.Context, alloc *memory.Allocator) (flux.Q
 
 	return p.Program.Start(ctx, alloc)
 }
+
+// Describe evaluates the AST of the program and returns a textual representation
+// of the operations in the resulting query spec and the edges between them.
+// The program is not started, so no query is executed.
+func (p *AstProgram) Describe(ctx context.Context) (string, error) {
+	if p.opts == nil {
+		p.opts = defaultOptions()
+	}
+
+	if err := checkForbidden(p.Ast, p.opts.forbidden); err != nil {
+		return "", err
+	}
+	s, err := p.getSpec(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "error in evaluating AST while describing program")
+	}
+	return fmt.Sprint(flux.Formatted(s, flux.FmtText)), nil
+}
+
+// getSpec evaluates the AST of the program into a query spec.
+func (p *AstProgram) getSpec(ctx context.Context) (*flux.Spec, error) {
+	if p.Now.IsZero() {
+		p.Now = p.Dependencies.Now()
+	}
+	rt := p.opts.runtime
+	if ss, ok := p.Dependencies.SecretService(); ok {
+		var err error
+		if rt, err = withSecretService(ctx, rt, ss); err != nil {
+			return nil, err
+		}
+	}
+	if rt != nil {
+		return spec.FromRuntimeAST(ctx, rt, p.Ast, p.Now)
+	}
+	return spec.FromAST(ctx, p.Ast, p.Now)
+}
+
+// withSecretService returns a copy of the runtime in which secrets.get loads secrets with the secret service.
+// If the runtime is nil, the copy is of the standard library.
+func withSecretService(ctx context.Context, rt *flux.Runtime, ss execute.SecretService) (*flux.Runtime, error) {
+	if rt == nil {
+		rt = flux.NewRuntime()
+	} else {
+		rt = rt.Copy()
+	}
+	if err := rt.ReplacePackageValue(secrets.PackagePath, secrets.GetName, secrets.Get(ctx, ss)); err != nil {
+		return nil, err
+	}
+	return rt, nil
+}
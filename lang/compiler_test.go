@@ -72,6 +72,77 @@ func TestCompilationError(t *testing.T) {
 	}
 }
 
+func TestCompile_ForbiddenBuiltins(t *testing.T) {
+	forbidden := lang.WithForbiddenBuiltins("to", "http.post", "sql.from")
+	testcases := []struct {
+		name    string
+		script  string
+		wantErr string
+	}{
+		{
+			name:   "allowed",
+			script: `from(bucket: "foo") |> range(start: -1h) |> filter(fn: (r) => r.to == "x")`,
+		},
+		{
+			name: "declarations are not references",
+			script: `f = (to, tables=<-) => tables |> set(key: "to", value: "x")
+r = {to: 1}
+from(bucket: "foo") |> f(to: "x")`,
+		},
+		{
+			name: "prelude call",
+			script: `from(bucket: "foo")
+    |> to(bucket: "bar")`,
+			wantErr: `error @2:8-2:10: builtin "to" is forbidden`,
+		},
+		{
+			name:    "prelude reference",
+			script:  `f = to`,
+			wantErr: `error @1:5-1:7: builtin "to" is forbidden`,
+		},
+		{
+			name: "package member",
+			script: `import "http"
+x = http.post(url: "http://localhost")`,
+			wantErr: `error @2:5-2:14: builtin "http.post" is forbidden`,
+		},
+		{
+			name: "aliased package",
+			script: `import s "sql"
+s.from(driverName: "postgres", dataSourceName: "", query: "")`,
+			wantErr: `error @2:1-2:7: builtin "sql.from" is forbidden`,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := lang.Compile(tc.script, time.Unix(0, 0), forbidden)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if got := err.Error(); got != tc.wantErr {
+				t.Errorf("unexpected error: want %q, got %q", tc.wantErr, got)
+			}
+		})
+	}
+}
+
+func TestCompileAST_ForbiddenBuiltins(t *testing.T) {
+	astPkg := parser.ParseSource(`from(bucket: "foo") |> to(bucket: "bar")`)
+	program := lang.CompileAST(astPkg, time.Unix(0, 0), lang.WithForbiddenBuiltins("to"))
+	if _, err := program.Start(context.Background(), &memory.Allocator{}); err == nil {
+		t.Fatal("expected error")
+	} else if _, ok := err.(*lang.ForbiddenBuiltinError); !ok {
+		t.Errorf("unexpected error type %T: %v", err, err)
+	}
+}
+
 func TestASTCompiler(t *testing.T) {
 	testcases := []struct {
 		name   string
@@ -425,3 +496,31 @@ func getTableObjectsFromArray(parents values.Array) []*flux.TableObject {
 	})
 	return tos
 }
+
+func TestAstProgram_Describe(t *testing.T) {
+	src := `from(bucket: "telegraf")
+	|> range(start: 2018-10-10T00:00:00Z)
+	|> mean()`
+
+	now := parser.MustParseTime("2018-10-10T01:00:00Z").Value
+	program, err := lang.Compile(src, now)
+	if err != nil {
+		t.Fatalf("failed to compile script: %v", err)
+	}
+
+	got, err := program.Describe(context.Background())
+	if err != nil {
+		t.Fatalf("failed to describe program: %v", err)
+	}
+	want := `from0: from {"Bucket":"telegraf"}
+range1: range {"start":"2018-10-10T00:00:00Z","stop":"now","timeColumn":"_time","startColumn":"_start","stopColumn":"_stop"} <- from0
+mean2: mean {"columns":["_value"]} <- range1
+`
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected description -want/+got:\n%s", cmp.Diff(want, got))
+	}
+	// describing the program must not plan or execute the query
+	if program.PlanSpec != nil {
+		t.Errorf("expected no plan spec after describing the program, got %v", program.PlanSpec)
+	}
+}
@@ -0,0 +1,137 @@
+package lang
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/influxdata/flux/ast"
+)
+
+// WithForbiddenBuiltins rejects any script that references one of the named builtins.
+// A builtin of the prelude is named by its identifier, such as "to".
+// A builtin of an imported package is named by the import path of the package and its identifier,
+// such as "http.post" or "influxdata/influxdb.to".
+//
+// The script is checked before it is evaluated, so a forbidden builtin is never called.
+// The check is conservative: a variable that shadows a forbidden prelude builtin is rejected as well.
+func WithForbiddenBuiltins(names ...string) CompileOption {
+	return func(o *compileOptions) {
+		if o.forbidden == nil {
+			o.forbidden = make(map[string]bool, len(names))
+		}
+		for _, name := range names {
+			o.forbidden[name] = true
+		}
+	}
+}
+
+// ForbiddenBuiltinError is returned when a script references a builtin
+// that was forbidden with WithForbiddenBuiltins.
+type ForbiddenBuiltinError struct {
+	// Name is the name of the forbidden builtin as it was passed to WithForbiddenBuiltins.
+	Name string
+	// Loc is the location of the reference to the builtin.
+	Loc ast.SourceLocation
+}
+
+func (e *ForbiddenBuiltinError) Error() string {
+	return fmt.Sprintf("error @%v: builtin %q is forbidden", e.Loc, e.Name)
+}
+
+// checkForbidden returns an error for the first reference to a forbidden builtin within the package.
+func checkForbidden(pkg *ast.Package, forbidden map[string]bool) error {
+	if len(forbidden) == 0 {
+		return nil
+	}
+	for _, file := range pkg.Files {
+		v := &forbidVisitor{
+			forbidden: forbidden,
+			imports:   make(map[string]string, len(file.Imports)),
+		}
+		for _, imp := range file.Imports {
+			name := path.Base(imp.Path.Value)
+			if imp.As != nil {
+				name = imp.As.Name
+			}
+			v.imports[name] = imp.Path.Value
+		}
+		ast.Walk(v, file)
+		if v.err != nil {
+			return v.err
+		}
+	}
+	return nil
+}
+
+// forbidVisitor finds references to forbidden builtins.
+// Identifiers that declare a name, such as the identifier of a variable assignment
+// or the key of a property, are not references and are skipped.
+type forbidVisitor struct {
+	forbidden map[string]bool
+	// imports maps the name of each package imported by the file to its path.
+	imports map[string]string
+	err     error
+}
+
+func (v *forbidVisitor) Visit(node ast.Node) ast.Visitor {
+	if v.err != nil {
+		return nil
+	}
+	switch n := node.(type) {
+	case *ast.PackageClause, *ast.ImportDeclaration:
+		return nil
+	case *ast.VariableAssignment:
+		ast.Walk(v, n.Init)
+		return nil
+	case *ast.MemberAssignment:
+		ast.Walk(v, n.Init)
+		return nil
+	case *ast.FunctionExpression:
+		// Parameters declare names, only their defaults may reference a builtin.
+		for _, p := range n.Params {
+			if p.Value != nil {
+				ast.Walk(v, p.Value)
+			}
+		}
+		ast.Walk(v, n.Body)
+		return nil
+	case *ast.Property:
+		if n.Value == nil {
+			// A property without a value, such as {to}, references the key.
+			if key, ok := n.Key.(*ast.Identifier); ok {
+				v.check(key.Name, key)
+			}
+			return nil
+		}
+		ast.Walk(v, n.Value)
+		return nil
+	case *ast.MemberExpression:
+		if obj, ok := n.Object.(*ast.Identifier); ok {
+			if pkgPath, ok := v.imports[obj.Name]; ok {
+				switch p := n.Property.(type) {
+				case *ast.Identifier:
+					v.check(pkgPath+"."+p.Name, n)
+				case *ast.StringLiteral:
+					v.check(pkgPath+"."+p.Value, n)
+				}
+				return nil
+			}
+		}
+		ast.Walk(v, n.Object)
+		return nil
+	case *ast.Identifier:
+		v.check(n.Name, n)
+	}
+	return v
+}
+
+func (v *forbidVisitor) Done(node ast.Node) {}
+
+func (v *forbidVisitor) check(name string, node ast.Node) {
+	if v.err == nil && v.forbidden[name] {
+		v.err = &ForbiddenBuiltinError{
+			Name: name,
+			Loc:  node.Location(),
+		}
+	}
+}
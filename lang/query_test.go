@@ -2,14 +2,20 @@ package lang_test
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	_ "github.com/influxdata/flux/builtin"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/lang"
 	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/parser"
+	"github.com/influxdata/flux/values"
 	"github.com/pkg/errors"
 )
 
@@ -166,3 +172,285 @@ csv.from(csv: data) |> map(fn: (r) => r.nonexistent)`
 		t.Fatal(errors.Wrap(q.Err(), "unexpected error from query execution"))
 	}
 }
+
+func TestQuery_Seed(t *testing.T) {
+	var buf strings.Builder
+	buf.WriteString(`
+import "csv"
+
+data = "
+#datatype,string,long,long,string
+#group,false,false,false,true
+#default,_result,,,
+,result,table,_value,tag
+`)
+	for i := 0; i < 50; i++ {
+		fmt.Fprintf(&buf, ",,0,%d,a\n", i)
+	}
+	buf.WriteString(`"
+
+csv.from(csv: data) |> sample(n: 10)`)
+	script := buf.String()
+
+	sample := func(seed int64) []int64 {
+		t.Helper()
+		program, err := lang.Compile(script, time.Unix(0, 0))
+		if err != nil {
+			t.Fatal(err)
+		}
+		program.SetExecutorDependencies(execute.Dependencies{
+			execute.SeedKey: seed,
+		})
+		q, err := program.Start(context.Background(), &memory.Allocator{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer q.Done()
+
+		var values []int64
+		for res := range q.Results() {
+			if err := res.Tables().Do(func(tbl flux.Table) error {
+				return tbl.Do(func(cr flux.ColReader) error {
+					vs := cr.Ints(execute.ColIdx("_value", cr.Cols()))
+					for i := 0; i < vs.Len(); i++ {
+						values = append(values, vs.Value(i))
+					}
+					return nil
+				})
+			}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := q.Err(); err != nil {
+			t.Fatal(err)
+		}
+		return values
+	}
+
+	first, second := sample(1), sample(1)
+	if len(first) == 0 {
+		t.Fatal("expected sampled values")
+	}
+	if !cmp.Equal(first, second) {
+		t.Errorf("expected identical samples with the same seed -first/+second:\n%s", cmp.Diff(first, second))
+	}
+	if other := sample(2); cmp.Equal(first, other) {
+		t.Errorf("expected different samples with a different seed, got %v for both", first)
+	}
+}
+
+func TestQuery_Clock(t *testing.T) {
+	script := `
+import "csv"
+
+data = "
+#datatype,string,long,dateTime:RFC3339,long
+#group,false,false,false,false
+#default,_result,,,
+,result,table,_time,_value
+,,0,2019-01-01T00:00:00Z,1
+,,0,2019-01-01T00:30:00Z,2
+,,0,2019-01-01T01:00:00Z,3
+"
+
+t = now()
+
+csv.from(csv: data)
+	|> range(start: -1h)
+	|> map(fn: (r) => ({_time: r._time, _value: r._value, now: t}))`
+
+	now := time.Date(2019, 1, 1, 1, 0, 0, 0, time.UTC)
+	c := lang.FluxCompiler{Query: script}
+	program, err := c.Compile(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	program.(lang.DependenciesAwareProgram).SetExecutorDependencies(execute.Dependencies{
+		execute.ClockKey: execute.ClockFunc(func() time.Time { return now }),
+	})
+	q, err := program.Start(context.Background(), &memory.Allocator{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer q.Done()
+
+	type row struct {
+		Start, Stop, Time, Now values.Time
+		Value                  int64
+	}
+	var got []row
+	for res := range q.Results() {
+		if err := res.Tables().Do(func(tbl flux.Table) error {
+			return tbl.Do(func(cr flux.ColReader) error {
+				for i := 0; i < cr.Len(); i++ {
+					got = append(got, row{
+						Start: values.Time(cr.Times(execute.ColIdx("_start", cr.Cols())).Value(i)),
+						Stop:  values.Time(cr.Times(execute.ColIdx("_stop", cr.Cols())).Value(i)),
+						Time:  values.Time(cr.Times(execute.ColIdx("_time", cr.Cols())).Value(i)),
+						Now:   values.Time(cr.Times(execute.ColIdx("now", cr.Cols())).Value(i)),
+						Value: cr.Ints(execute.ColIdx("_value", cr.Cols())).Value(i),
+					})
+				}
+				return nil
+			})
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := q.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	start, stop := values.ConvertTime(now.Add(-time.Hour)), values.ConvertTime(now)
+	want := []row{
+		{Start: start, Stop: stop, Time: start, Now: stop, Value: 1},
+		{Start: start, Stop: stop, Time: values.ConvertTime(now.Add(-30 * time.Minute)), Now: stop, Value: 2},
+	}
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected rows -want/+got:\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestQuery_Runtime(t *testing.T) {
+	pkg := parser.ParseSource(`
+package custom
+
+import "csv"
+
+builtin data
+
+from = () => csv.from(csv: data)
+`)
+	pkg.Path = "example.com/custom"
+
+	rt := flux.NewRuntime()
+	if err := rt.RegisterPackage(pkg, map[string]values.Value{
+		"data": values.NewString(`
+#datatype,string,long,long,string
+#group,false,false,false,true
+#default,_result,,,
+,result,table,value,tag
+,,0,10,a
+`),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	script := `
+import "example.com/custom"
+
+custom.from() |> yield(name: "res")`
+	program, err := lang.Compile(script, time.Unix(0, 0), lang.WithRuntime(rt))
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := program.Start(context.Background(), &memory.Allocator{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer q.Done()
+
+	var rows int
+	for res := range q.Results() {
+		if err := res.Tables().Do(func(tbl flux.Table) error {
+			return tbl.Do(func(cr flux.ColReader) error {
+				rows += cr.Len()
+				return nil
+			})
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := q.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if rows != 1 {
+		t.Errorf("got %d rows instead of %d", rows, 1)
+	}
+
+	// Without the runtime, the package cannot be imported.
+	if _, err := runQuery(script); err == nil {
+		t.Error("expected an error importing the package without the runtime")
+	}
+}
+
+type secretService map[string]string
+
+func (s secretService) LoadSecret(ctx context.Context, key string) (string, error) {
+	value, ok := s[key]
+	if !ok {
+		return "", fmt.Errorf("secret %q not found", key)
+	}
+	return value, nil
+}
+
+func TestQuery_SecretService(t *testing.T) {
+	script := `
+import "csv"
+import "secrets"
+
+data = "
+#datatype,string,long,long
+#group,false,false,false
+#default,_result,,
+,result,table,_value
+,,0,1
+"
+
+token = secrets.get(key: "%s")
+
+csv.from(csv: data)
+	|> map(fn: (r) => ({_value: r._value, token: token}))`
+
+	start := func(key string, deps execute.Dependencies) (flux.Query, error) {
+		c := lang.FluxCompiler{Query: fmt.Sprintf(script, key)}
+		program, err := c.Compile(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		program.(lang.DependenciesAwareProgram).SetExecutorDependencies(deps)
+		return program.Start(context.Background(), &memory.Allocator{})
+	}
+	deps := execute.Dependencies{
+		execute.SecretServiceKey: secretService{"token": "mytoken"},
+	}
+
+	q, err := start("token", deps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer q.Done()
+
+	var got []string
+	for res := range q.Results() {
+		if err := res.Tables().Do(func(tbl flux.Table) error {
+			return tbl.Do(func(cr flux.ColReader) error {
+				tokens := cr.Strings(execute.ColIdx("token", cr.Cols()))
+				for i := 0; i < cr.Len(); i++ {
+					got = append(got, tokens.ValueString(i))
+				}
+				return nil
+			})
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := q.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"mytoken"}; !cmp.Equal(want, got) {
+		t.Errorf("unexpected secrets -want/+got:\n%s", cmp.Diff(want, got))
+	}
+
+	// An unknown key is an error.
+	if _, err := start("password", deps); err == nil {
+		t.Error("expected an error loading an unknown secret")
+	} else if want := `cannot load secret "password": secret "password" not found`; !strings.Contains(err.Error(), want) {
+		t.Errorf("unexpected error, want it to contain %q, got %q", want, err)
+	}
+
+	// Without a secret service, no secret can be loaded.
+	if _, err := start("token", nil); err == nil {
+		t.Error("expected an error loading a secret without a secret service")
+	}
+}
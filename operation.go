@@ -71,6 +71,23 @@ type OperationSpec interface {
 	Kind() OperationKind
 }
 
+// BufferWriter is an operation that writes its input tables into a named buffer.
+// The buffer lives for a single evaluation of a script.
+type BufferWriter interface {
+	OperationSpec
+	// WriteBuffer returns the name of the buffer the operation writes.
+	WriteBuffer() string
+}
+
+// BufferReader is an operation that reads the tables of a named buffer.
+// When a spec is built, each BufferReader becomes a child of the BufferWriter
+// with the same name, and it is an error if no such BufferWriter exists.
+type BufferReader interface {
+	OperationSpec
+	// ReadBuffer returns the name of the buffer the operation reads.
+	ReadBuffer() string
+}
+
 // OperationID is a unique ID within a query for the operation.
 type OperationID string
 
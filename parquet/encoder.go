@@ -0,0 +1,441 @@
+// Package parquet contains an encoder that writes results as Apache Parquet files.
+package parquet
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/iocounter"
+	"github.com/pkg/errors"
+)
+
+const magic = "PAR1"
+
+// Values from the Parquet format specification.
+const (
+	typeBoolean   = 0
+	typeInt64     = 2
+	typeDouble    = 5
+	typeByteArray = 6
+
+	repetitionOptional = 1
+
+	convertedUTF8   = 0
+	convertedUInt64 = 14
+	convertedInt64  = 18
+
+	encodingPlain = 0
+	encodingRLE   = 3
+
+	codecUncompressed = 0
+
+	pageTypeData = 0
+)
+
+// ResultEncoderConfig are options that can be specified on the ResultEncoder.
+type ResultEncoderConfig struct {
+	// RowGroupSize is the maximum number of rows in a row group.
+	// If 0, each table is written as its own row group.
+	// Otherwise the rows of consecutive tables are combined
+	// into row groups of at most RowGroupSize rows.
+	RowGroupSize int
+}
+
+// ResultEncoder encodes a result as a Parquet file.
+//
+// The columns of the tables become the columns of the file,
+// so all tables in the result must have the same columns.
+// The types are mapped as follows:
+//
+//	bool   -> BOOLEAN
+//	int    -> INT64 (INTEGER(64, signed))
+//	uint   -> INT64 (INTEGER(64, unsigned))
+//	float  -> DOUBLE
+//	string -> BYTE_ARRAY (STRING)
+//	time   -> INT64 (TIMESTAMP(NANOS, UTC))
+//
+// All columns are optional, and null values are written as Parquet nulls.
+// Tables without records do not produce any rows.
+// The data is written uncompressed with the PLAIN encoding.
+type ResultEncoder struct {
+	c ResultEncoderConfig
+}
+
+// NewResultEncoder creates a new encoder with the provided configuration.
+func NewResultEncoder(c ResultEncoderConfig) *ResultEncoder {
+	return &ResultEncoder{
+		c: c,
+	}
+}
+
+type encoderError struct {
+	msg string
+}
+
+func (e *encoderError) Error() string {
+	return e.msg
+}
+
+func (e *encoderError) IsEncoderError() bool {
+	return true
+}
+
+func wrapEncodingError(err error) error {
+	return errors.Wrap(&encoderError{msg: err.Error()}, "parquet encoder error")
+}
+
+// Encode writes the result to w as a Parquet file.
+func (e *ResultEncoder) Encode(w io.Writer, result flux.Result) (int64, error) {
+	fw := &fileWriter{
+		w:            &iocounter.Writer{Writer: w},
+		rowGroupSize: e.c.RowGroupSize,
+	}
+	if err := fw.write([]byte(magic)); err != nil {
+		return fw.w.Count(), err
+	}
+	if err := result.Tables().Do(fw.writeTable); err != nil {
+		return fw.w.Count(), err
+	}
+	if err := fw.flush(); err != nil {
+		return fw.w.Count(), err
+	}
+	err := fw.writeFooter()
+	return fw.w.Count(), err
+}
+
+// fileWriter buffers the rows of a row group and writes them to w.
+type fileWriter struct {
+	w            *iocounter.Writer
+	rowGroupSize int
+
+	cols    []flux.ColMeta
+	columns []*columnBuffer
+	nrows   int
+
+	numRows   int64
+	rowGroups []rowGroup
+}
+
+type rowGroup struct {
+	numRows       int64
+	totalByteSize int64
+	chunks        []columnChunk
+}
+
+type columnChunk struct {
+	offset int64
+	size   int64
+}
+
+func (fw *fileWriter) write(b []byte) error {
+	if _, err := fw.w.Write(b); err != nil {
+		return wrapEncodingError(err)
+	}
+	return nil
+}
+
+func (fw *fileWriter) writeTable(tbl flux.Table) error {
+	if fw.columns == nil {
+		fw.cols = tbl.Cols()
+		fw.columns = make([]*columnBuffer, len(fw.cols))
+		for j, c := range fw.cols {
+			switch c.Type {
+			case flux.TBool, flux.TInt, flux.TUInt, flux.TFloat, flux.TString, flux.TTime:
+			default:
+				return fmt.Errorf("parquet encoder does not support column %q of type %v", c.Label, c.Type)
+			}
+			fw.columns[j] = &columnBuffer{typ: c.Type}
+		}
+	} else if !equalCols(fw.cols, tbl.Cols()) {
+		return errors.New("parquet encoder requires all tables to have the same columns")
+	}
+
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		for i, l := 0, cr.Len(); i < l; i++ {
+			for j, col := range fw.columns {
+				col.append(cr, i, j)
+			}
+			fw.nrows++
+			if fw.rowGroupSize > 0 && fw.nrows >= fw.rowGroupSize {
+				if err := fw.flush(); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if fw.rowGroupSize <= 0 {
+		return fw.flush()
+	}
+	return nil
+}
+
+// flush writes the buffered rows as a row group.
+func (fw *fileWriter) flush() error {
+	if fw.nrows == 0 {
+		return nil
+	}
+	rg := rowGroup{
+		numRows: int64(fw.nrows),
+		chunks:  make([]columnChunk, len(fw.columns)),
+	}
+	for j, col := range fw.columns {
+		offset := fw.w.Count()
+		data := col.page()
+		header := pageHeader(fw.nrows, len(data))
+		if err := fw.write(header); err != nil {
+			return err
+		}
+		if err := fw.write(data); err != nil {
+			return err
+		}
+		size := int64(len(header) + len(data))
+		rg.chunks[j] = columnChunk{offset: offset, size: size}
+		rg.totalByteSize += size
+		col.reset()
+	}
+	fw.rowGroups = append(fw.rowGroups, rg)
+	fw.numRows += rg.numRows
+	fw.nrows = 0
+	return nil
+}
+
+func pageHeader(numValues, size int) []byte {
+	w := newThriftWriter()
+	w.I32(1, pageTypeData)
+	w.I32(2, int32(size))
+	w.I32(3, int32(size))
+	w.BeginStruct(5)
+	w.I32(1, int32(numValues))
+	w.I32(2, encodingPlain)
+	w.I32(3, encodingRLE)
+	w.I32(4, encodingRLE)
+	w.EndStruct()
+	return w.Bytes()
+}
+
+func (fw *fileWriter) writeFooter() error {
+	w := newThriftWriter()
+	w.I32(1, 1)
+
+	w.BeginList(2, compactStruct, len(fw.cols)+1)
+	w.BeginListStruct()
+	w.String(4, "schema")
+	w.I32(5, int32(len(fw.cols)))
+	w.EndStruct()
+	for _, c := range fw.cols {
+		w.BeginListStruct()
+		writeSchemaElement(w, c)
+		w.EndStruct()
+	}
+
+	w.I64(3, fw.numRows)
+
+	w.BeginList(4, compactStruct, len(fw.rowGroups))
+	for _, rg := range fw.rowGroups {
+		w.BeginListStruct()
+		w.BeginList(1, compactStruct, len(rg.chunks))
+		for j, chunk := range rg.chunks {
+			w.BeginListStruct()
+			w.I64(2, chunk.offset)
+			w.BeginStruct(3)
+			w.I32(1, physicalType(fw.cols[j].Type))
+			w.BeginList(2, compactI32, 2)
+			w.ListI32(encodingPlain)
+			w.ListI32(encodingRLE)
+			w.BeginList(3, compactBinary, 1)
+			w.ListString(fw.cols[j].Label)
+			w.I32(4, codecUncompressed)
+			w.I64(5, rg.numRows)
+			w.I64(6, chunk.size)
+			w.I64(7, chunk.size)
+			w.I64(9, chunk.offset)
+			w.EndStruct()
+			w.EndStruct()
+		}
+		w.I64(2, rg.totalByteSize)
+		w.I64(3, rg.numRows)
+		w.EndStruct()
+	}
+	w.String(6, "flux")
+
+	meta := w.Bytes()
+	var size [4]byte
+	binary.LittleEndian.PutUint32(size[:], uint32(len(meta)))
+	if err := fw.write(meta); err != nil {
+		return err
+	}
+	if err := fw.write(size[:]); err != nil {
+		return err
+	}
+	return fw.write([]byte(magic))
+}
+
+func physicalType(typ flux.ColType) int32 {
+	switch typ {
+	case flux.TBool:
+		return typeBoolean
+	case flux.TFloat:
+		return typeDouble
+	case flux.TString:
+		return typeByteArray
+	default:
+		return typeInt64
+	}
+}
+
+func writeSchemaElement(w *thriftWriter, c flux.ColMeta) {
+	w.I32(1, physicalType(c.Type))
+	w.I32(3, repetitionOptional)
+	w.String(4, c.Label)
+	switch c.Type {
+	case flux.TInt:
+		w.I32(6, convertedInt64)
+	case flux.TUInt:
+		w.I32(6, convertedUInt64)
+	case flux.TString:
+		w.I32(6, convertedUTF8)
+	}
+
+	// The logical type is a union, the field id is the kind of type.
+	switch c.Type {
+	case flux.TInt, flux.TUInt:
+		w.BeginStruct(10)
+		w.BeginStruct(10)
+		w.Byte(1, 64)
+		w.Bool(2, c.Type == flux.TInt)
+		w.EndStruct()
+		w.EndStruct()
+	case flux.TString:
+		w.BeginStruct(10)
+		w.BeginStruct(1)
+		w.EndStruct()
+		w.EndStruct()
+	case flux.TTime:
+		w.BeginStruct(10)
+		w.BeginStruct(8)
+		w.Bool(1, true)
+		w.BeginStruct(2)
+		w.BeginStruct(3)
+		w.EndStruct()
+		w.EndStruct()
+		w.EndStruct()
+		w.EndStruct()
+	}
+}
+
+func equalCols(a, b []flux.ColMeta) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for j := range a {
+		if a[j] != b[j] {
+			return false
+		}
+	}
+	return true
+}
+
+// columnBuffer holds the values of a column for the current row group.
+type columnBuffer struct {
+	typ flux.ColType
+
+	valid []bool
+	// bools holds the non-null values of a bool column,
+	// which are bit packed when the page is written.
+	bools []bool
+	// values holds the PLAIN encoded non-null values of other columns.
+	values []byte
+}
+
+func (c *columnBuffer) append(cr flux.ColReader, i, j int) {
+	var b [8]byte
+	switch c.typ {
+	case flux.TBool:
+		vs := cr.Bools(j)
+		if c.appendValid(vs.IsValid(i)) {
+			c.bools = append(c.bools, vs.Value(i))
+		}
+	case flux.TInt:
+		vs := cr.Ints(j)
+		if c.appendValid(vs.IsValid(i)) {
+			binary.LittleEndian.PutUint64(b[:], uint64(vs.Value(i)))
+			c.values = append(c.values, b[:]...)
+		}
+	case flux.TUInt:
+		vs := cr.UInts(j)
+		if c.appendValid(vs.IsValid(i)) {
+			binary.LittleEndian.PutUint64(b[:], vs.Value(i))
+			c.values = append(c.values, b[:]...)
+		}
+	case flux.TFloat:
+		vs := cr.Floats(j)
+		if c.appendValid(vs.IsValid(i)) {
+			binary.LittleEndian.PutUint64(b[:], math.Float64bits(vs.Value(i)))
+			c.values = append(c.values, b[:]...)
+		}
+	case flux.TString:
+		vs := cr.Strings(j)
+		if c.appendValid(vs.IsValid(i)) {
+			v := vs.Value(i)
+			binary.LittleEndian.PutUint32(b[:4], uint32(len(v)))
+			c.values = append(c.values, b[:4]...)
+			c.values = append(c.values, v...)
+		}
+	case flux.TTime:
+		vs := cr.Times(j)
+		if c.appendValid(vs.IsValid(i)) {
+			binary.LittleEndian.PutUint64(b[:], uint64(vs.Value(i)))
+			c.values = append(c.values, b[:]...)
+		}
+	}
+}
+
+func (c *columnBuffer) appendValid(valid bool) bool {
+	c.valid = append(c.valid, valid)
+	return valid
+}
+
+// page returns the data of a page with the definition levels and values.
+func (c *columnBuffer) page() []byte {
+	levels := bitPackedRun(c.valid)
+	data := make([]byte, 4, 4+len(levels)+len(c.values))
+	binary.LittleEndian.PutUint32(data, uint32(len(levels)))
+	data = append(data, levels...)
+	if c.typ == flux.TBool {
+		return append(data, packBits(c.bools)...)
+	}
+	return append(data, c.values...)
+}
+
+func (c *columnBuffer) reset() {
+	c.valid = c.valid[:0]
+	c.bools = c.bools[:0]
+	c.values = c.values[:0]
+}
+
+// bitPackedRun encodes the values with a bit width of one as a single
+// bit-packed run of the RLE/bit-packing hybrid encoding.
+func bitPackedRun(vs []bool) []byte {
+	groups := (len(vs) + 7) / 8
+	var header [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(header[:], uint64(groups)<<1|1)
+	return append(header[:n], packBits(vs)...)
+}
+
+// packBits packs the values into bits starting from the least significant bit.
+func packBits(vs []bool) []byte {
+	b := make([]byte, (len(vs)+7)/8)
+	for i, v := range vs {
+		if v {
+			b[i/8] |= 1 << uint(i%8)
+		}
+	}
+	return b
+}
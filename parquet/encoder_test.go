@@ -0,0 +1,217 @@
+package parquet_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/parquet"
+	"github.com/influxdata/flux/values"
+)
+
+var allTypesCols = []flux.ColMeta{
+	{Label: "_time", Type: flux.TTime},
+	{Label: "host", Type: flux.TString},
+	{Label: "_value", Type: flux.TFloat},
+	{Label: "count", Type: flux.TInt},
+	{Label: "total", Type: flux.TUInt},
+	{Label: "ok", Type: flux.TBool},
+}
+
+func ts(sec int) values.Time {
+	return values.ConvertTime(time.Date(2018, 4, 17, 0, 0, sec, 0, time.UTC))
+}
+
+func TestResultEncoder_RoundTrip(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config parquet.ResultEncoderConfig
+		tables []*executetest.Table
+		want   [][][]interface{}
+	}{
+		{
+			name: "one row group per table",
+			tables: []*executetest.Table{
+				{
+					KeyCols: []string{"host"},
+					ColMeta: allTypesCols,
+					Data: [][]interface{}{
+						{ts(0), "A", 1.5, int64(-1), uint64(1), true},
+						{ts(1), "A", nil, nil, nil, nil},
+						{ts(2), "A", 2.5, int64(3), uint64(1 << 63), false},
+					},
+				},
+				{
+					KeyCols: []string{"host"},
+					ColMeta: allTypesCols,
+					Data: [][]interface{}{
+						{ts(0), "", -0.5, int64(7), uint64(0), false},
+						{nil, "", 9.0, int64(8), uint64(2), true},
+					},
+				},
+			},
+			want: [][][]interface{}{
+				{
+					{ts(0), "A", 1.5, int64(-1), uint64(1), true},
+					{ts(1), "A", nil, nil, nil, nil},
+					{ts(2), "A", 2.5, int64(3), uint64(1 << 63), false},
+				},
+				{
+					{ts(0), "", -0.5, int64(7), uint64(0), false},
+					{nil, "", 9.0, int64(8), uint64(2), true},
+				},
+			},
+		},
+		{
+			name:   "row group size",
+			config: parquet.ResultEncoderConfig{RowGroupSize: 2},
+			tables: []*executetest.Table{
+				{
+					KeyCols: []string{"host"},
+					ColMeta: allTypesCols,
+					Data: [][]interface{}{
+						{ts(0), "A", 1.0, int64(1), uint64(1), true},
+						{ts(1), "A", 2.0, int64(2), uint64(2), false},
+						{ts(2), "A", 3.0, int64(3), uint64(3), true},
+					},
+				},
+				{
+					KeyCols: []string{"host"},
+					ColMeta: allTypesCols,
+					Data: [][]interface{}{
+						{ts(0), "B", 4.0, int64(4), uint64(4), false},
+						{ts(1), "B", 5.0, int64(5), uint64(5), true},
+						{ts(2), "B", 6.0, int64(6), uint64(6), false},
+					},
+				},
+			},
+			want: [][][]interface{}{
+				{
+					{ts(0), "A", 1.0, int64(1), uint64(1), true},
+					{ts(1), "A", 2.0, int64(2), uint64(2), false},
+				},
+				{
+					{ts(2), "A", 3.0, int64(3), uint64(3), true},
+					{ts(0), "B", 4.0, int64(4), uint64(4), false},
+				},
+				{
+					{ts(1), "B", 5.0, int64(5), uint64(5), true},
+					{ts(2), "B", 6.0, int64(6), uint64(6), false},
+				},
+			},
+		},
+		{
+			name: "empty table",
+			tables: []*executetest.Table{
+				{
+					KeyCols:   []string{"host"},
+					KeyValues: []interface{}{"A"},
+					ColMeta:   allTypesCols,
+				},
+				{
+					KeyCols: []string{"host"},
+					ColMeta: allTypesCols,
+					Data: [][]interface{}{
+						{ts(0), "B", 4.0, int64(4), uint64(4), false},
+					},
+				},
+			},
+			want: [][][]interface{}{
+				{
+					{ts(0), "B", 4.0, int64(4), uint64(4), false},
+				},
+			},
+		},
+		{
+			name: "many rows",
+			tables: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_value", Type: flux.TBool},
+					},
+					Data: manyBools(1000),
+				},
+			},
+			want: [][][]interface{}{manyBools(1000)},
+		},
+		{
+			name: "empty result",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			encoder := parquet.NewResultEncoder(tc.config)
+			var buf bytes.Buffer
+			n, err := encoder.Encode(&buf, &executetest.Result{
+				Nm:   "_result",
+				Tbls: tc.tables,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, want := n, int64(buf.Len()); got != want {
+				t.Errorf("unexpected byte count: want %d got %d", want, got)
+			}
+
+			tables, err := readFile(buf.Bytes())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, want := len(tables), len(tc.want); got != want {
+				t.Fatalf("unexpected number of row groups: want %d got %d", want, got)
+			}
+			for i, tbl := range tables {
+				if !cmp.Equal(tc.tables[0].ColMeta, tbl.ColMeta) {
+					t.Errorf("unexpected columns in row group %d -want/+got:\n%s", i, cmp.Diff(tc.tables[0].ColMeta, tbl.ColMeta))
+				}
+				if !cmp.Equal(tc.want[i], tbl.Data) {
+					t.Errorf("unexpected data in row group %d -want/+got:\n%s", i, cmp.Diff(tc.want[i], tbl.Data))
+				}
+			}
+		})
+	}
+}
+
+func manyBools(n int) [][]interface{} {
+	rows := make([][]interface{}, n)
+	for i := range rows {
+		switch i % 3 {
+		case 0:
+			rows[i] = []interface{}{true}
+		case 1:
+			rows[i] = []interface{}{false}
+		default:
+			rows[i] = []interface{}{nil}
+		}
+	}
+	return rows
+}
+
+func TestResultEncoder_DifferentColumns(t *testing.T) {
+	result := &executetest.Result{
+		Nm: "_result",
+		Tbls: []*executetest.Table{
+			{
+				ColMeta: []flux.ColMeta{{Label: "_value", Type: flux.TFloat}},
+				Data:    [][]interface{}{{1.0}},
+			},
+			{
+				ColMeta: []flux.ColMeta{{Label: "_value", Type: flux.TInt}},
+				Data:    [][]interface{}{{int64(1)}},
+			},
+		},
+	}
+	encoder := parquet.NewResultEncoder(parquet.ResultEncoderConfig{})
+	var buf bytes.Buffer
+	_, err := encoder.Encode(&buf, result)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if got, want := err.Error(), "parquet encoder requires all tables to have the same columns"; got != want {
+		t.Errorf("unexpected error -want/+got:\n%s", cmp.Diff(want, got))
+	}
+}
@@ -0,0 +1,368 @@
+package parquet_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/values"
+)
+
+// This file contains a minimal Parquet reader used to check the encoder.
+// It only supports the features the encoder uses: uncompressed data pages
+// with PLAIN encoded values and RLE/bit-packed definition levels.
+
+// thriftStruct is a decoded thrift struct keyed by field id.
+type thriftStruct map[int16]interface{}
+
+func (s thriftStruct) int(id int16) int64 {
+	v, _ := s[id].(int64)
+	return v
+}
+
+func (s thriftStruct) str(id int16) string {
+	v, _ := s[id].([]byte)
+	return string(v)
+}
+
+func (s thriftStruct) bool(id int16) bool {
+	v, _ := s[id].(bool)
+	return v
+}
+
+func (s thriftStruct) has(id int16) bool {
+	_, ok := s[id]
+	return ok
+}
+
+func (s thriftStruct) structure(id int16) thriftStruct {
+	v, _ := s[id].(thriftStruct)
+	return v
+}
+
+func (s thriftStruct) list(id int16) []interface{} {
+	v, _ := s[id].([]interface{})
+	return v
+}
+
+// thriftReader reads the thrift compact protocol.
+type thriftReader struct {
+	b   []byte
+	pos int
+}
+
+func (r *thriftReader) byte() (byte, error) {
+	if r.pos >= len(r.b) {
+		return 0, errors.New("unexpected end of thrift data")
+	}
+	b := r.b[r.pos]
+	r.pos++
+	return b, nil
+}
+
+func (r *thriftReader) uvarint() (uint64, error) {
+	v, n := binary.Uvarint(r.b[r.pos:])
+	if n <= 0 {
+		return 0, errors.New("invalid varint")
+	}
+	r.pos += n
+	return v, nil
+}
+
+func (r *thriftReader) varint() (int64, error) {
+	v, err := r.uvarint()
+	return int64(v>>1) ^ -int64(v&1), err
+}
+
+func (r *thriftReader) readStruct() (thriftStruct, error) {
+	s := make(thriftStruct)
+	var lastID int16
+	for {
+		hdr, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		if hdr == 0 {
+			return s, nil
+		}
+		typ := hdr & 0x0f
+		id := lastID + int16(hdr>>4)
+		if hdr>>4 == 0 {
+			v, err := r.varint()
+			if err != nil {
+				return nil, err
+			}
+			id = int16(v)
+		}
+		lastID = id
+		v, err := r.readValue(typ)
+		if err != nil {
+			return nil, err
+		}
+		s[id] = v
+	}
+}
+
+func (r *thriftReader) readValue(typ byte) (interface{}, error) {
+	switch typ {
+	case 1:
+		return true, nil
+	case 2:
+		return false, nil
+	case 3:
+		b, err := r.byte()
+		return int64(int8(b)), err
+	case 4, 5, 6:
+		return r.varint()
+	case 7:
+		if r.pos+8 > len(r.b) {
+			return nil, errors.New("unexpected end of thrift data")
+		}
+		v := math.Float64frombits(binary.LittleEndian.Uint64(r.b[r.pos:]))
+		r.pos += 8
+		return v, nil
+	case 8:
+		n, err := r.uvarint()
+		if err != nil {
+			return nil, err
+		}
+		if r.pos+int(n) > len(r.b) {
+			return nil, errors.New("unexpected end of thrift data")
+		}
+		v := r.b[r.pos : r.pos+int(n)]
+		r.pos += int(n)
+		return v, nil
+	case 9, 10:
+		hdr, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		n, elemType := uint64(hdr>>4), hdr&0x0f
+		if n == 15 {
+			if n, err = r.uvarint(); err != nil {
+				return nil, err
+			}
+		}
+		list := make([]interface{}, n)
+		for i := range list {
+			if elemType == 1 || elemType == 2 {
+				b, err := r.byte()
+				if err != nil {
+					return nil, err
+				}
+				list[i] = b == 1
+				continue
+			}
+			if list[i], err = r.readValue(elemType); err != nil {
+				return nil, err
+			}
+		}
+		return list, nil
+	case 12:
+		return r.readStruct()
+	default:
+		return nil, fmt.Errorf("unsupported thrift type %d", typ)
+	}
+}
+
+// readFile decodes a Parquet file into one table per row group.
+// The group key is not part of the file, so the tables have no key columns.
+func readFile(data []byte) ([]*executetest.Table, error) {
+	if len(data) < 12 || string(data[:4]) != "PAR1" || string(data[len(data)-4:]) != "PAR1" {
+		return nil, errors.New("missing magic bytes")
+	}
+	size := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	footer := data[len(data)-8-size : len(data)-8]
+	meta, err := (&thriftReader{b: footer}).readStruct()
+	if err != nil {
+		return nil, err
+	}
+
+	schema := meta.list(2)
+	if len(schema) == 0 {
+		return nil, errors.New("missing schema")
+	}
+	root := schema[0].(thriftStruct)
+	if got, want := root.int(5), int64(len(schema)-1); got != want {
+		return nil, fmt.Errorf("unexpected number of children: %d != %d", got, want)
+	}
+	cols := make([]flux.ColMeta, 0, len(schema)-1)
+	for _, e := range schema[1:] {
+		el := e.(thriftStruct)
+		if el.int(3) != 1 {
+			return nil, fmt.Errorf("column %q is not optional", el.str(4))
+		}
+		typ, err := fluxType(el)
+		if err != nil {
+			return nil, err
+		}
+		cols = append(cols, flux.ColMeta{Label: el.str(4), Type: typ})
+	}
+
+	var tables []*executetest.Table
+	var numRows int64
+	for _, g := range meta.list(4) {
+		rg := g.(thriftStruct)
+		n := int(rg.int(3))
+		numRows += int64(n)
+		tbl := &executetest.Table{
+			ColMeta: cols,
+			Data:    make([][]interface{}, n),
+		}
+		for i := range tbl.Data {
+			tbl.Data[i] = make([]interface{}, len(cols))
+		}
+		chunks := rg.list(1)
+		if len(chunks) != len(cols) {
+			return nil, fmt.Errorf("unexpected number of column chunks: %d", len(chunks))
+		}
+		for j, c := range chunks {
+			cm := c.(thriftStruct).structure(3)
+			if cm.int(4) != 0 {
+				return nil, errors.New("compressed column chunks are not supported")
+			}
+			if got := string(cm.list(3)[0].([]byte)); got != cols[j].Label {
+				return nil, fmt.Errorf("unexpected path in schema %q", got)
+			}
+			if err := readColumnChunk(data, cm, cols[j].Type, n, tbl.Data, j); err != nil {
+				return nil, err
+			}
+		}
+		tables = append(tables, tbl)
+	}
+	if meta.int(3) != numRows {
+		return nil, fmt.Errorf("unexpected number of rows: %d != %d", meta.int(3), numRows)
+	}
+	return tables, nil
+}
+
+func fluxType(el thriftStruct) (flux.ColType, error) {
+	logical := el.structure(10)
+	switch el.int(1) {
+	case 0:
+		return flux.TBool, nil
+	case 5:
+		return flux.TFloat, nil
+	case 6:
+		if !logical.has(1) || el.int(6) != 0 {
+			return flux.TInvalid, fmt.Errorf("column %q is not a string", el.str(4))
+		}
+		return flux.TString, nil
+	case 2:
+		if ts := logical.structure(8); ts != nil {
+			if !ts.bool(1) || !ts.structure(2).has(3) {
+				return flux.TInvalid, fmt.Errorf("column %q is not a UTC timestamp in nanoseconds", el.str(4))
+			}
+			return flux.TTime, nil
+		}
+		it := logical.structure(10)
+		if it == nil || it.int(1) != 64 {
+			return flux.TInvalid, fmt.Errorf("column %q is not a 64 bit integer", el.str(4))
+		}
+		if it.bool(2) {
+			return flux.TInt, nil
+		}
+		return flux.TUInt, nil
+	default:
+		return flux.TInvalid, fmt.Errorf("unsupported physical type %d", el.int(1))
+	}
+}
+
+func readColumnChunk(data []byte, cm thriftStruct, typ flux.ColType, n int, rows [][]interface{}, j int) error {
+	r := &thriftReader{b: data, pos: int(cm.int(9))}
+	header, err := r.readStruct()
+	if err != nil {
+		return err
+	}
+	if header.int(1) != 0 {
+		return errors.New("expected a data page")
+	}
+	dph := header.structure(5)
+	if int(dph.int(1)) != n || int(cm.int(5)) != n {
+		return fmt.Errorf("unexpected number of values: %d", dph.int(1))
+	}
+	if dph.int(2) != 0 || dph.int(3) != 3 {
+		return errors.New("unsupported encoding")
+	}
+	page := data[r.pos : r.pos+int(header.int(3))]
+
+	levelsLen := int(binary.LittleEndian.Uint32(page))
+	valid, err := decodeLevels(page[4:4+levelsLen], n)
+	if err != nil {
+		return err
+	}
+	vs := bytes.NewReader(page[4+levelsLen:])
+	var bits []byte
+	if typ == flux.TBool {
+		bits = page[4+levelsLen:]
+	}
+	k := 0
+	for i := 0; i < n; i++ {
+		if !valid[i] {
+			continue
+		}
+		var b [8]byte
+		switch typ {
+		case flux.TBool:
+			rows[i][j] = bits[k/8]&(1<<uint(k%8)) != 0
+			k++
+			continue
+		case flux.TString:
+			if _, err := vs.Read(b[:4]); err != nil {
+				return err
+			}
+			s := make([]byte, binary.LittleEndian.Uint32(b[:4]))
+			if _, err := vs.Read(s); err != nil && len(s) > 0 {
+				return err
+			}
+			rows[i][j] = string(s)
+			continue
+		}
+		if _, err := vs.Read(b[:]); err != nil {
+			return err
+		}
+		v := binary.LittleEndian.Uint64(b[:])
+		switch typ {
+		case flux.TInt:
+			rows[i][j] = int64(v)
+		case flux.TUInt:
+			rows[i][j] = v
+		case flux.TFloat:
+			rows[i][j] = math.Float64frombits(v)
+		case flux.TTime:
+			rows[i][j] = values.Time(v)
+		}
+	}
+	return nil
+}
+
+// decodeLevels decodes n definition levels with a bit width of one
+// from the RLE/bit-packing hybrid encoding.
+func decodeLevels(b []byte, n int) ([]bool, error) {
+	levels := make([]bool, 0, n)
+	for len(levels) < n {
+		hdr, m := binary.Uvarint(b)
+		if m <= 0 {
+			return nil, errors.New("invalid run header")
+		}
+		b = b[m:]
+		if hdr&1 == 1 {
+			groups := int(hdr >> 1)
+			for i := 0; i < groups*8 && len(levels) < n; i++ {
+				levels = append(levels, b[i/8]&(1<<uint(i%8)) != 0)
+			}
+			b = b[groups:]
+		} else {
+			count := int(hdr >> 1)
+			for i := 0; i < count; i++ {
+				levels = append(levels, b[0] == 1)
+			}
+			b = b[1:]
+		}
+	}
+	return levels, nil
+}
@@ -0,0 +1,128 @@
+package parquet
+
+import (
+	"encoding/binary"
+)
+
+// Type identifiers of the thrift compact protocol.
+const (
+	compactBoolTrue  = 1
+	compactBoolFalse = 2
+	compactByte      = 3
+	compactI32       = 5
+	compactI64       = 6
+	compactBinary    = 8
+	compactList      = 9
+	compactStruct    = 12
+)
+
+// thriftWriter writes structs with the thrift compact protocol,
+// which is the protocol used for the Parquet metadata.
+// Only the types needed by the Parquet metadata are supported.
+type thriftWriter struct {
+	buf []byte
+	// lastID is a stack of the last field id written within
+	// each struct that is being written.
+	lastID []int16
+}
+
+func newThriftWriter() *thriftWriter {
+	return &thriftWriter{
+		lastID: []int16{0},
+	}
+}
+
+// Bytes returns the encoded bytes. All structs must have been ended.
+func (w *thriftWriter) Bytes() []byte {
+	// The top level struct has a stop field like any other.
+	return append(w.buf, 0)
+}
+
+func (w *thriftWriter) uvarint(v uint64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(b[:], v)
+	w.buf = append(w.buf, b[:n]...)
+}
+
+func (w *thriftWriter) varint(v int64) {
+	// Zigzag encoding
+	w.uvarint(uint64((v << 1) ^ (v >> 63)))
+}
+
+func (w *thriftWriter) field(id int16, typ byte) {
+	top := len(w.lastID) - 1
+	if delta := id - w.lastID[top]; delta > 0 && delta <= 15 {
+		w.buf = append(w.buf, byte(delta)<<4|typ)
+	} else {
+		w.buf = append(w.buf, typ)
+		w.varint(int64(id))
+	}
+	w.lastID[top] = id
+}
+
+func (w *thriftWriter) Byte(id int16, v int8) {
+	w.field(id, compactByte)
+	w.buf = append(w.buf, byte(v))
+}
+
+func (w *thriftWriter) I32(id int16, v int32) {
+	w.field(id, compactI32)
+	w.varint(int64(v))
+}
+
+func (w *thriftWriter) I64(id int16, v int64) {
+	w.field(id, compactI64)
+	w.varint(v)
+}
+
+func (w *thriftWriter) Bool(id int16, v bool) {
+	if v {
+		w.field(id, compactBoolTrue)
+	} else {
+		w.field(id, compactBoolFalse)
+	}
+}
+
+func (w *thriftWriter) String(id int16, v string) {
+	w.field(id, compactBinary)
+	w.uvarint(uint64(len(v)))
+	w.buf = append(w.buf, v...)
+}
+
+// BeginStruct starts a struct field. It must be followed by EndStruct.
+func (w *thriftWriter) BeginStruct(id int16) {
+	w.field(id, compactStruct)
+	w.lastID = append(w.lastID, 0)
+}
+
+// EndStruct ends the struct started by BeginStruct or BeginListStruct.
+func (w *thriftWriter) EndStruct() {
+	w.buf = append(w.buf, 0)
+	w.lastID = w.lastID[:len(w.lastID)-1]
+}
+
+// BeginList starts a list field with n elements of the element type.
+// The elements must be written with the List methods.
+func (w *thriftWriter) BeginList(id int16, elemType byte, n int) {
+	w.field(id, compactList)
+	if n < 15 {
+		w.buf = append(w.buf, byte(n)<<4|elemType)
+	} else {
+		w.buf = append(w.buf, 0xf0|elemType)
+		w.uvarint(uint64(n))
+	}
+}
+
+func (w *thriftWriter) ListI32(v int32) {
+	w.varint(int64(v))
+}
+
+func (w *thriftWriter) ListString(v string) {
+	w.uvarint(uint64(len(v)))
+	w.buf = append(w.buf, v...)
+}
+
+// BeginListStruct starts a struct element of a list. It must be followed by EndStruct.
+func (w *thriftWriter) BeginListStruct() {
+	w.lastID = append(w.lastID, 0)
+}
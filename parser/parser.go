@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -16,6 +17,16 @@ const defaultPackageName = "main"
 // All discovered packages are returned.
 // The parsed packages may contain errors, use ast.Check to check for errors.
 func ParseDir(fset *token.FileSet, path string) (map[string]*ast.Package, error) {
+	return parseDir(fset, path, ParseFile)
+}
+
+// ParseDirWithComments is like ParseDir, but attaches any comments
+// in the source files to the nearest node within the AST.
+func ParseDirWithComments(fset *token.FileSet, path string) (map[string]*ast.Package, error) {
+	return parseDir(fset, path, ParseFileWithComments)
+}
+
+func parseDir(fset *token.FileSet, path string, parseFile func(*token.FileSet, string) (*ast.File, error)) (map[string]*ast.Package, error) {
 	files, err := ioutil.ReadDir(path)
 	if err != nil {
 		return nil, err
@@ -26,7 +37,7 @@ func ParseDir(fset *token.FileSet, path string) (map[string]*ast.Package, error)
 			continue
 		}
 		fp := filepath.Join(path, fi.Name())
-		file, err := ParseFile(fset, fp)
+		file, err := parseFile(fset, fp)
 		if err != nil {
 			return nil, err
 		}
@@ -47,6 +58,16 @@ func ParseDir(fset *token.FileSet, path string) (map[string]*ast.Package, error)
 // ParseFile parses the specified path as a Flux source file.
 // The parsed file may contain errors, use ast.Check to check for errors.
 func ParseFile(fset *token.FileSet, path string) (*ast.File, error) {
+	return parseFile(fset, path, parser.ParseFile)
+}
+
+// ParseFileWithComments is like ParseFile, but attaches any comments
+// in the source file to the nearest node within the AST.
+func ParseFileWithComments(fset *token.FileSet, path string) (*ast.File, error) {
+	return parseFile(fset, path, parser.ParseFileWithComments)
+}
+
+func parseFile(fset *token.FileSet, path string, parse func(*token.File, []byte) *ast.File) (*ast.File, error) {
 	fi, err := os.Stat(path)
 	if err != nil {
 		return nil, err
@@ -56,6 +77,21 @@ func ParseFile(fset *token.FileSet, path string) (*ast.File, error) {
 	if err != nil {
 		return nil, err
 	}
+	return parse(f, src), nil
+}
+
+// ParseReader parses the contents of the reader as a Flux source file with the given name.
+// The parsed file may contain errors, use ast.Check to check for errors.
+//
+// The scanner requires the entire source to be available, so the reader is consumed
+// completely before parsing begins. The source of each node within the AST shares
+// the memory of that single copy of the source rather than being copied per node.
+func ParseReader(fset *token.FileSet, name string, r io.Reader) (*ast.File, error) {
+	src, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	f := fset.AddFile(name, len(src))
 	return parser.ParseFile(f, src), nil
 }
 
@@ -1,6 +1,8 @@
 package parser_test
 
 import (
+	"bytes"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -150,6 +152,100 @@ a = 1
 	}
 }
 
+func TestParseFile_RuneColumns(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "TestParseFile_RuneColumns")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	fpath := filepath.Join(tmpDir, "a.flux")
+	src := []byte("package foo\n\ncafé = \"🎉 party\" + x\n")
+	if err := ioutil.WriteFile(fpath, src, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		name        string
+		runeColumns bool
+		want        ast.SourceLocation
+	}{
+		{
+			name: "bytes",
+			want: ast.SourceLocation{
+				Start: ast.Position{Line: 3, Column: 24},
+				End:   ast.Position{Line: 3, Column: 25},
+			},
+		},
+		{
+			name:        "runes",
+			runeColumns: true,
+			want: ast.SourceLocation{
+				Start: ast.Position{Line: 3, Column: 20},
+				End:   ast.Position{Line: 3, Column: 21},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			fset := &token.FileSet{RuneColumns: tt.runeColumns}
+			file, err := parser.ParseFile(fset, fpath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ast.Check(file) > 0 {
+				t.Fatal(ast.GetError(file))
+			}
+			init := file.Body[0].(*ast.VariableAssignment).Init.(*ast.BinaryExpression)
+			x := init.Right.(*ast.Identifier)
+
+			want := tt.want
+			want.File = "a.flux"
+			want.Source = "x"
+			if got := x.Location(); !cmp.Equal(want, got) {
+				t.Errorf("unexpected location -want/+got:\n%s", cmp.Diff(want, got))
+			}
+			if got, want := init.Left.Location().Source, `"🎉 party"`; got != want {
+				t.Errorf("unexpected source -want/+got:\n\t- %s\n\t+ %s", want, got)
+			}
+		})
+	}
+}
+
+func TestParseReader(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "TestParseReader")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	src := []byte(`
+package foo
+
+import "strings"
+
+// a is a number
+a = 1
+f = (r) => strings.toUpper(v: r.s) == "A" and r._value > 2.0
+b = from(bucket: "b") |> range(start: -5m) |> filter(fn: f)
+`)
+	fpath := filepath.Join(tmpDir, "a.flux")
+	if err := ioutil.WriteFile(fpath, src, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	want, err := parser.ParseFile(new(token.FileSet), fpath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := parser.ParseReader(new(token.FileSet), "a.flux", bytes.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cmp.Equal(want, got, asttest.CmpOptions...) {
+		t.Errorf("ParseReader unexpected file -want/+got:\n%s", cmp.Diff(want, got, asttest.CmpOptions...))
+	}
+}
+
 func TestParseSource(t *testing.T) {
 	src := `
 package foo
@@ -177,3 +273,49 @@ a = 1
 		t.Errorf("ParseSource unexpected package -want/+got:\n%s", cmp.Diff(want, got, asttest.IgnoreBaseNodeOptions...))
 	}
 }
+
+// largeSource generates a script with many statements
+// similar to a large generated query.
+func largeSource(n int) []byte {
+	var buf bytes.Buffer
+	for i := 0; i < n; i++ {
+		fmt.Fprintf(&buf, "t%d = from(bucket: \"b%d\") |> range(start: -%dm) |> filter(fn: (r) => r._measurement == \"m%d\" and r._value > %d.0)\n", i, i, i+1, i, i)
+	}
+	return buf.Bytes()
+}
+
+func BenchmarkParseFile(b *testing.B) {
+	tmpDir, err := ioutil.TempDir("", "BenchmarkParseFile")
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	src := largeSource(10000)
+	fpath := filepath.Join(tmpDir, "a.flux")
+	if err := ioutil.WriteFile(fpath, src, 0644); err != nil {
+		b.Fatal(err)
+	}
+	b.SetBytes(int64(len(src)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := parser.ParseFile(new(token.FileSet), fpath); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseReader(b *testing.B) {
+	src := largeSource(10000)
+	b.SetBytes(int64(len(src)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := parser.ParseReader(new(token.FileSet), "a.flux", bytes.NewReader(src)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
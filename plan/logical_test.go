@@ -91,6 +91,7 @@ func TestPlan_LogicalPlanFromSpec(t *testing.T) {
 		joinSpec = &universe.MergeJoinProcedureSpec{
 			TableNames: []string{"a", "b"},
 			On:         []string{"_time"},
+			Method:     "inner",
 		}
 		toHTTPSpec = &http.ToHTTPProcedureSpec{
 			Spec: &toHTTPOpSpec,
@@ -155,7 +155,7 @@ func (r *REPL) executeLine(t string) (values.Value, error) {
 	r.scope.SetReturn(nil)
 
 	if _, err := r.interpreter.Eval(semPkg, r.scope, flux.StdLib()); err != nil {
-		return nil, err
+		return nil, semantic.AnnotateError(err, astPkg)
 	}
 
 	v := r.scope.Return()
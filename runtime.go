@@ -0,0 +1,112 @@
+package flux
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"sync"
+
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/values"
+)
+
+// Runtime evaluates Flux scripts with the standard library and any
+// builtin packages that have been registered with the runtime.
+//
+// Unlike RegisterPackage, packages registered with a Runtime do not modify
+// any global state. They are only visible to scripts evaluated by that Runtime,
+// so independent runtimes may register packages without affecting each other.
+type Runtime struct {
+	mu     sync.Mutex
+	stdlib *importer
+}
+
+// NewRuntime creates a Runtime that initially contains the standard library.
+// The builtins must have been finalized before a Runtime is created.
+func NewRuntime() *Runtime {
+	if !finalized {
+		panic("builtins not finalized")
+	}
+	return &Runtime{
+		stdlib: stdlib.Copy(),
+	}
+}
+
+// RegisterPackage evaluates the package and makes it available to be imported
+// by the scripts evaluated with the runtime using the path of the package.
+// The builtins are the values of the builtin statements within the package.
+func (r *Runtime) RegisterPackage(pkg *ast.Package, builtins map[string]values.Value) error {
+	if pkg.Path == "" {
+		return errors.New("builtin package must have a path")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.stdlib.pkgs[pkg.Path]; ok {
+		return fmt.Errorf("duplicate builtin package %q", pkg.Path)
+	}
+	name := pkg.Package
+	if name == "" {
+		name = path.Base(pkg.Path)
+	}
+	p := interpreter.NewPackage(name)
+	for k, v := range builtins {
+		p.Set(k, v)
+	}
+	if err := evalBuiltInPackage(pkg, p, r.stdlib); err != nil {
+		return err
+	}
+	r.stdlib.pkgs[pkg.Path] = p
+	return nil
+}
+
+// ReplacePackageValue replaces the value of an identifier in a package of the runtime.
+// Only scripts evaluated by the runtime see the new value.
+func (r *Runtime) ReplacePackageValue(pkgpath, name string, value values.Value) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p, ok := r.stdlib.pkgs[pkgpath]
+	if !ok {
+		return fmt.Errorf("missing builtin package %q", pkgpath)
+	}
+	if _, ok := p.Get(name); !ok {
+		return fmt.Errorf("missing builtin package value %q %q", pkgpath, name)
+	}
+	p.Set(name, value)
+	return nil
+}
+
+// Copy returns a runtime with the same packages as the runtime.
+// Packages registered with either runtime afterwards are not visible to the other.
+func (r *Runtime) Copy() *Runtime {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return &Runtime{
+		stdlib: r.stdlib.Copy(),
+	}
+}
+
+// StdLib returns an importer for the standard library
+// and the packages registered with the runtime.
+func (r *Runtime) StdLib() interpreter.Importer {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.stdlib.Copy()
+}
+
+// Eval accepts a Flux script and evaluates it with the runtime to produce a set of side effects (as a slice of values) and a scope.
+func (r *Runtime) Eval(flux string, opts ...ScopeMutator) ([]values.Value, interpreter.Scope, error) {
+	astPkg, err := Parse(flux)
+	if err != nil {
+		return nil, nil, err
+	}
+	return r.EvalAST(astPkg, opts...)
+}
+
+// EvalAST accepts a Flux AST and evaluates it with the runtime to produce a set of side effects (as a slice of values) and a scope.
+func (r *Runtime) EvalAST(astPkg *ast.Package, opts ...ScopeMutator) ([]values.Value, interpreter.Scope, error) {
+	return evalAST(astPkg, r.StdLib(), opts...)
+}
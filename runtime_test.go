@@ -0,0 +1,128 @@
+package flux_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/parser"
+	"github.com/influxdata/flux/values"
+)
+
+func TestRuntime_RegisterPackage(t *testing.T) {
+	pkg := parser.ParseSource(`
+package custom
+
+builtin answer
+
+double = (v) => v * 2
+`)
+	pkg.Path = "example.com/custom"
+
+	rt := flux.NewRuntime()
+	if err := rt.RegisterPackage(pkg, map[string]values.Value{
+		"answer": values.NewInt(21),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	script := `
+import "example.com/custom"
+
+x = custom.double(v: custom.answer)
+`
+	_, scope, err := rt.Eval(script)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x, ok := scope.Lookup("x")
+	if !ok {
+		t.Fatal("expected x to be defined")
+	}
+	if got, want := x.Int(), int64(42); got != want {
+		t.Errorf("unexpected value: want %d got %d", want, got)
+	}
+
+	// The package must not be visible to another runtime
+	// or to the global standard library.
+	if _, _, err := flux.NewRuntime().Eval(script); err == nil {
+		t.Error("expected an error importing the package from another runtime")
+	}
+	if _, _, err := flux.Eval(script); err == nil {
+		t.Error("expected an error importing the package from the standard library")
+	}
+
+	if err := rt.RegisterPackage(pkg, map[string]values.Value{
+		"answer": values.NewInt(21),
+	}); err == nil {
+		t.Error("expected an error registering a duplicate package")
+	}
+}
+
+func TestRuntime_RegisterPackage_MissingBuiltin(t *testing.T) {
+	pkg := parser.ParseSource(`
+package custom
+
+builtin answer
+`)
+	pkg.Path = "example.com/custom"
+
+	rt := flux.NewRuntime()
+	if err := rt.RegisterPackage(pkg, nil); err == nil {
+		t.Fatal("expected an error registering a package with a missing builtin")
+	}
+	if _, _, err := rt.Eval(`import "example.com/custom"`); err == nil {
+		t.Error("expected the package to not be registered")
+	}
+}
+
+func TestRuntime_ReplacePackageValue(t *testing.T) {
+	pkg := parser.ParseSource(`
+package custom
+
+builtin answer
+`)
+	pkg.Path = "example.com/custom"
+
+	rt := flux.NewRuntime()
+	if err := rt.RegisterPackage(pkg, map[string]values.Value{
+		"answer": values.NewInt(21),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	cp := rt.Copy()
+	if err := cp.ReplacePackageValue("example.com/custom", "answer", values.NewInt(42)); err != nil {
+		t.Fatal(err)
+	}
+
+	script := `
+import "example.com/custom"
+
+x = custom.answer
+`
+	for _, tc := range []struct {
+		rt   *flux.Runtime
+		want int64
+	}{
+		{rt: rt, want: 21},
+		{rt: cp, want: 42},
+	} {
+		_, scope, err := tc.rt.Eval(script)
+		if err != nil {
+			t.Fatal(err)
+		}
+		x, ok := scope.Lookup("x")
+		if !ok {
+			t.Fatal("expected x to be defined")
+		}
+		if got := x.Int(); got != tc.want {
+			t.Errorf("unexpected value: want %d got %d", tc.want, got)
+		}
+	}
+
+	if err := cp.ReplacePackageValue("example.com/custom", "question", values.NewInt(0)); err == nil {
+		t.Error("expected an error replacing a missing value")
+	}
+	if err := cp.ReplacePackageValue("example.com/other", "answer", values.NewInt(0)); err == nil {
+		t.Error("expected an error replacing a value of a missing package")
+	}
+}
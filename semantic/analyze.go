@@ -19,6 +19,7 @@ func New(pkg *ast.Package) (*Package, error) {
 	if err := runChecks(p, vars, opts); err != nil {
 		return nil, err
 	}
+	FoldConstants(p)
 	return p, nil
 }
 
@@ -131,6 +132,8 @@ func analyzeStatment(s ast.Statement) (Statement, error) {
 		return analyzeVariableAssignment(s)
 	case *ast.MemberAssignment:
 		return analyzeMemberAssignment(s)
+	case *ast.DestructuringAssignment:
+		return analyzeDestructuringAssignment(s)
 	default:
 		return nil, fmt.Errorf("unsupported statement %T", s)
 	}
@@ -252,6 +255,60 @@ func analyzeMemberAssignment(a *ast.MemberAssignment) (*MemberAssignment, error)
 	}, nil
 }
 
+func analyzeDestructuringAssignment(a *ast.DestructuringAssignment) (*DestructuringAssignment, error) {
+	d := &DestructuringAssignment{
+		loc: loc(a.Location()),
+	}
+	switch pattern := a.Pattern.(type) {
+	case *ast.ArrayExpression:
+		d.Identifiers = make([]*Identifier, len(pattern.Elements))
+		for i, e := range pattern.Elements {
+			ident, ok := e.(*ast.Identifier)
+			if !ok {
+				return nil, fmt.Errorf("array pattern elements must be identifiers, got %s", e.Type())
+			}
+			id, err := analyzeIdentifier(ident)
+			if err != nil {
+				return nil, err
+			}
+			d.Identifiers[i] = id
+		}
+	case *ast.ObjectExpression:
+		if pattern.With != nil {
+			return nil, errors.New("object pattern cannot use with")
+		}
+		d.Identifiers = make([]*Identifier, len(pattern.Properties))
+		d.Keys = make([]string, len(pattern.Properties))
+		for i, p := range pattern.Properties {
+			key, err := analyzePropertyKey(p.Key)
+			if err != nil {
+				return nil, err
+			}
+			ident, ok := p.Value.(*ast.Identifier)
+			if p.Value == nil {
+				ident, ok = p.Key.(*ast.Identifier)
+			}
+			if !ok {
+				return nil, fmt.Errorf("object pattern values must be identifiers, got %T", p.Value)
+			}
+			id, err := analyzeIdentifier(ident)
+			if err != nil {
+				return nil, err
+			}
+			d.Identifiers[i] = id
+			d.Keys[i] = key.Key()
+		}
+	default:
+		return nil, fmt.Errorf("unsupported destructuring pattern %T", a.Pattern)
+	}
+	init, err := analyzeExpression(a.Init)
+	if err != nil {
+		return nil, err
+	}
+	d.Init = init
+	return d, nil
+}
+
 func analyzeExpression(expr ast.Expression) (Expression, error) {
 	switch expr := expr.(type) {
 	case *ast.FunctionExpression:
@@ -274,10 +331,14 @@ func analyzeExpression(expr ast.Expression) (Expression, error) {
 		return analyzeConditionalExpression(expr)
 	case *ast.ObjectExpression:
 		return analyzeObjectExpression(expr)
+	case *ast.StringExpression:
+		return analyzeStringExpression(expr)
 	case *ast.ArrayExpression:
 		return analyzeArrayExpression(expr)
 	case *ast.Identifier:
 		return analyzeIdentifierExpression(expr)
+	case *ast.PipePlaceholder:
+		return analyzePipePlaceholder(expr)
 	case ast.Literal:
 		return analyzeLiteral(expr)
 	default:
@@ -466,6 +527,11 @@ func analyzeIndexExpression(e *ast.IndexExpression) (Expression, error) {
 	}, nil
 }
 
+// pipePlaceholder is the name that a pipe placeholder refers to.
+// It cannot be referenced by any other identifier because the parser
+// always reads the identifier _ as a placeholder.
+const pipePlaceholder = "_"
+
 func analyzePipeExpression(pipe *ast.PipeExpression) (*CallExpression, error) {
 	call, err := analyzeCallExpression(pipe.Call)
 	if err != nil {
@@ -477,8 +543,71 @@ func analyzePipeExpression(pipe *ast.PipeExpression) (*CallExpression, error) {
 		return nil, err
 	}
 
-	call.Pipe = value
-	return call, nil
+	if !hasPipePlaceholder(pipe.Call) {
+		call.Pipe = value
+		return call, nil
+	}
+
+	// The call refers to the piped value with a placeholder,
+	// so wrap the call in a function that binds the piped value
+	// to the name of the placeholder: ((_) => _ |> call)(_: value).
+	l := loc(pipe.Location())
+	key := &Identifier{loc: l, Name: pipePlaceholder}
+	call.Pipe = &IdentifierExpression{loc: l, Name: pipePlaceholder}
+	return &CallExpression{
+		loc: l,
+		Callee: &FunctionExpression{
+			loc: l,
+			Block: &FunctionBlock{
+				loc: l,
+				Parameters: &FunctionParameters{
+					loc:  l,
+					List: []*FunctionParameter{{loc: l, Key: key}},
+				},
+				Body: call,
+			},
+		},
+		Arguments: &ObjectExpression{
+			loc:        l,
+			Properties: []*Property{{loc: l, Key: key, Value: value}},
+		},
+	}, nil
+}
+
+// hasPipePlaceholder reports whether the call of a pipe expression contains a pipe placeholder.
+// Placeholders within the call of a nested pipe expression belong to that pipe expression.
+func hasPipePlaceholder(call *ast.CallExpression) bool {
+	v := new(placeholderVisitor)
+	ast.Walk(v, call)
+	return v.found
+}
+
+type placeholderVisitor struct {
+	found bool
+}
+
+func (v *placeholderVisitor) Visit(node ast.Node) ast.Visitor {
+	if v.found {
+		return nil
+	}
+	switch n := node.(type) {
+	case *ast.PipePlaceholder:
+		v.found = true
+		return nil
+	case *ast.PipeExpression:
+		ast.Walk(v, n.Argument)
+		return nil
+	}
+	return v
+}
+
+func (v *placeholderVisitor) Done(node ast.Node) {}
+
+func analyzePipePlaceholder(placeholder *ast.PipePlaceholder) (*IdentifierExpression, error) {
+	return &IdentifierExpression{
+		loc:  loc(placeholder.Location()),
+		Name: pipePlaceholder,
+	}, nil
 }
 
 func analyzeBinaryExpression(binary *ast.BinaryExpression) (*BinaryExpression, error) {
@@ -545,11 +674,45 @@ func analyzeConditionalExpression(ce *ast.ConditionalExpression) (*ConditionalEx
 		Alternate:  a,
 	}, nil
 }
+func analyzeStringExpression(se *ast.StringExpression) (*StringExpression, error) {
+	parts := make([]StringExpressionPart, len(se.Parts))
+	for i, p := range se.Parts {
+		switch p := p.(type) {
+		case *ast.TextPart:
+			parts[i] = &TextPart{
+				loc:   loc(p.Location()),
+				Value: p.Value,
+			}
+		case *ast.InterpolatedPart:
+			e, err := analyzeExpression(p.Expression)
+			if err != nil {
+				return nil, err
+			}
+			parts[i] = &InterpolatedPart{
+				loc:        loc(p.Location()),
+				Expression: e,
+			}
+		default:
+			return nil, fmt.Errorf("unsupported string expression part %T", p)
+		}
+	}
+	return &StringExpression{
+		loc:   loc(se.Location()),
+		Parts: parts,
+	}, nil
+}
 func analyzeObjectExpression(obj *ast.ObjectExpression) (*ObjectExpression, error) {
 	o := &ObjectExpression{
 		loc:        loc(obj.Location()),
 		Properties: make([]*Property, len(obj.Properties)),
 	}
+	if obj.With != nil {
+		w, err := analyzeIdentifierExpression(obj.With)
+		if err != nil {
+			return nil, err
+		}
+		o.With = w
+	}
 	for i, p := range obj.Properties {
 		n, err := analyzeProperty(p)
 		if err != nil {
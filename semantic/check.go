@@ -21,6 +21,10 @@ func runChecks(n Node, vars, opts map[string]bool) error {
 	if err := optionDependencies(stmts, opts); err != nil {
 		return err
 	}
+	// Check for duplicate properties within records.
+	if err := duplicateProperties(n); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -109,12 +113,13 @@ func optionReAssignments(stmts []*OptionStatement, vars, options map[string]bool
 }
 
 func varReAssignments(n Node, vars, opts map[string]bool) error {
-	var varDec, optDec *NativeVariableAssignment
-	varFn := func(n *NativeVariableAssignment) {
-		varDec = n
+	var varDec, optDec Node
+	var varName, optName string
+	varFn := func(name string, n Node) {
+		varName, varDec = name, n
 	}
-	optFn := func(n *NativeVariableAssignment) {
-		optDec = n
+	optFn := func(name string, n Node) {
+		optName, optDec = name, n
 	}
 	visitor := varStmtVisitor{
 		vars:  vars,
@@ -124,12 +129,10 @@ func varReAssignments(n Node, vars, opts map[string]bool) error {
 	}
 	Walk(NewScopedVisitor(visitor), n)
 	if varDec != nil {
-		name := varDec.Identifier.Name
-		return fmt.Errorf("var %q redeclared at %v", name, varDec.Location())
+		return fmt.Errorf("var %q redeclared at %v", varName, varDec.Location())
 	}
 	if optDec != nil {
-		name := optDec.Identifier.Name
-		return fmt.Errorf("cannot declare variable %q at %v; option with same name already declared", name, optDec.Location())
+		return fmt.Errorf("cannot declare variable %q at %v; option with same name already declared", optName, optDec.Location())
 	}
 	return nil
 }
@@ -138,7 +141,7 @@ func varReAssignments(n Node, vars, opts map[string]bool) error {
 // variable reassignments are passed to errFn.
 type varStmtVisitor struct {
 	vars, opts   map[string]bool
-	varFn, optFn func(*NativeVariableAssignment)
+	varFn, optFn func(name string, n Node)
 	option       bool
 }
 
@@ -147,24 +150,39 @@ func (v varStmtVisitor) Visit(node Node) Visitor {
 	case *OptionStatement:
 		v.option = true
 	case *NativeVariableAssignment:
-		name := n.Identifier.Name
 		if v.option {
 			v.option = false
 			return v
-		} else if v.vars[name] {
-			v.varFn(n)
-			return nil
-		} else if v.opts[name] {
-			v.optFn(n)
+		}
+		if !v.declare(n.Identifier.Name, n) {
 			return nil
 		}
-		v.vars[name] = true
+	case *DestructuringAssignment:
+		for _, id := range n.Identifiers {
+			if !v.declare(id.Name, n) {
+				return nil
+			}
+		}
 	case *FunctionParameter:
 		v.vars[n.Key.Name] = true
 	}
 	return v
 }
 
+// declare records the variable declared by n and reports
+// whether the name was not already declared.
+func (v varStmtVisitor) declare(name string, n Node) bool {
+	if v.vars[name] {
+		v.varFn(name, n)
+		return false
+	} else if v.opts[name] {
+		v.optFn(name, n)
+		return false
+	}
+	v.vars[name] = true
+	return true
+}
+
 func (v varStmtVisitor) Nest() NestingVisitor {
 	v.vars = make(map[string]bool)
 	v.opts = make(map[string]bool)
@@ -212,6 +230,10 @@ func (v optionExprVisitor) Visit(node Node) Visitor {
 	case *NativeVariableAssignment:
 		// var declarations shadow options
 		v.shadow[n.Identifier.Name] = true
+	case *DestructuringAssignment:
+		for _, id := range n.Identifiers {
+			v.shadow[id.Name] = true
+		}
 	case *FunctionParameter:
 		// function params shadow options
 		v.shadow[n.Key.Name] = true
@@ -234,3 +256,24 @@ func (v optionExprVisitor) Nest() NestingVisitor {
 }
 
 func (v optionExprVisitor) Done(node Node) {}
+
+// duplicateProperties returns an error for the first record
+// that has more than one property with the same key.
+func duplicateProperties(n Node) error {
+	var err error
+	Walk(CreateVisitor(func(node Node) {
+		obj, ok := node.(*ObjectExpression)
+		if !ok || err != nil {
+			return
+		}
+		seen := make(map[string]*Property, len(obj.Properties))
+		for _, p := range obj.Properties {
+			if first, ok := seen[p.Key.Key()]; ok {
+				err = fmt.Errorf("property %q redeclared at %v; first declared at %v", p.Key.Key(), p.Location(), first.Location())
+				return
+			}
+			seen[p.Key.Key()] = p
+		}
+	}), n)
+	return err
+}
@@ -1,10 +1,12 @@
 package semantic
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/parser"
 )
 
 func TestOptionDeclarations(t *testing.T) {
@@ -2158,3 +2160,135 @@ func TestOptionDependencies(t *testing.T) {
 		})
 	}
 }
+
+func TestDuplicateProperties(t *testing.T) {
+	testcases := []struct {
+		name   string
+		script string
+		err    error
+	}{
+		{
+			name:   "distinct properties",
+			script: `r = {a: 1, b: 2}`,
+		},
+		{
+			name:   "duplicate property",
+			script: `r = {a: 1, b: 2, a: 3}`,
+			err:    errors.New(`property "a" redeclared at 1:18-1:22; first declared at 1:6-1:10`),
+		},
+		{
+			name:   "duplicate string property",
+			script: `r = {"a": 1, a: 2}`,
+			err:    errors.New(`property "a" redeclared at 1:14-1:18; first declared at 1:6-1:12`),
+		},
+		{
+			name:   "duplicate with",
+			script: `r = {a: 1}
+s = {r with a: 2, a: 3}`,
+			err: errors.New(`property "a" redeclared at 2:19-2:23; first declared at 2:13-2:17`),
+		},
+		{
+			name:   "with overrides",
+			script: `r = {a: 1}
+s = {r with a: 2}`,
+		},
+		{
+			name:   "nested records",
+			script: `r = {a: {a: 1}, b: {a: 2}}`,
+		},
+		{
+			name:   "duplicate argument",
+			script: `f = (a) => a
+f(a: 1, a: 2)`,
+			err: errors.New(`property "a" redeclared at 2:9-2:13; first declared at 2:3-2:7`),
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pkg := parser.ParseSource(tc.script)
+			if ast.Check(pkg) > 0 {
+				t.Fatal(ast.GetError(pkg))
+			}
+			_, err := New(pkg)
+			switch {
+			case err == nil && tc.err == nil:
+				// Test passes
+			case err == nil && tc.err != nil:
+				t.Errorf("expected error: %v", tc.err)
+			case err != nil && tc.err == nil:
+				t.Errorf("unexpected error: %v", err)
+			case err != nil && tc.err != nil:
+				if err.Error() != tc.err.Error() {
+					t.Errorf("unexpected result; want err=%v, got err=%v", tc.err, err)
+				}
+			}
+		})
+	}
+}
+
+func TestDestructuringReAssignments(t *testing.T) {
+	testcases := []struct {
+		name   string
+		script string
+		err    error
+	}{
+		{
+			name:   "distinct identifiers",
+			script: `[a, b] = [1, 2]`,
+		},
+		{
+			name:   "repeated identifier",
+			script: `[a, a] = [1, 2]`,
+			err:    errors.New(`var "a" redeclared at 1:1-1:16`),
+		},
+		{
+			name:   "redeclared variable",
+			script: `a = 1
+{a} = {a: 2}`,
+			err: errors.New(`var "a" redeclared at 2:1-2:13`),
+		},
+		{
+			name:   "redeclared by variable",
+			script: `{a, b: c} = {a: 1, b: 2}
+c = 3`,
+			err: errors.New(`var "c" redeclared at 2:1-2:6`),
+		},
+		{
+			name:   "option with same name",
+			script: `option a = 1
+[a] = [2]`,
+			err: errors.New(`cannot declare variable "a" at 2:1-2:10; option with same name already declared`),
+		},
+		{
+			name:   "shadowed within function",
+			script: `a = 1
+f = (r) => {
+	{a} = r
+	return a
+}`,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pkg := parser.ParseSource(tc.script)
+			if ast.Check(pkg) > 0 {
+				t.Fatal(ast.GetError(pkg))
+			}
+			_, err := New(pkg)
+			switch {
+			case err == nil && tc.err == nil:
+				// Test passes
+			case err == nil && tc.err != nil:
+				t.Errorf("expected error: %v", tc.err)
+			case err != nil && tc.err == nil:
+				t.Errorf("unexpected error: %v", err)
+			case err != nil && tc.err != nil:
+				if err.Error() != tc.err.Error() {
+					t.Errorf("unexpected result; want err=%v, got err=%v", tc.err, err)
+				}
+			}
+		})
+	}
+}
@@ -45,6 +45,11 @@ type ConstraintGenerator struct {
 	env      *Env
 	err      *error
 	importer Importer
+
+	// fn is the function whose default values are being visited.
+	fn *FunctionExpression
+	// later lists the parameters declared after the default value being visited.
+	later []string
 }
 
 // Nest nests the internal type environment to obey scoping rules.
@@ -57,14 +62,54 @@ func (v ConstraintGenerator) Nest() NestingVisitor {
 	}
 }
 
-// Visit visits each node, the algorithm is depth first so nothing is performed in Visit except for an error check.
+// Visit visits each node, the algorithm is depth first so nothing is performed in Visit
+// except for an error check and scoping the parameters of a function for its default values.
 func (v ConstraintGenerator) Visit(node Node) Visitor {
 	if *v.err != nil {
 		return nil
 	}
+	switch n := node.(type) {
+	case *FunctionExpression:
+		if n.Defaults != nil {
+			v.env = v.env.Nest()
+			v.fn = n
+			v.later = nil
+		}
+	case *Property:
+		if v.fn != nil {
+			v.scopeDefault(n)
+		}
+	}
 	return v
 }
 
+// scopeDefault makes the parameters declared before the parameter of a default value
+// visible to the default value, and records the parameters declared after it.
+// The property is ignored if it is not a default value of the function being visited.
+func (v *ConstraintGenerator) scopeDefault(p *Property) {
+	isDefault := false
+	for _, d := range v.fn.Defaults.Properties {
+		if d == p {
+			isDefault = true
+			break
+		}
+	}
+	if !isDefault {
+		return
+	}
+	params := v.fn.Block.Parameters.List
+	for i, param := range params {
+		if param.Key.Name == p.Key.Key() {
+			v.later = make([]string, 0, len(params)-i-1)
+			for _, l := range params[i+1:] {
+				v.later = append(v.later, l.Key.Name)
+			}
+			return
+		}
+		v.env.Set(param.Key.Name, Scheme{T: v.cs.annotations[param].Var})
+	}
+}
+
 // Done visits nodes after all children of the node have been visited.
 func (v ConstraintGenerator) Done(node Node) {
 	a := v.cs.annotations[node]
@@ -75,7 +120,9 @@ func (v ConstraintGenerator) Done(node Node) {
 			v.cs.AddTypeConst(a.Var, a.Type, node.Location())
 		}
 	}
-	a.Err = errors.Wrapf(a.Err, "type error %v", node.Location())
+	if a.Err != nil {
+		a.Err = &TypeError{Loc: node.Location(), Err: a.Err}
+	}
 	//log.Printf("typeof %T@%v %v %v %v", node, node.Location(), a.Var, a.Type, a.Err)
 	if *v.err == nil && a.Err != nil {
 		*v.err = a.Err
@@ -158,7 +205,53 @@ func (v ConstraintGenerator) typeof(n Node) (PolyType, error) {
 		scheme := v.scheme(t)
 		v.env.Set(n.Identifier.Name, scheme)
 		return nil, nil
+	case *DestructuringAssignment:
+		t, err := v.lookup(n.Init)
+		if err != nil {
+			return nil, err
+		}
+		types := make([]PolyType, len(n.Identifiers))
+		if len(n.Keys) == 0 {
+			elt := v.cs.f.Fresh()
+			tv, ok := t.(Tvar)
+			if !ok {
+				return nil, errors.New("destructured array must be a type variable")
+			}
+			v.cs.AddKindConst(tv, ArrayKind{elt})
+			for i := range types {
+				types[i] = elt
+			}
+		} else {
+			tv, ok := t.(Tvar)
+			if !ok {
+				return nil, errors.New("destructured object must be a type variable")
+			}
+			properties := make(map[string]PolyType, len(n.Keys))
+			for i, key := range n.Keys {
+				ptv := v.cs.f.Fresh()
+				properties[key] = ptv
+				types[i] = ptv
+			}
+			v.cs.AddKindConst(tv, ObjectKind{
+				properties: properties,
+				lower:      LabelSet(n.Keys).copy(),
+				upper:      AllLabels(),
+			})
+		}
+		for i, id := range n.Identifiers {
+			v.cs.annotations[id] = annotation{Var: v.cs.f.Fresh(), Type: types[i]}
+			v.constrainExistingIdent(id.Name, types[i], id.Location())
+			// The types of the parts are only known once the constraints
+			// are solved, so the identifiers are not generalized.
+			v.env.Set(id.Name, Scheme{T: types[i]})
+		}
+		return nil, nil
 	case *IdentifierExpression:
+		for _, name := range v.later {
+			if name == n.Name {
+				return nil, fmt.Errorf("default value refers to parameter %q declared after it", n.Name)
+			}
+		}
 		scheme, ok := v.env.Lookup(n.Name)
 		if !ok {
 			return nil, fmt.Errorf("undefined identifier %q", n.Name)
@@ -232,6 +325,17 @@ func (v ConstraintGenerator) typeof(n Node) (PolyType, error) {
 		v.cs.AddTypeConst(t, Bool, n.Test.Location())
 		v.cs.AddTypeConst(c, a, n.Location())
 		return c, nil
+	case *StringExpression:
+		return String, nil
+	case *TextPart:
+		return String, nil
+	case *InterpolatedPart:
+		t, err := v.lookup(n.Expression)
+		if err != nil {
+			return nil, err
+		}
+		v.cs.AddTypeConst(t, String, n.Expression.Location())
+		return String, nil
 	case *UnaryExpression:
 		t, err := v.lookup(n.Argument)
 		if err != nil {
@@ -338,6 +442,30 @@ func (v ConstraintGenerator) typeof(n Node) (PolyType, error) {
 			properties[field.Key.Key()] = t
 			upper = append(upper, field.Key.Key())
 		}
+		if n.With != nil {
+			t, err := v.lookup(n.With)
+			if err != nil {
+				return nil, err
+			}
+			tv, ok := t.(Tvar)
+			if !ok {
+				return nil, errors.New("with object must be a type variable")
+			}
+			v.cs.AddKindConst(tv, ObjectKind{
+				properties: map[string]PolyType{},
+				lower:      nil,
+				upper:      AllLabels(),
+			})
+			// The properties of the with object are only known once the
+			// constraints are solved, so leave the upper bound open.
+			v.cs.AddKindConst(nodeVar, ObjectKind{
+				properties: properties,
+				lower:      nil,
+				upper:      AllLabels(),
+			})
+			v.cs.AddWithConst(nodeVar, tv, upper, n.Location())
+			return nodeVar, nil
+		}
 		v.cs.AddKindConst(nodeVar, ObjectKind{
 			properties: properties,
 			lower:      nil,
@@ -466,6 +594,7 @@ type Constraints struct {
 
 	typeConst []TypeConstraint
 	kindConst map[Tvar][]Kind
+	withConst []WithConstraint
 }
 
 func (c *Constraints) Copy() *Constraints {
@@ -474,12 +603,14 @@ func (c *Constraints) Copy() *Constraints {
 		annotations: make(map[Node]annotation, len(c.annotations)),
 		typeConst:   make([]TypeConstraint, len(c.typeConst)),
 		kindConst:   make(map[Tvar][]Kind, len(c.kindConst)),
+		withConst:   make([]WithConstraint, len(c.withConst)),
 	}
 	*n.f = *c.f
 	for k, v := range c.annotations {
 		n.annotations[k] = v
 	}
 	copy(n.typeConst, c.typeConst)
+	copy(n.withConst, c.withConst)
 	for k, v := range c.kindConst {
 		kinds := make([]Kind, len(v))
 		copy(kinds, v)
@@ -510,6 +641,27 @@ func (c *Constraints) AddKindConst(tv Tvar, k Kind) {
 	c.kindConst[tv] = append(c.kindConst[tv], k)
 }
 
+// WithConstraint states that the object r has all the properties of the object with,
+// except for the labels that are set on r directly.
+type WithConstraint struct {
+	r, with Tvar
+	labels  LabelSet
+	loc     ast.SourceLocation
+}
+
+func (wc WithConstraint) String() string {
+	return fmt.Sprintf("%v = {%v with %v} @ %v", wc.r, wc.with, wc.labels, wc.loc)
+}
+
+func (c *Constraints) AddWithConst(r, with Tvar, labels LabelSet, loc ast.SourceLocation) {
+	c.withConst = append(c.withConst, WithConstraint{
+		r:      r,
+		with:   with,
+		labels: labels,
+		loc:    loc,
+	})
+}
+
 // Instantiate produces a new poly type where the free variables from the scheme have been made fresh.
 // This way each new instantiation of a scheme is independent of the other but all have the same constraint structure.
 func (c *Constraints) Instantiate(s Scheme, loc ast.SourceLocation) (t PolyType) {
@@ -546,6 +698,13 @@ func (c *Constraints) Instantiate(s Scheme, loc ast.SourceLocation) (t PolyType)
 		}
 	}
 
+	// Add any new with constraints
+	for _, wc := range c.withConst {
+		if s.Free.contains(wc.r) || s.Free.contains(wc.with) {
+			c.AddWithConst(subst.ApplyTvar(wc.r), subst.ApplyTvar(wc.with), wc.labels, loc)
+		}
+	}
+
 	return subst.ApplyType(s.T)
 }
 
@@ -563,6 +722,10 @@ func (c *Constraints) String() string {
 	for tv, ks := range c.kindConst {
 		fmt.Fprintf(&builder, "%v = %v,\n", tv, ks)
 	}
+	builder.WriteString("withs:\n")
+	for _, wc := range c.withConst {
+		fmt.Fprintf(&builder, "%v,\n", wc)
+	}
 	builder.WriteString("}")
 	return builder.String()
 }
@@ -0,0 +1,102 @@
+package semantic
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/influxdata/flux/ast"
+)
+
+// TypeError is returned when the types of a program cannot be unified.
+type TypeError struct {
+	// Loc is the location of the expression that failed to type check.
+	Loc ast.SourceLocation
+	// Err is the reason the types could not be unified.
+	Err error
+	// Source is the source text of the file containing Loc.
+	// When it is set, the error message includes the offending line
+	// with a caret pointing at the expression.
+	Source string
+}
+
+func (e *TypeError) Error() string {
+	msg := fmt.Sprintf("type error %v: %v", e.Loc, e.Err)
+	if snippet := e.snippet(); snippet != "" {
+		msg += "\n" + snippet
+	}
+	return msg
+}
+
+// Cause returns the reason the types could not be unified.
+func (e *TypeError) Cause() error {
+	return e.Err
+}
+
+// snippet renders the line of the source referenced by the error location
+// followed by a line of carets underlining the expression.
+func (e *TypeError) snippet() string {
+	if e.Source == "" || !e.Loc.IsValid() {
+		return ""
+	}
+	lines := strings.Split(e.Source, "\n")
+	if e.Loc.Start.Line < 1 || e.Loc.Start.Line > len(lines) {
+		return ""
+	}
+	line := strings.TrimRight(lines[e.Loc.Start.Line-1], "\r")
+	// Columns count runes, so work with the line as runes.
+	runes := []rune(line)
+	start := e.Loc.Start.Column - 1
+	if start < 0 || start > len(runes) {
+		return ""
+	}
+	end := len(runes)
+	if e.Loc.End.Line == e.Loc.Start.Line && e.Loc.End.Column-1 < end {
+		end = e.Loc.End.Column - 1
+	}
+	width := end - start
+	if width < 1 {
+		width = 1
+	}
+
+	// Keep tabs in the padding so the carets line up with the source.
+	var pad strings.Builder
+	for _, r := range runes[:start] {
+		if r == '\t' {
+			pad.WriteRune('\t')
+		} else {
+			pad.WriteRune(' ')
+		}
+	}
+
+	num := fmt.Sprintf("%d", e.Loc.Start.Line)
+	gutter := strings.Repeat(" ", len(num))
+	return fmt.Sprintf("%s |\n%s | %s\n%s | %s%s",
+		gutter,
+		num, line,
+		gutter, pad.String(), strings.Repeat("^", width),
+	)
+}
+
+// AnnotateError adds the source text of the package to a type error
+// so that its message shows the line where the error occurred.
+// Errors that are not type errors, or whose source cannot be found,
+// are returned unchanged.
+func AnnotateError(err error, pkg *ast.Package) error {
+	te, ok := err.(*TypeError)
+	if !ok || te.Source != "" || pkg == nil {
+		return err
+	}
+	for _, f := range pkg.Files {
+		if f.Loc == nil || f.Loc.Source == "" || f.Loc.File != te.Loc.File {
+			continue
+		}
+		// The file location starts at its first statement, so restore the
+		// leading lines and columns that were trimmed from the source.
+		annotated := *te
+		annotated.Source = strings.Repeat("\n", f.Loc.Start.Line-1) +
+			strings.Repeat(" ", f.Loc.Start.Column-1) +
+			f.Loc.Source
+		return &annotated
+	}
+	return err
+}
@@ -0,0 +1,34 @@
+package semantic_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/flux/parser"
+	"github.com/influxdata/flux/semantic"
+)
+
+func TestAnnotateError(t *testing.T) {
+	// The leading comment and blank lines are not part of the file source
+	// so the annotated error must still point at the right line.
+	pkg := parser.ParseSource(`
+// leading comment
+
+x = 1
+y = if x then 1 else 2
+`)
+	semPkg, err := semantic.New(pkg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = semantic.InferTypes(semPkg, nil)
+	if _, ok := err.(*semantic.TypeError); !ok {
+		t.Fatalf("expected *semantic.TypeError, got %T: %v", err, err)
+	}
+	want := `type error 5:8-5:9: int != bool
+  |
+5 | y = if x then 1 else 2
+  |        ^`
+	if got := semantic.AnnotateError(err, pkg).Error(); got != want {
+		t.Errorf("unexpected error -want/+got:\n\t- %q\n\t+ %q", want, got)
+	}
+}
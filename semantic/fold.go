@@ -0,0 +1,169 @@
+package semantic
+
+import "github.com/influxdata/flux/ast"
+
+// FoldConstants replaces each arithmetic expression within the graph
+// whose operands are literals with a literal of its value.
+// Integer, unsigned integer, float and duration arithmetic and string concatenation are folded.
+// Expressions that reference identifiers, call functions, divide by zero
+// or mix operand types are left for the interpreter and type inference.
+func FoldConstants(n Node) {
+	Walk(new(foldVisitor), n)
+}
+
+// foldVisitor folds the expressions of each node after its children have been folded,
+// so nested constant expressions are folded from the innermost outwards.
+type foldVisitor struct{}
+
+func (v *foldVisitor) Visit(node Node) Visitor {
+	return v
+}
+
+func (v *foldVisitor) Done(node Node) {
+	switch n := node.(type) {
+	case *ExpressionStatement:
+		n.Expression = fold(n.Expression)
+	case *ReturnStatement:
+		n.Argument = fold(n.Argument)
+	case *NativeVariableAssignment:
+		n.Init = fold(n.Init)
+	case *MemberAssignment:
+		n.Init = fold(n.Init)
+	case *DestructuringAssignment:
+		n.Init = fold(n.Init)
+	case *FunctionBlock:
+		if e, ok := n.Body.(Expression); ok {
+			n.Body = fold(e)
+		}
+	case *ArrayExpression:
+		for i, e := range n.Elements {
+			n.Elements[i] = fold(e)
+		}
+	case *Property:
+		n.Value = fold(n.Value)
+	case *InterpolatedPart:
+		n.Expression = fold(n.Expression)
+	case *UnaryExpression:
+		n.Argument = fold(n.Argument)
+	case *BinaryExpression:
+		n.Left = fold(n.Left)
+		n.Right = fold(n.Right)
+	case *LogicalExpression:
+		n.Left = fold(n.Left)
+		n.Right = fold(n.Right)
+	case *ConditionalExpression:
+		n.Test = fold(n.Test)
+		n.Consequent = fold(n.Consequent)
+		n.Alternate = fold(n.Alternate)
+	case *CallExpression:
+		if n.Pipe != nil {
+			n.Pipe = fold(n.Pipe)
+		}
+	case *MemberExpression:
+		n.Object = fold(n.Object)
+	case *IndexExpression:
+		n.Array = fold(n.Array)
+		n.Index = fold(n.Index)
+	}
+}
+
+// fold returns the literal value of e if it is a constant arithmetic expression, otherwise e.
+func fold(e Expression) Expression {
+	switch e := e.(type) {
+	case *UnaryExpression:
+		if e.Operator != ast.SubtractionOperator {
+			return e
+		}
+		switch a := e.Argument.(type) {
+		case *IntegerLiteral:
+			return &IntegerLiteral{loc: e.loc, Value: -a.Value}
+		case *FloatLiteral:
+			return &FloatLiteral{loc: e.loc, Value: -a.Value}
+		case *DurationLiteral:
+			return &DurationLiteral{loc: e.loc, Value: -a.Value}
+		}
+	case *BinaryExpression:
+		if lit := foldBinary(e); lit != nil {
+			return lit
+		}
+	}
+	return e
+}
+
+// foldBinary returns the literal value of a binary expression of two literals of the same type,
+// or nil if the expression cannot be folded.
+func foldBinary(e *BinaryExpression) Expression {
+	switch l := e.Left.(type) {
+	case *IntegerLiteral:
+		r, ok := e.Right.(*IntegerLiteral)
+		if !ok {
+			return nil
+		}
+		switch e.Operator {
+		case ast.AdditionOperator:
+			return &IntegerLiteral{loc: e.loc, Value: l.Value + r.Value}
+		case ast.SubtractionOperator:
+			return &IntegerLiteral{loc: e.loc, Value: l.Value - r.Value}
+		case ast.MultiplicationOperator:
+			return &IntegerLiteral{loc: e.loc, Value: l.Value * r.Value}
+		case ast.DivisionOperator:
+			if r.Value != 0 {
+				return &IntegerLiteral{loc: e.loc, Value: l.Value / r.Value}
+			}
+		}
+	case *UnsignedIntegerLiteral:
+		r, ok := e.Right.(*UnsignedIntegerLiteral)
+		if !ok {
+			return nil
+		}
+		switch e.Operator {
+		case ast.AdditionOperator:
+			return &UnsignedIntegerLiteral{loc: e.loc, Value: l.Value + r.Value}
+		case ast.SubtractionOperator:
+			return &UnsignedIntegerLiteral{loc: e.loc, Value: l.Value - r.Value}
+		case ast.MultiplicationOperator:
+			return &UnsignedIntegerLiteral{loc: e.loc, Value: l.Value * r.Value}
+		case ast.DivisionOperator:
+			if r.Value != 0 {
+				return &UnsignedIntegerLiteral{loc: e.loc, Value: l.Value / r.Value}
+			}
+		}
+	case *FloatLiteral:
+		r, ok := e.Right.(*FloatLiteral)
+		if !ok {
+			return nil
+		}
+		switch e.Operator {
+		case ast.AdditionOperator:
+			return &FloatLiteral{loc: e.loc, Value: l.Value + r.Value}
+		case ast.SubtractionOperator:
+			return &FloatLiteral{loc: e.loc, Value: l.Value - r.Value}
+		case ast.MultiplicationOperator:
+			return &FloatLiteral{loc: e.loc, Value: l.Value * r.Value}
+		case ast.DivisionOperator:
+			if r.Value != 0 {
+				return &FloatLiteral{loc: e.loc, Value: l.Value / r.Value}
+			}
+		}
+	case *DurationLiteral:
+		r, ok := e.Right.(*DurationLiteral)
+		if !ok {
+			return nil
+		}
+		switch e.Operator {
+		case ast.AdditionOperator:
+			return &DurationLiteral{loc: e.loc, Value: l.Value + r.Value}
+		case ast.SubtractionOperator:
+			return &DurationLiteral{loc: e.loc, Value: l.Value - r.Value}
+		}
+	case *StringLiteral:
+		r, ok := e.Right.(*StringLiteral)
+		if !ok {
+			return nil
+		}
+		if e.Operator == ast.AdditionOperator {
+			return &StringLiteral{loc: e.loc, Value: l.Value + r.Value}
+		}
+	}
+	return nil
+}
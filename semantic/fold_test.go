@@ -0,0 +1,132 @@
+package semantic_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/parser"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/semantic/semantictest"
+)
+
+func TestFoldConstants(t *testing.T) {
+	testCases := []struct {
+		name string
+		expr string
+		want semantic.Expression
+	}{
+		{
+			name: "integer arithmetic",
+			expr: `1 + 2 * 3`,
+			want: &semantic.IntegerLiteral{Value: 7},
+		},
+		{
+			name: "conversion",
+			expr: `uint(v: 1) + 2`,
+			want: &semantic.BinaryExpression{
+				Operator: ast.AdditionOperator,
+				Left: &semantic.CallExpression{
+					Callee: &semantic.IdentifierExpression{Name: "uint"},
+					Arguments: &semantic.ObjectExpression{
+						Properties: []*semantic.Property{{
+							Key:   &semantic.Identifier{Name: "v"},
+							Value: &semantic.IntegerLiteral{Value: 1},
+						}},
+					},
+				},
+				Right: &semantic.IntegerLiteral{Value: 2},
+			},
+		},
+		{
+			name: "float arithmetic",
+			expr: `(1.5 - 0.5) / 4.0`,
+			want: &semantic.FloatLiteral{Value: 0.25},
+		},
+		{
+			name: "negation",
+			expr: `-(2 - 3)`,
+			want: &semantic.IntegerLiteral{Value: 1},
+		},
+		{
+			name: "duration arithmetic",
+			expr: `2h + 30m`,
+			want: &semantic.DurationLiteral{Value: 150 * time.Minute},
+		},
+		{
+			name: "string concatenation",
+			expr: `"a" + "b" + "c"`,
+			want: &semantic.StringLiteral{Value: "abc"},
+		},
+		{
+			name: "nested in a call",
+			expr: `f(v: 60 * 60)`,
+			want: &semantic.CallExpression{
+				Callee: &semantic.IdentifierExpression{Name: "f"},
+				Arguments: &semantic.ObjectExpression{
+					Properties: []*semantic.Property{{
+						Key:   &semantic.Identifier{Name: "v"},
+						Value: &semantic.IntegerLiteral{Value: 3600},
+					}},
+				},
+			},
+		},
+		{
+			name: "variable",
+			expr: `x + 1 * 2`,
+			want: &semantic.BinaryExpression{
+				Operator: ast.AdditionOperator,
+				Left:     &semantic.IdentifierExpression{Name: "x"},
+				Right:    &semantic.IntegerLiteral{Value: 2},
+			},
+		},
+		{
+			name: "now",
+			expr: `now() + 1h`,
+			want: &semantic.BinaryExpression{
+				Operator: ast.AdditionOperator,
+				Left: &semantic.CallExpression{
+					Callee:    &semantic.IdentifierExpression{Name: "now"},
+					Arguments: &semantic.ObjectExpression{},
+				},
+				Right: &semantic.DurationLiteral{Value: time.Hour},
+			},
+		},
+		{
+			name: "mixed types",
+			expr: `1 + 2.0`,
+			want: &semantic.BinaryExpression{
+				Operator: ast.AdditionOperator,
+				Left:     &semantic.IntegerLiteral{Value: 1},
+				Right:    &semantic.FloatLiteral{Value: 2.0},
+			},
+		},
+		{
+			name: "division by zero",
+			expr: `1 / 0`,
+			want: &semantic.BinaryExpression{
+				Operator: ast.DivisionOperator,
+				Left:     &semantic.IntegerLiteral{Value: 1},
+				Right:    &semantic.IntegerLiteral{Value: 0},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pkg := parser.ParseSource(tc.expr)
+			if ast.Check(pkg) > 0 {
+				t.Fatal(ast.GetError(pkg))
+			}
+			node, err := semantic.New(pkg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := node.Files[0].Body[0].(*semantic.ExpressionStatement).Expression
+			if !cmp.Equal(tc.want, got, semantictest.CmpOptions...) {
+				t.Errorf("unexpected expression -want/+got:\n%s", cmp.Diff(tc.want, got, semantictest.CmpOptions...))
+			}
+		})
+	}
+}
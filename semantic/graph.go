@@ -40,6 +40,7 @@ func (*ReturnStatement) node()            {}
 func (*MemberAssignment) node()           {}
 func (*NativeVariableAssignment) node()   {}
 func (*ExternalVariableAssignment) node() {}
+func (*DestructuringAssignment) node()    {}
 
 func (*ArrayExpression) node()       {}
 func (*FunctionExpression) node()    {}
@@ -51,11 +52,15 @@ func (*LogicalExpression) node()     {}
 func (*MemberExpression) node()      {}
 func (*IndexExpression) node()       {}
 func (*ObjectExpression) node()      {}
+func (*StringExpression) node()      {}
 func (*UnaryExpression) node()       {}
 
 func (*Identifier) node() {}
 func (*Property) node()   {}
 
+func (*TextPart) node()         {}
+func (*InterpolatedPart) node() {}
+
 func (*FunctionParameters) node() {}
 func (*FunctionParameter) node()  {}
 func (*FunctionBlock) node()      {}
@@ -81,6 +86,7 @@ func (*ExpressionStatement) stmt()      {}
 func (*ReturnStatement) stmt()          {}
 func (*NativeVariableAssignment) stmt() {}
 func (*MemberAssignment) stmt()         {}
+func (*DestructuringAssignment) stmt()  {}
 
 type Assignment interface {
 	Statement
@@ -111,6 +117,7 @@ func (*MemberExpression) expression()       {}
 func (*IndexExpression) expression()        {}
 func (*ObjectExpression) expression()       {}
 func (*RegexpLiteral) expression()          {}
+func (*StringExpression) expression()       {}
 func (*StringLiteral) expression()          {}
 func (*UnaryExpression) expression()        {}
 func (*UnsignedIntegerLiteral) expression() {}
@@ -416,6 +423,43 @@ func (s *MemberAssignment) Copy() Node {
 	return ns
 }
 
+// DestructuringAssignment binds each of its identifiers to a part of the value of Init.
+// When it has no keys, the identifiers are bound to the elements of an array in order.
+// Otherwise each identifier is bound to the property of an object named by the key at the same index.
+type DestructuringAssignment struct {
+	loc `json:"-"`
+
+	Identifiers []*Identifier `json:"identifiers"`
+	Keys        []string      `json:"keys,omitempty"`
+	Init        Expression    `json:"init"`
+}
+
+func (*DestructuringAssignment) NodeType() string { return "DestructuringAssignment" }
+
+func (s *DestructuringAssignment) Copy() Node {
+	if s == nil {
+		return s
+	}
+	ns := new(DestructuringAssignment)
+	*ns = *s
+
+	if len(s.Identifiers) > 0 {
+		ns.Identifiers = make([]*Identifier, len(s.Identifiers))
+		for i, id := range s.Identifiers {
+			ns.Identifiers[i] = id.Copy().(*Identifier)
+		}
+	}
+	if len(s.Keys) > 0 {
+		ns.Keys = make([]string, len(s.Keys))
+		copy(ns.Keys, s.Keys)
+	}
+	if s.Init != nil {
+		ns.Init = s.Init.Copy().(Expression)
+	}
+
+	return ns
+}
+
 // Extern is a node that represents a node with a set of external assignments defined.
 type Extern struct {
 	loc `json:"-"`
@@ -685,6 +729,77 @@ func (e *ConditionalExpression) Copy() Node {
 	return ne
 }
 
+type StringExpression struct {
+	loc `json:"-"`
+
+	Parts []StringExpressionPart `json:"parts"`
+}
+
+func (*StringExpression) NodeType() string { return "StringExpression" }
+
+func (e *StringExpression) Copy() Node {
+	if e == nil {
+		return e
+	}
+	ne := new(StringExpression)
+	*ne = *e
+
+	if len(e.Parts) > 0 {
+		ne.Parts = make([]StringExpressionPart, len(e.Parts))
+		for i, p := range e.Parts {
+			ne.Parts[i] = p.Copy().(StringExpressionPart)
+		}
+	}
+
+	return ne
+}
+
+type StringExpressionPart interface {
+	Node
+	stringPart()
+}
+
+func (*TextPart) stringPart()         {}
+func (*InterpolatedPart) stringPart() {}
+
+type TextPart struct {
+	loc `json:"-"`
+
+	Value string `json:"value"`
+}
+
+func (*TextPart) NodeType() string { return "TextPart" }
+
+func (p *TextPart) Copy() Node {
+	if p == nil {
+		return p
+	}
+	np := new(TextPart)
+	*np = *p
+
+	return np
+}
+
+type InterpolatedPart struct {
+	loc `json:"-"`
+
+	Expression Expression `json:"expression"`
+}
+
+func (*InterpolatedPart) NodeType() string { return "InterpolatedPart" }
+
+func (p *InterpolatedPart) Copy() Node {
+	if p == nil {
+		return p
+	}
+	np := new(InterpolatedPart)
+	*np = *p
+
+	np.Expression = p.Expression.Copy().(Expression)
+
+	return np
+}
+
 type LogicalExpression struct {
 	loc `json:"-"`
 
@@ -752,7 +867,8 @@ func (e *IndexExpression) Copy() Node {
 type ObjectExpression struct {
 	loc `json:"-"`
 
-	Properties []*Property `json:"properties"`
+	With       *IdentifierExpression `json:"with,omitempty"`
+	Properties []*Property           `json:"properties"`
 }
 
 func (*ObjectExpression) NodeType() string { return "ObjectExpression" }
@@ -764,6 +880,10 @@ func (e *ObjectExpression) Copy() Node {
 	ne := new(ObjectExpression)
 	*ne = *e
 
+	if e.With != nil {
+		ne.With = e.With.Copy().(*IdentifierExpression)
+	}
+
 	if len(e.Properties) > 0 {
 		ne.Properties = make([]*Property, len(e.Properties))
 		for i, prop := range e.Properties {
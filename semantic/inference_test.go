@@ -1671,6 +1671,19 @@ foo.b
 			script:  `if 1 then 0.1 else 0.0`,
 			wantErr: errors.New(`type error 1:4-1:5: int != bool`),
 		},
+		{
+			name: "object with missing property",
+			script: `
+f = (r) => ({r with c: r.a + r.b})
+f(r: {a: 1})
+`,
+			wantErr: errors.New(`type error 3:1-3:13: missing object properties (b)`),
+		},
+		{
+			name:    "default referencing a later parameter",
+			script:  `f = (a=b, b) => a + b`,
+			wantErr: errors.New(`type error 1:8-1:9: default value refers to parameter "b" declared after it`),
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -1818,3 +1831,191 @@ func (imp importer) Import(path string) (semantic.PackageType, bool) {
 	pkg, ok := imp.packages[path]
 	return pkg, ok
 }
+
+func TestInferTypes_ObjectWith(t *testing.T) {
+	pkg := parser.ParseSource(`
+f = (r) => ({r with c: r.a + r.b})
+f(r: {a: 1, b: 2})
+f(r: {a: 1.0, b: 2.0, d: "x"})
+o = {a: 1, b: 2}
+{o with b: "two"}
+`)
+	if ast.Check(pkg) > 0 {
+		t.Fatal(ast.GetError(pkg))
+	}
+	node, err := semantic.New(pkg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts, err := semantic.InferTypes(node, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	types := semantic.CreateTypeMap(node, ts)
+
+	want := map[int]semantic.Type{
+		3: semantic.NewObjectType(map[string]semantic.Type{
+			"a": semantic.Int,
+			"b": semantic.Int,
+			"c": semantic.Int,
+		}),
+		4: semantic.NewObjectType(map[string]semantic.Type{
+			"a": semantic.Float,
+			"b": semantic.Float,
+			"c": semantic.Float,
+			"d": semantic.String,
+		}),
+		6: semantic.NewObjectType(map[string]semantic.Type{
+			"a": semantic.Int,
+			"b": semantic.String,
+		}),
+	}
+	for _, stmt := range node.Files[0].Body {
+		es, ok := stmt.(*semantic.ExpressionStatement)
+		if !ok {
+			continue
+		}
+		line := es.Location().Start.Line
+		if got, want := types.TypeOf(es.Expression), want[line]; got != want {
+			t.Errorf("unexpected type on line %d, want: %v got: %v", line, want, got)
+		}
+	}
+}
+
+func TestInferTypes_Destructuring(t *testing.T) {
+	pkg := parser.ParseSource(`
+[a, b] = [1, 2]
+{c, d: e} = {c: "c", d: 2.0, f: 1}
+a
+b
+c
+e
+`)
+	if ast.Check(pkg) > 0 {
+		t.Fatal(ast.GetError(pkg))
+	}
+	node, err := semantic.New(pkg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts, err := semantic.InferTypes(node, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	types := semantic.CreateTypeMap(node, ts)
+
+	want := map[int]semantic.Type{
+		4: semantic.Int,
+		5: semantic.Int,
+		6: semantic.String,
+		7: semantic.Float,
+	}
+	for _, stmt := range node.Files[0].Body {
+		es, ok := stmt.(*semantic.ExpressionStatement)
+		if !ok {
+			continue
+		}
+		line := es.Location().Start.Line
+		if got, want := types.TypeOf(es.Expression), want[line]; got != want {
+			t.Errorf("unexpected type on line %d, want: %v got: %v", line, want, got)
+		}
+	}
+}
+
+func TestInferTypes_DestructuringMissingProperty(t *testing.T) {
+	pkg := parser.ParseSource(`{a, b} = {a: 1}`)
+	if ast.Check(pkg) > 0 {
+		t.Fatal(ast.GetError(pkg))
+	}
+	node, err := semantic.New(pkg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := semantic.InferTypes(node, nil); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestInferTypes_ExternNestedObject(t *testing.T) {
+	pkg := parser.ParseSource(`f(pairs: [{key: "a", value: 1}])`)
+	if ast.Check(pkg) > 0 {
+		t.Fatal(ast.GetError(pkg))
+	}
+	file, err := semantic.New(pkg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pairs := semantic.NewArrayPolyType(semantic.NewObjectPolyType(
+		map[string]semantic.PolyType{
+			"key":   semantic.Tvar(1),
+			"value": semantic.Tvar(2),
+		},
+		semantic.LabelSet{"key", "value"},
+		semantic.LabelSet{"key", "value"},
+	))
+	node := &semantic.Extern{
+		Assignments: []*semantic.ExternalVariableAssignment{{
+			Identifier: &semantic.Identifier{Name: "f"},
+			ExternType: semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+				Parameters: map[string]semantic.PolyType{"pairs": pairs},
+				Required:   semantic.LabelSet{"pairs"},
+				Return:     pairs,
+			}),
+		}},
+		Block: &semantic.ExternBlock{Node: file},
+	}
+	ts, err := semantic.InferTypes(node, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	types := semantic.CreateTypeMap(node, ts)
+
+	want := semantic.NewArrayType(semantic.NewObjectType(map[string]semantic.Type{
+		"key":   semantic.String,
+		"value": semantic.Int,
+	}))
+	semantic.Walk(semantic.CreateVisitor(func(node semantic.Node) {
+		switch e := node.(type) {
+		case *semantic.CallExpression, *semantic.ArrayExpression:
+			if got := types.TypeOf(e.(semantic.Expression)); got != want {
+				t.Errorf("unexpected type for node %T, want: %v got: %v", e, want, got)
+			}
+		}
+	}), node)
+}
+
+func TestCreateTypeMap(t *testing.T) {
+	pkg := parser.ParseSource(`a = 1
+x = a + 2`)
+	if ast.Check(pkg) > 0 {
+		t.Fatal(ast.GetError(pkg))
+	}
+	node, err := semantic.New(pkg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts, err := semantic.InferTypes(node, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	types := semantic.CreateTypeMap(node, ts)
+
+	var exprs []semantic.Expression
+	semantic.Walk(semantic.CreateVisitor(func(node semantic.Node) {
+		switch node.(type) {
+		case *semantic.BinaryExpression, *semantic.IntegerLiteral:
+			exprs = append(exprs, node.(semantic.Expression))
+		}
+	}), node)
+	if got, want := len(exprs), 3; got != want {
+		t.Fatalf("unexpected number of expressions want: %d got: %d", want, got)
+	}
+	for _, e := range exprs {
+		if got := types.TypeOf(e); got != semantic.Int {
+			t.Errorf("unexpected type for node %T@%v, want: %v got: %v", e, e.Location(), semantic.Int, got)
+		}
+	}
+	if got, want := len(types), 4; got != want {
+		t.Errorf("unexpected type map length want: %d got: %d\n%v", want, got, types)
+	}
+}
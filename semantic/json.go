@@ -299,6 +299,37 @@ func (s *MemberAssignment) UnmarshalJSON(data []byte) error {
 
 	return nil
 }
+func (d *DestructuringAssignment) MarshalJSON() ([]byte, error) {
+	type Alias DestructuringAssignment
+	raw := struct {
+		Type string `json:"type"`
+		*Alias
+	}{
+		Type:  d.NodeType(),
+		Alias: (*Alias)(d),
+	}
+	return json.Marshal(raw)
+}
+func (d *DestructuringAssignment) UnmarshalJSON(data []byte) error {
+	type Alias DestructuringAssignment
+	raw := struct {
+		*Alias
+		Init json.RawMessage `json:"init"`
+	}{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if raw.Alias != nil {
+		*d = *(*DestructuringAssignment)(raw.Alias)
+	}
+
+	e, err := unmarshalExpression(raw.Init)
+	if err != nil {
+		return err
+	}
+	d.Init = e
+	return nil
+}
 func (d *NativeVariableAssignment) MarshalJSON() ([]byte, error) {
 	type Alias NativeVariableAssignment
 	raw := struct {
@@ -653,6 +684,84 @@ func (e *ObjectExpression) MarshalJSON() ([]byte, error) {
 	}
 	return json.Marshal(raw)
 }
+func (e *StringExpression) MarshalJSON() ([]byte, error) {
+	type Alias StringExpression
+	raw := struct {
+		Type string `json:"type"`
+		*Alias
+	}{
+		Type:  e.NodeType(),
+		Alias: (*Alias)(e),
+	}
+	return json.Marshal(raw)
+}
+func (e *StringExpression) UnmarshalJSON(data []byte) error {
+	type Alias StringExpression
+	raw := struct {
+		*Alias
+		Parts []json.RawMessage `json:"parts"`
+	}{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if raw.Alias != nil {
+		*e = *(*StringExpression)(raw.Alias)
+	}
+
+	if raw.Parts != nil {
+		e.Parts = make([]StringExpressionPart, len(raw.Parts))
+		for i, r := range raw.Parts {
+			part, err := unmarshalStringExpressionPart(r)
+			if err != nil {
+				return err
+			}
+			e.Parts[i] = part
+		}
+	}
+	return nil
+}
+func (p *TextPart) MarshalJSON() ([]byte, error) {
+	type Alias TextPart
+	raw := struct {
+		Type string `json:"type"`
+		*Alias
+	}{
+		Type:  p.NodeType(),
+		Alias: (*Alias)(p),
+	}
+	return json.Marshal(raw)
+}
+func (p *InterpolatedPart) MarshalJSON() ([]byte, error) {
+	type Alias InterpolatedPart
+	raw := struct {
+		Type string `json:"type"`
+		*Alias
+	}{
+		Type:  p.NodeType(),
+		Alias: (*Alias)(p),
+	}
+	return json.Marshal(raw)
+}
+func (p *InterpolatedPart) UnmarshalJSON(data []byte) error {
+	type Alias InterpolatedPart
+	raw := struct {
+		*Alias
+		Expression json.RawMessage `json:"expression"`
+	}{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if raw.Alias != nil {
+		*p = *(*InterpolatedPart)(raw.Alias)
+	}
+
+	expr, err := unmarshalExpression(raw.Expression)
+	if err != nil {
+		return err
+	}
+	p.Expression = expr
+	return nil
+}
 func (e *ConditionalExpression) MarshalJSON() ([]byte, error) {
 	type Alias ConditionalExpression
 	raw := struct {
@@ -980,6 +1089,20 @@ func unmarshalExpression(msg json.RawMessage) (Expression, error) {
 	}
 	return e, nil
 }
+func unmarshalStringExpressionPart(msg json.RawMessage) (StringExpressionPart, error) {
+	if checkNullMsg(msg) {
+		return nil, nil
+	}
+	n, err := unmarshalNode(msg)
+	if err != nil {
+		return nil, err
+	}
+	p, ok := n.(StringExpressionPart)
+	if !ok {
+		return nil, fmt.Errorf("node %q is not a string expression part", n.NodeType())
+	}
+	return p, nil
+}
 func unmarshalPropertyKey(msg json.RawMessage) (PropertyKey, error) {
 	if checkNullMsg(msg) {
 		return nil, nil
@@ -1048,6 +1171,8 @@ func unmarshalNode(msg json.RawMessage) (Node, error) {
 		node = new(NativeVariableAssignment)
 	case "MemberAssignment":
 		node = new(MemberAssignment)
+	case "DestructuringAssignment":
+		node = new(DestructuringAssignment)
 	case "CallExpression":
 		node = new(CallExpression)
 	case "MemberExpression":
@@ -1070,6 +1195,12 @@ func unmarshalNode(msg json.RawMessage) (Node, error) {
 		node = new(Identifier)
 	case "IdentifierExpression":
 		node = new(IdentifierExpression)
+	case "StringExpression":
+		node = new(StringExpression)
+	case "TextPart":
+		node = new(TextPart)
+	case "InterpolatedPart":
+		node = new(InterpolatedPart)
 	case "StringLiteral":
 		node = new(StringLiteral)
 	case "BooleanLiteral":
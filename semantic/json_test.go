@@ -112,6 +112,18 @@ func TestJSONMarshal(t *testing.T) {
 			},
 			want: `{"type":"OptionStatement","assignment":{"type":"MemberAssignment","member":{"type":"MemberExpression","object":{"type":"IdentifierExpression","name":"alert"},"property":"state"},"init":{"type":"StringLiteral","value":"Warning"}}}`,
 		},
+		{
+			name: "destructuring assignment",
+			node: &semantic.DestructuringAssignment{
+				Identifiers: []*semantic.Identifier{
+					{Name: "a"},
+					{Name: "c"},
+				},
+				Keys: []string{"a", "b"},
+				Init: &semantic.IdentifierExpression{Name: "r"},
+			},
+			want: `{"type":"DestructuringAssignment","identifiers":[{"type":"Identifier","name":"a"},{"type":"Identifier","name":"c"}],"keys":["a","b"],"init":{"type":"IdentifierExpression","name":"r"}}`,
+		},
 		{
 			name: "test statement",
 			node: &semantic.TestStatement{
@@ -29,17 +29,25 @@ func CreatePackage(n Node, importer Importer) (PackageType, error) {
 	types := make(map[string]PolyType, len(v.body))
 	names := make(LabelSet, 0, len(v.body))
 	for _, s := range v.body {
-		assignment, ok := s.(*NativeVariableAssignment)
-		if !ok {
-			continue
+		switch assignment := s.(type) {
+		case *NativeVariableAssignment:
+			typ, err := ts.PolyTypeOf(assignment.Init)
+			if err != nil {
+				return PackageType{}, err
+			}
+			name := assignment.Identifier.Name
+			types[name] = typ
+			names = append(names, name)
+		case *DestructuringAssignment:
+			for _, id := range assignment.Identifiers {
+				typ, err := ts.PolyTypeOf(id)
+				if err != nil {
+					return PackageType{}, err
+				}
+				types[id.Name] = typ
+				names = append(names, id.Name)
+			}
 		}
-		typ, err := ts.PolyTypeOf(assignment.Init)
-		if err != nil {
-			return PackageType{}, err
-		}
-		name := assignment.Identifier.Name
-		types[name] = typ
-		names = append(names, name)
 	}
 	return PackageType{
 		Name: v.name,
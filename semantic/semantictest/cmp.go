@@ -24,6 +24,7 @@ var CmpOptions = []cmp.Option{
 	cmpopts.IgnoreUnexported(semantic.ReturnStatement{}),
 	cmpopts.IgnoreUnexported(semantic.NativeVariableAssignment{}),
 	cmpopts.IgnoreUnexported(semantic.MemberAssignment{}),
+	cmpopts.IgnoreUnexported(semantic.DestructuringAssignment{}),
 	cmpopts.IgnoreUnexported(semantic.Extern{}),
 	cmpopts.IgnoreUnexported(semantic.ExternalVariableAssignment{}),
 	cmpopts.IgnoreUnexported(semantic.ArrayExpression{}),
@@ -38,6 +39,9 @@ var CmpOptions = []cmp.Option{
 	cmpopts.IgnoreUnexported(semantic.MemberExpression{}),
 	cmpopts.IgnoreUnexported(semantic.IndexExpression{}),
 	cmpopts.IgnoreUnexported(semantic.ObjectExpression{}),
+	cmpopts.IgnoreUnexported(semantic.StringExpression{}),
+	cmpopts.IgnoreUnexported(semantic.TextPart{}),
+	cmpopts.IgnoreUnexported(semantic.InterpolatedPart{}),
 	cmpopts.IgnoreUnexported(semantic.UnaryExpression{}),
 	cmpopts.IgnoreUnexported(semantic.Property{}),
 	cmpopts.IgnoreUnexported(semantic.IdentifierExpression{}),
@@ -83,11 +83,24 @@ func (sol *Solution) solve() error {
 		r := subst.ApplyType(tc.r)
 		s, err := unifyTypes(kinds, l, r)
 		if err != nil {
-			return errors.Wrapf(err, "type error %v", tc.loc)
+			return &TypeError{Loc: tc.loc, Err: err}
 		}
 		subst.Merge(s)
 	}
 
+	// Propagate properties between objects and the objects they extend.
+	// Each pass propagates properties across every with constraint once,
+	// so a chain of extended objects needs at most one pass per constraint.
+	for range sol.cs.withConst {
+		for _, wc := range sol.cs.withConst {
+			s, err := unifyWith(kinds, subst, wc)
+			if err != nil {
+				return &TypeError{Loc: wc.loc, Err: err}
+			}
+			subst.Merge(s)
+		}
+	}
+
 	// Apply substituion to kind constraints
 	sol.kinds = make(map[Tvar]Kind, len(kinds))
 	for tv, k := range kinds {
@@ -161,10 +174,68 @@ func unifyKinds(kinds map[Tvar]Kind, tvl, tvr Tvar, l, r Kind) (Substitution, er
 	return s, nil
 }
 
+// unifyWith unifies the properties of the object r that are not set directly on r
+// with the properties of the object that r extends.
+func unifyWith(kinds map[Tvar]Kind, subst Substitution, wc WithConstraint) (Substitution, error) {
+	r := subst.ApplyTvar(wc.r)
+	with := subst.ApplyTvar(wc.with)
+	kr, okr := kinds[r].(ObjectKind)
+	kw, okw := kinds[with].(ObjectKind)
+	if !okr || !okw {
+		return nil, nil
+	}
+	kr = subst.ApplyKind(kr).(ObjectKind)
+	kw = subst.ApplyKind(kw).(ObjectKind)
+
+	// Properties of r that come from the with object.
+	inherited := ObjectKind{
+		properties: make(map[string]PolyType, len(kr.properties)),
+		lower:      kr.lower.diff(wc.labels),
+		upper:      AllLabels(),
+	}
+	for l, t := range kr.properties {
+		if _, ok := t.(invalid); !ok && !wc.labels.contains(l) {
+			inherited.properties[l] = t
+		}
+	}
+	// Properties of the with object that are not replaced by r.
+	extended := ObjectKind{
+		properties: make(map[string]PolyType, len(kw.properties)),
+		lower:      kw.lower.diff(wc.labels),
+		upper:      kw.upper.union(wc.labels),
+	}
+	for l, t := range kw.properties {
+		if _, ok := t.(invalid); !ok && !wc.labels.contains(l) {
+			extended.properties[l] = t
+		}
+	}
+
+	subst = make(Substitution)
+	s, err := unifyKinds(kinds, with, with, kw, inherited)
+	if err != nil {
+		return nil, err
+	}
+	subst.Merge(s)
+	s, err = unifyKinds(kinds, r, r, kr, extended)
+	if err != nil {
+		return nil, err
+	}
+	subst.Merge(s)
+	return subst, nil
+}
+
 func unifyVarAndType(kinds map[Tvar]Kind, tv Tvar, t PolyType) (Substitution, error) {
 	if t.occurs(tv) {
 		return nil, fmt.Errorf("type var %v occurs in %v creating a cycle", tv, t)
 	}
+	// An object type, such as one nested in the signature of a builtin,
+	// must be merged into the record kind of the type variable
+	// so that the types of the common properties are unified.
+	if o, ok := t.(object); ok {
+		if k, ok := kinds[tv].(ObjectKind); ok {
+			return unifyKinds(kinds, tv, tv, k, o.krecord)
+		}
+	}
 	unifyKindsByType(kinds, tv, t)
 	return Substitution{tv: t}, nil
 }
@@ -251,6 +322,48 @@ func (s SolutionMap) String() string {
 	return builder.String()
 }
 
+// TypeMap represents a mapping of expressions to their inferred monotypes.
+type TypeMap map[Expression]Type
+
+// CreateTypeMap constructs a new type map from the expressions within the node and the type solution.
+// Expressions that do not have a monotype, such as polymorphic functions, are omitted.
+// Any type errors in the type solution are ignored.
+func CreateTypeMap(node Node, sol TypeSolution) TypeMap {
+	typeMap := make(TypeMap)
+	Walk(CreateVisitor(func(node Node) {
+		e, ok := node.(Expression)
+		if !ok {
+			return
+		}
+		t, _ := sol.TypeOf(e)
+		if t != nil {
+			typeMap[e] = t
+		}
+	}), node)
+	return typeMap
+}
+
+// TypeOf reports the inferred monotype of the expression or nil if it is not known.
+func (m TypeMap) TypeOf(e Expression) Type {
+	return m[e]
+}
+
+func (m TypeMap) String() string {
+	var builder strings.Builder
+	builder.WriteString("{\n")
+	nodes := make([]Node, 0, len(m))
+	for e := range m {
+		nodes = append(nodes, e)
+	}
+	SortNodes(nodes)
+	for _, n := range nodes {
+		t := m[n.(Expression)]
+		fmt.Fprintf(&builder, "%T@%v: %v\n", n, n.Location(), t)
+	}
+	builder.WriteString("}")
+	return builder.String()
+}
+
 // SortNodes sorts a list of nodes by their source locations.
 func SortNodes(nodes []Node) {
 	sort.Slice(nodes, func(i, j int) bool {
@@ -53,10 +53,12 @@ func (l Substitution) Merge(r Substitution) {
 	for tvL, tL := range l {
 		l[r.ApplyTvar(tvL)] = r.ApplyType(tL)
 	}
-	// Add missing keys from r to l
+	// Add missing keys from r to l.
+	// The types in r may refer to type variables that l already knows about,
+	// so apply l to them as well.
 	for tvR, tR := range r {
 		if _, ok := l[tvR]; !ok {
-			l[tvR] = tR
+			l[tvR] = l.ApplyType(tR)
 		}
 	}
 }
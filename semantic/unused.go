@@ -0,0 +1,134 @@
+package semantic
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/influxdata/flux/ast"
+)
+
+// Diagnostic is a warning about a node within a semantic graph.
+// Diagnostics do not prevent the graph from being evaluated.
+type Diagnostic struct {
+	Msg string
+	Loc ast.SourceLocation
+}
+
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%v: %s", d.Loc, d.Msg)
+}
+
+// UnusedBindings reports each variable declaration within the graph
+// whose identifier is never referenced within its scope.
+// The diagnostics are sorted by the location of the declared identifiers.
+//
+// Package level declarations are only reported for the main package,
+// since the declarations of any other package may be used by importers.
+// Options and tests are never reported.
+func UnusedBindings(n Node) []Diagnostic {
+	v := &unusedVisitor{
+		skip: make(map[*NativeVariableAssignment]bool),
+	}
+	if pkg, ok := n.(*Package); ok && pkg.Package != "main" {
+		v.exported = true
+	}
+	v.push()
+	Walk(v, n)
+
+	var diagnostics []Diagnostic
+	for _, b := range v.bindings {
+		if b.used {
+			continue
+		}
+		diagnostics = append(diagnostics, Diagnostic{
+			Msg: fmt.Sprintf("variable %q is declared but never used", b.id.Name),
+			Loc: b.id.Location(),
+		})
+	}
+	sort.SliceStable(diagnostics, func(i, j int) bool {
+		return diagnostics[i].Loc.Less(diagnostics[j].Loc)
+	})
+	return diagnostics
+}
+
+// binding is an identifier that has been declared within a scope.
+type binding struct {
+	// id is the declared identifier of a reported binding.
+	// It is nil for bindings that are never reported.
+	id   *Identifier
+	used bool
+}
+
+// unusedVisitor resolves each identifier to its binding
+// to determine which bindings are never referenced.
+type unusedVisitor struct {
+	scopes   []map[string]*binding
+	bindings []*binding
+	skip     map[*NativeVariableAssignment]bool
+	exported bool
+}
+
+func (v *unusedVisitor) push() {
+	v.scopes = append(v.scopes, make(map[string]*binding))
+}
+
+func (v *unusedVisitor) pop() {
+	v.scopes = v.scopes[:len(v.scopes)-1]
+}
+
+func (v *unusedVisitor) declare(name string, id *Identifier) {
+	b := &binding{id: id}
+	v.scopes[len(v.scopes)-1][name] = b
+	if id != nil {
+		v.bindings = append(v.bindings, b)
+	}
+}
+
+func (v *unusedVisitor) Visit(node Node) Visitor {
+	switch n := node.(type) {
+	case *ExternBlock, *Block, *FunctionBlock:
+		v.push()
+	case *OptionStatement:
+		if assign, ok := n.Assignment.(*NativeVariableAssignment); ok {
+			v.skip[assign] = true
+		}
+	case *TestStatement:
+		v.skip[n.Assignment] = true
+	case *ExternalVariableAssignment:
+		v.declare(n.Identifier.Name, nil)
+	case *FunctionParameter:
+		v.declare(n.Key.Name, nil)
+	case *IdentifierExpression:
+		for i := len(v.scopes) - 1; i >= 0; i-- {
+			if b, ok := v.scopes[i][n.Name]; ok {
+				b.used = true
+				break
+			}
+		}
+	}
+	return v
+}
+
+func (v *unusedVisitor) Done(node Node) {
+	switch n := node.(type) {
+	case *ExternBlock, *Block, *FunctionBlock:
+		v.pop()
+	case *NativeVariableAssignment:
+		// The binding is declared after its initializer has been visited
+		// so that any reference within the initializer resolves to an
+		// outer binding of the same name.
+		if v.skip[n] || (v.exported && len(v.scopes) == 1) {
+			v.declare(n.Identifier.Name, nil)
+			return
+		}
+		v.declare(n.Identifier.Name, n.Identifier)
+	case *DestructuringAssignment:
+		for _, id := range n.Identifiers {
+			if v.exported && len(v.scopes) == 1 {
+				v.declare(id.Name, nil)
+				continue
+			}
+			v.declare(id.Name, id)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package semantic_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/parser"
+	"github.com/influxdata/flux/semantic"
+)
+
+func TestUnusedBindings(t *testing.T) {
+	testCases := []struct {
+		name   string
+		script string
+		want   []string
+	}{
+		{
+			name: "used binding",
+			script: `
+x = 1
+x + 1
+`,
+		},
+		{
+			name: "unused binding",
+			script: `
+x = 1
+y = 2
+y + 1
+`,
+			want: []string{`2:1-2:2: variable "x" is declared but never used`},
+		},
+		{
+			name: "used in nested function",
+			script: `
+x = 1
+f = (a) => a + x
+f(a: 1)
+`,
+		},
+		{
+			name: "unused within function",
+			script: `
+f = () => {
+	x = 1
+	y = 2
+	return y
+}
+f()
+`,
+			want: []string{`3:2-3:3: variable "x" is declared but never used`},
+		},
+		{
+			name: "shadowed binding",
+			script: `
+x = 1
+f = (x) => x + 1
+f(x: 2)
+`,
+			want: []string{`2:1-2:2: variable "x" is declared but never used`},
+		},
+		{
+			name: "unused parameter",
+			script: `
+f = (a, b) => a
+f(a: 1, b: 2)
+`,
+		},
+		{
+			name: "options and tests",
+			script: `
+option now = () => 2018-05-22T19:53:00Z
+test t = () => ({input: 1, want: 1})
+`,
+		},
+		{
+			name: "unused destructured identifier",
+			script: `
+[x, y] = [1, 2]
+{a, b: c} = {a: 1, b: 2}
+y + c
+`,
+			want: []string{
+				`2:2-2:3: variable "x" is declared but never used`,
+				`3:2-3:3: variable "a" is declared but never used`,
+			},
+		},
+		{
+			name: "library package",
+			script: `
+package foo
+
+x = 1
+f = () => {
+	y = 1
+	return 2
+}
+`,
+			want: []string{`6:2-6:3: variable "y" is declared but never used`},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pkg := parser.ParseSource(tc.script)
+			if ast.Check(pkg) > 0 {
+				t.Fatal(ast.GetError(pkg))
+			}
+			node, err := semantic.New(pkg)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, d := range semantic.UnusedBindings(node) {
+				got = append(got, d.String())
+			}
+			if !cmp.Equal(tc.want, got) {
+				t.Errorf("unexpected diagnostics -want/+got:\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
@@ -156,6 +156,17 @@ func walk(v Visitor, n Node) {
 			walk(w, n.Member)
 			walk(w, n.Init)
 		}
+	case *DestructuringAssignment:
+		if n == nil {
+			return
+		}
+		w := v.Visit(n)
+		if w != nil {
+			for _, id := range n.Identifiers {
+				walk(w, id)
+			}
+			walk(w, n.Init)
+		}
 	case *ExternalVariableAssignment:
 		if n == nil {
 			return
@@ -243,6 +254,29 @@ func walk(v Visitor, n Node) {
 			walk(w, n.Alternate)
 			walk(w, n.Consequent)
 		}
+	case *StringExpression:
+		if n == nil {
+			return
+		}
+		w := v.Visit(n)
+		if w != nil {
+			for _, p := range n.Parts {
+				walk(w, p)
+			}
+		}
+	case *TextPart:
+		if n == nil {
+			return
+		}
+		v.Visit(n)
+	case *InterpolatedPart:
+		if n == nil {
+			return
+		}
+		w := v.Visit(n)
+		if w != nil {
+			walk(w, n.Expression)
+		}
 	case *IdentifierExpression:
 		if n == nil {
 			return
@@ -280,6 +314,9 @@ func walk(v Visitor, n Node) {
 		}
 		w := v.Visit(n)
 		if w != nil {
+			if n.With != nil {
+				walk(w, n.With)
+			}
 			for _, p := range n.Properties {
 				walk(w, p)
 			}
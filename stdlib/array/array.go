@@ -0,0 +1,152 @@
+package array
+
+import (
+	"fmt"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+const (
+	arrArg   = "arr"
+	vArg     = "v"
+	startArg = "start"
+	endArg   = "end"
+)
+
+func init() {
+	flux.RegisterPackageValue("array", "concat", Concat())
+	flux.RegisterPackageValue("array", "slice", Slice())
+	flux.RegisterPackageValue("array", "length", Length())
+}
+
+// Concat returns a function value that appends the elements of v to arr.
+// Both arrays must have the same element type.
+func Concat() values.Value {
+	arrType := semantic.NewArrayPolyType(semantic.Tvar(1))
+	ftype := semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{
+			arrArg: arrType,
+			vArg:   arrType,
+		},
+		Required: semantic.LabelSet{arrArg, vArg},
+		Return:   arrType,
+	})
+	call := func(args values.Object) (values.Value, error) {
+		arr, err := getArray(args, arrArg)
+		if err != nil {
+			return nil, err
+		}
+		v, err := getArray(args, vArg)
+		if err != nil {
+			return nil, err
+		}
+		elements := make([]values.Value, 0, arr.Len()+v.Len())
+		arr.Range(func(i int, e values.Value) {
+			elements = append(elements, e)
+		})
+		v.Range(func(i int, e values.Value) {
+			elements = append(elements, e)
+		})
+		// An empty array literal has no element type of its own,
+		// so prefer the type of the array that has elements.
+		et := arr.Type().ElementType()
+		if arr.Len() == 0 {
+			et = v.Type().ElementType()
+		}
+		return values.NewArrayWithBacking(et, elements), nil
+	}
+	return values.NewFunction("concat", ftype, call, false)
+}
+
+// Slice returns a function value that selects the elements of arr
+// from index start up to but not including index end.
+// The start index defaults to 0 and the end index defaults to the length of arr.
+// Indices that are out of range are clamped to the bounds of arr,
+// and an end index before the start index produces an empty array.
+func Slice() values.Value {
+	arrType := semantic.NewArrayPolyType(semantic.Tvar(1))
+	ftype := semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{
+			arrArg:   arrType,
+			startArg: semantic.Int,
+			endArg:   semantic.Int,
+		},
+		Required: semantic.LabelSet{arrArg},
+		Return:   arrType,
+	})
+	call := func(args values.Object) (values.Value, error) {
+		arr, err := getArray(args, arrArg)
+		if err != nil {
+			return nil, err
+		}
+		l := arr.Len()
+		start, err := getIndex(args, startArg, 0, l)
+		if err != nil {
+			return nil, err
+		}
+		end, err := getIndex(args, endArg, l, l)
+		if err != nil {
+			return nil, err
+		}
+		if end < start {
+			end = start
+		}
+		elements := make([]values.Value, 0, end-start)
+		for i := start; i < end; i++ {
+			elements = append(elements, arr.Get(i))
+		}
+		return values.NewArrayWithBacking(arr.Type().ElementType(), elements), nil
+	}
+	return values.NewFunction("slice", ftype, call, false)
+}
+
+// Length returns a function value that reports the number of elements in arr.
+func Length() values.Value {
+	ftype := semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{
+			arrArg: semantic.NewArrayPolyType(semantic.Tvar(1)),
+		},
+		Required: semantic.LabelSet{arrArg},
+		Return:   semantic.Int,
+	})
+	call := func(args values.Object) (values.Value, error) {
+		arr, err := getArray(args, arrArg)
+		if err != nil {
+			return nil, err
+		}
+		return values.NewInt(int64(arr.Len())), nil
+	}
+	return values.NewFunction("length", ftype, call, false)
+}
+
+func getArray(args values.Object, name string) (values.Array, error) {
+	v, ok := args.Get(name)
+	if !ok {
+		return nil, fmt.Errorf("missing argument %q", name)
+	}
+	if v.Type().Nature() != semantic.Array {
+		return nil, fmt.Errorf("argument %q must be an array, got %v", name, v.Type().Nature())
+	}
+	return v.Array(), nil
+}
+
+// getIndex reads an optional index argument and clamps it to the range [0, l].
+func getIndex(args values.Object, name string, def, l int) (int, error) {
+	v, ok := args.Get(name)
+	if !ok {
+		return def, nil
+	}
+	if v.Type().Nature() != semantic.Int {
+		return 0, fmt.Errorf("argument %q must be an int, got %v", name, v.Type().Nature())
+	}
+	i := v.Int()
+	if i < 0 {
+		return 0, nil
+	}
+	if i > int64(l) {
+		return l, nil
+	}
+	return int(i), nil
+}
@@ -0,0 +1,112 @@
+package array_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/flux"
+	_ "github.com/influxdata/flux/builtin"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+func ints(vs ...int64) values.Array {
+	elements := make([]values.Value, len(vs))
+	for i, v := range vs {
+		elements[i] = values.NewInt(v)
+	}
+	return values.NewArrayWithBacking(semantic.Int, elements)
+}
+
+func TestArray_Flux(t *testing.T) {
+	testCases := []struct {
+		name    string
+		script  string
+		want    values.Value
+		wantErr bool
+	}{
+		{
+			name:   "concat",
+			script: `x = array.concat(arr: [1, 2], v: [3, 4])`,
+			want:   ints(1, 2, 3, 4),
+		},
+		{
+			name:   "concat empty",
+			script: `x = array.concat(arr: [], v: [1])`,
+			want:   ints(1),
+		},
+		{
+			name:   "concat strings",
+			script: `x = array.concat(arr: ["a"], v: ["b"])`,
+			want: values.NewArrayWithBacking(semantic.String, []values.Value{
+				values.NewString("a"),
+				values.NewString("b"),
+			}),
+		},
+		{
+			name:    "concat mismatched types",
+			script:  `x = array.concat(arr: [1], v: ["a"])`,
+			wantErr: true,
+		},
+		{
+			name:   "slice",
+			script: `x = array.slice(arr: [1, 2, 3, 4], start: 1, end: 3)`,
+			want:   ints(2, 3),
+		},
+		{
+			name:   "slice defaults",
+			script: `x = array.slice(arr: [1, 2, 3])`,
+			want:   ints(1, 2, 3),
+		},
+		{
+			name:   "slice negative start",
+			script: `x = array.slice(arr: [1, 2, 3], start: -5, end: 2)`,
+			want:   ints(1, 2),
+		},
+		{
+			name:   "slice end past length",
+			script: `x = array.slice(arr: [1, 2, 3], start: 1, end: 10)`,
+			want:   ints(2, 3),
+		},
+		{
+			name:   "slice start past length",
+			script: `x = array.slice(arr: [1, 2, 3], start: 10)`,
+			want:   ints(),
+		},
+		{
+			name:   "slice end before start",
+			script: `x = array.slice(arr: [1, 2, 3], start: 2, end: 1)`,
+			want:   ints(),
+		},
+		{
+			name:   "length",
+			script: `x = array.length(arr: [1, 2, 3])`,
+			want:   values.NewInt(3),
+		},
+		{
+			name:   "length empty",
+			script: `x = array.length(arr: [])`,
+			want:   values.NewInt(0),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, scope, err := flux.Eval("import \"array\"\n" + tc.script)
+			if err != nil {
+				if !tc.wantErr {
+					t.Fatal(err)
+				}
+				return
+			} else if tc.wantErr {
+				t.Fatal("expected error")
+			}
+			got, ok := scope.Lookup("x")
+			if !ok {
+				t.Fatal("missing value x in scope")
+			}
+			if !values.Equal(tc.want, got) {
+				t.Errorf("unexpected value -want/+got\n\t- %v\n\t+ %v", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package date
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+const (
+	timeArg     = "t"
+	unitArg     = "unit"
+	locationArg = "location"
+)
+
+func init() {
+	flux.RegisterPackageValue("date", "quarter", quarter)
+	flux.RegisterPackageValue("date", "truncate", truncate)
+}
+
+// getTime reads the time argument and converts it into the location
+// named by the optional location argument. Times are in UTC by default.
+func getTime(args values.Object) (time.Time, error) {
+	v, ok := args.Get(timeArg)
+	if !ok {
+		return time.Time{}, fmt.Errorf("missing argument %q", timeArg)
+	}
+	if v.Type().Nature() != semantic.Time {
+		return time.Time{}, fmt.Errorf("expected argument %q to be of type %v, got type %v", timeArg, semantic.Time, v.Type().Nature())
+	}
+	t := v.Time().Time().UTC()
+
+	l, ok := args.Get(locationArg)
+	if !ok {
+		return t, nil
+	}
+	if l.Type().Nature() != semantic.String {
+		return time.Time{}, fmt.Errorf("expected argument %q to be of type %v, got type %v", locationArg, semantic.String, l.Type().Nature())
+	}
+	loc, err := time.LoadLocation(l.Str())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid location %q: %v", l.Str(), err)
+	}
+	return t.In(loc), nil
+}
+
+// quarter returns the calendar quarter of a time as an int from 1 to 4.
+var quarter = values.NewFunction(
+	"quarter",
+	semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{
+			timeArg:     semantic.Time,
+			locationArg: semantic.String,
+		},
+		Required: semantic.LabelSet{timeArg},
+		Return:   semantic.Int,
+	}),
+	func(args values.Object) (values.Value, error) {
+		t, err := getTime(args)
+		if err != nil {
+			return nil, err
+		}
+		return values.NewInt(int64(t.Month()-1)/3 + 1), nil
+	},
+	false,
+)
+
+// truncate returns a time rounded down to a multiple of a unit duration.
+// Month and year units truncate to the start of a calendar month,
+// and units that are whole days truncate to midnight, in the location.
+// Like windows, the multiples are counted from the Unix epoch.
+var truncate = values.NewFunction(
+	"truncate",
+	semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{
+			timeArg:     semantic.Time,
+			unitArg:     semantic.Duration,
+			locationArg: semantic.String,
+		},
+		Required: semantic.LabelSet{timeArg, unitArg},
+		Return:   semantic.Time,
+	}),
+	func(args values.Object) (values.Value, error) {
+		t, err := getTime(args)
+		if err != nil {
+			return nil, err
+		}
+		v, ok := args.Get(unitArg)
+		if !ok {
+			return nil, fmt.Errorf("missing argument %q", unitArg)
+		}
+		if v.Type().Nature() != semantic.Duration {
+			return nil, fmt.Errorf("expected argument %q to be of type %v, got type %v", unitArg, semantic.Duration, v.Type().Nature())
+		}
+		unit := v.Duration()
+		if unit <= 0 {
+			return nil, fmt.Errorf("truncate unit must be positive, got %v", unit)
+		}
+		return values.NewTime(values.ConvertTime(truncateTime(t, unit))), nil
+	},
+	false,
+)
+
+const day = 24 * time.Hour
+
+// truncateTime rounds t down to a multiple of unit in the location of t.
+func truncateTime(t time.Time, unit values.Duration) time.Time {
+	if months, ok := unit.Months(); ok {
+		month := int64(t.Year()-1970)*12 + int64(t.Month()-time.January)
+		month = floorDiv(month, months) * months
+		return time.Date(1970, time.January+time.Month(month), 1, 0, 0, 0, 0, t.Location())
+	}
+
+	d := unit.Duration()
+	if d%day == 0 {
+		// Days may be shorter or longer than 24h across a daylight
+		// saving time change, so count the days of the calendar.
+		days := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Unix() / int64(day/time.Second)
+		days = floorDiv(days, int64(d/day)) * int64(d/day)
+		return time.Date(1970, time.January, 1+int(days), 0, 0, 0, 0, t.Location())
+	}
+
+	// Truncate the wall clock time so that units that divide an hour
+	// fall on the boundaries of the location's hours.
+	_, offset := t.Zone()
+	wall := t.UnixNano() + int64(offset)*int64(time.Second)
+	wall = floorDiv(wall, int64(d)) * int64(d)
+	return time.Unix(0, wall-int64(offset)*int64(time.Second)).In(t.Location())
+}
+
+// floorDiv returns x divided by y rounded towards negative infinity.
+func floorDiv(x, y int64) int64 {
+	q := x / y
+	if (x%y != 0) && ((x < 0) != (y < 0)) {
+		q--
+	}
+	return q
+}
@@ -0,0 +1,124 @@
+package date
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/values"
+)
+
+func TestQuarter(t *testing.T) {
+	testCases := []struct {
+		name     string
+		t        string
+		location string
+		want     int64
+	}{
+		{name: "start of Q1", t: "2019-01-01T00:00:00Z", want: 1},
+		{name: "end of Q1", t: "2019-03-31T23:59:59Z", want: 1},
+		{name: "start of Q2", t: "2019-04-01T00:00:00Z", want: 2},
+		{name: "end of Q2", t: "2019-06-30T23:59:59Z", want: 2},
+		{name: "start of Q3", t: "2019-07-01T00:00:00Z", want: 3},
+		{name: "end of Q3", t: "2019-09-30T23:59:59Z", want: 3},
+		{name: "start of Q4", t: "2019-10-01T00:00:00Z", want: 4},
+		{name: "end of Q4", t: "2019-12-31T23:59:59Z", want: 4},
+		{name: "UTC location", t: "2019-04-01T00:00:00Z", location: "UTC", want: 2},
+		{name: "location behind UTC", t: "2019-04-01T02:00:00Z", location: "America/New_York", want: 1},
+		{name: "location ahead of UTC", t: "2019-06-30T23:00:00Z", location: "Asia/Tokyo", want: 3},
+		{name: "location ahead of UTC at year end", t: "2019-12-31T20:00:00Z", location: "Australia/Sydney", want: 1},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tm, err := time.Parse(time.RFC3339, tc.t)
+			if err != nil {
+				t.Fatal(err)
+			}
+			args := map[string]values.Value{"t": values.NewTime(values.ConvertTime(tm))}
+			if tc.location != "" {
+				args["location"] = values.NewString(tc.location)
+			}
+			got, err := quarter.Call(values.NewObjectWithValues(args))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Int() != tc.want {
+				t.Errorf("unexpected quarter for %s in %q: want %d got %d", tc.t, tc.location, tc.want, got.Int())
+			}
+		})
+	}
+}
+
+func TestQuarter_InvalidLocation(t *testing.T) {
+	args := values.NewObjectWithValues(map[string]values.Value{
+		"t":        values.NewTime(0),
+		"location": values.NewString("Nowhere/Special"),
+	})
+	if _, err := quarter.Call(args); err == nil {
+		t.Fatal("expected error for invalid location")
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		t        string
+		unit     time.Duration
+		location string
+		want     string
+	}{
+		{name: "second", t: "2019-06-03T13:59:01.123456789Z", unit: time.Second, want: "2019-06-03T13:59:01Z"},
+		{name: "minute", t: "2019-06-03T13:59:01Z", unit: time.Minute, want: "2019-06-03T13:59:00Z"},
+		{name: "hour", t: "2019-06-03T13:59:01Z", unit: time.Hour, want: "2019-06-03T13:00:00Z"},
+		{name: "hour before epoch", t: "1969-12-31T23:30:00Z", unit: time.Hour, want: "1969-12-31T23:00:00Z"},
+		{name: "hour in location with half hour offset", t: "2019-06-03T13:59:01Z", unit: time.Hour, location: "Asia/Kolkata", want: "2019-06-03T13:30:00Z"},
+		{name: "day", t: "2019-06-03T13:59:01Z", unit: 24 * time.Hour, want: "2019-06-03T00:00:00Z"},
+		{name: "day in location behind UTC", t: "2019-06-03T02:00:00Z", unit: 24 * time.Hour, location: "America/New_York", want: "2019-06-02T04:00:00Z"},
+		{name: "day in location ahead of UTC", t: "2019-06-03T20:00:00Z", unit: 24 * time.Hour, location: "Asia/Tokyo", want: "2019-06-03T15:00:00Z"},
+		{name: "day after daylight saving time starts", t: "2019-03-10T12:00:00Z", unit: 24 * time.Hour, location: "America/New_York", want: "2019-03-10T05:00:00Z"},
+		{name: "week", t: "2019-06-03T13:59:01Z", unit: 7 * 24 * time.Hour, want: "2019-05-30T00:00:00Z"},
+		{name: "month", t: "2019-06-03T13:59:01Z", unit: ast.MonthDuration, want: "2019-06-01T00:00:00Z"},
+		{name: "month in location ahead of UTC", t: "2019-05-31T20:00:00Z", unit: ast.MonthDuration, location: "Asia/Tokyo", want: "2019-05-31T15:00:00Z"},
+		{name: "quarter", t: "2019-06-03T13:59:01Z", unit: 3 * ast.MonthDuration, want: "2019-04-01T00:00:00Z"},
+		{name: "year", t: "2019-06-03T13:59:01Z", unit: 12 * ast.MonthDuration, want: "2019-01-01T00:00:00Z"},
+		{name: "year before epoch", t: "1969-06-03T13:59:01Z", unit: 12 * ast.MonthDuration, want: "1969-01-01T00:00:00Z"},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tm, err := time.Parse(time.RFC3339Nano, tc.t)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, err := time.Parse(time.RFC3339, tc.want)
+			if err != nil {
+				t.Fatal(err)
+			}
+			args := map[string]values.Value{
+				"t":    values.NewTime(values.ConvertTime(tm)),
+				"unit": values.NewDuration(values.Duration(tc.unit)),
+			}
+			if tc.location != "" {
+				args["location"] = values.NewString(tc.location)
+			}
+			got, err := truncate.Call(values.NewObjectWithValues(args))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := got.Time().Time(); !got.Equal(want) {
+				t.Errorf("unexpected truncated time for %s to %v in %q: want %s got %s", tc.t, tc.unit, tc.location, want, got.UTC())
+			}
+		})
+	}
+}
+
+func TestTruncate_InvalidUnit(t *testing.T) {
+	args := values.NewObjectWithValues(map[string]values.Value{
+		"t":    values.NewTime(0),
+		"unit": values.NewDuration(0),
+	})
+	if _, err := truncate.Call(args); err == nil {
+		t.Fatal("expected error for zero unit")
+	}
+}
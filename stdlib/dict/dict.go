@@ -0,0 +1,288 @@
+// Package dict provides associative lookups for Flux scripts.
+//
+// A dictionary is represented as an array of records with a key and a value property,
+// so dictionaries can be passed to any function that accepts an array.
+// Keys must be of a comparable basic type and are unique within a dictionary.
+package dict
+
+import (
+	"fmt"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+const (
+	keyProperty   = "key"
+	valueProperty = "value"
+)
+
+func init() {
+	flux.RegisterPackageValue("dict", "fromList", FromList())
+	flux.RegisterPackageValue("dict", "get", Get())
+	flux.RegisterPackageValue("dict", "insert", Insert())
+	flux.RegisterPackageValue("dict", "remove", Remove())
+}
+
+// dictType is the polymorphic type of a dictionary with keys
+// of type variable 1 and values of type variable 2.
+var dictType = semantic.NewArrayPolyType(
+	semantic.NewObjectPolyType(
+		map[string]semantic.PolyType{
+			keyProperty:   semantic.Tvar(1),
+			valueProperty: semantic.Tvar(2),
+		},
+		semantic.LabelSet{keyProperty, valueProperty},
+		semantic.LabelSet{keyProperty, valueProperty},
+	),
+)
+
+// FromList returns a function value that creates a dictionary from an array of key/value records.
+// When a key appears more than once, the last value for the key is kept
+// at the position of its first occurrence.
+func FromList() values.Value {
+	ftype := semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{"pairs": dictType},
+		Required:   semantic.LabelSet{"pairs"},
+		Return:     dictType,
+	})
+	call := func(args values.Object) (values.Value, error) {
+		pairs, err := getDict(args, "pairs")
+		if err != nil {
+			return nil, err
+		}
+		d := &dict{elementType: pairs.Type().ElementType()}
+		for i := 0; i < pairs.Len(); i++ {
+			pair := pairs.Get(i).Object()
+			k, _ := pair.Get(keyProperty)
+			v, _ := pair.Get(valueProperty)
+			if err := d.insert(k, v); err != nil {
+				return nil, err
+			}
+		}
+		return d.array(), nil
+	}
+	return values.NewFunction("fromList", ftype, call, false)
+}
+
+// Get returns a function value that looks up the value for a key in a dictionary.
+// The default value is returned when the key is not present.
+func Get() values.Value {
+	ftype := semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{
+			"dict":    dictType,
+			"key":     semantic.Tvar(1),
+			"default": semantic.Tvar(2),
+		},
+		Required: semantic.LabelSet{"dict", "key", "default"},
+		Return:   semantic.Tvar(2),
+	})
+	call := func(args values.Object) (values.Value, error) {
+		d, err := newDict(args)
+		if err != nil {
+			return nil, err
+		}
+		k, err := getKey(args)
+		if err != nil {
+			return nil, err
+		}
+		if err := d.checkKeyType(k); err != nil {
+			return nil, err
+		}
+		if i := d.index(k); i >= 0 {
+			return d.values[i], nil
+		}
+		def, ok := args.Get("default")
+		if !ok {
+			return nil, fmt.Errorf("missing argument %q", "default")
+		}
+		return def, nil
+	}
+	return values.NewFunction("get", ftype, call, false)
+}
+
+// Insert returns a function value that adds a key and value to a dictionary.
+// The value of an existing key is overwritten.
+// The dictionary given as an argument is not modified.
+func Insert() values.Value {
+	ftype := semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{
+			"dict":  dictType,
+			"key":   semantic.Tvar(1),
+			"value": semantic.Tvar(2),
+		},
+		Required: semantic.LabelSet{"dict", "key", "value"},
+		Return:   dictType,
+	})
+	call := func(args values.Object) (values.Value, error) {
+		d, err := newDict(args)
+		if err != nil {
+			return nil, err
+		}
+		k, err := getKey(args)
+		if err != nil {
+			return nil, err
+		}
+		v, ok := args.Get("value")
+		if !ok {
+			return nil, fmt.Errorf("missing argument %q", "value")
+		}
+		if err := d.insert(k, v); err != nil {
+			return nil, err
+		}
+		return d.array(), nil
+	}
+	return values.NewFunction("insert", ftype, call, false)
+}
+
+// Remove returns a function value that removes a key from a dictionary.
+// Removing a key that is not present returns the dictionary unchanged.
+// The dictionary given as an argument is not modified.
+func Remove() values.Value {
+	ftype := semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{
+			"dict": dictType,
+			"key":  semantic.Tvar(1),
+		},
+		Required: semantic.LabelSet{"dict", "key"},
+		Return:   dictType,
+	})
+	call := func(args values.Object) (values.Value, error) {
+		d, err := newDict(args)
+		if err != nil {
+			return nil, err
+		}
+		k, err := getKey(args)
+		if err != nil {
+			return nil, err
+		}
+		if err := d.checkKeyType(k); err != nil {
+			return nil, err
+		}
+		if i := d.index(k); i >= 0 {
+			d.keys = append(d.keys[:i], d.keys[i+1:]...)
+			d.values = append(d.values[:i], d.values[i+1:]...)
+		}
+		return d.array(), nil
+	}
+	return values.NewFunction("remove", ftype, call, false)
+}
+
+// dict holds the keys and values of a dictionary in order.
+type dict struct {
+	elementType semantic.Type
+	keys        []values.Value
+	values      []values.Value
+}
+
+// newDict copies the dictionary argument of a function call.
+func newDict(args values.Object) (*dict, error) {
+	arr, err := getDict(args, "dict")
+	if err != nil {
+		return nil, err
+	}
+	d := &dict{
+		elementType: arr.Type().ElementType(),
+		keys:        make([]values.Value, 0, arr.Len()),
+		values:      make([]values.Value, 0, arr.Len()),
+	}
+	arr.Range(func(i int, e values.Value) {
+		pair := e.Object()
+		k, _ := pair.Get(keyProperty)
+		v, _ := pair.Get(valueProperty)
+		d.keys = append(d.keys, k)
+		d.values = append(d.values, v)
+	})
+	return d, nil
+}
+
+// checkKeyType reports an error if k cannot be compared with the keys of the dictionary.
+func (d *dict) checkKeyType(k values.Value) error {
+	if len(d.keys) > 0 && d.keys[0].Type() != k.Type() {
+		return fmt.Errorf("dictionary key must be of type %v, got %v", d.keys[0].Type(), k.Type())
+	}
+	return nil
+}
+
+func (d *dict) index(k values.Value) int {
+	for i, key := range d.keys {
+		if key.Equal(k) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (d *dict) insert(k, v values.Value) error {
+	if k == nil || v == nil {
+		return fmt.Errorf("dictionary entries must have a %q and a %q", keyProperty, valueProperty)
+	}
+	if err := checkKey(k); err != nil {
+		return err
+	}
+	if err := d.checkKeyType(k); err != nil {
+		return err
+	}
+	if i := d.index(k); i >= 0 {
+		d.values[i] = v
+		return nil
+	}
+	d.keys = append(d.keys, k)
+	d.values = append(d.values, v)
+	return nil
+}
+
+// array converts the dictionary back into an array of key/value records.
+func (d *dict) array() values.Array {
+	elements := make([]values.Value, len(d.keys))
+	for i := range d.keys {
+		pair := values.NewObject()
+		pair.Set(keyProperty, d.keys[i])
+		pair.Set(valueProperty, d.values[i])
+		elements[i] = pair
+	}
+	et := d.elementType
+	if len(elements) > 0 && et.Nature() != semantic.Object {
+		// An empty dictionary does not know its element type,
+		// so take it from the first entry.
+		et = elements[0].Type()
+	}
+	return values.NewArrayWithBacking(et, elements)
+}
+
+func getDict(args values.Object, name string) (values.Array, error) {
+	v, ok := args.Get(name)
+	if !ok {
+		return nil, fmt.Errorf("missing argument %q", name)
+	}
+	if v.Type().Nature() != semantic.Array {
+		return nil, fmt.Errorf("argument %q must be an array of records, got %v", name, v.Type().Nature())
+	}
+	return v.Array(), nil
+}
+
+func getKey(args values.Object) (values.Value, error) {
+	k, ok := args.Get("key")
+	if !ok {
+		return nil, fmt.Errorf("missing argument %q", "key")
+	}
+	if err := checkKey(k); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// checkKey reports an error if a value cannot be used as a dictionary key.
+func checkKey(k values.Value) error {
+	switch n := k.Type().Nature(); n {
+	case semantic.String, semantic.Int, semantic.UInt, semantic.Float,
+		semantic.Bool, semantic.Time, semantic.Duration:
+		if k.IsNull() {
+			return fmt.Errorf("dictionary key must not be null")
+		}
+		return nil
+	default:
+		return fmt.Errorf("dictionary key must be a comparable basic type, got %v", n)
+	}
+}
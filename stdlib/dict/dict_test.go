@@ -0,0 +1,125 @@
+package dict_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/flux"
+	_ "github.com/influxdata/flux/builtin"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+func intArray(vs ...int64) values.Array {
+	elements := make([]values.Value, len(vs))
+	for i, v := range vs {
+		elements[i] = values.NewInt(v)
+	}
+	return values.NewArrayWithBacking(semantic.Int, elements)
+}
+
+func TestDict_Flux(t *testing.T) {
+	testCases := []struct {
+		name    string
+		script  string
+		want    values.Value
+		wantErr bool
+	}{
+		{
+			name: "get",
+			script: `
+d = dict.fromList(pairs: [{key: "a", value: 1}, {key: "b", value: 2}])
+x = dict.get(dict: d, key: "b", default: 0)`,
+			want: values.NewInt(2),
+		},
+		{
+			name: "get with default",
+			script: `
+d = dict.fromList(pairs: [{key: "a", value: 1}])
+x = dict.get(dict: d, key: "c", default: -1)`,
+			want: values.NewInt(-1),
+		},
+		{
+			name: "get from empty",
+			script: `
+d = dict.fromList(pairs: [])
+x = dict.get(dict: d, key: 1, default: "none")`,
+			want: values.NewString("none"),
+		},
+		{
+			name: "fromList duplicate keys",
+			script: `
+d = dict.fromList(pairs: [{key: "a", value: 1}, {key: "b", value: 2}, {key: "a", value: 3}])
+x = [dict.get(dict: d, key: "a", default: 0), array.length(arr: d)]`,
+			want: intArray(3, 2),
+		},
+		{
+			name: "insert",
+			script: `
+d = dict.insert(dict: dict.fromList(pairs: [{key: 1, value: "one"}]), key: 2, value: "two")
+x = dict.get(dict: d, key: 2, default: "")`,
+			want: values.NewString("two"),
+		},
+		{
+			name: "insert overwrite",
+			script: `
+d = dict.fromList(pairs: [{key: "a", value: 1}])
+e = dict.insert(dict: d, key: "a", value: 10)
+x = [dict.get(dict: d, key: "a", default: 0), dict.get(dict: e, key: "a", default: 0), array.length(arr: e)]`,
+			want: intArray(1, 10, 1),
+		},
+		{
+			name: "insert into empty",
+			script: `
+d = dict.insert(dict: dict.fromList(pairs: []), key: "a", value: 1.5)
+x = dict.get(dict: d, key: "a", default: 0.0)`,
+			want: values.NewFloat(1.5),
+		},
+		{
+			name: "remove",
+			script: `
+d = dict.fromList(pairs: [{key: "a", value: 1}, {key: "b", value: 2}])
+e = dict.remove(dict: d, key: "a")
+x = [dict.get(dict: e, key: "a", default: 0), dict.get(dict: e, key: "b", default: 0), array.length(arr: e)]`,
+			want: intArray(0, 2, 1),
+		},
+		{
+			name: "remove nonexistent",
+			script: `
+d = dict.fromList(pairs: [{key: "a", value: 1}])
+e = dict.remove(dict: d, key: "z")
+x = [dict.get(dict: e, key: "a", default: 0), array.length(arr: e)]`,
+			want: intArray(1, 1),
+		},
+		{
+			name:    "key type mismatch",
+			script:  `x = dict.get(dict: dict.fromList(pairs: [{key: "a", value: 1}]), key: 1, default: 0)`,
+			wantErr: true,
+		},
+		{
+			name:    "key not comparable",
+			script:  `x = dict.fromList(pairs: [{key: [1], value: 1}])`,
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, scope, err := flux.Eval("import \"array\"\nimport \"dict\"\n" + tc.script)
+			if err != nil {
+				if !tc.wantErr {
+					t.Fatal(err)
+				}
+				return
+			} else if tc.wantErr {
+				t.Fatal("expected error")
+			}
+			got, ok := scope.Lookup("x")
+			if !ok {
+				t.Fatal("missing value x in scope")
+			}
+			if !values.Equal(tc.want, got) {
+				t.Errorf("unexpected value -want/+got\n\t- %v\n\t+ %v", tc.want, got)
+			}
+		})
+	}
+}
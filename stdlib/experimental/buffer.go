@@ -0,0 +1,210 @@
+package experimental
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+)
+
+const (
+	ToBufferKind   = "toBuffer"
+	FromBufferKind = "fromBuffer"
+)
+
+// ToBufferOpSpec writes its input tables into a named in-memory buffer.
+type ToBufferOpSpec struct {
+	Name string `json:"name"`
+}
+
+func (s *ToBufferOpSpec) Kind() flux.OperationKind {
+	return ToBufferKind
+}
+
+// WriteBuffer implements flux.BufferWriter.
+func (s *ToBufferOpSpec) WriteBuffer() string {
+	return s.Name
+}
+
+// FromBufferOpSpec reads the tables of a named in-memory buffer.
+// The buffer must be written by a ToBufferOpSpec within the same script.
+type FromBufferOpSpec struct {
+	Name string `json:"name"`
+}
+
+func (s *FromBufferOpSpec) Kind() flux.OperationKind {
+	return FromBufferKind
+}
+
+// ReadBuffer implements flux.BufferReader.
+func (s *FromBufferOpSpec) ReadBuffer() string {
+	return s.Name
+}
+
+func init() {
+	toSignature := flux.FunctionSignature(
+		map[string]semantic.PolyType{
+			"name": semantic.String,
+		},
+		[]string{"name"},
+	)
+	fromSignature := semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{
+			"name": semantic.String,
+		},
+		Required: semantic.LabelSet{"name"},
+		Return:   flux.TableObjectType,
+	}
+
+	flux.RegisterPackageValue("experimental", "to", flux.FunctionValueWithSideEffect(ToBufferKind, createToBufferOpSpec, toSignature))
+	flux.RegisterOpSpec(ToBufferKind, newToBufferOp)
+	plan.RegisterProcedureSpec(ToBufferKind, newToBufferProcedure, ToBufferKind)
+	execute.RegisterTransformation(ToBufferKind, createBufferTransformation)
+
+	flux.RegisterPackageValue("experimental", "from", flux.FunctionValue(FromBufferKind, createFromBufferOpSpec, fromSignature))
+	flux.RegisterOpSpec(FromBufferKind, newFromBufferOp)
+	plan.RegisterProcedureSpec(FromBufferKind, newFromBufferProcedure, FromBufferKind)
+	execute.RegisterTransformation(FromBufferKind, createBufferTransformation)
+}
+
+func createToBufferOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+	name, err := args.GetRequiredString("name")
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		return nil, errors.New("buffer name must not be empty")
+	}
+	return &ToBufferOpSpec{Name: name}, nil
+}
+
+func newToBufferOp() flux.OperationSpec {
+	return new(ToBufferOpSpec)
+}
+
+func createFromBufferOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	name, err := args.GetRequiredString("name")
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		return nil, errors.New("buffer name must not be empty")
+	}
+	// The parent of the operation is the writer of the buffer,
+	// which is added once the whole script has been evaluated.
+	return &FromBufferOpSpec{Name: name}, nil
+}
+
+func newFromBufferOp() flux.OperationSpec {
+	return new(FromBufferOpSpec)
+}
+
+type ToBufferProcedureSpec struct {
+	plan.DefaultCost
+	Name string
+}
+
+func (s *ToBufferProcedureSpec) Kind() plan.ProcedureKind {
+	return ToBufferKind
+}
+
+func (s *ToBufferProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := *s
+	return &ns
+}
+
+func newToBufferProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*ToBufferOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+	return &ToBufferProcedureSpec{Name: spec.Name}, nil
+}
+
+type FromBufferProcedureSpec struct {
+	plan.DefaultCost
+	Name string
+}
+
+func (s *FromBufferProcedureSpec) Kind() plan.ProcedureKind {
+	return FromBufferKind
+}
+
+func (s *FromBufferProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := *s
+	return &ns
+}
+
+// TriggerSpec implements plan.TriggerAwareProcedureSpec
+func (s *FromBufferProcedureSpec) TriggerSpec() plan.TriggerSpec {
+	return plan.NarrowTransformationTriggerSpec{}
+}
+
+func newFromBufferProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*FromBufferOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+	return &FromBufferProcedureSpec{Name: spec.Name}, nil
+}
+
+func createBufferTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	switch spec.(type) {
+	case *ToBufferProcedureSpec, *FromBufferProcedureSpec:
+	default:
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewBufferTransformation(d, cache)
+	return t, d, nil
+}
+
+// bufferTransformation copies each table into a table builder
+// whose memory is charged to the allocator of the query.
+// Writing a buffer holds all of its tables until the input is finished,
+// after which each reader of the buffer receives a copy of the tables.
+type bufferTransformation struct {
+	d     execute.Dataset
+	cache execute.TableBuilderCache
+}
+
+func NewBufferTransformation(d execute.Dataset, cache execute.TableBuilderCache) *bufferTransformation {
+	return &bufferTransformation{
+		d:     d,
+		cache: cache,
+	}
+}
+
+func (t *bufferTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return t.d.RetractTable(key)
+}
+
+func (t *bufferTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	builder, created := t.cache.TableBuilder(tbl.Key())
+	if !created {
+		return fmt.Errorf("buffer found duplicate table with key: %v", tbl.Key())
+	}
+	if err := execute.AddTableCols(tbl, builder); err != nil {
+		return err
+	}
+	return execute.AppendTable(tbl, builder)
+}
+
+func (t *bufferTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+
+func (t *bufferTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+
+func (t *bufferTransformation) Finish(id execute.DatasetID, err error) {
+	t.d.Finish(err)
+}
@@ -0,0 +1,246 @@
+package experimental_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	_ "github.com/influxdata/flux/builtin"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/lang"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/querytest"
+	"github.com/influxdata/flux/stdlib/experimental"
+	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
+	"github.com/influxdata/flux/stdlib/universe"
+)
+
+func TestToBufferOperation_Marshaling(t *testing.T) {
+	data := []byte(`{"id":"toBuffer","kind":"toBuffer","spec":{"name":"buf"}}`)
+	op := &flux.Operation{
+		ID:   "toBuffer",
+		Spec: &experimental.ToBufferOpSpec{Name: "buf"},
+	}
+	querytest.OperationMarshalingTestHelper(t, data, op)
+}
+
+func TestFromBufferOperation_Marshaling(t *testing.T) {
+	data := []byte(`{"id":"fromBuffer","kind":"fromBuffer","spec":{"name":"buf"}}`)
+	op := &flux.Operation{
+		ID:   "fromBuffer",
+		Spec: &experimental.FromBufferOpSpec{Name: "buf"},
+	}
+	querytest.OperationMarshalingTestHelper(t, data, op)
+}
+
+func TestBuffer_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "read twice",
+			Raw: `import "experimental"
+from(bucket: "db") |> experimental.to(name: "buf")
+experimental.from(name: "buf") |> yield(name: "a")
+experimental.from(name: "buf") |> yield(name: "b")`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID:   "from0",
+						Spec: &influxdb.FromOpSpec{Bucket: "db"},
+					},
+					{
+						ID:   "toBuffer1",
+						Spec: &experimental.ToBufferOpSpec{Name: "buf"},
+					},
+					{
+						ID:   "fromBuffer2",
+						Spec: &experimental.FromBufferOpSpec{Name: "buf"},
+					},
+					{
+						ID:   "yield3",
+						Spec: &universe.YieldOpSpec{Name: "a"},
+					},
+					{
+						ID:   "fromBuffer4",
+						Spec: &experimental.FromBufferOpSpec{Name: "buf"},
+					},
+					{
+						ID:   "yield5",
+						Spec: &universe.YieldOpSpec{Name: "b"},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "toBuffer1"},
+					{Parent: "toBuffer1", Child: "fromBuffer2"},
+					{Parent: "fromBuffer2", Child: "yield3"},
+					{Parent: "toBuffer1", Child: "fromBuffer4"},
+					{Parent: "fromBuffer4", Child: "yield5"},
+				},
+			},
+		},
+		{
+			Name: "unwritten buffer",
+			Raw: `import "experimental"
+experimental.from(name: "buf") |> yield(name: "a")`,
+			WantErr: true,
+		},
+		{
+			Name: "written twice",
+			Raw: `import "experimental"
+from(bucket: "db") |> experimental.to(name: "buf")
+from(bucket: "db2") |> experimental.to(name: "buf")
+experimental.from(name: "buf") |> yield(name: "a")`,
+			WantErr: true,
+		},
+		{
+			Name: "read by its own input",
+			Raw: `import "experimental"
+experimental.from(name: "buf") |> experimental.to(name: "buf")`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+func TestBuffer_ReadTwice(t *testing.T) {
+	script := `
+import "csv"
+import "experimental"
+
+data = "
+#datatype,string,long,string,dateTime:RFC3339,long
+#group,false,false,true,false,false
+#default,_result,,,,
+,result,table,host,_time,_value
+,,0,A,2019-01-01T00:00:00Z,1
+,,0,A,2019-01-01T00:00:10Z,2
+,,1,B,2019-01-01T00:00:00Z,3
+"
+
+csv.from(csv: data) |> experimental.to(name: "buf")
+experimental.from(name: "buf") |> yield(name: "rows")
+experimental.from(name: "buf") |> sum() |> yield(name: "sums")
+`
+	program, err := lang.Compile(script, time.Unix(0, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	alloc := &memory.Allocator{}
+	q, err := program.Start(context.Background(), alloc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer q.Done()
+
+	got := make(map[string][]*executetest.Table)
+	for res := range q.Results() {
+		if err := res.Tables().Do(func(tbl flux.Table) error {
+			t, err := executetest.ConvertTable(tbl)
+			if err != nil {
+				return err
+			}
+			t.Normalize()
+			got[res.Name()] = append(got[res.Name()], t)
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := q.Err(); err != nil {
+		t.Fatal(err)
+	}
+	for _, tables := range got {
+		executetest.NormalizeTables(tables)
+	}
+
+	cols := []flux.ColMeta{
+		{Label: "host", Type: flux.TString},
+		{Label: "_time", Type: flux.TTime},
+		{Label: "_value", Type: flux.TInt},
+	}
+	want := map[string][]*executetest.Table{
+		"rows": {
+			{
+				KeyCols: []string{"host"},
+				ColMeta: cols,
+				Data: [][]interface{}{
+					{"A", mustParseTime("2019-01-01T00:00:00Z"), int64(1)},
+					{"A", mustParseTime("2019-01-01T00:00:10Z"), int64(2)},
+				},
+			},
+			{
+				KeyCols: []string{"host"},
+				ColMeta: cols,
+				Data: [][]interface{}{
+					{"B", mustParseTime("2019-01-01T00:00:00Z"), int64(3)},
+				},
+			},
+		},
+		"sums": {
+			{
+				KeyCols: []string{"host"},
+				ColMeta: []flux.ColMeta{
+					{Label: "host", Type: flux.TString},
+					{Label: "_value", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{"A", int64(3)},
+				},
+			},
+			{
+				KeyCols: []string{"host"},
+				ColMeta: []flux.ColMeta{
+					{Label: "host", Type: flux.TString},
+					{Label: "_value", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{"B", int64(3)},
+				},
+			},
+		},
+	}
+	for _, tables := range want {
+		executetest.NormalizeTables(tables)
+	}
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected results -want/+got:\n%s", cmp.Diff(want, got))
+	}
+	if alloc.MaxAllocated() == 0 {
+		t.Error("expected the buffered tables to be charged to the allocator")
+	}
+}
+
+func TestBuffer_Unwritten(t *testing.T) {
+	script := `
+import "experimental"
+experimental.from(name: "missing") |> yield(name: "rows")
+`
+	program, err := lang.Compile(script, time.Unix(0, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = program.Start(context.Background(), &memory.Allocator{})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if want := `buffer "missing" is read but never written`; !strings.Contains(err.Error(), want) {
+		t.Errorf("unexpected error: want %q, got %q", want, err)
+	}
+}
+
+func mustParseTime(s string) execute.Time {
+	ts, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		panic(err)
+	}
+	return execute.Time(ts.UnixNano())
+}
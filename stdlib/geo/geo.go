@@ -0,0 +1,111 @@
+package geo
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+const (
+	lat1Arg = "lat1"
+	lon1Arg = "lon1"
+	lat2Arg = "lat2"
+	lon2Arg = "lon2"
+	unitArg = "unit"
+)
+
+// earthRadius is the mean radius of the earth in kilometers.
+const earthRadius = 6371.0088
+
+// units maps each supported unit of distance to its length in kilometers.
+var units = map[string]float64{
+	"km": 1,
+	"m":  0.001,
+	"mi": 1.609344,
+}
+
+func init() {
+	flux.RegisterPackageValue("geo", "distance", Distance())
+}
+
+// Distance returns a function value that computes the great-circle distance
+// between two points given by their latitude and longitude in degrees.
+// The distance is computed with the haversine formula and is returned
+// in the unit given by the unit argument, which defaults to kilometers.
+func Distance() values.Value {
+	ftype := semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{
+			lat1Arg: semantic.Float,
+			lon1Arg: semantic.Float,
+			lat2Arg: semantic.Float,
+			lon2Arg: semantic.Float,
+			unitArg: semantic.String,
+		},
+		Required: semantic.LabelSet{lat1Arg, lon1Arg, lat2Arg, lon2Arg},
+		Return:   semantic.Float,
+	})
+	call := func(args values.Object) (values.Value, error) {
+		lat1, err := getCoordinate(args, lat1Arg, 90)
+		if err != nil {
+			return nil, err
+		}
+		lon1, err := getCoordinate(args, lon1Arg, 180)
+		if err != nil {
+			return nil, err
+		}
+		lat2, err := getCoordinate(args, lat2Arg, 90)
+		if err != nil {
+			return nil, err
+		}
+		lon2, err := getCoordinate(args, lon2Arg, 180)
+		if err != nil {
+			return nil, err
+		}
+		unit := "km"
+		if v, ok := args.Get(unitArg); ok {
+			if v.Type().Nature() != semantic.String {
+				return nil, fmt.Errorf("argument %q must be a string, got %v", unitArg, v.Type().Nature())
+			}
+			unit = v.Str()
+		}
+		km, ok := units[unit]
+		if !ok {
+			return nil, fmt.Errorf("unknown unit %q, must be one of \"km\", \"m\" or \"mi\"", unit)
+		}
+		return values.NewFloat(haversine(lat1, lon1, lat2, lon2) / km), nil
+	}
+	return values.NewFunction("distance", ftype, call, false)
+}
+
+// haversine returns the great-circle distance in kilometers
+// between two points given by their latitude and longitude in degrees.
+func haversine(lat1, lon1, lat2, lon2 float64) float64 {
+	phi1, phi2 := radians(lat1), radians(lat2)
+	dphi, dlambda := radians(lat2-lat1), radians(lon2-lon1)
+	a := math.Pow(math.Sin(dphi/2), 2) + math.Cos(phi1)*math.Cos(phi2)*math.Pow(math.Sin(dlambda/2), 2)
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+// getCoordinate reads a coordinate argument in degrees
+// and checks that it is within the range [-limit, limit].
+func getCoordinate(args values.Object, name string, limit float64) (float64, error) {
+	v, ok := args.Get(name)
+	if !ok {
+		return 0, fmt.Errorf("missing argument %q", name)
+	}
+	if v.Type().Nature() != semantic.Float {
+		return 0, fmt.Errorf("argument %q must be a float, got %v", name, v.Type().Nature())
+	}
+	f := v.Float()
+	if math.IsNaN(f) || f < -limit || f > limit {
+		return 0, fmt.Errorf("argument %q must be between %v and %v, got %v", name, -limit, limit, f)
+	}
+	return f, nil
+}
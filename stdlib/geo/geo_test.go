@@ -0,0 +1,98 @@
+package geo_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/influxdata/flux"
+	_ "github.com/influxdata/flux/builtin"
+)
+
+func TestDistance(t *testing.T) {
+	const (
+		london     = "lat1: 51.5074, lon1: -0.1278"
+		paris      = "lat2: 48.8566, lon2: 2.3522"
+		newYork    = "lat1: 40.7128, lon1: -74.0060"
+		losAngeles = "lat2: 34.0522, lon2: -118.2437"
+		toNewYork  = "lat2: 40.7128, lon2: -74.0060"
+	)
+	testCases := []struct {
+		name      string
+		args      string
+		want      float64
+		tolerance float64
+		wantErr   bool
+	}{
+		{
+			name:      "london to paris",
+			args:      london + ", " + paris,
+			want:      343.5,
+			tolerance: 1,
+		},
+		{
+			name:      "london to paris in meters",
+			args:      london + ", " + paris + `, unit: "m"`,
+			want:      343500,
+			tolerance: 1000,
+		},
+		{
+			name:      "new york to los angeles",
+			args:      newYork + ", " + losAngeles + `, unit: "km"`,
+			want:      3936,
+			tolerance: 1,
+		},
+		{
+			name:      "new york to los angeles in miles",
+			args:      newYork + ", " + losAngeles + `, unit: "mi"`,
+			want:      2445.6,
+			tolerance: 1,
+		},
+		{
+			name: "same point",
+			args: newYork + ", " + toNewYork,
+			want: 0,
+		},
+		{
+			name:      "antipodes",
+			args:      "lat1: 0.0, lon1: 0.0, lat2: 0.0, lon2: 180.0",
+			want:      math.Pi * 6371.0088,
+			tolerance: 1e-6,
+		},
+		{
+			name:    "latitude out of range",
+			args:    "lat1: 90.5, lon1: 0.0, " + paris,
+			wantErr: true,
+		},
+		{
+			name:    "longitude out of range",
+			args:    london + ", lat2: 0.0, lon2: -181.0",
+			wantErr: true,
+		},
+		{
+			name:    "unknown unit",
+			args:    london + ", " + paris + `, unit: "ft"`,
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, scope, err := flux.Eval("import \"geo\"\nx = geo.distance(" + tc.args + ")")
+			if err != nil {
+				if !tc.wantErr {
+					t.Fatal(err)
+				}
+				return
+			} else if tc.wantErr {
+				t.Fatal("expected error")
+			}
+			got, ok := scope.Lookup("x")
+			if !ok {
+				t.Fatal("missing value x in scope")
+			}
+			if diff := math.Abs(got.Float() - tc.want); diff > tc.tolerance {
+				t.Errorf("unexpected distance: want %v within %v, got %v", tc.want, tc.tolerance, got.Float())
+			}
+		})
+	}
+}
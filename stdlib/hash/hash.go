@@ -0,0 +1,47 @@
+package hash
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	gohash "hash"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+const stringArg = "v"
+
+func init() {
+	flux.RegisterPackageValue("hash", "md5", generateHashFunction("md5", md5.New))
+	flux.RegisterPackageValue("hash", "sha1", generateHashFunction("sha1", sha1.New))
+	flux.RegisterPackageValue("hash", "sha256", generateHashFunction("sha256", sha256.New))
+}
+
+// generateHashFunction creates a function that returns the digest of the UTF-8 bytes
+// of a string as a lowercase hex string.
+func generateHashFunction(name string, newHash func() gohash.Hash) values.Function {
+	return values.NewFunction(
+		name,
+		semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+			Parameters: map[string]semantic.PolyType{stringArg: semantic.String},
+			Required:   semantic.LabelSet{stringArg},
+			Return:     semantic.String,
+		}),
+		func(args values.Object) (values.Value, error) {
+			v, ok := args.Get(stringArg)
+			if !ok {
+				return nil, fmt.Errorf("missing argument %q", stringArg)
+			}
+			if v.Type().Nature() != semantic.String {
+				return nil, fmt.Errorf("cannot hash argument of type %v", v.Type().Nature())
+			}
+			h := newHash()
+			h.Write([]byte(v.Str()))
+			return values.NewString(hex.EncodeToString(h.Sum(nil))), nil
+		}, false,
+	)
+}
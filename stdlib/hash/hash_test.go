@@ -0,0 +1,75 @@
+package hash
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/influxdata/flux/values"
+)
+
+func TestHash(t *testing.T) {
+	testCases := []struct {
+		name string
+		fn   values.Function
+		v    string
+		want string
+	}{
+		{
+			name: "md5 empty",
+			fn:   generateHashFunction("md5", md5.New),
+			v:    "",
+			want: "d41d8cd98f00b204e9800998ecf8427e",
+		},
+		{
+			name: "md5",
+			fn:   generateHashFunction("md5", md5.New),
+			v:    "hello world",
+			want: "5eb63bbbe01eeed093cb22bb8f5acdc3",
+		},
+		{
+			name: "sha1 empty",
+			fn:   generateHashFunction("sha1", sha1.New),
+			v:    "",
+			want: "da39a3ee5e6b4b0d3255bfef95601890afd80709",
+		},
+		{
+			name: "sha1",
+			fn:   generateHashFunction("sha1", sha1.New),
+			v:    "hello world",
+			want: "2aae6c35c94fcfb415dbe95f408b9ce91ee846ed",
+		},
+		{
+			name: "sha256 empty",
+			fn:   generateHashFunction("sha256", sha256.New),
+			v:    "",
+			want: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		},
+		{
+			name: "sha256",
+			fn:   generateHashFunction("sha256", sha256.New),
+			v:    "hello world",
+			want: "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9",
+		},
+		{
+			name: "sha256 multibyte",
+			fn:   generateHashFunction("sha256", sha256.New),
+			v:    "héllo",
+			want: "3c48591d8d098a4538f5e013dfcf406e948eac4d3277b10bf614e295d6068179",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			args := values.NewObjectWithValues(map[string]values.Value{"v": values.NewString(tc.v)})
+			result, err := tc.fn.Call(args)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := result.Str(); got != tc.want {
+				t.Errorf("unexpected digest of %q: want %s, got %s", tc.v, tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package json
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+	"github.com/pkg/errors"
+)
+
+const parseFuncName = "parse"
+
+func init() {
+	flux.RegisterPackageValue("json", parseFuncName, Parse())
+}
+
+// Parse returns a function value that decodes a JSON string into a Flux value.
+// See Decode for how JSON values map to Flux values.
+//
+// The type of the result is only known once the string has been decoded,
+// so the function cannot be used where a monomorphic type is required,
+// such as within the row function given to map or filter.
+func Parse() values.Value {
+	ftype := semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{"v": semantic.String},
+		Required:   semantic.LabelSet{"v"},
+		Return:     semantic.Tvar(1),
+	})
+	call := func(args values.Object) (values.Value, error) {
+		v, ok := args.Get("v")
+		if !ok {
+			return nil, errors.New("missing argument v")
+		}
+		if v.Type().Nature() != semantic.String {
+			return nil, fmt.Errorf("cannot parse argument v of type %v as JSON", v.Type().Nature())
+		}
+		return Decode([]byte(v.Str()))
+	}
+	return values.NewFunction(parseFuncName, ftype, call, false)
+}
+
+// Decode converts a JSON document into a Flux value.
+//
+// Objects become records and arrays become arrays.
+// Strings and booleans become strings and bools.
+// A number becomes an int if it is written without a fraction or exponent
+// and fits in an int64, otherwise it becomes a float.
+// A null becomes a null value of the nil type.
+//
+// Flux arrays are homogeneous, so all elements of an array must have the same type.
+// Null elements take on the type of the other elements of the array.
+// An array that mixes ints and floats becomes an array of floats.
+// Any other mix of element types is an error.
+// An empty array or an array that contains only nulls has elements of the nil type.
+func Decode(data []byte) (values.Value, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, errors.Wrap(err, "failed to parse JSON")
+	}
+	if dec.More() {
+		return nil, errors.New("failed to parse JSON: unexpected data after top-level value")
+	}
+	return convert(v, false)
+}
+
+func convert(v interface{}, forceFloat bool) (values.Value, error) {
+	switch v := v.(type) {
+	case nil:
+		return values.NewNull(semantic.Nil), nil
+	case bool:
+		return values.NewBool(v), nil
+	case string:
+		return values.NewString(v), nil
+	case json.Number:
+		if !forceFloat {
+			if i, err := v.Int64(); err == nil {
+				return values.NewInt(i), nil
+			}
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid JSON number %q", v)
+		}
+		return values.NewFloat(f), nil
+	case []interface{}:
+		return convertArray(v)
+	case map[string]interface{}:
+		obj := make(map[string]values.Value, len(v))
+		for k, e := range v {
+			ev, err := convert(e, false)
+			if err != nil {
+				return nil, err
+			}
+			obj[k] = ev
+		}
+		return values.NewObjectWithValues(obj), nil
+	default:
+		return nil, fmt.Errorf("unexpected JSON value of type %T", v)
+	}
+}
+
+func convertArray(arr []interface{}) (values.Value, error) {
+	// Mixed ints and floats are all converted to floats.
+	forceFloat := false
+	for _, e := range arr {
+		if n, ok := e.(json.Number); ok {
+			if _, err := n.Int64(); err != nil {
+				forceFloat = true
+				break
+			}
+		}
+	}
+
+	elements := make([]values.Value, len(arr))
+	var elemType semantic.Type = semantic.Nil
+	for i, e := range arr {
+		ev, err := convert(e, forceFloat)
+		if err != nil {
+			return nil, err
+		}
+		elements[i] = ev
+		if e == nil {
+			continue
+		}
+		if elemType == semantic.Nil {
+			elemType = ev.Type()
+		} else if t := ev.Type(); t != elemType {
+			return nil, fmt.Errorf("JSON array contains elements of different types %v and %v", elemType, t)
+		}
+	}
+	if elemType != semantic.Nil {
+		for i, e := range arr {
+			if e == nil {
+				elements[i] = values.NewNull(elemType)
+			}
+		}
+	}
+	return values.NewArrayWithBacking(elemType, elements), nil
+}
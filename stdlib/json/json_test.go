@@ -0,0 +1,182 @@
+package json_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/flux"
+	_ "github.com/influxdata/flux/builtin"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/stdlib/json"
+	"github.com/influxdata/flux/values"
+)
+
+func TestDecode(t *testing.T) {
+	testCases := []struct {
+		name    string
+		json    string
+		want    values.Value
+		wantErr string
+	}{
+		{
+			name: "string",
+			json: `"koala"`,
+			want: values.NewString("koala"),
+		},
+		{
+			name: "int",
+			json: `42`,
+			want: values.NewInt(42),
+		},
+		{
+			name: "float",
+			json: `-4.5`,
+			want: values.NewFloat(-4.5),
+		},
+		{
+			name: "exponent",
+			json: `1e3`,
+			want: values.NewFloat(1000),
+		},
+		{
+			name: "int overflow",
+			json: `9223372036854775808`,
+			want: values.NewFloat(9223372036854775808),
+		},
+		{
+			name: "bool",
+			json: `true`,
+			want: values.NewBool(true),
+		},
+		{
+			name: "null",
+			json: `null`,
+			want: values.NewNull(semantic.Nil),
+		},
+		{
+			name: "array",
+			json: `["a", "b"]`,
+			want: values.NewArrayWithBacking(semantic.String, []values.Value{
+				values.NewString("a"),
+				values.NewString("b"),
+			}),
+		},
+		{
+			name: "empty array",
+			json: `[]`,
+			want: values.NewArrayWithBacking(semantic.Nil, []values.Value{}),
+		},
+		{
+			name: "array with nulls",
+			json: `[null, 1, null]`,
+			want: values.NewArrayWithBacking(semantic.Int, []values.Value{
+				values.NewNull(semantic.Int),
+				values.NewInt(1),
+				values.NewNull(semantic.Int),
+			}),
+		},
+		{
+			name: "array of ints and floats",
+			json: `[1, 2.5]`,
+			want: values.NewArrayWithBacking(semantic.Float, []values.Value{
+				values.NewFloat(1),
+				values.NewFloat(2.5),
+			}),
+		},
+		{
+			name:    "heterogeneous array",
+			json:    `[1, "a"]`,
+			wantErr: "JSON array contains elements of different types int and string",
+		},
+		{
+			name: "nested",
+			json: `{"a": {"b": [{"c": 1}, {"c": 2}]}, "d": null, "e": "x"}`,
+			want: values.NewObjectWithValues(map[string]values.Value{
+				"a": values.NewObjectWithValues(map[string]values.Value{
+					"b": values.NewArrayWithBacking(semantic.NewObjectType(map[string]semantic.Type{"c": semantic.Int}), []values.Value{
+						values.NewObjectWithValues(map[string]values.Value{"c": values.NewInt(1)}),
+						values.NewObjectWithValues(map[string]values.Value{"c": values.NewInt(2)}),
+					}),
+				}),
+				"d": values.NewNull(semantic.Nil),
+				"e": values.NewString("x"),
+			}),
+		},
+		{
+			name:    "records with different fields",
+			json:    `[{"a": 1}, {"b": 1}]`,
+			wantErr: "JSON array contains elements of different types {a: int,} and {b: int,}",
+		},
+		{
+			name:    "invalid",
+			json:    `{"a": `,
+			wantErr: "failed to parse JSON: unexpected EOF",
+		},
+		{
+			name:    "trailing data",
+			json:    `1 2`,
+			wantErr: "failed to parse JSON: unexpected data after top-level value",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := json.Decode([]byte(tc.json))
+			if tc.wantErr != "" {
+				if err == nil {
+					t.Fatalf("expected error %q, got value %v", tc.wantErr, got)
+				}
+				if err.Error() != tc.wantErr {
+					t.Fatalf("unexpected error: want %q got %q", tc.wantErr, err.Error())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !values.Equal(tc.want, got) {
+				t.Errorf("unexpected value -want/+got\n\t- %v\n\t+ %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	parse := json.Parse().Function()
+	got, err := parse.Call(values.NewObjectWithValues(map[string]values.Value{
+		"v": values.NewString(`{"a": [1, 2]}`),
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := values.NewObjectWithValues(map[string]values.Value{
+		"a": values.NewArrayWithBacking(semantic.Int, []values.Value{values.NewInt(1), values.NewInt(2)}),
+	})
+	if !values.Equal(want, got) {
+		t.Errorf("unexpected value -want/+got\n\t- %v\n\t+ %v", want, got)
+	}
+}
+
+func TestParse_Flux(t *testing.T) {
+	_, scope, err := flux.Eval(`
+import "json"
+
+data = json.parse(v: "{\"a\": [1, 2], \"b\": {\"c\": \"koala\"}}")
+a = data.a[1] + 1
+c = data.b.c
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]values.Value{
+		"a": values.NewInt(3),
+		"c": values.NewString("koala"),
+	} {
+		got, ok := scope.Lookup(name)
+		if !ok {
+			t.Fatalf("missing value %q in scope", name)
+		}
+		if !values.Equal(want, got) {
+			t.Errorf("unexpected value for %q -want/+got\n\t- %v\n\t+ %v", name, want, got)
+		}
+	}
+}
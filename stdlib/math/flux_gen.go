@@ -13,19 +13,21 @@ func init() {
 
 var pkgAST = &ast.Package{
 	BaseNode: ast.BaseNode{
-		Errors: nil,
-		Loc:    nil,
+		Comments: nil,
+		Errors:   nil,
+		Loc:      nil,
 	},
 	Files: []*ast.File{&ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 2,
-					Line:   166,
+					Line:   170,
 				},
 				File:   "math.flux",
-				Source: "package math\n\n// builtin constants\nbuiltin pi\nbuiltin e\nbuiltin phi\nbuiltin sqrt2\nbuiltin sqrte\nbuiltin sqrtpi\nbuiltin sqrtphi\nbuiltin ln2\nbuiltin log2e\nbuiltin ln10\nbuiltin log10e\nbuiltin maxfloat\nbuiltin smallestNonzeroFloat\nbuiltin maxint\nbuiltin minint\nbuiltin maxuint\n\n// builtin functions\nbuiltin abs\nbuiltin acos\nbuiltin acosh\nbuiltin asin\nbuiltin asinh\nbuiltin atan\nbuiltin atan2\nbuiltin atanh\nbuiltin cbrt\nbuiltin ceil\nbuiltin copysign\nbuiltin cos\nbuiltin cosh\nbuiltin dim\nbuiltin erf\nbuiltin erfc\nbuiltin erfcinv\nbuiltin erfinv\nbuiltin exp\nbuiltin exp2\nbuiltin expm1\nbuiltin float64bits\nbuiltin float64frombits\nbuiltin floor\nbuiltin frexp\nbuiltin gamma\nbuiltin hypot\nbuiltin ilogb\nbuiltin mInf\nbuiltin isInf\nbuiltin isNaN\nbuiltin j0\nbuiltin j1\nbuiltin jn\nbuiltin ldexp\nbuiltin lgamma\nbuiltin log\nbuiltin log10\nbuiltin log1p\nbuiltin log2\nbuiltin logb\nbuiltin mMax\nbuiltin mMin\nbuiltin mod\nbuiltin modf\nbuiltin NaN\nbuiltin nextafter\nbuiltin pow\nbuiltin pow10\nbuiltin remainder\nbuiltin round\nbuiltin roundtoeven\nbuiltin signbit\nbuiltin sin\nbuiltin sincos\nbuiltin sinh\nbuiltin sqrt\nbuiltin tan\nbuiltin tanh\nbuiltin trunc\nbuiltin y0\nbuiltin y1\nbuiltin yn\n\n// hack to simulate an imported math package\nmath = {\npi:pi\ne:e\nphi:phi\nsqrt2:sqrt2\nsqrte:sqrte\nsqrtpi:sqrtpi\nsqrtphi:sqrtphi\nln2:ln2\nlog2e:log2e\nln10:ln10\nlog10e:log10e\nmaxfloat:maxfloat\nsmallestNonzeroFloat:smallestNonzeroFloat\nmaxint:maxint\nminint:minint\nmaxuint:maxuint\nabs:abs\nacos:acos\nacosh:acosh\nasin:asin\nasinh:asinh\natan:atan\natan2:atan2\natanh:atanh\ncbrt:cbrt\nceil:ceil\ncopysign:copysign\ncos:cos\ncosh:cosh\ndim:dim\nerf:erf\nerfc:erfc\nerfcinv:erfcinv\nerfinv:erfinv\nexp:exp\nexp2:exp2\nexpm1:expm1\nfloat64bits:float64bits\nfloor:floor\nfrexp:frexp\ngamma:gamma\nhypot:hypot\nilogb:ilogb\nmInf:mInf\nisInf:isInf\nisNaN:isNaN\nj0:j0\nj1:j1\njn:jn\nldexp:ldexp\nlgamma:lgamma\nlog:log\nlog10:log10\nlog1p:log1p\nlog2:log2\nlogb:logb\nmMax:mMax\nmMin:mMin\nmod:mod\nmodf:modf\nNaN:NaN\nnextafter:nextafter\npow:pow\npow10:pow10\nremainder:remainder\nround:round\nroundtoeven:roundtoeven\nsignbit:signbit\nsin:sin\nsincos:sincos\nsinh:sinh\nsqrt:sqrt\ntan:tan\ntanh:tanh\ntrunc:trunc\ny0:y0\ny1:y1\nyn:yn\n}",
+				Source: "package math\n\n// builtin constants\nbuiltin pi\nbuiltin e\nbuiltin phi\nbuiltin sqrt2\nbuiltin sqrte\nbuiltin sqrtpi\nbuiltin sqrtphi\nbuiltin ln2\nbuiltin log2e\nbuiltin ln10\nbuiltin log10e\nbuiltin maxfloat\nbuiltin smallestNonzeroFloat\nbuiltin maxint\nbuiltin minint\nbuiltin maxuint\n\n// builtin functions\nbuiltin abs\nbuiltin acos\nbuiltin acosh\nbuiltin asin\nbuiltin asinh\nbuiltin atan\nbuiltin atan2\nbuiltin atanh\nbuiltin cbrt\nbuiltin ceil\nbuiltin clamp\nbuiltin copysign\nbuiltin cos\nbuiltin cosh\nbuiltin dim\nbuiltin erf\nbuiltin erfc\nbuiltin erfcinv\nbuiltin erfinv\nbuiltin exp\nbuiltin exp2\nbuiltin expm1\nbuiltin float64bits\nbuiltin float64frombits\nbuiltin floor\nbuiltin frexp\nbuiltin gamma\nbuiltin hypot\nbuiltin ilogb\nbuiltin mInf\nbuiltin isInf\nbuiltin isNaN\nbuiltin j0\nbuiltin j1\nbuiltin jn\nbuiltin ldexp\nbuiltin lgamma\nbuiltin log\nbuiltin log10\nbuiltin log1p\nbuiltin log2\nbuiltin logb\nbuiltin mMax\nbuiltin mMin\nbuiltin mod\nbuiltin modf\nbuiltin NaN\nbuiltin nextafter\nbuiltin pow\nbuiltin pow10\nbuiltin remainder\nbuiltin round\nbuiltin roundtoeven\nbuiltin roundTo\nbuiltin signbit\nbuiltin sin\nbuiltin sincos\nbuiltin sinh\nbuiltin sqrt\nbuiltin tan\nbuiltin tanh\nbuiltin trunc\nbuiltin y0\nbuiltin y1\nbuiltin yn\n\n// hack to simulate an imported math package\nmath = {\npi:pi\ne:e\nphi:phi\nsqrt2:sqrt2\nsqrte:sqrte\nsqrtpi:sqrtpi\nsqrtphi:sqrtphi\nln2:ln2\nlog2e:log2e\nln10:ln10\nlog10e:log10e\nmaxfloat:maxfloat\nsmallestNonzeroFloat:smallestNonzeroFloat\nmaxint:maxint\nminint:minint\nmaxuint:maxuint\nabs:abs\nacos:acos\nacosh:acosh\nasin:asin\nasinh:asinh\natan:atan\natan2:atan2\natanh:atanh\ncbrt:cbrt\nceil:ceil\nclamp:clamp\ncopysign:copysign\ncos:cos\ncosh:cosh\ndim:dim\nerf:erf\nerfc:erfc\nerfcinv:erfcinv\nerfinv:erfinv\nexp:exp\nexp2:exp2\nexpm1:expm1\nfloat64bits:float64bits\nfloor:floor\nfrexp:frexp\ngamma:gamma\nhypot:hypot\nilogb:ilogb\nmInf:mInf\nisInf:isInf\nisNaN:isNaN\nj0:j0\nj1:j1\njn:jn\nldexp:ldexp\nlgamma:lgamma\nlog:log\nlog10:log10\nlog1p:log1p\nlog2:log2\nlogb:logb\nmMax:mMax\nmMin:mMin\nmod:mod\nmodf:modf\nNaN:NaN\nnextafter:nextafter\npow:pow\npow10:pow10\nremainder:remainder\nround:round\nroundtoeven:roundtoeven\nroundTo:roundTo\nsignbit:signbit\nsin:sin\nsincos:sincos\nsinh:sinh\nsqrt:sqrt\ntan:tan\ntanh:tanh\ntrunc:trunc\ny0:y0\ny1:y1\nyn:yn\n}",
 				Start: ast.Position{
 					Column: 1,
 					Line:   1,
@@ -34,6 +36,21 @@ var pkgAST = &ast.Package{
 		},
 		Body: []ast.Statement{&ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
+				Comments: []ast.Comment{ast.Comment{
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 21,
+							Line:   3,
+						},
+						File:   "math.flux",
+						Source: "",
+						Start: ast.Position{
+							Column: 1,
+							Line:   3,
+						},
+					},
+					Text: "// builtin constants",
+				}},
 				Errors: nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
@@ -50,7 +67,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 11,
@@ -68,7 +86,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 10,
@@ -84,7 +103,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 10,
@@ -102,7 +122,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 12,
@@ -118,7 +139,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 12,
@@ -136,7 +158,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
@@ -152,7 +175,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
@@ -170,7 +194,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
@@ -186,7 +211,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
@@ -204,7 +230,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 15,
@@ -220,7 +247,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 15,
@@ -238,7 +266,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 16,
@@ -254,7 +283,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 16,
@@ -272,7 +302,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 12,
@@ -288,7 +319,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 12,
@@ -306,7 +338,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
@@ -322,7 +355,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
@@ -340,7 +374,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 13,
@@ -356,7 +391,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 13,
@@ -374,7 +410,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 15,
@@ -390,7 +427,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 15,
@@ -408,7 +446,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 17,
@@ -424,7 +463,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 17,
@@ -442,7 +482,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 29,
@@ -458,7 +499,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 29,
@@ -476,7 +518,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 15,
@@ -492,7 +535,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 15,
@@ -510,7 +554,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 15,
@@ -526,7 +571,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 15,
@@ -544,7 +590,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 16,
@@ -560,7 +607,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 16,
@@ -578,6 +626,21 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
+				Comments: []ast.Comment{ast.Comment{
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 21,
+							Line:   21,
+						},
+						File:   "math.flux",
+						Source: "",
+						Start: ast.Position{
+							Column: 1,
+							Line:   21,
+						},
+					},
+					Text: "// builtin functions",
+				}},
 				Errors: nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
@@ -594,7 +657,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 12,
@@ -612,7 +676,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 13,
@@ -628,7 +693,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 13,
@@ -646,7 +712,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
@@ -662,7 +729,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
@@ -680,7 +748,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 13,
@@ -696,7 +765,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 13,
@@ -714,7 +784,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
@@ -730,7 +801,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
@@ -748,7 +820,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 13,
@@ -764,7 +837,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 13,
@@ -782,7 +856,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
@@ -798,7 +873,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
@@ -816,7 +892,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
@@ -832,7 +909,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
@@ -850,7 +928,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 13,
@@ -866,7 +945,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 13,
@@ -884,7 +964,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 13,
@@ -900,7 +981,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 13,
@@ -918,14 +1000,15 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
-						Column: 17,
+						Column: 14,
 						Line:   32,
 					},
 					File:   "math.flux",
-					Source: "builtin copysign",
+					Source: "builtin clamp",
 					Start: ast.Position{
 						Column: 1,
 						Line:   32,
@@ -934,32 +1017,34 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
-							Column: 17,
+							Column: 14,
 							Line:   32,
 						},
 						File:   "math.flux",
-						Source: "copysign",
+						Source: "clamp",
 						Start: ast.Position{
 							Column: 9,
 							Line:   32,
 						},
 					},
 				},
-				Name: "copysign",
+				Name: "clamp",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
-						Column: 12,
+						Column: 17,
 						Line:   33,
 					},
 					File:   "math.flux",
-					Source: "builtin cos",
+					Source: "builtin copysign",
 					Start: ast.Position{
 						Column: 1,
 						Line:   33,
@@ -968,32 +1053,34 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
-							Column: 12,
+							Column: 17,
 							Line:   33,
 						},
 						File:   "math.flux",
-						Source: "cos",
+						Source: "copysign",
 						Start: ast.Position{
 							Column: 9,
 							Line:   33,
 						},
 					},
 				},
-				Name: "cos",
+				Name: "copysign",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
-						Column: 13,
+						Column: 12,
 						Line:   34,
 					},
 					File:   "math.flux",
-					Source: "builtin cosh",
+					Source: "builtin cos",
 					Start: ast.Position{
 						Column: 1,
 						Line:   34,
@@ -1002,32 +1089,34 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
-							Column: 13,
+							Column: 12,
 							Line:   34,
 						},
 						File:   "math.flux",
-						Source: "cosh",
+						Source: "cos",
 						Start: ast.Position{
 							Column: 9,
 							Line:   34,
 						},
 					},
 				},
-				Name: "cosh",
+				Name: "cos",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
-						Column: 12,
+						Column: 13,
 						Line:   35,
 					},
 					File:   "math.flux",
-					Source: "builtin dim",
+					Source: "builtin cosh",
 					Start: ast.Position{
 						Column: 1,
 						Line:   35,
@@ -1036,32 +1125,34 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
-							Column: 12,
+							Column: 13,
 							Line:   35,
 						},
 						File:   "math.flux",
-						Source: "dim",
+						Source: "cosh",
 						Start: ast.Position{
 							Column: 9,
 							Line:   35,
 						},
 					},
 				},
-				Name: "dim",
+				Name: "cosh",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 12,
 						Line:   36,
 					},
 					File:   "math.flux",
-					Source: "builtin erf",
+					Source: "builtin dim",
 					Start: ast.Position{
 						Column: 1,
 						Line:   36,
@@ -1070,32 +1161,34 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 12,
 							Line:   36,
 						},
 						File:   "math.flux",
-						Source: "erf",
+						Source: "dim",
 						Start: ast.Position{
 							Column: 9,
 							Line:   36,
 						},
 					},
 				},
-				Name: "erf",
+				Name: "dim",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
-						Column: 13,
+						Column: 12,
 						Line:   37,
 					},
 					File:   "math.flux",
-					Source: "builtin erfc",
+					Source: "builtin erf",
 					Start: ast.Position{
 						Column: 1,
 						Line:   37,
@@ -1104,32 +1197,34 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
-							Column: 13,
+							Column: 12,
 							Line:   37,
 						},
 						File:   "math.flux",
-						Source: "erfc",
+						Source: "erf",
 						Start: ast.Position{
 							Column: 9,
 							Line:   37,
 						},
 					},
 				},
-				Name: "erfc",
+				Name: "erf",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
-						Column: 16,
+						Column: 13,
 						Line:   38,
 					},
 					File:   "math.flux",
-					Source: "builtin erfcinv",
+					Source: "builtin erfc",
 					Start: ast.Position{
 						Column: 1,
 						Line:   38,
@@ -1138,32 +1233,34 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
-							Column: 16,
+							Column: 13,
 							Line:   38,
 						},
 						File:   "math.flux",
-						Source: "erfcinv",
+						Source: "erfc",
 						Start: ast.Position{
 							Column: 9,
 							Line:   38,
 						},
 					},
 				},
-				Name: "erfcinv",
+				Name: "erfc",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
-						Column: 15,
+						Column: 16,
 						Line:   39,
 					},
 					File:   "math.flux",
-					Source: "builtin erfinv",
+					Source: "builtin erfcinv",
 					Start: ast.Position{
 						Column: 1,
 						Line:   39,
@@ -1172,32 +1269,34 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
-							Column: 15,
+							Column: 16,
 							Line:   39,
 						},
 						File:   "math.flux",
-						Source: "erfinv",
+						Source: "erfcinv",
 						Start: ast.Position{
 							Column: 9,
 							Line:   39,
 						},
 					},
 				},
-				Name: "erfinv",
+				Name: "erfcinv",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
-						Column: 12,
+						Column: 15,
 						Line:   40,
 					},
 					File:   "math.flux",
-					Source: "builtin exp",
+					Source: "builtin erfinv",
 					Start: ast.Position{
 						Column: 1,
 						Line:   40,
@@ -1206,32 +1305,34 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
-							Column: 12,
+							Column: 15,
 							Line:   40,
 						},
 						File:   "math.flux",
-						Source: "exp",
+						Source: "erfinv",
 						Start: ast.Position{
 							Column: 9,
 							Line:   40,
 						},
 					},
 				},
-				Name: "exp",
+				Name: "erfinv",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
-						Column: 13,
+						Column: 12,
 						Line:   41,
 					},
 					File:   "math.flux",
-					Source: "builtin exp2",
+					Source: "builtin exp",
 					Start: ast.Position{
 						Column: 1,
 						Line:   41,
@@ -1240,32 +1341,34 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
-							Column: 13,
+							Column: 12,
 							Line:   41,
 						},
 						File:   "math.flux",
-						Source: "exp2",
+						Source: "exp",
 						Start: ast.Position{
 							Column: 9,
 							Line:   41,
 						},
 					},
 				},
-				Name: "exp2",
+				Name: "exp",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
-						Column: 14,
+						Column: 13,
 						Line:   42,
 					},
 					File:   "math.flux",
-					Source: "builtin expm1",
+					Source: "builtin exp2",
 					Start: ast.Position{
 						Column: 1,
 						Line:   42,
@@ -1274,32 +1377,34 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
-							Column: 14,
+							Column: 13,
 							Line:   42,
 						},
 						File:   "math.flux",
-						Source: "expm1",
+						Source: "exp2",
 						Start: ast.Position{
 							Column: 9,
 							Line:   42,
 						},
 					},
 				},
-				Name: "expm1",
+				Name: "exp2",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
-						Column: 20,
+						Column: 14,
 						Line:   43,
 					},
 					File:   "math.flux",
-					Source: "builtin float64bits",
+					Source: "builtin expm1",
 					Start: ast.Position{
 						Column: 1,
 						Line:   43,
@@ -1308,32 +1413,34 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
-							Column: 20,
+							Column: 14,
 							Line:   43,
 						},
 						File:   "math.flux",
-						Source: "float64bits",
+						Source: "expm1",
 						Start: ast.Position{
 							Column: 9,
 							Line:   43,
 						},
 					},
 				},
-				Name: "float64bits",
+				Name: "expm1",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
-						Column: 24,
+						Column: 20,
 						Line:   44,
 					},
 					File:   "math.flux",
-					Source: "builtin float64frombits",
+					Source: "builtin float64bits",
 					Start: ast.Position{
 						Column: 1,
 						Line:   44,
@@ -1342,32 +1449,34 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
-							Column: 24,
+							Column: 20,
 							Line:   44,
 						},
 						File:   "math.flux",
-						Source: "float64frombits",
+						Source: "float64bits",
 						Start: ast.Position{
 							Column: 9,
 							Line:   44,
 						},
 					},
 				},
-				Name: "float64frombits",
+				Name: "float64bits",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
-						Column: 14,
+						Column: 24,
 						Line:   45,
 					},
 					File:   "math.flux",
-					Source: "builtin floor",
+					Source: "builtin float64frombits",
 					Start: ast.Position{
 						Column: 1,
 						Line:   45,
@@ -1376,32 +1485,34 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
-							Column: 14,
+							Column: 24,
 							Line:   45,
 						},
 						File:   "math.flux",
-						Source: "floor",
+						Source: "float64frombits",
 						Start: ast.Position{
 							Column: 9,
 							Line:   45,
 						},
 					},
 				},
-				Name: "floor",
+				Name: "float64frombits",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
 						Line:   46,
 					},
 					File:   "math.flux",
-					Source: "builtin frexp",
+					Source: "builtin floor",
 					Start: ast.Position{
 						Column: 1,
 						Line:   46,
@@ -1410,32 +1521,34 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
 							Line:   46,
 						},
 						File:   "math.flux",
-						Source: "frexp",
+						Source: "floor",
 						Start: ast.Position{
 							Column: 9,
 							Line:   46,
 						},
 					},
 				},
-				Name: "frexp",
+				Name: "floor",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
 						Line:   47,
 					},
 					File:   "math.flux",
-					Source: "builtin gamma",
+					Source: "builtin frexp",
 					Start: ast.Position{
 						Column: 1,
 						Line:   47,
@@ -1444,32 +1557,34 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
 							Line:   47,
 						},
 						File:   "math.flux",
-						Source: "gamma",
+						Source: "frexp",
 						Start: ast.Position{
 							Column: 9,
 							Line:   47,
 						},
 					},
 				},
-				Name: "gamma",
+				Name: "frexp",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
 						Line:   48,
 					},
 					File:   "math.flux",
-					Source: "builtin hypot",
+					Source: "builtin gamma",
 					Start: ast.Position{
 						Column: 1,
 						Line:   48,
@@ -1478,32 +1593,34 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
 							Line:   48,
 						},
 						File:   "math.flux",
-						Source: "hypot",
+						Source: "gamma",
 						Start: ast.Position{
 							Column: 9,
 							Line:   48,
 						},
 					},
 				},
-				Name: "hypot",
+				Name: "gamma",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
 						Line:   49,
 					},
 					File:   "math.flux",
-					Source: "builtin ilogb",
+					Source: "builtin hypot",
 					Start: ast.Position{
 						Column: 1,
 						Line:   49,
@@ -1512,32 +1629,34 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
 							Line:   49,
 						},
 						File:   "math.flux",
-						Source: "ilogb",
+						Source: "hypot",
 						Start: ast.Position{
 							Column: 9,
 							Line:   49,
 						},
 					},
 				},
-				Name: "ilogb",
+				Name: "hypot",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
-						Column: 13,
+						Column: 14,
 						Line:   50,
 					},
 					File:   "math.flux",
-					Source: "builtin mInf",
+					Source: "builtin ilogb",
 					Start: ast.Position{
 						Column: 1,
 						Line:   50,
@@ -1546,32 +1665,34 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
-							Column: 13,
+							Column: 14,
 							Line:   50,
 						},
 						File:   "math.flux",
-						Source: "mInf",
+						Source: "ilogb",
 						Start: ast.Position{
 							Column: 9,
 							Line:   50,
 						},
 					},
 				},
-				Name: "mInf",
+				Name: "ilogb",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
-						Column: 14,
+						Column: 13,
 						Line:   51,
 					},
 					File:   "math.flux",
-					Source: "builtin isInf",
+					Source: "builtin mInf",
 					Start: ast.Position{
 						Column: 1,
 						Line:   51,
@@ -1580,32 +1701,34 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
-							Column: 14,
+							Column: 13,
 							Line:   51,
 						},
 						File:   "math.flux",
-						Source: "isInf",
+						Source: "mInf",
 						Start: ast.Position{
 							Column: 9,
 							Line:   51,
 						},
 					},
 				},
-				Name: "isInf",
+				Name: "mInf",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
 						Line:   52,
 					},
 					File:   "math.flux",
-					Source: "builtin isNaN",
+					Source: "builtin isInf",
 					Start: ast.Position{
 						Column: 1,
 						Line:   52,
@@ -1614,32 +1737,34 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
 							Line:   52,
 						},
 						File:   "math.flux",
-						Source: "isNaN",
+						Source: "isInf",
 						Start: ast.Position{
 							Column: 9,
 							Line:   52,
 						},
 					},
 				},
-				Name: "isNaN",
+				Name: "isInf",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
-						Column: 11,
+						Column: 14,
 						Line:   53,
 					},
 					File:   "math.flux",
-					Source: "builtin j0",
+					Source: "builtin isNaN",
 					Start: ast.Position{
 						Column: 1,
 						Line:   53,
@@ -1648,32 +1773,34 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
-							Column: 11,
+							Column: 14,
 							Line:   53,
 						},
 						File:   "math.flux",
-						Source: "j0",
+						Source: "isNaN",
 						Start: ast.Position{
 							Column: 9,
 							Line:   53,
 						},
 					},
 				},
-				Name: "j0",
+				Name: "isNaN",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 11,
 						Line:   54,
 					},
 					File:   "math.flux",
-					Source: "builtin j1",
+					Source: "builtin j0",
 					Start: ast.Position{
 						Column: 1,
 						Line:   54,
@@ -1682,32 +1809,34 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 11,
 							Line:   54,
 						},
 						File:   "math.flux",
-						Source: "j1",
+						Source: "j0",
 						Start: ast.Position{
 							Column: 9,
 							Line:   54,
 						},
 					},
 				},
-				Name: "j1",
+				Name: "j0",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 11,
 						Line:   55,
 					},
 					File:   "math.flux",
-					Source: "builtin jn",
+					Source: "builtin j1",
 					Start: ast.Position{
 						Column: 1,
 						Line:   55,
@@ -1716,51 +1845,90 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 11,
 							Line:   55,
 						},
 						File:   "math.flux",
-						Source: "jn",
+						Source: "j1",
 						Start: ast.Position{
 							Column: 9,
 							Line:   55,
 						},
 					},
 				},
+				Name: "j1",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 11,
+						Line:   56,
+					},
+					File:   "math.flux",
+					Source: "builtin jn",
+					Start: ast.Position{
+						Column: 1,
+						Line:   56,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 11,
+							Line:   56,
+						},
+						File:   "math.flux",
+						Source: "jn",
+						Start: ast.Position{
+							Column: 9,
+							Line:   56,
+						},
+					},
+				},
 				Name: "jn",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
-						Line:   56,
+						Line:   57,
 					},
 					File:   "math.flux",
 					Source: "builtin ldexp",
 					Start: ast.Position{
 						Column: 1,
-						Line:   56,
+						Line:   57,
 					},
 				},
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
-							Line:   56,
+							Line:   57,
 						},
 						File:   "math.flux",
 						Source: "ldexp",
 						Start: ast.Position{
 							Column: 9,
-							Line:   56,
+							Line:   57,
 						},
 					},
 				},
@@ -1768,33 +1936,35 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 15,
-						Line:   57,
+						Line:   58,
 					},
 					File:   "math.flux",
 					Source: "builtin lgamma",
 					Start: ast.Position{
 						Column: 1,
-						Line:   57,
+						Line:   58,
 					},
 				},
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 15,
-							Line:   57,
+							Line:   58,
 						},
 						File:   "math.flux",
 						Source: "lgamma",
 						Start: ast.Position{
 							Column: 9,
-							Line:   57,
+							Line:   58,
 						},
 					},
 				},
@@ -1802,33 +1972,35 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 12,
-						Line:   58,
+						Line:   59,
 					},
 					File:   "math.flux",
 					Source: "builtin log",
 					Start: ast.Position{
 						Column: 1,
-						Line:   58,
+						Line:   59,
 					},
 				},
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 12,
-							Line:   58,
+							Line:   59,
 						},
 						File:   "math.flux",
 						Source: "log",
 						Start: ast.Position{
 							Column: 9,
-							Line:   58,
+							Line:   59,
 						},
 					},
 				},
@@ -1836,33 +2008,35 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
-						Line:   59,
+						Line:   60,
 					},
 					File:   "math.flux",
 					Source: "builtin log10",
 					Start: ast.Position{
 						Column: 1,
-						Line:   59,
+						Line:   60,
 					},
 				},
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
-							Line:   59,
+							Line:   60,
 						},
 						File:   "math.flux",
 						Source: "log10",
 						Start: ast.Position{
 							Column: 9,
-							Line:   59,
+							Line:   60,
 						},
 					},
 				},
@@ -1870,33 +2044,35 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
-						Line:   60,
+						Line:   61,
 					},
 					File:   "math.flux",
 					Source: "builtin log1p",
 					Start: ast.Position{
 						Column: 1,
-						Line:   60,
+						Line:   61,
 					},
 				},
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
-							Line:   60,
+							Line:   61,
 						},
 						File:   "math.flux",
 						Source: "log1p",
 						Start: ast.Position{
 							Column: 9,
-							Line:   60,
+							Line:   61,
 						},
 					},
 				},
@@ -1904,33 +2080,35 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 13,
-						Line:   61,
+						Line:   62,
 					},
 					File:   "math.flux",
 					Source: "builtin log2",
 					Start: ast.Position{
 						Column: 1,
-						Line:   61,
+						Line:   62,
 					},
 				},
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 13,
-							Line:   61,
+							Line:   62,
 						},
 						File:   "math.flux",
 						Source: "log2",
 						Start: ast.Position{
 							Column: 9,
-							Line:   61,
+							Line:   62,
 						},
 					},
 				},
@@ -1938,33 +2116,35 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 13,
-						Line:   62,
+						Line:   63,
 					},
 					File:   "math.flux",
 					Source: "builtin logb",
 					Start: ast.Position{
 						Column: 1,
-						Line:   62,
+						Line:   63,
 					},
 				},
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 13,
-							Line:   62,
+							Line:   63,
 						},
 						File:   "math.flux",
 						Source: "logb",
 						Start: ast.Position{
 							Column: 9,
-							Line:   62,
+							Line:   63,
 						},
 					},
 				},
@@ -1972,33 +2152,35 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 13,
-						Line:   63,
+						Line:   64,
 					},
 					File:   "math.flux",
 					Source: "builtin mMax",
 					Start: ast.Position{
 						Column: 1,
-						Line:   63,
+						Line:   64,
 					},
 				},
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 13,
-							Line:   63,
+							Line:   64,
 						},
 						File:   "math.flux",
 						Source: "mMax",
 						Start: ast.Position{
 							Column: 9,
-							Line:   63,
+							Line:   64,
 						},
 					},
 				},
@@ -2006,33 +2188,35 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 13,
-						Line:   64,
+						Line:   65,
 					},
 					File:   "math.flux",
 					Source: "builtin mMin",
 					Start: ast.Position{
 						Column: 1,
-						Line:   64,
+						Line:   65,
 					},
 				},
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 13,
-							Line:   64,
+							Line:   65,
 						},
 						File:   "math.flux",
 						Source: "mMin",
 						Start: ast.Position{
 							Column: 9,
-							Line:   64,
+							Line:   65,
 						},
 					},
 				},
@@ -2040,33 +2224,35 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 12,
-						Line:   65,
+						Line:   66,
 					},
 					File:   "math.flux",
 					Source: "builtin mod",
 					Start: ast.Position{
 						Column: 1,
-						Line:   65,
+						Line:   66,
 					},
 				},
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 12,
-							Line:   65,
+							Line:   66,
 						},
 						File:   "math.flux",
 						Source: "mod",
 						Start: ast.Position{
 							Column: 9,
-							Line:   65,
+							Line:   66,
 						},
 					},
 				},
@@ -2074,33 +2260,35 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 13,
-						Line:   66,
+						Line:   67,
 					},
 					File:   "math.flux",
 					Source: "builtin modf",
 					Start: ast.Position{
 						Column: 1,
-						Line:   66,
+						Line:   67,
 					},
 				},
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 13,
-							Line:   66,
+							Line:   67,
 						},
 						File:   "math.flux",
 						Source: "modf",
 						Start: ast.Position{
 							Column: 9,
-							Line:   66,
+							Line:   67,
 						},
 					},
 				},
@@ -2108,33 +2296,35 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 12,
-						Line:   67,
+						Line:   68,
 					},
 					File:   "math.flux",
 					Source: "builtin NaN",
 					Start: ast.Position{
 						Column: 1,
-						Line:   67,
+						Line:   68,
 					},
 				},
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 12,
-							Line:   67,
+							Line:   68,
 						},
 						File:   "math.flux",
 						Source: "NaN",
 						Start: ast.Position{
 							Column: 9,
-							Line:   67,
+							Line:   68,
 						},
 					},
 				},
@@ -2142,33 +2332,35 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 18,
-						Line:   68,
+						Line:   69,
 					},
 					File:   "math.flux",
 					Source: "builtin nextafter",
 					Start: ast.Position{
 						Column: 1,
-						Line:   68,
+						Line:   69,
 					},
 				},
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 18,
-							Line:   68,
+							Line:   69,
 						},
 						File:   "math.flux",
 						Source: "nextafter",
 						Start: ast.Position{
 							Column: 9,
-							Line:   68,
+							Line:   69,
 						},
 					},
 				},
@@ -2176,33 +2368,35 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 12,
-						Line:   69,
+						Line:   70,
 					},
 					File:   "math.flux",
 					Source: "builtin pow",
 					Start: ast.Position{
 						Column: 1,
-						Line:   69,
+						Line:   70,
 					},
 				},
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 12,
-							Line:   69,
+							Line:   70,
 						},
 						File:   "math.flux",
 						Source: "pow",
 						Start: ast.Position{
 							Column: 9,
-							Line:   69,
+							Line:   70,
 						},
 					},
 				},
@@ -2210,33 +2404,35 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
-						Line:   70,
+						Line:   71,
 					},
 					File:   "math.flux",
 					Source: "builtin pow10",
 					Start: ast.Position{
 						Column: 1,
-						Line:   70,
+						Line:   71,
 					},
 				},
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
-							Line:   70,
+							Line:   71,
 						},
 						File:   "math.flux",
 						Source: "pow10",
 						Start: ast.Position{
 							Column: 9,
-							Line:   70,
+							Line:   71,
 						},
 					},
 				},
@@ -2244,33 +2440,35 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 18,
-						Line:   71,
+						Line:   72,
 					},
 					File:   "math.flux",
 					Source: "builtin remainder",
 					Start: ast.Position{
 						Column: 1,
-						Line:   71,
+						Line:   72,
 					},
 				},
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 18,
-							Line:   71,
+							Line:   72,
 						},
 						File:   "math.flux",
 						Source: "remainder",
 						Start: ast.Position{
 							Column: 9,
-							Line:   71,
+							Line:   72,
 						},
 					},
 				},
@@ -2278,33 +2476,35 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
-						Line:   72,
+						Line:   73,
 					},
 					File:   "math.flux",
 					Source: "builtin round",
 					Start: ast.Position{
 						Column: 1,
-						Line:   72,
+						Line:   73,
 					},
 				},
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
-							Line:   72,
+							Line:   73,
 						},
 						File:   "math.flux",
 						Source: "round",
 						Start: ast.Position{
 							Column: 9,
-							Line:   72,
+							Line:   73,
 						},
 					},
 				},
@@ -2312,33 +2512,35 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 20,
-						Line:   73,
+						Line:   74,
 					},
 					File:   "math.flux",
 					Source: "builtin roundtoeven",
 					Start: ast.Position{
 						Column: 1,
-						Line:   73,
+						Line:   74,
 					},
 				},
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 20,
-							Line:   73,
+							Line:   74,
 						},
 						File:   "math.flux",
 						Source: "roundtoeven",
 						Start: ast.Position{
 							Column: 9,
-							Line:   73,
+							Line:   74,
 						},
 					},
 				},
@@ -2346,33 +2548,71 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 16,
-						Line:   74,
+						Line:   75,
+					},
+					File:   "math.flux",
+					Source: "builtin roundTo",
+					Start: ast.Position{
+						Column: 1,
+						Line:   75,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 16,
+							Line:   75,
+						},
+						File:   "math.flux",
+						Source: "roundTo",
+						Start: ast.Position{
+							Column: 9,
+							Line:   75,
+						},
+					},
+				},
+				Name: "roundTo",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 16,
+						Line:   76,
 					},
 					File:   "math.flux",
 					Source: "builtin signbit",
 					Start: ast.Position{
 						Column: 1,
-						Line:   74,
+						Line:   76,
 					},
 				},
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 16,
-							Line:   74,
+							Line:   76,
 						},
 						File:   "math.flux",
 						Source: "signbit",
 						Start: ast.Position{
 							Column: 9,
-							Line:   74,
+							Line:   76,
 						},
 					},
 				},
@@ -2380,33 +2620,35 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 12,
-						Line:   75,
+						Line:   77,
 					},
 					File:   "math.flux",
 					Source: "builtin sin",
 					Start: ast.Position{
 						Column: 1,
-						Line:   75,
+						Line:   77,
 					},
 				},
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 12,
-							Line:   75,
+							Line:   77,
 						},
 						File:   "math.flux",
 						Source: "sin",
 						Start: ast.Position{
 							Column: 9,
-							Line:   75,
+							Line:   77,
 						},
 					},
 				},
@@ -2414,33 +2656,35 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 15,
-						Line:   76,
+						Line:   78,
 					},
 					File:   "math.flux",
 					Source: "builtin sincos",
 					Start: ast.Position{
 						Column: 1,
-						Line:   76,
+						Line:   78,
 					},
 				},
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 15,
-							Line:   76,
+							Line:   78,
 						},
 						File:   "math.flux",
 						Source: "sincos",
 						Start: ast.Position{
 							Column: 9,
-							Line:   76,
+							Line:   78,
 						},
 					},
 				},
@@ -2448,33 +2692,35 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 13,
-						Line:   77,
+						Line:   79,
 					},
 					File:   "math.flux",
 					Source: "builtin sinh",
 					Start: ast.Position{
 						Column: 1,
-						Line:   77,
+						Line:   79,
 					},
 				},
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 13,
-							Line:   77,
+							Line:   79,
 						},
 						File:   "math.flux",
 						Source: "sinh",
 						Start: ast.Position{
 							Column: 9,
-							Line:   77,
+							Line:   79,
 						},
 					},
 				},
@@ -2482,33 +2728,35 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 13,
-						Line:   78,
+						Line:   80,
 					},
 					File:   "math.flux",
 					Source: "builtin sqrt",
 					Start: ast.Position{
 						Column: 1,
-						Line:   78,
+						Line:   80,
 					},
 				},
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 13,
-							Line:   78,
+							Line:   80,
 						},
 						File:   "math.flux",
 						Source: "sqrt",
 						Start: ast.Position{
 							Column: 9,
-							Line:   78,
+							Line:   80,
 						},
 					},
 				},
@@ -2516,33 +2764,35 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 12,
-						Line:   79,
+						Line:   81,
 					},
 					File:   "math.flux",
 					Source: "builtin tan",
 					Start: ast.Position{
 						Column: 1,
-						Line:   79,
+						Line:   81,
 					},
 				},
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 12,
-							Line:   79,
+							Line:   81,
 						},
 						File:   "math.flux",
 						Source: "tan",
 						Start: ast.Position{
 							Column: 9,
-							Line:   79,
+							Line:   81,
 						},
 					},
 				},
@@ -2550,33 +2800,35 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 13,
-						Line:   80,
+						Line:   82,
 					},
 					File:   "math.flux",
 					Source: "builtin tanh",
 					Start: ast.Position{
 						Column: 1,
-						Line:   80,
+						Line:   82,
 					},
 				},
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 13,
-							Line:   80,
+							Line:   82,
 						},
 						File:   "math.flux",
 						Source: "tanh",
 						Start: ast.Position{
 							Column: 9,
-							Line:   80,
+							Line:   82,
 						},
 					},
 				},
@@ -2584,33 +2836,35 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
-						Line:   81,
+						Line:   83,
 					},
 					File:   "math.flux",
 					Source: "builtin trunc",
 					Start: ast.Position{
 						Column: 1,
-						Line:   81,
+						Line:   83,
 					},
 				},
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
-							Line:   81,
+							Line:   83,
 						},
 						File:   "math.flux",
 						Source: "trunc",
 						Start: ast.Position{
 							Column: 9,
-							Line:   81,
+							Line:   83,
 						},
 					},
 				},
@@ -2618,33 +2872,35 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 11,
-						Line:   82,
+						Line:   84,
 					},
 					File:   "math.flux",
 					Source: "builtin y0",
 					Start: ast.Position{
 						Column: 1,
-						Line:   82,
+						Line:   84,
 					},
 				},
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 11,
-							Line:   82,
+							Line:   84,
 						},
 						File:   "math.flux",
 						Source: "y0",
 						Start: ast.Position{
 							Column: 9,
-							Line:   82,
+							Line:   84,
 						},
 					},
 				},
@@ -2652,33 +2908,35 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 11,
-						Line:   83,
+						Line:   85,
 					},
 					File:   "math.flux",
 					Source: "builtin y1",
 					Start: ast.Position{
 						Column: 1,
-						Line:   83,
+						Line:   85,
 					},
 				},
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 11,
-							Line:   83,
+							Line:   85,
 						},
 						File:   "math.flux",
 						Source: "y1",
 						Start: ast.Position{
 							Column: 9,
-							Line:   83,
+							Line:   85,
 						},
 					},
 				},
@@ -2686,33 +2944,35 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 11,
-						Line:   84,
+						Line:   86,
 					},
 					File:   "math.flux",
 					Source: "builtin yn",
 					Start: ast.Position{
 						Column: 1,
-						Line:   84,
+						Line:   86,
 					},
 				},
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 11,
-							Line:   84,
+							Line:   86,
 						},
 						File:   "math.flux",
 						Source: "yn",
 						Start: ast.Position{
 							Column: 9,
-							Line:   84,
+							Line:   86,
 						},
 					},
 				},
@@ -2720,33 +2980,49 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
+				Comments: []ast.Comment{ast.Comment{
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 45,
+							Line:   88,
+						},
+						File:   "math.flux",
+						Source: "",
+						Start: ast.Position{
+							Column: 1,
+							Line:   88,
+						},
+					},
+					Text: "// hack to simulate an imported math package",
+				}},
 				Errors: nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
-						Line:   166,
+						Line:   170,
 					},
 					File:   "math.flux",
-					Source: "math = {\npi:pi\ne:e\nphi:phi\nsqrt2:sqrt2\nsqrte:sqrte\nsqrtpi:sqrtpi\nsqrtphi:sqrtphi\nln2:ln2\nlog2e:log2e\nln10:ln10\nlog10e:log10e\nmaxfloat:maxfloat\nsmallestNonzeroFloat:smallestNonzeroFloat\nmaxint:maxint\nminint:minint\nmaxuint:maxuint\nabs:abs\nacos:acos\nacosh:acosh\nasin:asin\nasinh:asinh\natan:atan\natan2:atan2\natanh:atanh\ncbrt:cbrt\nceil:ceil\ncopysign:copysign\ncos:cos\ncosh:cosh\ndim:dim\nerf:erf\nerfc:erfc\nerfcinv:erfcinv\nerfinv:erfinv\nexp:exp\nexp2:exp2\nexpm1:expm1\nfloat64bits:float64bits\nfloor:floor\nfrexp:frexp\ngamma:gamma\nhypot:hypot\nilogb:ilogb\nmInf:mInf\nisInf:isInf\nisNaN:isNaN\nj0:j0\nj1:j1\njn:jn\nldexp:ldexp\nlgamma:lgamma\nlog:log\nlog10:log10\nlog1p:log1p\nlog2:log2\nlogb:logb\nmMax:mMax\nmMin:mMin\nmod:mod\nmodf:modf\nNaN:NaN\nnextafter:nextafter\npow:pow\npow10:pow10\nremainder:remainder\nround:round\nroundtoeven:roundtoeven\nsignbit:signbit\nsin:sin\nsincos:sincos\nsinh:sinh\nsqrt:sqrt\ntan:tan\ntanh:tanh\ntrunc:trunc\ny0:y0\ny1:y1\nyn:yn\n}",
+					Source: "math = {\npi:pi\ne:e\nphi:phi\nsqrt2:sqrt2\nsqrte:sqrte\nsqrtpi:sqrtpi\nsqrtphi:sqrtphi\nln2:ln2\nlog2e:log2e\nln10:ln10\nlog10e:log10e\nmaxfloat:maxfloat\nsmallestNonzeroFloat:smallestNonzeroFloat\nmaxint:maxint\nminint:minint\nmaxuint:maxuint\nabs:abs\nacos:acos\nacosh:acosh\nasin:asin\nasinh:asinh\natan:atan\natan2:atan2\natanh:atanh\ncbrt:cbrt\nceil:ceil\nclamp:clamp\ncopysign:copysign\ncos:cos\ncosh:cosh\ndim:dim\nerf:erf\nerfc:erfc\nerfcinv:erfcinv\nerfinv:erfinv\nexp:exp\nexp2:exp2\nexpm1:expm1\nfloat64bits:float64bits\nfloor:floor\nfrexp:frexp\ngamma:gamma\nhypot:hypot\nilogb:ilogb\nmInf:mInf\nisInf:isInf\nisNaN:isNaN\nj0:j0\nj1:j1\njn:jn\nldexp:ldexp\nlgamma:lgamma\nlog:log\nlog10:log10\nlog1p:log1p\nlog2:log2\nlogb:logb\nmMax:mMax\nmMin:mMin\nmod:mod\nmodf:modf\nNaN:NaN\nnextafter:nextafter\npow:pow\npow10:pow10\nremainder:remainder\nround:round\nroundtoeven:roundtoeven\nroundTo:roundTo\nsignbit:signbit\nsin:sin\nsincos:sincos\nsinh:sinh\nsqrt:sqrt\ntan:tan\ntanh:tanh\ntrunc:trunc\ny0:y0\ny1:y1\nyn:yn\n}",
 					Start: ast.Position{
 						Column: 1,
-						Line:   87,
+						Line:   89,
 					},
 				},
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 5,
-							Line:   87,
+							Line:   89,
 						},
 						File:   "math.flux",
 						Source: "math",
 						Start: ast.Position{
 							Column: 1,
-							Line:   87,
+							Line:   89,
 						},
 					},
 				},
@@ -2754,49 +3030,52 @@ var pkgAST = &ast.Package{
 			},
 			Init: &ast.ObjectExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
-							Line:   166,
+							Line:   170,
 						},
 						File:   "math.flux",
-						Source: "{\npi:pi\ne:e\nphi:phi\nsqrt2:sqrt2\nsqrte:sqrte\nsqrtpi:sqrtpi\nsqrtphi:sqrtphi\nln2:ln2\nlog2e:log2e\nln10:ln10\nlog10e:log10e\nmaxfloat:maxfloat\nsmallestNonzeroFloat:smallestNonzeroFloat\nmaxint:maxint\nminint:minint\nmaxuint:maxuint\nabs:abs\nacos:acos\nacosh:acosh\nasin:asin\nasinh:asinh\natan:atan\natan2:atan2\natanh:atanh\ncbrt:cbrt\nceil:ceil\ncopysign:copysign\ncos:cos\ncosh:cosh\ndim:dim\nerf:erf\nerfc:erfc\nerfcinv:erfcinv\nerfinv:erfinv\nexp:exp\nexp2:exp2\nexpm1:expm1\nfloat64bits:float64bits\nfloor:floor\nfrexp:frexp\ngamma:gamma\nhypot:hypot\nilogb:ilogb\nmInf:mInf\nisInf:isInf\nisNaN:isNaN\nj0:j0\nj1:j1\njn:jn\nldexp:ldexp\nlgamma:lgamma\nlog:log\nlog10:log10\nlog1p:log1p\nlog2:log2\nlogb:logb\nmMax:mMax\nmMin:mMin\nmod:mod\nmodf:modf\nNaN:NaN\nnextafter:nextafter\npow:pow\npow10:pow10\nremainder:remainder\nround:round\nroundtoeven:roundtoeven\nsignbit:signbit\nsin:sin\nsincos:sincos\nsinh:sinh\nsqrt:sqrt\ntan:tan\ntanh:tanh\ntrunc:trunc\ny0:y0\ny1:y1\nyn:yn\n}",
+						Source: "{\npi:pi\ne:e\nphi:phi\nsqrt2:sqrt2\nsqrte:sqrte\nsqrtpi:sqrtpi\nsqrtphi:sqrtphi\nln2:ln2\nlog2e:log2e\nln10:ln10\nlog10e:log10e\nmaxfloat:maxfloat\nsmallestNonzeroFloat:smallestNonzeroFloat\nmaxint:maxint\nminint:minint\nmaxuint:maxuint\nabs:abs\nacos:acos\nacosh:acosh\nasin:asin\nasinh:asinh\natan:atan\natan2:atan2\natanh:atanh\ncbrt:cbrt\nceil:ceil\nclamp:clamp\ncopysign:copysign\ncos:cos\ncosh:cosh\ndim:dim\nerf:erf\nerfc:erfc\nerfcinv:erfcinv\nerfinv:erfinv\nexp:exp\nexp2:exp2\nexpm1:expm1\nfloat64bits:float64bits\nfloor:floor\nfrexp:frexp\ngamma:gamma\nhypot:hypot\nilogb:ilogb\nmInf:mInf\nisInf:isInf\nisNaN:isNaN\nj0:j0\nj1:j1\njn:jn\nldexp:ldexp\nlgamma:lgamma\nlog:log\nlog10:log10\nlog1p:log1p\nlog2:log2\nlogb:logb\nmMax:mMax\nmMin:mMin\nmod:mod\nmodf:modf\nNaN:NaN\nnextafter:nextafter\npow:pow\npow10:pow10\nremainder:remainder\nround:round\nroundtoeven:roundtoeven\nroundTo:roundTo\nsignbit:signbit\nsin:sin\nsincos:sincos\nsinh:sinh\nsqrt:sqrt\ntan:tan\ntanh:tanh\ntrunc:trunc\ny0:y0\ny1:y1\nyn:yn\n}",
 						Start: ast.Position{
 							Column: 8,
-							Line:   87,
+							Line:   89,
 						},
 					},
 				},
 				Properties: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 6,
-								Line:   88,
+								Line:   90,
 							},
 							File:   "math.flux",
 							Source: "pi:pi",
 							Start: ast.Position{
 								Column: 1,
-								Line:   88,
+								Line:   90,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 3,
-									Line:   88,
+									Line:   90,
 								},
 								File:   "math.flux",
 								Source: "pi",
 								Start: ast.Position{
 									Column: 1,
-									Line:   88,
+									Line:   90,
 								},
 							},
 						},
@@ -2804,17 +3083,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
-									Line:   88,
+									Line:   90,
 								},
 								File:   "math.flux",
 								Source: "pi",
 								Start: ast.Position{
 									Column: 4,
-									Line:   88,
+									Line:   90,
 								},
 							},
 						},
@@ -2822,33 +3102,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 4,
-								Line:   89,
+								Line:   91,
 							},
 							File:   "math.flux",
 							Source: "e:e",
 							Start: ast.Position{
 								Column: 1,
-								Line:   89,
+								Line:   91,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 2,
-									Line:   89,
+									Line:   91,
 								},
 								File:   "math.flux",
 								Source: "e",
 								Start: ast.Position{
 									Column: 1,
-									Line:   89,
+									Line:   91,
 								},
 							},
 						},
@@ -2856,17 +3138,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 4,
-									Line:   89,
+									Line:   91,
 								},
 								File:   "math.flux",
 								Source: "e",
 								Start: ast.Position{
 									Column: 3,
-									Line:   89,
+									Line:   91,
 								},
 							},
 						},
@@ -2874,33 +3157,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 8,
-								Line:   90,
+								Line:   92,
 							},
 							File:   "math.flux",
 							Source: "phi:phi",
 							Start: ast.Position{
 								Column: 1,
-								Line:   90,
+								Line:   92,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 4,
-									Line:   90,
+									Line:   92,
 								},
 								File:   "math.flux",
 								Source: "phi",
 								Start: ast.Position{
 									Column: 1,
-									Line:   90,
+									Line:   92,
 								},
 							},
 						},
@@ -2908,17 +3193,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 8,
-									Line:   90,
+									Line:   92,
 								},
 								File:   "math.flux",
 								Source: "phi",
 								Start: ast.Position{
 									Column: 5,
-									Line:   90,
+									Line:   92,
 								},
 							},
 						},
@@ -2926,33 +3212,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 12,
-								Line:   91,
+								Line:   93,
 							},
 							File:   "math.flux",
 							Source: "sqrt2:sqrt2",
 							Start: ast.Position{
 								Column: 1,
-								Line:   91,
+								Line:   93,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
-									Line:   91,
+									Line:   93,
 								},
 								File:   "math.flux",
 								Source: "sqrt2",
 								Start: ast.Position{
 									Column: 1,
-									Line:   91,
+									Line:   93,
 								},
 							},
 						},
@@ -2960,17 +3248,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 12,
-									Line:   91,
+									Line:   93,
 								},
 								File:   "math.flux",
 								Source: "sqrt2",
 								Start: ast.Position{
 									Column: 7,
-									Line:   91,
+									Line:   93,
 								},
 							},
 						},
@@ -2978,33 +3267,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 12,
-								Line:   92,
+								Line:   94,
 							},
 							File:   "math.flux",
 							Source: "sqrte:sqrte",
 							Start: ast.Position{
 								Column: 1,
-								Line:   92,
+								Line:   94,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
-									Line:   92,
+									Line:   94,
 								},
 								File:   "math.flux",
 								Source: "sqrte",
 								Start: ast.Position{
 									Column: 1,
-									Line:   92,
+									Line:   94,
 								},
 							},
 						},
@@ -3012,17 +3303,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 12,
-									Line:   92,
+									Line:   94,
 								},
 								File:   "math.flux",
 								Source: "sqrte",
 								Start: ast.Position{
 									Column: 7,
-									Line:   92,
+									Line:   94,
 								},
 							},
 						},
@@ -3030,33 +3322,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 14,
-								Line:   93,
+								Line:   95,
 							},
 							File:   "math.flux",
 							Source: "sqrtpi:sqrtpi",
 							Start: ast.Position{
 								Column: 1,
-								Line:   93,
+								Line:   95,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 7,
-									Line:   93,
+									Line:   95,
 								},
 								File:   "math.flux",
 								Source: "sqrtpi",
 								Start: ast.Position{
 									Column: 1,
-									Line:   93,
+									Line:   95,
 								},
 							},
 						},
@@ -3064,17 +3358,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 14,
-									Line:   93,
+									Line:   95,
 								},
 								File:   "math.flux",
 								Source: "sqrtpi",
 								Start: ast.Position{
 									Column: 8,
-									Line:   93,
+									Line:   95,
 								},
 							},
 						},
@@ -3082,33 +3377,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 16,
-								Line:   94,
+								Line:   96,
 							},
 							File:   "math.flux",
 							Source: "sqrtphi:sqrtphi",
 							Start: ast.Position{
 								Column: 1,
-								Line:   94,
+								Line:   96,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 8,
-									Line:   94,
+									Line:   96,
 								},
 								File:   "math.flux",
 								Source: "sqrtphi",
 								Start: ast.Position{
 									Column: 1,
-									Line:   94,
+									Line:   96,
 								},
 							},
 						},
@@ -3116,17 +3413,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 16,
-									Line:   94,
+									Line:   96,
 								},
 								File:   "math.flux",
 								Source: "sqrtphi",
 								Start: ast.Position{
 									Column: 9,
-									Line:   94,
+									Line:   96,
 								},
 							},
 						},
@@ -3134,33 +3432,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 8,
-								Line:   95,
+								Line:   97,
 							},
 							File:   "math.flux",
 							Source: "ln2:ln2",
 							Start: ast.Position{
 								Column: 1,
-								Line:   95,
+								Line:   97,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 4,
-									Line:   95,
+									Line:   97,
 								},
 								File:   "math.flux",
 								Source: "ln2",
 								Start: ast.Position{
 									Column: 1,
-									Line:   95,
+									Line:   97,
 								},
 							},
 						},
@@ -3168,17 +3468,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 8,
-									Line:   95,
+									Line:   97,
 								},
 								File:   "math.flux",
 								Source: "ln2",
 								Start: ast.Position{
 									Column: 5,
-									Line:   95,
+									Line:   97,
 								},
 							},
 						},
@@ -3186,33 +3487,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 12,
-								Line:   96,
+								Line:   98,
 							},
 							File:   "math.flux",
 							Source: "log2e:log2e",
 							Start: ast.Position{
 								Column: 1,
-								Line:   96,
+								Line:   98,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
-									Line:   96,
+									Line:   98,
 								},
 								File:   "math.flux",
 								Source: "log2e",
 								Start: ast.Position{
 									Column: 1,
-									Line:   96,
+									Line:   98,
 								},
 							},
 						},
@@ -3220,17 +3523,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 12,
-									Line:   96,
+									Line:   98,
 								},
 								File:   "math.flux",
 								Source: "log2e",
 								Start: ast.Position{
 									Column: 7,
-									Line:   96,
+									Line:   98,
 								},
 							},
 						},
@@ -3238,33 +3542,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 10,
-								Line:   97,
+								Line:   99,
 							},
 							File:   "math.flux",
 							Source: "ln10:ln10",
 							Start: ast.Position{
 								Column: 1,
-								Line:   97,
+								Line:   99,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 5,
-									Line:   97,
+									Line:   99,
 								},
 								File:   "math.flux",
 								Source: "ln10",
 								Start: ast.Position{
 									Column: 1,
-									Line:   97,
+									Line:   99,
 								},
 							},
 						},
@@ -3272,17 +3578,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 10,
-									Line:   97,
+									Line:   99,
 								},
 								File:   "math.flux",
 								Source: "ln10",
 								Start: ast.Position{
 									Column: 6,
-									Line:   97,
+									Line:   99,
 								},
 							},
 						},
@@ -3290,33 +3597,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 14,
-								Line:   98,
+								Line:   100,
 							},
 							File:   "math.flux",
 							Source: "log10e:log10e",
 							Start: ast.Position{
 								Column: 1,
-								Line:   98,
+								Line:   100,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 7,
-									Line:   98,
+									Line:   100,
 								},
 								File:   "math.flux",
 								Source: "log10e",
 								Start: ast.Position{
 									Column: 1,
-									Line:   98,
+									Line:   100,
 								},
 							},
 						},
@@ -3324,17 +3633,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 14,
-									Line:   98,
+									Line:   100,
 								},
 								File:   "math.flux",
 								Source: "log10e",
 								Start: ast.Position{
 									Column: 8,
-									Line:   98,
+									Line:   100,
 								},
 							},
 						},
@@ -3342,33 +3652,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 18,
-								Line:   99,
+								Line:   101,
 							},
 							File:   "math.flux",
 							Source: "maxfloat:maxfloat",
 							Start: ast.Position{
 								Column: 1,
-								Line:   99,
+								Line:   101,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 9,
-									Line:   99,
+									Line:   101,
 								},
 								File:   "math.flux",
 								Source: "maxfloat",
 								Start: ast.Position{
 									Column: 1,
-									Line:   99,
+									Line:   101,
 								},
 							},
 						},
@@ -3376,17 +3688,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 18,
-									Line:   99,
+									Line:   101,
 								},
 								File:   "math.flux",
 								Source: "maxfloat",
 								Start: ast.Position{
 									Column: 10,
-									Line:   99,
+									Line:   101,
 								},
 							},
 						},
@@ -3394,33 +3707,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 42,
-								Line:   100,
+								Line:   102,
 							},
 							File:   "math.flux",
 							Source: "smallestNonzeroFloat:smallestNonzeroFloat",
 							Start: ast.Position{
 								Column: 1,
-								Line:   100,
+								Line:   102,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 21,
-									Line:   100,
+									Line:   102,
 								},
 								File:   "math.flux",
 								Source: "smallestNonzeroFloat",
 								Start: ast.Position{
 									Column: 1,
-									Line:   100,
+									Line:   102,
 								},
 							},
 						},
@@ -3428,17 +3743,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 42,
-									Line:   100,
+									Line:   102,
 								},
 								File:   "math.flux",
 								Source: "smallestNonzeroFloat",
 								Start: ast.Position{
 									Column: 22,
-									Line:   100,
+									Line:   102,
 								},
 							},
 						},
@@ -3446,33 +3762,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 14,
-								Line:   101,
+								Line:   103,
 							},
 							File:   "math.flux",
 							Source: "maxint:maxint",
 							Start: ast.Position{
 								Column: 1,
-								Line:   101,
+								Line:   103,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 7,
-									Line:   101,
+									Line:   103,
 								},
 								File:   "math.flux",
 								Source: "maxint",
 								Start: ast.Position{
 									Column: 1,
-									Line:   101,
+									Line:   103,
 								},
 							},
 						},
@@ -3480,17 +3798,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 14,
-									Line:   101,
+									Line:   103,
 								},
 								File:   "math.flux",
 								Source: "maxint",
 								Start: ast.Position{
 									Column: 8,
-									Line:   101,
+									Line:   103,
 								},
 							},
 						},
@@ -3498,33 +3817,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 14,
-								Line:   102,
+								Line:   104,
 							},
 							File:   "math.flux",
 							Source: "minint:minint",
 							Start: ast.Position{
 								Column: 1,
-								Line:   102,
+								Line:   104,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 7,
-									Line:   102,
+									Line:   104,
 								},
 								File:   "math.flux",
 								Source: "minint",
 								Start: ast.Position{
 									Column: 1,
-									Line:   102,
+									Line:   104,
 								},
 							},
 						},
@@ -3532,17 +3853,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 14,
-									Line:   102,
+									Line:   104,
 								},
 								File:   "math.flux",
 								Source: "minint",
 								Start: ast.Position{
 									Column: 8,
-									Line:   102,
+									Line:   104,
 								},
 							},
 						},
@@ -3550,33 +3872,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 16,
-								Line:   103,
+								Line:   105,
 							},
 							File:   "math.flux",
 							Source: "maxuint:maxuint",
 							Start: ast.Position{
 								Column: 1,
-								Line:   103,
+								Line:   105,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 8,
-									Line:   103,
+									Line:   105,
 								},
 								File:   "math.flux",
 								Source: "maxuint",
 								Start: ast.Position{
 									Column: 1,
-									Line:   103,
+									Line:   105,
 								},
 							},
 						},
@@ -3584,17 +3908,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 16,
-									Line:   103,
+									Line:   105,
 								},
 								File:   "math.flux",
 								Source: "maxuint",
 								Start: ast.Position{
 									Column: 9,
-									Line:   103,
+									Line:   105,
 								},
 							},
 						},
@@ -3602,33 +3927,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 8,
-								Line:   104,
+								Line:   106,
 							},
 							File:   "math.flux",
 							Source: "abs:abs",
 							Start: ast.Position{
 								Column: 1,
-								Line:   104,
+								Line:   106,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 4,
-									Line:   104,
+									Line:   106,
 								},
 								File:   "math.flux",
 								Source: "abs",
 								Start: ast.Position{
 									Column: 1,
-									Line:   104,
+									Line:   106,
 								},
 							},
 						},
@@ -3636,17 +3963,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 8,
-									Line:   104,
+									Line:   106,
 								},
 								File:   "math.flux",
 								Source: "abs",
 								Start: ast.Position{
 									Column: 5,
-									Line:   104,
+									Line:   106,
 								},
 							},
 						},
@@ -3654,33 +3982,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 10,
-								Line:   105,
+								Line:   107,
 							},
 							File:   "math.flux",
 							Source: "acos:acos",
 							Start: ast.Position{
 								Column: 1,
-								Line:   105,
+								Line:   107,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 5,
-									Line:   105,
+									Line:   107,
 								},
 								File:   "math.flux",
 								Source: "acos",
 								Start: ast.Position{
 									Column: 1,
-									Line:   105,
+									Line:   107,
 								},
 							},
 						},
@@ -3688,17 +4018,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 10,
-									Line:   105,
+									Line:   107,
 								},
 								File:   "math.flux",
 								Source: "acos",
 								Start: ast.Position{
 									Column: 6,
-									Line:   105,
+									Line:   107,
 								},
 							},
 						},
@@ -3706,33 +4037,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 12,
-								Line:   106,
+								Line:   108,
 							},
 							File:   "math.flux",
 							Source: "acosh:acosh",
 							Start: ast.Position{
 								Column: 1,
-								Line:   106,
+								Line:   108,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
-									Line:   106,
+									Line:   108,
 								},
 								File:   "math.flux",
 								Source: "acosh",
 								Start: ast.Position{
 									Column: 1,
-									Line:   106,
+									Line:   108,
 								},
 							},
 						},
@@ -3740,17 +4073,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 12,
-									Line:   106,
+									Line:   108,
 								},
 								File:   "math.flux",
 								Source: "acosh",
 								Start: ast.Position{
 									Column: 7,
-									Line:   106,
+									Line:   108,
 								},
 							},
 						},
@@ -3758,33 +4092,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 10,
-								Line:   107,
+								Line:   109,
 							},
 							File:   "math.flux",
 							Source: "asin:asin",
 							Start: ast.Position{
 								Column: 1,
-								Line:   107,
+								Line:   109,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 5,
-									Line:   107,
+									Line:   109,
 								},
 								File:   "math.flux",
 								Source: "asin",
 								Start: ast.Position{
 									Column: 1,
-									Line:   107,
+									Line:   109,
 								},
 							},
 						},
@@ -3792,17 +4128,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 10,
-									Line:   107,
+									Line:   109,
 								},
 								File:   "math.flux",
 								Source: "asin",
 								Start: ast.Position{
 									Column: 6,
-									Line:   107,
+									Line:   109,
 								},
 							},
 						},
@@ -3810,33 +4147,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 12,
-								Line:   108,
+								Line:   110,
 							},
 							File:   "math.flux",
 							Source: "asinh:asinh",
 							Start: ast.Position{
 								Column: 1,
-								Line:   108,
+								Line:   110,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
-									Line:   108,
+									Line:   110,
 								},
 								File:   "math.flux",
 								Source: "asinh",
 								Start: ast.Position{
 									Column: 1,
-									Line:   108,
+									Line:   110,
 								},
 							},
 						},
@@ -3844,17 +4183,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 12,
-									Line:   108,
+									Line:   110,
 								},
 								File:   "math.flux",
 								Source: "asinh",
 								Start: ast.Position{
 									Column: 7,
-									Line:   108,
+									Line:   110,
 								},
 							},
 						},
@@ -3862,33 +4202,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 10,
-								Line:   109,
+								Line:   111,
 							},
 							File:   "math.flux",
 							Source: "atan:atan",
 							Start: ast.Position{
 								Column: 1,
-								Line:   109,
+								Line:   111,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 5,
-									Line:   109,
+									Line:   111,
 								},
 								File:   "math.flux",
 								Source: "atan",
 								Start: ast.Position{
 									Column: 1,
-									Line:   109,
+									Line:   111,
 								},
 							},
 						},
@@ -3896,17 +4238,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 10,
-									Line:   109,
+									Line:   111,
 								},
 								File:   "math.flux",
 								Source: "atan",
 								Start: ast.Position{
 									Column: 6,
-									Line:   109,
+									Line:   111,
 								},
 							},
 						},
@@ -3914,33 +4257,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 12,
-								Line:   110,
+								Line:   112,
 							},
 							File:   "math.flux",
 							Source: "atan2:atan2",
 							Start: ast.Position{
 								Column: 1,
-								Line:   110,
+								Line:   112,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
-									Line:   110,
+									Line:   112,
 								},
 								File:   "math.flux",
 								Source: "atan2",
 								Start: ast.Position{
 									Column: 1,
-									Line:   110,
+									Line:   112,
 								},
 							},
 						},
@@ -3948,17 +4293,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 12,
-									Line:   110,
+									Line:   112,
 								},
 								File:   "math.flux",
 								Source: "atan2",
 								Start: ast.Position{
 									Column: 7,
-									Line:   110,
+									Line:   112,
 								},
 							},
 						},
@@ -3966,33 +4312,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 12,
-								Line:   111,
+								Line:   113,
 							},
 							File:   "math.flux",
 							Source: "atanh:atanh",
 							Start: ast.Position{
 								Column: 1,
-								Line:   111,
+								Line:   113,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
-									Line:   111,
+									Line:   113,
 								},
 								File:   "math.flux",
 								Source: "atanh",
 								Start: ast.Position{
 									Column: 1,
-									Line:   111,
+									Line:   113,
 								},
 							},
 						},
@@ -4000,17 +4348,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 12,
-									Line:   111,
+									Line:   113,
 								},
 								File:   "math.flux",
 								Source: "atanh",
 								Start: ast.Position{
 									Column: 7,
-									Line:   111,
+									Line:   113,
 								},
 							},
 						},
@@ -4018,33 +4367,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 10,
-								Line:   112,
+								Line:   114,
 							},
 							File:   "math.flux",
 							Source: "cbrt:cbrt",
 							Start: ast.Position{
 								Column: 1,
-								Line:   112,
+								Line:   114,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 5,
-									Line:   112,
+									Line:   114,
 								},
 								File:   "math.flux",
 								Source: "cbrt",
 								Start: ast.Position{
 									Column: 1,
-									Line:   112,
+									Line:   114,
 								},
 							},
 						},
@@ -4052,17 +4403,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 10,
-									Line:   112,
+									Line:   114,
 								},
 								File:   "math.flux",
 								Source: "cbrt",
 								Start: ast.Position{
 									Column: 6,
-									Line:   112,
+									Line:   114,
 								},
 							},
 						},
@@ -4070,33 +4422,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 10,
-								Line:   113,
+								Line:   115,
 							},
 							File:   "math.flux",
 							Source: "ceil:ceil",
 							Start: ast.Position{
 								Column: 1,
-								Line:   113,
+								Line:   115,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 5,
-									Line:   113,
+									Line:   115,
 								},
 								File:   "math.flux",
 								Source: "ceil",
 								Start: ast.Position{
 									Column: 1,
-									Line:   113,
+									Line:   115,
 								},
 							},
 						},
@@ -4104,17 +4458,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 10,
-									Line:   113,
+									Line:   115,
 								},
 								File:   "math.flux",
 								Source: "ceil",
 								Start: ast.Position{
 									Column: 6,
-									Line:   113,
+									Line:   115,
 								},
 							},
 						},
@@ -4122,33 +4477,90 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 12,
+								Line:   116,
+							},
+							File:   "math.flux",
+							Source: "clamp:clamp",
+							Start: ast.Position{
+								Column: 1,
+								Line:   116,
+							},
+						},
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 6,
+									Line:   116,
+								},
+								File:   "math.flux",
+								Source: "clamp",
+								Start: ast.Position{
+									Column: 1,
+									Line:   116,
+								},
+							},
+						},
+						Name: "clamp",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 12,
+									Line:   116,
+								},
+								File:   "math.flux",
+								Source: "clamp",
+								Start: ast.Position{
+									Column: 7,
+									Line:   116,
+								},
+							},
+						},
+						Name: "clamp",
+					},
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 18,
-								Line:   114,
+								Line:   117,
 							},
 							File:   "math.flux",
 							Source: "copysign:copysign",
 							Start: ast.Position{
 								Column: 1,
-								Line:   114,
+								Line:   117,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 9,
-									Line:   114,
+									Line:   117,
 								},
 								File:   "math.flux",
 								Source: "copysign",
 								Start: ast.Position{
 									Column: 1,
-									Line:   114,
+									Line:   117,
 								},
 							},
 						},
@@ -4156,17 +4568,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 18,
-									Line:   114,
+									Line:   117,
 								},
 								File:   "math.flux",
 								Source: "copysign",
 								Start: ast.Position{
 									Column: 10,
-									Line:   114,
+									Line:   117,
 								},
 							},
 						},
@@ -4174,33 +4587,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 8,
-								Line:   115,
+								Line:   118,
 							},
 							File:   "math.flux",
 							Source: "cos:cos",
 							Start: ast.Position{
 								Column: 1,
-								Line:   115,
+								Line:   118,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 4,
-									Line:   115,
+									Line:   118,
 								},
 								File:   "math.flux",
 								Source: "cos",
 								Start: ast.Position{
 									Column: 1,
-									Line:   115,
+									Line:   118,
 								},
 							},
 						},
@@ -4208,17 +4623,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 8,
-									Line:   115,
+									Line:   118,
 								},
 								File:   "math.flux",
 								Source: "cos",
 								Start: ast.Position{
 									Column: 5,
-									Line:   115,
+									Line:   118,
 								},
 							},
 						},
@@ -4226,33 +4642,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 10,
-								Line:   116,
+								Line:   119,
 							},
 							File:   "math.flux",
 							Source: "cosh:cosh",
 							Start: ast.Position{
 								Column: 1,
-								Line:   116,
+								Line:   119,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 5,
-									Line:   116,
+									Line:   119,
 								},
 								File:   "math.flux",
 								Source: "cosh",
 								Start: ast.Position{
 									Column: 1,
-									Line:   116,
+									Line:   119,
 								},
 							},
 						},
@@ -4260,17 +4678,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 10,
-									Line:   116,
+									Line:   119,
 								},
 								File:   "math.flux",
 								Source: "cosh",
 								Start: ast.Position{
 									Column: 6,
-									Line:   116,
+									Line:   119,
 								},
 							},
 						},
@@ -4278,33 +4697,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 8,
-								Line:   117,
+								Line:   120,
 							},
 							File:   "math.flux",
 							Source: "dim:dim",
 							Start: ast.Position{
 								Column: 1,
-								Line:   117,
+								Line:   120,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 4,
-									Line:   117,
+									Line:   120,
 								},
 								File:   "math.flux",
 								Source: "dim",
 								Start: ast.Position{
 									Column: 1,
-									Line:   117,
+									Line:   120,
 								},
 							},
 						},
@@ -4312,17 +4733,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 8,
-									Line:   117,
+									Line:   120,
 								},
 								File:   "math.flux",
 								Source: "dim",
 								Start: ast.Position{
 									Column: 5,
-									Line:   117,
+									Line:   120,
 								},
 							},
 						},
@@ -4330,33 +4752,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 8,
-								Line:   118,
+								Line:   121,
 							},
 							File:   "math.flux",
 							Source: "erf:erf",
 							Start: ast.Position{
 								Column: 1,
-								Line:   118,
+								Line:   121,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 4,
-									Line:   118,
+									Line:   121,
 								},
 								File:   "math.flux",
 								Source: "erf",
 								Start: ast.Position{
 									Column: 1,
-									Line:   118,
+									Line:   121,
 								},
 							},
 						},
@@ -4364,17 +4788,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 8,
-									Line:   118,
+									Line:   121,
 								},
 								File:   "math.flux",
 								Source: "erf",
 								Start: ast.Position{
 									Column: 5,
-									Line:   118,
+									Line:   121,
 								},
 							},
 						},
@@ -4382,33 +4807,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 10,
-								Line:   119,
+								Line:   122,
 							},
 							File:   "math.flux",
 							Source: "erfc:erfc",
 							Start: ast.Position{
 								Column: 1,
-								Line:   119,
+								Line:   122,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 5,
-									Line:   119,
+									Line:   122,
 								},
 								File:   "math.flux",
 								Source: "erfc",
 								Start: ast.Position{
 									Column: 1,
-									Line:   119,
+									Line:   122,
 								},
 							},
 						},
@@ -4416,17 +4843,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 10,
-									Line:   119,
+									Line:   122,
 								},
 								File:   "math.flux",
 								Source: "erfc",
 								Start: ast.Position{
 									Column: 6,
-									Line:   119,
+									Line:   122,
 								},
 							},
 						},
@@ -4434,33 +4862,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 16,
-								Line:   120,
+								Line:   123,
 							},
 							File:   "math.flux",
 							Source: "erfcinv:erfcinv",
 							Start: ast.Position{
 								Column: 1,
-								Line:   120,
+								Line:   123,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 8,
-									Line:   120,
+									Line:   123,
 								},
 								File:   "math.flux",
 								Source: "erfcinv",
 								Start: ast.Position{
 									Column: 1,
-									Line:   120,
+									Line:   123,
 								},
 							},
 						},
@@ -4468,17 +4898,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 16,
-									Line:   120,
+									Line:   123,
 								},
 								File:   "math.flux",
 								Source: "erfcinv",
 								Start: ast.Position{
 									Column: 9,
-									Line:   120,
+									Line:   123,
 								},
 							},
 						},
@@ -4486,33 +4917,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 14,
-								Line:   121,
+								Line:   124,
 							},
 							File:   "math.flux",
 							Source: "erfinv:erfinv",
 							Start: ast.Position{
 								Column: 1,
-								Line:   121,
+								Line:   124,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 7,
-									Line:   121,
+									Line:   124,
 								},
 								File:   "math.flux",
 								Source: "erfinv",
 								Start: ast.Position{
 									Column: 1,
-									Line:   121,
+									Line:   124,
 								},
 							},
 						},
@@ -4520,17 +4953,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 14,
-									Line:   121,
+									Line:   124,
 								},
 								File:   "math.flux",
 								Source: "erfinv",
 								Start: ast.Position{
 									Column: 8,
-									Line:   121,
+									Line:   124,
 								},
 							},
 						},
@@ -4538,33 +4972,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 8,
-								Line:   122,
+								Line:   125,
 							},
 							File:   "math.flux",
 							Source: "exp:exp",
 							Start: ast.Position{
 								Column: 1,
-								Line:   122,
+								Line:   125,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 4,
-									Line:   122,
+									Line:   125,
 								},
 								File:   "math.flux",
 								Source: "exp",
 								Start: ast.Position{
 									Column: 1,
-									Line:   122,
+									Line:   125,
 								},
 							},
 						},
@@ -4572,17 +5008,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 8,
-									Line:   122,
+									Line:   125,
 								},
 								File:   "math.flux",
 								Source: "exp",
 								Start: ast.Position{
 									Column: 5,
-									Line:   122,
+									Line:   125,
 								},
 							},
 						},
@@ -4590,33 +5027,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 10,
-								Line:   123,
+								Line:   126,
 							},
 							File:   "math.flux",
 							Source: "exp2:exp2",
 							Start: ast.Position{
 								Column: 1,
-								Line:   123,
+								Line:   126,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 5,
-									Line:   123,
+									Line:   126,
 								},
 								File:   "math.flux",
 								Source: "exp2",
 								Start: ast.Position{
 									Column: 1,
-									Line:   123,
+									Line:   126,
 								},
 							},
 						},
@@ -4624,17 +5063,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 10,
-									Line:   123,
+									Line:   126,
 								},
 								File:   "math.flux",
 								Source: "exp2",
 								Start: ast.Position{
 									Column: 6,
-									Line:   123,
+									Line:   126,
 								},
 							},
 						},
@@ -4642,33 +5082,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 12,
-								Line:   124,
+								Line:   127,
 							},
 							File:   "math.flux",
 							Source: "expm1:expm1",
 							Start: ast.Position{
 								Column: 1,
-								Line:   124,
+								Line:   127,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
-									Line:   124,
+									Line:   127,
 								},
 								File:   "math.flux",
 								Source: "expm1",
 								Start: ast.Position{
 									Column: 1,
-									Line:   124,
+									Line:   127,
 								},
 							},
 						},
@@ -4676,17 +5118,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 12,
-									Line:   124,
+									Line:   127,
 								},
 								File:   "math.flux",
 								Source: "expm1",
 								Start: ast.Position{
 									Column: 7,
-									Line:   124,
+									Line:   127,
 								},
 							},
 						},
@@ -4694,33 +5137,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 24,
-								Line:   125,
+								Line:   128,
 							},
 							File:   "math.flux",
 							Source: "float64bits:float64bits",
 							Start: ast.Position{
 								Column: 1,
-								Line:   125,
+								Line:   128,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 12,
-									Line:   125,
+									Line:   128,
 								},
 								File:   "math.flux",
 								Source: "float64bits",
 								Start: ast.Position{
 									Column: 1,
-									Line:   125,
+									Line:   128,
 								},
 							},
 						},
@@ -4728,17 +5173,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 24,
-									Line:   125,
+									Line:   128,
 								},
 								File:   "math.flux",
 								Source: "float64bits",
 								Start: ast.Position{
 									Column: 13,
-									Line:   125,
+									Line:   128,
 								},
 							},
 						},
@@ -4746,33 +5192,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 12,
-								Line:   126,
+								Line:   129,
 							},
 							File:   "math.flux",
 							Source: "floor:floor",
 							Start: ast.Position{
 								Column: 1,
-								Line:   126,
+								Line:   129,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
-									Line:   126,
+									Line:   129,
 								},
 								File:   "math.flux",
 								Source: "floor",
 								Start: ast.Position{
 									Column: 1,
-									Line:   126,
+									Line:   129,
 								},
 							},
 						},
@@ -4780,17 +5228,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 12,
-									Line:   126,
+									Line:   129,
 								},
 								File:   "math.flux",
 								Source: "floor",
 								Start: ast.Position{
 									Column: 7,
-									Line:   126,
+									Line:   129,
 								},
 							},
 						},
@@ -4798,33 +5247,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 12,
-								Line:   127,
+								Line:   130,
 							},
 							File:   "math.flux",
 							Source: "frexp:frexp",
 							Start: ast.Position{
 								Column: 1,
-								Line:   127,
+								Line:   130,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
-									Line:   127,
+									Line:   130,
 								},
 								File:   "math.flux",
 								Source: "frexp",
 								Start: ast.Position{
 									Column: 1,
-									Line:   127,
+									Line:   130,
 								},
 							},
 						},
@@ -4832,17 +5283,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 12,
-									Line:   127,
+									Line:   130,
 								},
 								File:   "math.flux",
 								Source: "frexp",
 								Start: ast.Position{
 									Column: 7,
-									Line:   127,
+									Line:   130,
 								},
 							},
 						},
@@ -4850,33 +5302,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 12,
-								Line:   128,
+								Line:   131,
 							},
 							File:   "math.flux",
 							Source: "gamma:gamma",
 							Start: ast.Position{
 								Column: 1,
-								Line:   128,
+								Line:   131,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
-									Line:   128,
+									Line:   131,
 								},
 								File:   "math.flux",
 								Source: "gamma",
 								Start: ast.Position{
 									Column: 1,
-									Line:   128,
+									Line:   131,
 								},
 							},
 						},
@@ -4884,17 +5338,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 12,
-									Line:   128,
+									Line:   131,
 								},
 								File:   "math.flux",
 								Source: "gamma",
 								Start: ast.Position{
 									Column: 7,
-									Line:   128,
+									Line:   131,
 								},
 							},
 						},
@@ -4902,33 +5357,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 12,
-								Line:   129,
+								Line:   132,
 							},
 							File:   "math.flux",
 							Source: "hypot:hypot",
 							Start: ast.Position{
 								Column: 1,
-								Line:   129,
+								Line:   132,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
-									Line:   129,
+									Line:   132,
 								},
 								File:   "math.flux",
 								Source: "hypot",
 								Start: ast.Position{
 									Column: 1,
-									Line:   129,
+									Line:   132,
 								},
 							},
 						},
@@ -4936,17 +5393,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 12,
-									Line:   129,
+									Line:   132,
 								},
 								File:   "math.flux",
 								Source: "hypot",
 								Start: ast.Position{
 									Column: 7,
-									Line:   129,
+									Line:   132,
 								},
 							},
 						},
@@ -4954,33 +5412,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 12,
-								Line:   130,
+								Line:   133,
 							},
 							File:   "math.flux",
 							Source: "ilogb:ilogb",
 							Start: ast.Position{
 								Column: 1,
-								Line:   130,
+								Line:   133,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
-									Line:   130,
+									Line:   133,
 								},
 								File:   "math.flux",
 								Source: "ilogb",
 								Start: ast.Position{
 									Column: 1,
-									Line:   130,
+									Line:   133,
 								},
 							},
 						},
@@ -4988,17 +5448,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 12,
-									Line:   130,
+									Line:   133,
 								},
 								File:   "math.flux",
 								Source: "ilogb",
 								Start: ast.Position{
 									Column: 7,
-									Line:   130,
+									Line:   133,
 								},
 							},
 						},
@@ -5006,33 +5467,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 10,
-								Line:   131,
+								Line:   134,
 							},
 							File:   "math.flux",
 							Source: "mInf:mInf",
 							Start: ast.Position{
 								Column: 1,
-								Line:   131,
+								Line:   134,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 5,
-									Line:   131,
+									Line:   134,
 								},
 								File:   "math.flux",
 								Source: "mInf",
 								Start: ast.Position{
 									Column: 1,
-									Line:   131,
+									Line:   134,
 								},
 							},
 						},
@@ -5040,17 +5503,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 10,
-									Line:   131,
+									Line:   134,
 								},
 								File:   "math.flux",
 								Source: "mInf",
 								Start: ast.Position{
 									Column: 6,
-									Line:   131,
+									Line:   134,
 								},
 							},
 						},
@@ -5058,33 +5522,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 12,
-								Line:   132,
+								Line:   135,
 							},
 							File:   "math.flux",
 							Source: "isInf:isInf",
 							Start: ast.Position{
 								Column: 1,
-								Line:   132,
+								Line:   135,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
-									Line:   132,
+									Line:   135,
 								},
 								File:   "math.flux",
 								Source: "isInf",
 								Start: ast.Position{
 									Column: 1,
-									Line:   132,
+									Line:   135,
 								},
 							},
 						},
@@ -5092,17 +5558,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 12,
-									Line:   132,
+									Line:   135,
 								},
 								File:   "math.flux",
 								Source: "isInf",
 								Start: ast.Position{
 									Column: 7,
-									Line:   132,
+									Line:   135,
 								},
 							},
 						},
@@ -5110,33 +5577,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 12,
-								Line:   133,
+								Line:   136,
 							},
 							File:   "math.flux",
 							Source: "isNaN:isNaN",
 							Start: ast.Position{
 								Column: 1,
-								Line:   133,
+								Line:   136,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
-									Line:   133,
+									Line:   136,
 								},
 								File:   "math.flux",
 								Source: "isNaN",
 								Start: ast.Position{
 									Column: 1,
-									Line:   133,
+									Line:   136,
 								},
 							},
 						},
@@ -5144,17 +5613,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 12,
-									Line:   133,
+									Line:   136,
 								},
 								File:   "math.flux",
 								Source: "isNaN",
 								Start: ast.Position{
 									Column: 7,
-									Line:   133,
+									Line:   136,
 								},
 							},
 						},
@@ -5162,33 +5632,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 6,
-								Line:   134,
+								Line:   137,
 							},
 							File:   "math.flux",
 							Source: "j0:j0",
 							Start: ast.Position{
 								Column: 1,
-								Line:   134,
+								Line:   137,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 3,
-									Line:   134,
+									Line:   137,
 								},
 								File:   "math.flux",
 								Source: "j0",
 								Start: ast.Position{
 									Column: 1,
-									Line:   134,
+									Line:   137,
 								},
 							},
 						},
@@ -5196,17 +5668,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
-									Line:   134,
+									Line:   137,
 								},
 								File:   "math.flux",
 								Source: "j0",
 								Start: ast.Position{
 									Column: 4,
-									Line:   134,
+									Line:   137,
 								},
 							},
 						},
@@ -5214,33 +5687,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 6,
-								Line:   135,
+								Line:   138,
 							},
 							File:   "math.flux",
 							Source: "j1:j1",
 							Start: ast.Position{
 								Column: 1,
-								Line:   135,
+								Line:   138,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 3,
-									Line:   135,
+									Line:   138,
 								},
 								File:   "math.flux",
 								Source: "j1",
 								Start: ast.Position{
 									Column: 1,
-									Line:   135,
+									Line:   138,
 								},
 							},
 						},
@@ -5248,17 +5723,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
-									Line:   135,
+									Line:   138,
 								},
 								File:   "math.flux",
 								Source: "j1",
 								Start: ast.Position{
 									Column: 4,
-									Line:   135,
+									Line:   138,
 								},
 							},
 						},
@@ -5266,33 +5742,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 6,
-								Line:   136,
+								Line:   139,
 							},
 							File:   "math.flux",
 							Source: "jn:jn",
 							Start: ast.Position{
 								Column: 1,
-								Line:   136,
+								Line:   139,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 3,
-									Line:   136,
+									Line:   139,
 								},
 								File:   "math.flux",
 								Source: "jn",
 								Start: ast.Position{
 									Column: 1,
-									Line:   136,
+									Line:   139,
 								},
 							},
 						},
@@ -5300,17 +5778,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
-									Line:   136,
+									Line:   139,
 								},
 								File:   "math.flux",
 								Source: "jn",
 								Start: ast.Position{
 									Column: 4,
-									Line:   136,
+									Line:   139,
 								},
 							},
 						},
@@ -5318,33 +5797,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 12,
-								Line:   137,
+								Line:   140,
 							},
 							File:   "math.flux",
 							Source: "ldexp:ldexp",
 							Start: ast.Position{
 								Column: 1,
-								Line:   137,
+								Line:   140,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
-									Line:   137,
+									Line:   140,
 								},
 								File:   "math.flux",
 								Source: "ldexp",
 								Start: ast.Position{
 									Column: 1,
-									Line:   137,
+									Line:   140,
 								},
 							},
 						},
@@ -5352,17 +5833,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 12,
-									Line:   137,
+									Line:   140,
 								},
 								File:   "math.flux",
 								Source: "ldexp",
 								Start: ast.Position{
 									Column: 7,
-									Line:   137,
+									Line:   140,
 								},
 							},
 						},
@@ -5370,33 +5852,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 14,
-								Line:   138,
+								Line:   141,
 							},
 							File:   "math.flux",
 							Source: "lgamma:lgamma",
 							Start: ast.Position{
 								Column: 1,
-								Line:   138,
+								Line:   141,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 7,
-									Line:   138,
+									Line:   141,
 								},
 								File:   "math.flux",
 								Source: "lgamma",
 								Start: ast.Position{
 									Column: 1,
-									Line:   138,
+									Line:   141,
 								},
 							},
 						},
@@ -5404,17 +5888,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 14,
-									Line:   138,
+									Line:   141,
 								},
 								File:   "math.flux",
 								Source: "lgamma",
 								Start: ast.Position{
 									Column: 8,
-									Line:   138,
+									Line:   141,
 								},
 							},
 						},
@@ -5422,33 +5907,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 8,
-								Line:   139,
+								Line:   142,
 							},
 							File:   "math.flux",
 							Source: "log:log",
 							Start: ast.Position{
 								Column: 1,
-								Line:   139,
+								Line:   142,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 4,
-									Line:   139,
+									Line:   142,
 								},
 								File:   "math.flux",
 								Source: "log",
 								Start: ast.Position{
 									Column: 1,
-									Line:   139,
+									Line:   142,
 								},
 							},
 						},
@@ -5456,17 +5943,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 8,
-									Line:   139,
+									Line:   142,
 								},
 								File:   "math.flux",
 								Source: "log",
 								Start: ast.Position{
 									Column: 5,
-									Line:   139,
+									Line:   142,
 								},
 							},
 						},
@@ -5474,33 +5962,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 12,
-								Line:   140,
+								Line:   143,
 							},
 							File:   "math.flux",
 							Source: "log10:log10",
 							Start: ast.Position{
 								Column: 1,
-								Line:   140,
+								Line:   143,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
-									Line:   140,
+									Line:   143,
 								},
 								File:   "math.flux",
 								Source: "log10",
 								Start: ast.Position{
 									Column: 1,
-									Line:   140,
+									Line:   143,
 								},
 							},
 						},
@@ -5508,17 +5998,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 12,
-									Line:   140,
+									Line:   143,
 								},
 								File:   "math.flux",
 								Source: "log10",
 								Start: ast.Position{
 									Column: 7,
-									Line:   140,
+									Line:   143,
 								},
 							},
 						},
@@ -5526,33 +6017,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 12,
-								Line:   141,
+								Line:   144,
 							},
 							File:   "math.flux",
 							Source: "log1p:log1p",
 							Start: ast.Position{
 								Column: 1,
-								Line:   141,
+								Line:   144,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
-									Line:   141,
+									Line:   144,
 								},
 								File:   "math.flux",
 								Source: "log1p",
 								Start: ast.Position{
 									Column: 1,
-									Line:   141,
+									Line:   144,
 								},
 							},
 						},
@@ -5560,17 +6053,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 12,
-									Line:   141,
+									Line:   144,
 								},
 								File:   "math.flux",
 								Source: "log1p",
 								Start: ast.Position{
 									Column: 7,
-									Line:   141,
+									Line:   144,
 								},
 							},
 						},
@@ -5578,33 +6072,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 10,
-								Line:   142,
+								Line:   145,
 							},
 							File:   "math.flux",
 							Source: "log2:log2",
 							Start: ast.Position{
 								Column: 1,
-								Line:   142,
+								Line:   145,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 5,
-									Line:   142,
+									Line:   145,
 								},
 								File:   "math.flux",
 								Source: "log2",
 								Start: ast.Position{
 									Column: 1,
-									Line:   142,
+									Line:   145,
 								},
 							},
 						},
@@ -5612,17 +6108,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 10,
-									Line:   142,
+									Line:   145,
 								},
 								File:   "math.flux",
 								Source: "log2",
 								Start: ast.Position{
 									Column: 6,
-									Line:   142,
+									Line:   145,
 								},
 							},
 						},
@@ -5630,33 +6127,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 10,
-								Line:   143,
+								Line:   146,
 							},
 							File:   "math.flux",
 							Source: "logb:logb",
 							Start: ast.Position{
 								Column: 1,
-								Line:   143,
+								Line:   146,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 5,
-									Line:   143,
+									Line:   146,
 								},
 								File:   "math.flux",
 								Source: "logb",
 								Start: ast.Position{
 									Column: 1,
-									Line:   143,
+									Line:   146,
 								},
 							},
 						},
@@ -5664,17 +6163,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 10,
-									Line:   143,
+									Line:   146,
 								},
 								File:   "math.flux",
 								Source: "logb",
 								Start: ast.Position{
 									Column: 6,
-									Line:   143,
+									Line:   146,
 								},
 							},
 						},
@@ -5682,33 +6182,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 10,
-								Line:   144,
+								Line:   147,
 							},
 							File:   "math.flux",
 							Source: "mMax:mMax",
 							Start: ast.Position{
 								Column: 1,
-								Line:   144,
+								Line:   147,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 5,
-									Line:   144,
+									Line:   147,
 								},
 								File:   "math.flux",
 								Source: "mMax",
 								Start: ast.Position{
 									Column: 1,
-									Line:   144,
+									Line:   147,
 								},
 							},
 						},
@@ -5716,17 +6218,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 10,
-									Line:   144,
+									Line:   147,
 								},
 								File:   "math.flux",
 								Source: "mMax",
 								Start: ast.Position{
 									Column: 6,
-									Line:   144,
+									Line:   147,
 								},
 							},
 						},
@@ -5734,33 +6237,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 10,
-								Line:   145,
+								Line:   148,
 							},
 							File:   "math.flux",
 							Source: "mMin:mMin",
 							Start: ast.Position{
 								Column: 1,
-								Line:   145,
+								Line:   148,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 5,
-									Line:   145,
+									Line:   148,
 								},
 								File:   "math.flux",
 								Source: "mMin",
 								Start: ast.Position{
 									Column: 1,
-									Line:   145,
+									Line:   148,
 								},
 							},
 						},
@@ -5768,17 +6273,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 10,
-									Line:   145,
+									Line:   148,
 								},
 								File:   "math.flux",
 								Source: "mMin",
 								Start: ast.Position{
 									Column: 6,
-									Line:   145,
+									Line:   148,
 								},
 							},
 						},
@@ -5786,33 +6292,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 8,
-								Line:   146,
+								Line:   149,
 							},
 							File:   "math.flux",
 							Source: "mod:mod",
 							Start: ast.Position{
 								Column: 1,
-								Line:   146,
+								Line:   149,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 4,
-									Line:   146,
+									Line:   149,
 								},
 								File:   "math.flux",
 								Source: "mod",
 								Start: ast.Position{
 									Column: 1,
-									Line:   146,
+									Line:   149,
 								},
 							},
 						},
@@ -5820,17 +6328,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 8,
-									Line:   146,
+									Line:   149,
 								},
 								File:   "math.flux",
 								Source: "mod",
 								Start: ast.Position{
 									Column: 5,
-									Line:   146,
+									Line:   149,
 								},
 							},
 						},
@@ -5838,33 +6347,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 10,
-								Line:   147,
+								Line:   150,
 							},
 							File:   "math.flux",
 							Source: "modf:modf",
 							Start: ast.Position{
 								Column: 1,
-								Line:   147,
+								Line:   150,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 5,
-									Line:   147,
+									Line:   150,
 								},
 								File:   "math.flux",
 								Source: "modf",
 								Start: ast.Position{
 									Column: 1,
-									Line:   147,
+									Line:   150,
 								},
 							},
 						},
@@ -5872,17 +6383,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 10,
-									Line:   147,
+									Line:   150,
 								},
 								File:   "math.flux",
 								Source: "modf",
 								Start: ast.Position{
 									Column: 6,
-									Line:   147,
+									Line:   150,
 								},
 							},
 						},
@@ -5890,33 +6402,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 8,
-								Line:   148,
+								Line:   151,
 							},
 							File:   "math.flux",
 							Source: "NaN:NaN",
 							Start: ast.Position{
 								Column: 1,
-								Line:   148,
+								Line:   151,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 4,
-									Line:   148,
+									Line:   151,
 								},
 								File:   "math.flux",
 								Source: "NaN",
 								Start: ast.Position{
 									Column: 1,
-									Line:   148,
+									Line:   151,
 								},
 							},
 						},
@@ -5924,17 +6438,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 8,
-									Line:   148,
+									Line:   151,
 								},
 								File:   "math.flux",
 								Source: "NaN",
 								Start: ast.Position{
 									Column: 5,
-									Line:   148,
+									Line:   151,
 								},
 							},
 						},
@@ -5942,33 +6457,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 20,
-								Line:   149,
+								Line:   152,
 							},
 							File:   "math.flux",
 							Source: "nextafter:nextafter",
 							Start: ast.Position{
 								Column: 1,
-								Line:   149,
+								Line:   152,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 10,
-									Line:   149,
+									Line:   152,
 								},
 								File:   "math.flux",
 								Source: "nextafter",
 								Start: ast.Position{
 									Column: 1,
-									Line:   149,
+									Line:   152,
 								},
 							},
 						},
@@ -5976,17 +6493,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 20,
-									Line:   149,
+									Line:   152,
 								},
 								File:   "math.flux",
 								Source: "nextafter",
 								Start: ast.Position{
 									Column: 11,
-									Line:   149,
+									Line:   152,
 								},
 							},
 						},
@@ -5994,33 +6512,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 8,
-								Line:   150,
+								Line:   153,
 							},
 							File:   "math.flux",
 							Source: "pow:pow",
 							Start: ast.Position{
 								Column: 1,
-								Line:   150,
+								Line:   153,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 4,
-									Line:   150,
+									Line:   153,
 								},
 								File:   "math.flux",
 								Source: "pow",
 								Start: ast.Position{
 									Column: 1,
-									Line:   150,
+									Line:   153,
 								},
 							},
 						},
@@ -6028,17 +6548,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 8,
-									Line:   150,
+									Line:   153,
 								},
 								File:   "math.flux",
 								Source: "pow",
 								Start: ast.Position{
 									Column: 5,
-									Line:   150,
+									Line:   153,
 								},
 							},
 						},
@@ -6046,33 +6567,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 12,
-								Line:   151,
+								Line:   154,
 							},
 							File:   "math.flux",
 							Source: "pow10:pow10",
 							Start: ast.Position{
 								Column: 1,
-								Line:   151,
+								Line:   154,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
-									Line:   151,
+									Line:   154,
 								},
 								File:   "math.flux",
 								Source: "pow10",
 								Start: ast.Position{
 									Column: 1,
-									Line:   151,
+									Line:   154,
 								},
 							},
 						},
@@ -6080,17 +6603,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 12,
-									Line:   151,
+									Line:   154,
 								},
 								File:   "math.flux",
 								Source: "pow10",
 								Start: ast.Position{
 									Column: 7,
-									Line:   151,
+									Line:   154,
 								},
 							},
 						},
@@ -6098,33 +6622,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 20,
-								Line:   152,
+								Line:   155,
 							},
 							File:   "math.flux",
 							Source: "remainder:remainder",
 							Start: ast.Position{
 								Column: 1,
-								Line:   152,
+								Line:   155,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 10,
-									Line:   152,
+									Line:   155,
 								},
 								File:   "math.flux",
 								Source: "remainder",
 								Start: ast.Position{
 									Column: 1,
-									Line:   152,
+									Line:   155,
 								},
 							},
 						},
@@ -6132,17 +6658,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 20,
-									Line:   152,
+									Line:   155,
 								},
 								File:   "math.flux",
 								Source: "remainder",
 								Start: ast.Position{
 									Column: 11,
-									Line:   152,
+									Line:   155,
 								},
 							},
 						},
@@ -6150,33 +6677,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 12,
-								Line:   153,
+								Line:   156,
 							},
 							File:   "math.flux",
 							Source: "round:round",
 							Start: ast.Position{
 								Column: 1,
-								Line:   153,
+								Line:   156,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
-									Line:   153,
+									Line:   156,
 								},
 								File:   "math.flux",
 								Source: "round",
 								Start: ast.Position{
 									Column: 1,
-									Line:   153,
+									Line:   156,
 								},
 							},
 						},
@@ -6184,17 +6713,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 12,
-									Line:   153,
+									Line:   156,
 								},
 								File:   "math.flux",
 								Source: "round",
 								Start: ast.Position{
 									Column: 7,
-									Line:   153,
+									Line:   156,
 								},
 							},
 						},
@@ -6202,33 +6732,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 24,
-								Line:   154,
+								Line:   157,
 							},
 							File:   "math.flux",
 							Source: "roundtoeven:roundtoeven",
 							Start: ast.Position{
 								Column: 1,
-								Line:   154,
+								Line:   157,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 12,
-									Line:   154,
+									Line:   157,
 								},
 								File:   "math.flux",
 								Source: "roundtoeven",
 								Start: ast.Position{
 									Column: 1,
-									Line:   154,
+									Line:   157,
 								},
 							},
 						},
@@ -6236,17 +6768,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 24,
-									Line:   154,
+									Line:   157,
 								},
 								File:   "math.flux",
 								Source: "roundtoeven",
 								Start: ast.Position{
 									Column: 13,
-									Line:   154,
+									Line:   157,
 								},
 							},
 						},
@@ -6254,33 +6787,90 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 16,
-								Line:   155,
+								Line:   158,
+							},
+							File:   "math.flux",
+							Source: "roundTo:roundTo",
+							Start: ast.Position{
+								Column: 1,
+								Line:   158,
+							},
+						},
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 8,
+									Line:   158,
+								},
+								File:   "math.flux",
+								Source: "roundTo",
+								Start: ast.Position{
+									Column: 1,
+									Line:   158,
+								},
+							},
+						},
+						Name: "roundTo",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 16,
+									Line:   158,
+								},
+								File:   "math.flux",
+								Source: "roundTo",
+								Start: ast.Position{
+									Column: 9,
+									Line:   158,
+								},
+							},
+						},
+						Name: "roundTo",
+					},
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 16,
+								Line:   159,
 							},
 							File:   "math.flux",
 							Source: "signbit:signbit",
 							Start: ast.Position{
 								Column: 1,
-								Line:   155,
+								Line:   159,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 8,
-									Line:   155,
+									Line:   159,
 								},
 								File:   "math.flux",
 								Source: "signbit",
 								Start: ast.Position{
 									Column: 1,
-									Line:   155,
+									Line:   159,
 								},
 							},
 						},
@@ -6288,17 +6878,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 16,
-									Line:   155,
+									Line:   159,
 								},
 								File:   "math.flux",
 								Source: "signbit",
 								Start: ast.Position{
 									Column: 9,
-									Line:   155,
+									Line:   159,
 								},
 							},
 						},
@@ -6306,33 +6897,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 8,
-								Line:   156,
+								Line:   160,
 							},
 							File:   "math.flux",
 							Source: "sin:sin",
 							Start: ast.Position{
 								Column: 1,
-								Line:   156,
+								Line:   160,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 4,
-									Line:   156,
+									Line:   160,
 								},
 								File:   "math.flux",
 								Source: "sin",
 								Start: ast.Position{
 									Column: 1,
-									Line:   156,
+									Line:   160,
 								},
 							},
 						},
@@ -6340,17 +6933,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 8,
-									Line:   156,
+									Line:   160,
 								},
 								File:   "math.flux",
 								Source: "sin",
 								Start: ast.Position{
 									Column: 5,
-									Line:   156,
+									Line:   160,
 								},
 							},
 						},
@@ -6358,33 +6952,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 14,
-								Line:   157,
+								Line:   161,
 							},
 							File:   "math.flux",
 							Source: "sincos:sincos",
 							Start: ast.Position{
 								Column: 1,
-								Line:   157,
+								Line:   161,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 7,
-									Line:   157,
+									Line:   161,
 								},
 								File:   "math.flux",
 								Source: "sincos",
 								Start: ast.Position{
 									Column: 1,
-									Line:   157,
+									Line:   161,
 								},
 							},
 						},
@@ -6392,17 +6988,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 14,
-									Line:   157,
+									Line:   161,
 								},
 								File:   "math.flux",
 								Source: "sincos",
 								Start: ast.Position{
 									Column: 8,
-									Line:   157,
+									Line:   161,
 								},
 							},
 						},
@@ -6410,33 +7007,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 10,
-								Line:   158,
+								Line:   162,
 							},
 							File:   "math.flux",
 							Source: "sinh:sinh",
 							Start: ast.Position{
 								Column: 1,
-								Line:   158,
+								Line:   162,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 5,
-									Line:   158,
+									Line:   162,
 								},
 								File:   "math.flux",
 								Source: "sinh",
 								Start: ast.Position{
 									Column: 1,
-									Line:   158,
+									Line:   162,
 								},
 							},
 						},
@@ -6444,17 +7043,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 10,
-									Line:   158,
+									Line:   162,
 								},
 								File:   "math.flux",
 								Source: "sinh",
 								Start: ast.Position{
 									Column: 6,
-									Line:   158,
+									Line:   162,
 								},
 							},
 						},
@@ -6462,33 +7062,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 10,
-								Line:   159,
+								Line:   163,
 							},
 							File:   "math.flux",
 							Source: "sqrt:sqrt",
 							Start: ast.Position{
 								Column: 1,
-								Line:   159,
+								Line:   163,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 5,
-									Line:   159,
+									Line:   163,
 								},
 								File:   "math.flux",
 								Source: "sqrt",
 								Start: ast.Position{
 									Column: 1,
-									Line:   159,
+									Line:   163,
 								},
 							},
 						},
@@ -6496,17 +7098,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 10,
-									Line:   159,
+									Line:   163,
 								},
 								File:   "math.flux",
 								Source: "sqrt",
 								Start: ast.Position{
 									Column: 6,
-									Line:   159,
+									Line:   163,
 								},
 							},
 						},
@@ -6514,33 +7117,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 8,
-								Line:   160,
+								Line:   164,
 							},
 							File:   "math.flux",
 							Source: "tan:tan",
 							Start: ast.Position{
 								Column: 1,
-								Line:   160,
+								Line:   164,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 4,
-									Line:   160,
+									Line:   164,
 								},
 								File:   "math.flux",
 								Source: "tan",
 								Start: ast.Position{
 									Column: 1,
-									Line:   160,
+									Line:   164,
 								},
 							},
 						},
@@ -6548,17 +7153,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 8,
-									Line:   160,
+									Line:   164,
 								},
 								File:   "math.flux",
 								Source: "tan",
 								Start: ast.Position{
 									Column: 5,
-									Line:   160,
+									Line:   164,
 								},
 							},
 						},
@@ -6566,33 +7172,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 10,
-								Line:   161,
+								Line:   165,
 							},
 							File:   "math.flux",
 							Source: "tanh:tanh",
 							Start: ast.Position{
 								Column: 1,
-								Line:   161,
+								Line:   165,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 5,
-									Line:   161,
+									Line:   165,
 								},
 								File:   "math.flux",
 								Source: "tanh",
 								Start: ast.Position{
 									Column: 1,
-									Line:   161,
+									Line:   165,
 								},
 							},
 						},
@@ -6600,17 +7208,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 10,
-									Line:   161,
+									Line:   165,
 								},
 								File:   "math.flux",
 								Source: "tanh",
 								Start: ast.Position{
 									Column: 6,
-									Line:   161,
+									Line:   165,
 								},
 							},
 						},
@@ -6618,33 +7227,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 12,
-								Line:   162,
+								Line:   166,
 							},
 							File:   "math.flux",
 							Source: "trunc:trunc",
 							Start: ast.Position{
 								Column: 1,
-								Line:   162,
+								Line:   166,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
-									Line:   162,
+									Line:   166,
 								},
 								File:   "math.flux",
 								Source: "trunc",
 								Start: ast.Position{
 									Column: 1,
-									Line:   162,
+									Line:   166,
 								},
 							},
 						},
@@ -6652,17 +7263,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 12,
-									Line:   162,
+									Line:   166,
 								},
 								File:   "math.flux",
 								Source: "trunc",
 								Start: ast.Position{
 									Column: 7,
-									Line:   162,
+									Line:   166,
 								},
 							},
 						},
@@ -6670,33 +7282,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 6,
-								Line:   163,
+								Line:   167,
 							},
 							File:   "math.flux",
 							Source: "y0:y0",
 							Start: ast.Position{
 								Column: 1,
-								Line:   163,
+								Line:   167,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 3,
-									Line:   163,
+									Line:   167,
 								},
 								File:   "math.flux",
 								Source: "y0",
 								Start: ast.Position{
 									Column: 1,
-									Line:   163,
+									Line:   167,
 								},
 							},
 						},
@@ -6704,17 +7318,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
-									Line:   163,
+									Line:   167,
 								},
 								File:   "math.flux",
 								Source: "y0",
 								Start: ast.Position{
 									Column: 4,
-									Line:   163,
+									Line:   167,
 								},
 							},
 						},
@@ -6722,33 +7337,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 6,
-								Line:   164,
+								Line:   168,
 							},
 							File:   "math.flux",
 							Source: "y1:y1",
 							Start: ast.Position{
 								Column: 1,
-								Line:   164,
+								Line:   168,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 3,
-									Line:   164,
+									Line:   168,
 								},
 								File:   "math.flux",
 								Source: "y1",
 								Start: ast.Position{
 									Column: 1,
-									Line:   164,
+									Line:   168,
 								},
 							},
 						},
@@ -6756,17 +7373,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
-									Line:   164,
+									Line:   168,
 								},
 								File:   "math.flux",
 								Source: "y1",
 								Start: ast.Position{
 									Column: 4,
-									Line:   164,
+									Line:   168,
 								},
 							},
 						},
@@ -6774,33 +7392,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 6,
-								Line:   165,
+								Line:   169,
 							},
 							File:   "math.flux",
 							Source: "yn:yn",
 							Start: ast.Position{
 								Column: 1,
-								Line:   165,
+								Line:   169,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 3,
-									Line:   165,
+									Line:   169,
 								},
 								File:   "math.flux",
 								Source: "yn",
 								Start: ast.Position{
 									Column: 1,
-									Line:   165,
+									Line:   169,
 								},
 							},
 						},
@@ -6808,30 +7428,33 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
-									Line:   165,
+									Line:   169,
 								},
 								File:   "math.flux",
 								Source: "yn",
 								Start: ast.Position{
 									Column: 4,
-									Line:   165,
+									Line:   169,
 								},
 							},
 						},
 						Name: "yn",
 					},
 				}},
+				With: nil,
 			},
 		}},
 		Imports: nil,
 		Name:    "math.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 13,
@@ -6847,7 +7470,8 @@ var pkgAST = &ast.Package{
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 13,
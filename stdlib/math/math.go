@@ -3,6 +3,8 @@ package math
 import (
 	"fmt"
 	"math"
+	"strconv"
+	"strings"
 
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/semantic"
@@ -68,6 +70,101 @@ func generateMathFunctionXY(name string, mathFn func(float64, float64) float64,
 	)
 }
 
+// clamp bounds x to the range [min, max].
+// The arguments must all be ints, uints or floats.
+func clamp(x, min, max values.Value) (values.Value, error) {
+	switch x.Type().Nature() {
+	case semantic.Int:
+		if min.Int() > max.Int() {
+			return nil, fmt.Errorf("invalid range: min %d is greater than max %d", min.Int(), max.Int())
+		}
+		if x.Int() < min.Int() {
+			return min, nil
+		} else if x.Int() > max.Int() {
+			return max, nil
+		}
+	case semantic.UInt:
+		if min.UInt() > max.UInt() {
+			return nil, fmt.Errorf("invalid range: min %d is greater than max %d", min.UInt(), max.UInt())
+		}
+		if x.UInt() < min.UInt() {
+			return min, nil
+		} else if x.UInt() > max.UInt() {
+			return max, nil
+		}
+	case semantic.Float:
+		if min.Float() > max.Float() {
+			return nil, fmt.Errorf("invalid range: min %v is greater than max %v", min.Float(), max.Float())
+		}
+		if x.Float() < min.Float() {
+			return min, nil
+		} else if x.Float() > max.Float() {
+			return max, nil
+		}
+	default:
+		return nil, fmt.Errorf("cannot clamp argument x of type %v, expected int, uint or float", x.Type().Nature())
+	}
+	return x, nil
+}
+
+// roundTo rounds x to the given number of decimal digits, rounding half away from zero.
+// A negative number of digits rounds to the left of the decimal point.
+//
+// The rounding is done on the shortest decimal representation of x
+// so that values such as 2.345, which cannot be represented exactly,
+// round the way they are written.
+func roundTo(x float64, digits int) float64 {
+	if math.IsNaN(x) || math.IsInf(x, 0) || x == 0 {
+		return x
+	}
+	s := strconv.FormatFloat(math.Abs(x), 'f', -1, 64)
+	point := strings.IndexByte(s, '.')
+	if point < 0 {
+		point = len(s)
+	} else {
+		s = s[:point] + s[point+1:]
+	}
+
+	// n is the number of leading digits that are kept.
+	n := point + digits
+	if n >= len(s) {
+		return x
+	}
+	var d []byte
+	if n >= 0 {
+		d = []byte(s[:n])
+		if s[n] >= '5' {
+			// Propagate the carry from the last kept digit.
+			i := len(d) - 1
+			for ; i >= 0 && d[i] == '9'; i-- {
+				d[i] = '0'
+			}
+			if i >= 0 {
+				d[i]++
+			} else {
+				d = append([]byte{'1'}, d...)
+				point++
+			}
+		}
+	}
+
+	var r float64
+	if len(d) > 0 {
+		m, err := strconv.ParseFloat(string(d), 64)
+		if err != nil {
+			panic(err)
+		}
+		// Divide rather than multiply by a negative power of ten
+		// so the result is the closest float to the decimal value.
+		if exp := point - len(d); exp >= 0 {
+			r = m * math.Pow10(exp)
+		} else {
+			r = m / math.Pow10(-exp)
+		}
+	}
+	return math.Copysign(r, x)
+}
+
 func init() {
 	// constants
 	flux.RegisterPackageValue("math", "pi", values.NewFloat(math.Pi))
@@ -466,6 +563,58 @@ func init() {
 				return nil, fmt.Errorf("cannot convert argument frac of type %v to float", v1.Type().Nature())
 			}, false,
 		),
+		// (float, int) --> float
+		"roundTo": values.NewFunction(
+			"roundTo",
+			semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+				Parameters: map[string]semantic.PolyType{"x": semantic.Float, "digits": semantic.Int},
+				Required:   semantic.LabelSet{"x", "digits"},
+				Return:     semantic.Float,
+			}),
+			func(args values.Object) (values.Value, error) {
+				v1, ok := args.Get("x")
+				if !ok {
+					return nil, errors.New("missing argument x")
+				}
+				v2, ok := args.Get("digits")
+				if !ok {
+					return nil, errors.New("missing argument digits")
+				}
+
+				if v1.Type().Nature() == semantic.Float {
+					if v2.Type().Nature() == semantic.Int {
+						return values.NewFloat(roundTo(v1.Float(), int(v2.Int()))), nil
+					} else {
+						return nil, fmt.Errorf("cannot convert argument digits of type %v to int", v2.Type().Nature())
+					}
+				}
+				return nil, fmt.Errorf("cannot convert argument x of type %v to float", v1.Type().Nature())
+			}, false,
+		),
+		// T, T, T --> T where T is int, uint or float
+		"clamp": values.NewFunction(
+			"clamp",
+			semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+				Parameters: map[string]semantic.PolyType{"x": semantic.Tvar(1), "min": semantic.Tvar(1), "max": semantic.Tvar(1)},
+				Required:   semantic.LabelSet{"x", "min", "max"},
+				Return:     semantic.Tvar(1),
+			}),
+			func(args values.Object) (values.Value, error) {
+				x, ok := args.Get("x")
+				if !ok {
+					return nil, errors.New("missing argument x")
+				}
+				min, ok := args.Get("min")
+				if !ok {
+					return nil, errors.New("missing argument min")
+				}
+				max, ok := args.Get("max")
+				if !ok {
+					return nil, errors.New("missing argument max")
+				}
+				return clamp(x, min, max)
+			}, false,
+		),
 		// int --> float
 		"pow10": values.NewFunction(
 			"pow10",
@@ -505,4 +654,6 @@ func init() {
 	flux.RegisterPackageValue("math", "yn", SpecialFns["yn"])
 	flux.RegisterPackageValue("math", "ldexp", SpecialFns["ldexp"])
 	flux.RegisterPackageValue("math", "pow10", SpecialFns["pow10"])
+	flux.RegisterPackageValue("math", "roundTo", SpecialFns["roundTo"])
+	flux.RegisterPackageValue("math", "clamp", SpecialFns["clamp"])
 }
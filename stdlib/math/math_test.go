@@ -396,6 +396,104 @@ func TestPow10(t *testing.T) {
 
 }
 
+func TestRoundTo(t *testing.T) {
+	testCases := []struct {
+		name   string
+		x      float64
+		digits int64
+		want   float64
+	}{
+		{name: "half up", x: 2.345, digits: 2, want: 2.35},
+		{name: "down", x: 2.344, digits: 2, want: 2.34},
+		{name: "fewer digits than requested", x: 2.5, digits: 3, want: 2.5},
+		{name: "negative half away from zero", x: -2.345, digits: 2, want: -2.35},
+		{name: "negative down", x: -2.344, digits: 2, want: -2.34},
+		{name: "carry", x: 9.995, digits: 2, want: 10},
+		{name: "one digit", x: 0.05, digits: 1, want: 0.1},
+		{name: "tens", x: 1234.5, digits: -1, want: 1230},
+		{name: "hundreds", x: 1250, digits: -2, want: 1300},
+		{name: "negative hundreds", x: -1250, digits: -2, want: -1300},
+		{name: "thousands carry", x: 9500, digits: -3, want: 10000},
+		{name: "more digits than value", x: 499, digits: -3, want: 0},
+		{name: "half more digits than value", x: 500, digits: -3, want: 1000},
+		{name: "zero", x: 0, digits: 2, want: 0},
+		{name: "infinity", x: math.Inf(1), digits: 2, want: math.Inf(1)},
+		{name: "NaN", x: math.NaN(), digits: 2, want: math.NaN()},
+	}
+	fluxFunc := SpecialFns["roundTo"]
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			fluxArg := values.NewObjectWithValues(map[string]values.Value{"x": values.NewFloat(tc.x), "digits": values.NewInt(tc.digits)})
+			got, err := fluxFunc.Call(fluxArg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if floatsNotEqual(tc.want, got.Float()) {
+				t.Errorf("math.roundTo function result input %v, %d: expected %v, got %v", tc.x, tc.digits, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRoundTo_ZeroDigits(t *testing.T) {
+	fluxFunc := SpecialFns["roundTo"]
+	for _, x := range []float64{0.5, -0.5, 1.5, 2.5, -2.5, 2.4999, 1e15 + 0.5, rand.Float64() * 1000, -rand.Float64() * 1000} {
+		fluxArg := values.NewObjectWithValues(map[string]values.Value{"x": values.NewFloat(x), "digits": values.NewInt(0)})
+		got, err := fluxFunc.Call(fluxArg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := math.Round(x); floatsNotEqual(want, got.Float()) {
+			t.Errorf("math.roundTo function result input %v: expected %v, got %v", x, want, got)
+		}
+	}
+}
+
 func floatsNotEqual(want, got float64) bool {
 	return want != got && !(math.IsNaN(want) && math.IsNaN(got))
 }
+
+func TestClamp(t *testing.T) {
+	testCases := []struct {
+		name        string
+		x, min, max values.Value
+		want        values.Value
+		wantErr     bool
+	}{
+		{name: "int below", x: values.NewInt(-5), min: values.NewInt(0), max: values.NewInt(10), want: values.NewInt(0)},
+		{name: "int within", x: values.NewInt(5), min: values.NewInt(0), max: values.NewInt(10), want: values.NewInt(5)},
+		{name: "int above", x: values.NewInt(15), min: values.NewInt(0), max: values.NewInt(10), want: values.NewInt(10)},
+		{name: "int empty range", x: values.NewInt(15), min: values.NewInt(3), max: values.NewInt(3), want: values.NewInt(3)},
+		{name: "uint below", x: values.NewUInt(1), min: values.NewUInt(2), max: values.NewUInt(4), want: values.NewUInt(2)},
+		{name: "uint within", x: values.NewUInt(3), min: values.NewUInt(2), max: values.NewUInt(4), want: values.NewUInt(3)},
+		{name: "uint above", x: values.NewUInt(5), min: values.NewUInt(2), max: values.NewUInt(4), want: values.NewUInt(4)},
+		{name: "float below", x: values.NewFloat(-0.5), min: values.NewFloat(0), max: values.NewFloat(1), want: values.NewFloat(0)},
+		{name: "float within", x: values.NewFloat(0.5), min: values.NewFloat(0), max: values.NewFloat(1), want: values.NewFloat(0.5)},
+		{name: "float above", x: values.NewFloat(1.5), min: values.NewFloat(0), max: values.NewFloat(1), want: values.NewFloat(1)},
+		{name: "int invalid range", x: values.NewInt(5), min: values.NewInt(10), max: values.NewInt(0), wantErr: true},
+		{name: "uint invalid range", x: values.NewUInt(5), min: values.NewUInt(10), max: values.NewUInt(0), wantErr: true},
+		{name: "float invalid range", x: values.NewFloat(0.5), min: values.NewFloat(1), max: values.NewFloat(0), wantErr: true},
+		{name: "string", x: values.NewString("b"), min: values.NewString("a"), max: values.NewString("c"), wantErr: true},
+	}
+	fluxFunc := SpecialFns["clamp"]
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			fluxArg := values.NewObjectWithValues(map[string]values.Value{"x": tc.x, "min": tc.min, "max": tc.max})
+			got, err := fluxFunc.Call(fluxArg)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(tc.want) {
+				t.Errorf("math.clamp function result input %v, %v, %v: expected %v, got %v", tc.x, tc.min, tc.max, tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package monitor
+
+import (
+	"fmt"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+const DeadmanKind = "deadman"
+
+// DeadLabel is the label of the column that reports whether a series is dead.
+const DeadLabel = "dead"
+
+// DeadmanOpSpec reports whether each series has stopped reporting since the time T.
+type DeadmanOpSpec struct {
+	T flux.Time `json:"t"`
+}
+
+func init() {
+	deadmanSignature := flux.FunctionSignature(
+		map[string]semantic.PolyType{
+			"t": semantic.Tvar(1),
+		},
+		[]string{"t"},
+	)
+
+	flux.RegisterPackageValue("monitor", DeadmanKind, flux.FunctionValue(DeadmanKind, createDeadmanOpSpec, deadmanSignature))
+	flux.RegisterOpSpec(DeadmanKind, newDeadmanOp)
+	plan.RegisterProcedureSpec(DeadmanKind, newDeadmanProcedure, DeadmanKind)
+	execute.RegisterTransformation(DeadmanKind, createDeadmanTransformation)
+}
+
+func createDeadmanOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+
+	t, err := args.GetRequiredTime("t")
+	if err != nil {
+		return nil, err
+	}
+	return &DeadmanOpSpec{T: t}, nil
+}
+
+func newDeadmanOp() flux.OperationSpec {
+	return new(DeadmanOpSpec)
+}
+
+func (s *DeadmanOpSpec) Kind() flux.OperationKind {
+	return DeadmanKind
+}
+
+type DeadmanProcedureSpec struct {
+	plan.DefaultCost
+	// Threshold is the absolute time at or after which a series must have reported to be alive.
+	Threshold values.Time
+}
+
+func newDeadmanProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*DeadmanOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+
+	return &DeadmanProcedureSpec{
+		Threshold: values.ConvertTime(spec.T.Time(pa.Now())),
+	}, nil
+}
+
+func (s *DeadmanProcedureSpec) Kind() plan.ProcedureKind {
+	return DeadmanKind
+}
+func (s *DeadmanProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := new(DeadmanProcedureSpec)
+	*ns = *s
+	return ns
+}
+
+// TriggerSpec implements plan.TriggerAwareProcedureSpec
+func (s *DeadmanProcedureSpec) TriggerSpec() plan.TriggerSpec {
+	return plan.NarrowTransformationTriggerSpec{}
+}
+
+func createDeadmanTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*DeadmanProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewDeadmanTransformation(d, cache, s)
+	return t, d, nil
+}
+
+type deadmanTransformation struct {
+	d     execute.Dataset
+	cache execute.TableBuilderCache
+
+	threshold values.Time
+}
+
+// NewDeadmanTransformation creates a transformation that outputs one row for each table.
+// The row contains the group key, the time of the last point in the _time column
+// and whether the series is dead because it has no points at or after the threshold.
+func NewDeadmanTransformation(d execute.Dataset, cache execute.TableBuilderCache, spec *DeadmanProcedureSpec) *deadmanTransformation {
+	return &deadmanTransformation{
+		d:         d,
+		cache:     cache,
+		threshold: spec.Threshold,
+	}
+}
+
+func (t *deadmanTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return t.d.RetractTable(key)
+}
+
+func (t *deadmanTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	key := tbl.Key()
+	if key.HasCol(execute.DefaultTimeColLabel) {
+		return fmt.Errorf("deadman cannot use the group key column %q", execute.DefaultTimeColLabel)
+	}
+
+	builder, created := t.cache.TableBuilder(key)
+	if !created {
+		return fmt.Errorf("deadman found duplicate table with key: %v", key)
+	}
+	if err := execute.AddTableKeyCols(key, builder); err != nil {
+		return err
+	}
+	timeIdx, err := builder.AddCol(flux.ColMeta{
+		Label: execute.DefaultTimeColLabel,
+		Type:  flux.TTime,
+	})
+	if err != nil {
+		return err
+	}
+	deadIdx, err := builder.AddCol(flux.ColMeta{
+		Label: DeadLabel,
+		Type:  flux.TBool,
+	})
+	if err != nil {
+		return err
+	}
+
+	// A table without a time column has never reported, like an empty table.
+	j := execute.ColIdx(execute.DefaultTimeColLabel, tbl.Cols())
+	if j >= 0 && tbl.Cols()[j].Type != flux.TTime {
+		return fmt.Errorf("deadman column %q must be of type %v, got %v", execute.DefaultTimeColLabel, flux.TTime, tbl.Cols()[j].Type)
+	}
+	var (
+		last     values.Time
+		reported bool
+	)
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		if j < 0 {
+			return nil
+		}
+		ts := cr.Times(j)
+		for i := 0; i < cr.Len(); i++ {
+			if !ts.IsValid(i) {
+				continue
+			}
+			if ti := values.Time(ts.Value(i)); !reported || ti > last {
+				last, reported = ti, true
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err := execute.AppendKeyValues(key, builder); err != nil {
+		return err
+	}
+	if reported {
+		if err := builder.AppendTime(timeIdx, last); err != nil {
+			return err
+		}
+	} else if err := builder.AppendNil(timeIdx); err != nil {
+		return err
+	}
+	return builder.AppendBool(deadIdx, !reported || last < t.threshold)
+}
+
+func (t *deadmanTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+func (t *deadmanTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+func (t *deadmanTransformation) Finish(id execute.DatasetID, err error) {
+	t.d.Finish(err)
+}
@@ -0,0 +1,245 @@
+package monitor_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/influxdata/flux"
+	_ "github.com/influxdata/flux/builtin"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/querytest"
+	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
+	"github.com/influxdata/flux/stdlib/monitor"
+)
+
+func TestDeadmanOperation_Marshaling(t *testing.T) {
+	data := []byte(`{"id":"deadman","kind":"deadman","spec":{"t":"-5m"}}`)
+	op := &flux.Operation{
+		ID: "deadman",
+		Spec: &monitor.DeadmanOpSpec{
+			T: flux.Time{
+				Relative:   -5 * time.Minute,
+				IsRelative: true,
+			},
+		},
+	}
+	querytest.OperationMarshalingTestHelper(t, data, op)
+}
+
+func TestDeadman_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "relative time",
+			Raw: `import "monitor"
+from(bucket: "telegraf") |> monitor.deadman(t: -5m)`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID:   "from0",
+						Spec: &influxdb.FromOpSpec{Bucket: "telegraf"},
+					},
+					{
+						ID: "deadman1",
+						Spec: &monitor.DeadmanOpSpec{
+							T: flux.Time{
+								Relative:   -5 * time.Minute,
+								IsRelative: true,
+							},
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "deadman1"},
+				},
+			},
+		},
+		{
+			Name: "missing time",
+			Raw: `import "monitor"
+from(bucket: "telegraf") |> monitor.deadman()`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+func TestDeadman_Process(t *testing.T) {
+	testCases := []struct {
+		name    string
+		spec    *monitor.DeadmanProcedureSpec
+		data    []flux.Table
+		want    []*executetest.Table
+		wantErr error
+	}{
+		{
+			name: "reported before and after threshold",
+			spec: &monitor.DeadmanProcedureSpec{
+				Threshold: execute.Time(10),
+			},
+			data: []flux.Table{
+				&executetest.Table{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, "a"},
+						{execute.Time(5), 2.0, "a"},
+						{execute.Time(9), 3.0, "a"},
+					},
+				},
+				&executetest.Table{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 1.0, "b"},
+						{execute.Time(12), 2.0, "b"},
+						{execute.Time(7), 3.0, "b"},
+					},
+				},
+				&executetest.Table{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(10), 1.0, "c"},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "host", Type: flux.TString},
+						{Label: "_time", Type: flux.TTime},
+						{Label: "dead", Type: flux.TBool},
+					},
+					Data: [][]interface{}{
+						{"a", execute.Time(9), true},
+					},
+				},
+				{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "host", Type: flux.TString},
+						{Label: "_time", Type: flux.TTime},
+						{Label: "dead", Type: flux.TBool},
+					},
+					Data: [][]interface{}{
+						{"b", execute.Time(12), false},
+					},
+				},
+				{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "host", Type: flux.TString},
+						{Label: "_time", Type: flux.TTime},
+						{Label: "dead", Type: flux.TBool},
+					},
+					Data: [][]interface{}{
+						{"c", execute.Time(10), false},
+					},
+				},
+			},
+		},
+		{
+			name: "empty series",
+			spec: &monitor.DeadmanProcedureSpec{
+				Threshold: execute.Time(10),
+			},
+			data: []flux.Table{&executetest.Table{
+				KeyCols:   []string{"host"},
+				KeyValues: []interface{}{"a"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "host", Type: flux.TString},
+				},
+			}},
+			want: []*executetest.Table{{
+				KeyCols: []string{"host"},
+				ColMeta: []flux.ColMeta{
+					{Label: "host", Type: flux.TString},
+					{Label: "_time", Type: flux.TTime},
+					{Label: "dead", Type: flux.TBool},
+				},
+				Data: [][]interface{}{
+					{"a", nil, true},
+				},
+			}},
+		},
+		{
+			name: "null times",
+			spec: &monitor.DeadmanProcedureSpec{
+				Threshold: execute.Time(10),
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{nil, 1.0},
+					{nil, 2.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "dead", Type: flux.TBool},
+				},
+				Data: [][]interface{}{
+					{nil, true},
+				},
+			}},
+		},
+		{
+			name: "time in group key",
+			spec: &monitor.DeadmanProcedureSpec{
+				Threshold: execute.Time(10),
+			},
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"_time"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0},
+				},
+			}},
+			wantErr: errors.New(`deadman cannot use the group key column "_time"`),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			executetest.ProcessTestHelper(
+				t,
+				tc.data,
+				tc.want,
+				tc.wantErr,
+				func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+					return monitor.NewDeadmanTransformation(d, c, tc.spec)
+				},
+			)
+		})
+	}
+}
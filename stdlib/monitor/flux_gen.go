@@ -0,0 +1,144 @@
+// DO NOT EDIT: This file is autogenerated via the builtin command.
+
+package monitor
+
+import (
+	flux "github.com/influxdata/flux"
+	ast "github.com/influxdata/flux/ast"
+)
+
+func init() {
+	flux.RegisterPackage(pkgAST)
+}
+
+var pkgAST = &ast.Package{
+	BaseNode: ast.BaseNode{
+		Comments: nil,
+		Errors:   nil,
+		Loc:      nil,
+	},
+	Files: []*ast.File{&ast.File{
+		BaseNode: ast.BaseNode{
+			Comments: nil,
+			Errors:   nil,
+			Loc: &ast.SourceLocation{
+				End: ast.Position{
+					Column: 16,
+					Line:   5,
+				},
+				File:   "monitor.flux",
+				Source: "package monitor\n\n// deadman reports for each table whether its series has stopped reporting.\n// A series is dead when it has no points at or after the time t.\nbuiltin deadman",
+				Start: ast.Position{
+					Column: 1,
+					Line:   1,
+				},
+			},
+		},
+		Body: []ast.Statement{&ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Comments: []ast.Comment{ast.Comment{
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 76,
+							Line:   3,
+						},
+						File:   "monitor.flux",
+						Source: "",
+						Start: ast.Position{
+							Column: 1,
+							Line:   3,
+						},
+					},
+					Text: "// deadman reports for each table whether its series has stopped reporting.",
+				}, ast.Comment{
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 66,
+							Line:   4,
+						},
+						File:   "monitor.flux",
+						Source: "",
+						Start: ast.Position{
+							Column: 1,
+							Line:   4,
+						},
+					},
+					Text: "// A series is dead when it has no points at or after the time t.",
+				}},
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 16,
+						Line:   5,
+					},
+					File:   "monitor.flux",
+					Source: "builtin deadman",
+					Start: ast.Position{
+						Column: 1,
+						Line:   5,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 16,
+							Line:   5,
+						},
+						File:   "monitor.flux",
+						Source: "deadman",
+						Start: ast.Position{
+							Column: 9,
+							Line:   5,
+						},
+					},
+				},
+				Name: "deadman",
+			},
+		}},
+		Imports: nil,
+		Name:    "monitor.flux",
+		Package: &ast.PackageClause{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 16,
+						Line:   1,
+					},
+					File:   "monitor.flux",
+					Source: "package monitor",
+					Start: ast.Position{
+						Column: 1,
+						Line:   1,
+					},
+				},
+			},
+			Name: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 16,
+							Line:   1,
+						},
+						File:   "monitor.flux",
+						Source: "monitor",
+						Start: ast.Position{
+							Column: 9,
+							Line:   1,
+						},
+					},
+				},
+				Name: "monitor",
+			},
+		},
+	}},
+	Package: "monitor",
+	Path:    "monitor",
+}
@@ -3,13 +3,23 @@
 package stdlib
 
 import (
+	_ "github.com/influxdata/flux/stdlib/array"
 	_ "github.com/influxdata/flux/stdlib/csv"
+	_ "github.com/influxdata/flux/stdlib/date"
+	_ "github.com/influxdata/flux/stdlib/dict"
+	_ "github.com/influxdata/flux/stdlib/experimental"
 	_ "github.com/influxdata/flux/stdlib/generate"
+	_ "github.com/influxdata/flux/stdlib/geo"
+	_ "github.com/influxdata/flux/stdlib/hash"
 	_ "github.com/influxdata/flux/stdlib/http"
 	_ "github.com/influxdata/flux/stdlib/influxdata/influxdb"
 	_ "github.com/influxdata/flux/stdlib/influxdata/influxdb/v1"
+	_ "github.com/influxdata/flux/stdlib/json"
 	_ "github.com/influxdata/flux/stdlib/kafka"
 	_ "github.com/influxdata/flux/stdlib/math"
+	_ "github.com/influxdata/flux/stdlib/monitor"
+	_ "github.com/influxdata/flux/stdlib/regexp"
+	_ "github.com/influxdata/flux/stdlib/secrets"
 	_ "github.com/influxdata/flux/stdlib/socket"
 	_ "github.com/influxdata/flux/stdlib/sql"
 	_ "github.com/influxdata/flux/stdlib/strings"
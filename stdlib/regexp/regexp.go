@@ -0,0 +1,102 @@
+package regexp
+
+import (
+	"fmt"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+const (
+	regexpArg = "r"
+	stringArg = "v"
+)
+
+var (
+	stringArrayType      = semantic.NewArrayType(semantic.String)
+	stringArrayArrayType = semantic.NewArrayType(stringArrayType)
+)
+
+func init() {
+	flux.RegisterPackageValue("regexp", "findAll", findAll)
+	flux.RegisterPackageValue("regexp", "findAllGroups", findAllGroups)
+}
+
+func getArgs(args values.Object) (values.Value, string, error) {
+	r, ok := args.Get(regexpArg)
+	if !ok {
+		return nil, "", fmt.Errorf("missing argument %q", regexpArg)
+	}
+	if r.Type().Nature() != semantic.Regexp {
+		return nil, "", fmt.Errorf("expected argument %q to be of type %v, got type %v", regexpArg, semantic.Regexp, r.Type().Nature())
+	}
+	v, ok := args.Get(stringArg)
+	if !ok {
+		return nil, "", fmt.Errorf("missing argument %q", stringArg)
+	}
+	if v.Type().Nature() != semantic.String {
+		return nil, "", fmt.Errorf("expected argument %q to be of type %v, got type %v", stringArg, semantic.String, v.Type().Nature())
+	}
+	return r, v.Str(), nil
+}
+
+// findAll returns all successive non-overlapping matches of the regular expression in a string.
+// If there are no matches, the result is an empty array.
+var findAll = values.NewFunction(
+	"findAll",
+	semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{
+			regexpArg: semantic.Regexp,
+			stringArg: semantic.String,
+		},
+		Required: semantic.LabelSet{regexpArg, stringArg},
+		Return:   stringArrayType.PolyType(),
+	}),
+	func(args values.Object) (values.Value, error) {
+		r, v, err := getArgs(args)
+		if err != nil {
+			return nil, err
+		}
+		matches := r.Regexp().FindAllString(v, -1)
+		elements := make([]values.Value, len(matches))
+		for i, m := range matches {
+			elements[i] = values.NewString(m)
+		}
+		return values.NewArrayWithBacking(semantic.String, elements), nil
+	},
+	false,
+)
+
+// findAllGroups returns all successive non-overlapping matches of the regular expression in a string.
+// Each match is an array that contains the text of the whole match followed by the
+// text of each capture group. A group that does not participate in the match is an empty string.
+// If there are no matches, the result is an empty array.
+var findAllGroups = values.NewFunction(
+	"findAllGroups",
+	semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{
+			regexpArg: semantic.Regexp,
+			stringArg: semantic.String,
+		},
+		Required: semantic.LabelSet{regexpArg, stringArg},
+		Return:   stringArrayArrayType.PolyType(),
+	}),
+	func(args values.Object) (values.Value, error) {
+		r, v, err := getArgs(args)
+		if err != nil {
+			return nil, err
+		}
+		matches := r.Regexp().FindAllStringSubmatch(v, -1)
+		elements := make([]values.Value, len(matches))
+		for i, groups := range matches {
+			gs := make([]values.Value, len(groups))
+			for j, g := range groups {
+				gs[j] = values.NewString(g)
+			}
+			elements[i] = values.NewArrayWithBacking(semantic.String, gs)
+		}
+		return values.NewArrayWithBacking(stringArrayType, elements), nil
+	},
+	false,
+)
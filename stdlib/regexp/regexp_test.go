@@ -0,0 +1,141 @@
+package regexp
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+func stringArray(vs ...string) values.Array {
+	elements := make([]values.Value, len(vs))
+	for i, v := range vs {
+		elements[i] = values.NewString(v)
+	}
+	return values.NewArrayWithBacking(semantic.String, elements)
+}
+
+func TestFindAll(t *testing.T) {
+	testCases := []struct {
+		name string
+		r    string
+		v    string
+		want values.Value
+	}{
+		{
+			name: "multiple matches",
+			r:    `\d+`,
+			v:    "a1b22c333",
+			want: stringArray("1", "22", "333"),
+		},
+		{
+			name: "no match",
+			r:    `\d+`,
+			v:    "abc",
+			want: stringArray(),
+		},
+		{
+			name: "overlapping pattern",
+			r:    `aa`,
+			v:    "aaaaa",
+			want: stringArray("aa", "aa"),
+		},
+		{
+			name: "overlapping alternatives",
+			r:    `ab|bc`,
+			v:    "abc",
+			want: stringArray("ab"),
+		},
+		{
+			name: "empty matches",
+			r:    `x*`,
+			v:    "axb",
+			want: stringArray("", "x", ""),
+		},
+		{
+			name: "unicode",
+			r:    `\p{Han}+`,
+			v:    "a日本b語",
+			want: stringArray("日本", "語"),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			args := values.NewObjectWithValues(map[string]values.Value{
+				"r": values.NewRegexp(regexp.MustCompile(tc.r)),
+				"v": values.NewString(tc.v),
+			})
+			got, err := findAll.Call(args)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !values.Equal(tc.want, got) {
+				t.Errorf("unexpected result -want/+got\n\t- %v\n\t+ %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestFindAllGroups(t *testing.T) {
+	groups := func(vs ...values.Value) values.Value {
+		return values.NewArrayWithBacking(semantic.NewArrayType(semantic.String), vs)
+	}
+	testCases := []struct {
+		name string
+		r    string
+		v    string
+		want values.Value
+	}{
+		{
+			name: "capture groups",
+			r:    `(\w+)=(\d+)`,
+			v:    "a=1, bb=22",
+			want: groups(
+				stringArray("a=1", "a", "1"),
+				stringArray("bb=22", "bb", "22"),
+			),
+		},
+		{
+			name: "no groups",
+			r:    `\d`,
+			v:    "1a2",
+			want: groups(
+				stringArray("1"),
+				stringArray("2"),
+			),
+		},
+		{
+			name: "optional group",
+			r:    `(a)(b)?`,
+			v:    "ab a",
+			want: groups(
+				stringArray("ab", "a", "b"),
+				stringArray("a", "a", ""),
+			),
+		},
+		{
+			name: "no match",
+			r:    `(\d)`,
+			v:    "abc",
+			want: groups(),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			args := values.NewObjectWithValues(map[string]values.Value{
+				"r": values.NewRegexp(regexp.MustCompile(tc.r)),
+				"v": values.NewString(tc.v),
+			})
+			got, err := findAllGroups.Call(args)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !values.Equal(tc.want, got) {
+				t.Errorf("unexpected result -want/+got\n\t- %v\n\t+ %v", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+const (
+	// PackagePath is the import path of the secrets package.
+	PackagePath = "secrets"
+	// GetName is the name of the function that loads a secret.
+	GetName = "get"
+
+	keyArg = "key"
+)
+
+func init() {
+	flux.RegisterPackageValue(PackagePath, GetName, Get(context.Background(), nil))
+}
+
+// Get returns a function value that loads the value of a secret with the secret service.
+// If the secret service is nil, calling the function returns an error.
+func Get(ctx context.Context, ss execute.SecretService) values.Value {
+	ftype := semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{
+			keyArg: semantic.String,
+		},
+		Required: semantic.LabelSet{keyArg},
+		Return:   semantic.String,
+	})
+	call := func(args values.Object) (values.Value, error) {
+		key, ok := args.Get(keyArg)
+		if !ok {
+			return nil, fmt.Errorf("missing argument %q", keyArg)
+		}
+		if key.Type() != semantic.String {
+			return nil, fmt.Errorf("argument %q must be a string, got %v", keyArg, key.Type())
+		}
+		if ss == nil {
+			return nil, fmt.Errorf("cannot load secret %q: no secret service is configured", key.Str())
+		}
+		value, err := ss.LoadSecret(ctx, key.Str())
+		if err != nil {
+			return nil, fmt.Errorf("cannot load secret %q: %v", key.Str(), err)
+		}
+		return values.NewString(value), nil
+	}
+	return values.NewFunction(GetName, ftype, call, false)
+}
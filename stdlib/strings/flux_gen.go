@@ -13,19 +13,21 @@ func init() {
 
 var pkgAST = &ast.Package{
 	BaseNode: ast.BaseNode{
-		Errors: nil,
-		Loc:    nil,
+		Comments: nil,
+		Errors:   nil,
+		Loc:      nil,
 	},
 	Files: []*ast.File{&ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 2,
-					Line:   21,
+					Line:   31,
 				},
 				File:   "strings.flux",
-				Source: "package strings\n\n// Transformation functions\nbuiltin title\nbuiltin toUpper\nbuiltin toLower\nbuiltin trim\nbuiltin trimPrefix\nbuiltin trimSpace\nbuiltin trimSuffix\n\n// hack to simulate an imported strings package\nstrings = {\n  title:title\n  toUpper:toUpper\n  toLower:toLower\n  trim:trim\n  trimPrefix:trimPrefix\n  trimSpace:trimSpace\n  trimSuffix:trimSuffix\n}",
+				Source: "package strings\n\n// Transformation functions\nbuiltin title\nbuiltin toUpper\nbuiltin toLower\nbuiltin trim\nbuiltin trimPrefix\nbuiltin trimSpace\nbuiltin trimSuffix\nbuiltin levenshtein\nbuiltin splitN\nbuiltin repeat\nbuiltin padStart\nbuiltin padEnd\n\n// hack to simulate an imported strings package\nstrings = {\n  title:title\n  toUpper:toUpper\n  toLower:toLower\n  trim:trim\n  trimPrefix:trimPrefix\n  trimSpace:trimSpace\n  trimSuffix:trimSuffix\n  levenshtein:levenshtein\n  splitN:splitN\n  repeat:repeat\n  padStart:padStart\n  padEnd:padEnd\n}",
 				Start: ast.Position{
 					Column: 1,
 					Line:   1,
@@ -34,6 +36,21 @@ var pkgAST = &ast.Package{
 		},
 		Body: []ast.Statement{&ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
+				Comments: []ast.Comment{ast.Comment{
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 28,
+							Line:   3,
+						},
+						File:   "strings.flux",
+						Source: "",
+						Start: ast.Position{
+							Column: 1,
+							Line:   3,
+						},
+					},
+					Text: "// Transformation functions",
+				}},
 				Errors: nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
@@ -50,7 +67,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
@@ -68,7 +86,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 16,
@@ -84,7 +103,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 16,
@@ -102,7 +122,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 16,
@@ -118,7 +139,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 16,
@@ -136,7 +158,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 13,
@@ -152,7 +175,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 13,
@@ -170,7 +194,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 19,
@@ -186,7 +211,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 19,
@@ -204,7 +230,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 18,
@@ -220,7 +247,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 18,
@@ -238,7 +266,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 19,
@@ -254,7 +283,8 @@ var pkgAST = &ast.Package{
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 19,
@@ -270,35 +300,231 @@ var pkgAST = &ast.Package{
 				},
 				Name: "trimSuffix",
 			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 20,
+						Line:   11,
+					},
+					File:   "strings.flux",
+					Source: "builtin levenshtein",
+					Start: ast.Position{
+						Column: 1,
+						Line:   11,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 20,
+							Line:   11,
+						},
+						File:   "strings.flux",
+						Source: "levenshtein",
+						Start: ast.Position{
+							Column: 9,
+							Line:   11,
+						},
+					},
+				},
+				Name: "levenshtein",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 15,
+						Line:   12,
+					},
+					File:   "strings.flux",
+					Source: "builtin splitN",
+					Start: ast.Position{
+						Column: 1,
+						Line:   12,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 15,
+							Line:   12,
+						},
+						File:   "strings.flux",
+						Source: "splitN",
+						Start: ast.Position{
+							Column: 9,
+							Line:   12,
+						},
+					},
+				},
+				Name: "splitN",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 15,
+						Line:   13,
+					},
+					File:   "strings.flux",
+					Source: "builtin repeat",
+					Start: ast.Position{
+						Column: 1,
+						Line:   13,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 15,
+							Line:   13,
+						},
+						File:   "strings.flux",
+						Source: "repeat",
+						Start: ast.Position{
+							Column: 9,
+							Line:   13,
+						},
+					},
+				},
+				Name: "repeat",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 17,
+						Line:   14,
+					},
+					File:   "strings.flux",
+					Source: "builtin padStart",
+					Start: ast.Position{
+						Column: 1,
+						Line:   14,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 17,
+							Line:   14,
+						},
+						File:   "strings.flux",
+						Source: "padStart",
+						Start: ast.Position{
+							Column: 9,
+							Line:   14,
+						},
+					},
+				},
+				Name: "padStart",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 15,
+						Line:   15,
+					},
+					File:   "strings.flux",
+					Source: "builtin padEnd",
+					Start: ast.Position{
+						Column: 1,
+						Line:   15,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 15,
+							Line:   15,
+						},
+						File:   "strings.flux",
+						Source: "padEnd",
+						Start: ast.Position{
+							Column: 9,
+							Line:   15,
+						},
+					},
+				},
+				Name: "padEnd",
+			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
+				Comments: []ast.Comment{ast.Comment{
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 48,
+							Line:   17,
+						},
+						File:   "strings.flux",
+						Source: "",
+						Start: ast.Position{
+							Column: 1,
+							Line:   17,
+						},
+					},
+					Text: "// hack to simulate an imported strings package",
+				}},
 				Errors: nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
-						Line:   21,
+						Line:   31,
 					},
 					File:   "strings.flux",
-					Source: "strings = {\n  title:title\n  toUpper:toUpper\n  toLower:toLower\n  trim:trim\n  trimPrefix:trimPrefix\n  trimSpace:trimSpace\n  trimSuffix:trimSuffix\n}",
+					Source: "strings = {\n  title:title\n  toUpper:toUpper\n  toLower:toLower\n  trim:trim\n  trimPrefix:trimPrefix\n  trimSpace:trimSpace\n  trimSuffix:trimSuffix\n  levenshtein:levenshtein\n  splitN:splitN\n  repeat:repeat\n  padStart:padStart\n  padEnd:padEnd\n}",
 					Start: ast.Position{
 						Column: 1,
-						Line:   13,
+						Line:   18,
 					},
 				},
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 8,
-							Line:   13,
+							Line:   18,
 						},
 						File:   "strings.flux",
 						Source: "strings",
 						Start: ast.Position{
 							Column: 1,
-							Line:   13,
+							Line:   18,
 						},
 					},
 				},
@@ -306,49 +532,52 @@ var pkgAST = &ast.Package{
 			},
 			Init: &ast.ObjectExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
-							Line:   21,
+							Line:   31,
 						},
 						File:   "strings.flux",
-						Source: "{\n  title:title\n  toUpper:toUpper\n  toLower:toLower\n  trim:trim\n  trimPrefix:trimPrefix\n  trimSpace:trimSpace\n  trimSuffix:trimSuffix\n}",
+						Source: "{\n  title:title\n  toUpper:toUpper\n  toLower:toLower\n  trim:trim\n  trimPrefix:trimPrefix\n  trimSpace:trimSpace\n  trimSuffix:trimSuffix\n  levenshtein:levenshtein\n  splitN:splitN\n  repeat:repeat\n  padStart:padStart\n  padEnd:padEnd\n}",
 						Start: ast.Position{
 							Column: 11,
-							Line:   13,
+							Line:   18,
 						},
 					},
 				},
 				Properties: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 14,
-								Line:   14,
+								Line:   19,
 							},
 							File:   "strings.flux",
 							Source: "title:title",
 							Start: ast.Position{
 								Column: 3,
-								Line:   14,
+								Line:   19,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 8,
-									Line:   14,
+									Line:   19,
 								},
 								File:   "strings.flux",
 								Source: "title",
 								Start: ast.Position{
 									Column: 3,
-									Line:   14,
+									Line:   19,
 								},
 							},
 						},
@@ -356,17 +585,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 14,
-									Line:   14,
+									Line:   19,
 								},
 								File:   "strings.flux",
 								Source: "title",
 								Start: ast.Position{
 									Column: 9,
-									Line:   14,
+									Line:   19,
 								},
 							},
 						},
@@ -374,33 +604,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 18,
-								Line:   15,
+								Line:   20,
 							},
 							File:   "strings.flux",
 							Source: "toUpper:toUpper",
 							Start: ast.Position{
 								Column: 3,
-								Line:   15,
+								Line:   20,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 10,
-									Line:   15,
+									Line:   20,
 								},
 								File:   "strings.flux",
 								Source: "toUpper",
 								Start: ast.Position{
 									Column: 3,
-									Line:   15,
+									Line:   20,
 								},
 							},
 						},
@@ -408,17 +640,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 18,
-									Line:   15,
+									Line:   20,
 								},
 								File:   "strings.flux",
 								Source: "toUpper",
 								Start: ast.Position{
 									Column: 11,
-									Line:   15,
+									Line:   20,
 								},
 							},
 						},
@@ -426,33 +659,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 18,
-								Line:   16,
+								Line:   21,
 							},
 							File:   "strings.flux",
 							Source: "toLower:toLower",
 							Start: ast.Position{
 								Column: 3,
-								Line:   16,
+								Line:   21,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 10,
-									Line:   16,
+									Line:   21,
 								},
 								File:   "strings.flux",
 								Source: "toLower",
 								Start: ast.Position{
 									Column: 3,
-									Line:   16,
+									Line:   21,
 								},
 							},
 						},
@@ -460,17 +695,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 18,
-									Line:   16,
+									Line:   21,
 								},
 								File:   "strings.flux",
 								Source: "toLower",
 								Start: ast.Position{
 									Column: 11,
-									Line:   16,
+									Line:   21,
 								},
 							},
 						},
@@ -478,33 +714,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 12,
-								Line:   17,
+								Line:   22,
 							},
 							File:   "strings.flux",
 							Source: "trim:trim",
 							Start: ast.Position{
 								Column: 3,
-								Line:   17,
+								Line:   22,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 7,
-									Line:   17,
+									Line:   22,
 								},
 								File:   "strings.flux",
 								Source: "trim",
 								Start: ast.Position{
 									Column: 3,
-									Line:   17,
+									Line:   22,
 								},
 							},
 						},
@@ -512,17 +750,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 12,
-									Line:   17,
+									Line:   22,
 								},
 								File:   "strings.flux",
 								Source: "trim",
 								Start: ast.Position{
 									Column: 8,
-									Line:   17,
+									Line:   22,
 								},
 							},
 						},
@@ -530,33 +769,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 24,
-								Line:   18,
+								Line:   23,
 							},
 							File:   "strings.flux",
 							Source: "trimPrefix:trimPrefix",
 							Start: ast.Position{
 								Column: 3,
-								Line:   18,
+								Line:   23,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 13,
-									Line:   18,
+									Line:   23,
 								},
 								File:   "strings.flux",
 								Source: "trimPrefix",
 								Start: ast.Position{
 									Column: 3,
-									Line:   18,
+									Line:   23,
 								},
 							},
 						},
@@ -564,17 +805,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 24,
-									Line:   18,
+									Line:   23,
 								},
 								File:   "strings.flux",
 								Source: "trimPrefix",
 								Start: ast.Position{
 									Column: 14,
-									Line:   18,
+									Line:   23,
 								},
 							},
 						},
@@ -582,33 +824,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 22,
-								Line:   19,
+								Line:   24,
 							},
 							File:   "strings.flux",
 							Source: "trimSpace:trimSpace",
 							Start: ast.Position{
 								Column: 3,
-								Line:   19,
+								Line:   24,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 12,
-									Line:   19,
+									Line:   24,
 								},
 								File:   "strings.flux",
 								Source: "trimSpace",
 								Start: ast.Position{
 									Column: 3,
-									Line:   19,
+									Line:   24,
 								},
 							},
 						},
@@ -616,17 +860,18 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 22,
-									Line:   19,
+									Line:   24,
 								},
 								File:   "strings.flux",
 								Source: "trimSpace",
 								Start: ast.Position{
 									Column: 13,
-									Line:   19,
+									Line:   24,
 								},
 							},
 						},
@@ -634,33 +879,35 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 24,
-								Line:   20,
+								Line:   25,
 							},
 							File:   "strings.flux",
 							Source: "trimSuffix:trimSuffix",
 							Start: ast.Position{
 								Column: 3,
-								Line:   20,
+								Line:   25,
 							},
 						},
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 13,
-									Line:   20,
+									Line:   25,
 								},
 								File:   "strings.flux",
 								Source: "trimSuffix",
 								Start: ast.Position{
 									Column: 3,
-									Line:   20,
+									Line:   25,
 								},
 							},
 						},
@@ -668,30 +915,308 @@ var pkgAST = &ast.Package{
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 24,
-									Line:   20,
+									Line:   25,
 								},
 								File:   "strings.flux",
 								Source: "trimSuffix",
 								Start: ast.Position{
 									Column: 14,
-									Line:   20,
+									Line:   25,
 								},
 							},
 						},
 						Name: "trimSuffix",
 					},
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 26,
+								Line:   26,
+							},
+							File:   "strings.flux",
+							Source: "levenshtein:levenshtein",
+							Start: ast.Position{
+								Column: 3,
+								Line:   26,
+							},
+						},
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 14,
+									Line:   26,
+								},
+								File:   "strings.flux",
+								Source: "levenshtein",
+								Start: ast.Position{
+									Column: 3,
+									Line:   26,
+								},
+							},
+						},
+						Name: "levenshtein",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 26,
+									Line:   26,
+								},
+								File:   "strings.flux",
+								Source: "levenshtein",
+								Start: ast.Position{
+									Column: 15,
+									Line:   26,
+								},
+							},
+						},
+						Name: "levenshtein",
+					},
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 16,
+								Line:   27,
+							},
+							File:   "strings.flux",
+							Source: "splitN:splitN",
+							Start: ast.Position{
+								Column: 3,
+								Line:   27,
+							},
+						},
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 9,
+									Line:   27,
+								},
+								File:   "strings.flux",
+								Source: "splitN",
+								Start: ast.Position{
+									Column: 3,
+									Line:   27,
+								},
+							},
+						},
+						Name: "splitN",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 16,
+									Line:   27,
+								},
+								File:   "strings.flux",
+								Source: "splitN",
+								Start: ast.Position{
+									Column: 10,
+									Line:   27,
+								},
+							},
+						},
+						Name: "splitN",
+					},
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 16,
+								Line:   28,
+							},
+							File:   "strings.flux",
+							Source: "repeat:repeat",
+							Start: ast.Position{
+								Column: 3,
+								Line:   28,
+							},
+						},
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 9,
+									Line:   28,
+								},
+								File:   "strings.flux",
+								Source: "repeat",
+								Start: ast.Position{
+									Column: 3,
+									Line:   28,
+								},
+							},
+						},
+						Name: "repeat",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 16,
+									Line:   28,
+								},
+								File:   "strings.flux",
+								Source: "repeat",
+								Start: ast.Position{
+									Column: 10,
+									Line:   28,
+								},
+							},
+						},
+						Name: "repeat",
+					},
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 20,
+								Line:   29,
+							},
+							File:   "strings.flux",
+							Source: "padStart:padStart",
+							Start: ast.Position{
+								Column: 3,
+								Line:   29,
+							},
+						},
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 11,
+									Line:   29,
+								},
+								File:   "strings.flux",
+								Source: "padStart",
+								Start: ast.Position{
+									Column: 3,
+									Line:   29,
+								},
+							},
+						},
+						Name: "padStart",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 20,
+									Line:   29,
+								},
+								File:   "strings.flux",
+								Source: "padStart",
+								Start: ast.Position{
+									Column: 12,
+									Line:   29,
+								},
+							},
+						},
+						Name: "padStart",
+					},
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 16,
+								Line:   30,
+							},
+							File:   "strings.flux",
+							Source: "padEnd:padEnd",
+							Start: ast.Position{
+								Column: 3,
+								Line:   30,
+							},
+						},
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 9,
+									Line:   30,
+								},
+								File:   "strings.flux",
+								Source: "padEnd",
+								Start: ast.Position{
+									Column: 3,
+									Line:   30,
+								},
+							},
+						},
+						Name: "padEnd",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 16,
+									Line:   30,
+								},
+								File:   "strings.flux",
+								Source: "padEnd",
+								Start: ast.Position{
+									Column: 10,
+									Line:   30,
+								},
+							},
+						},
+						Name: "padEnd",
+					},
 				}},
+				With: nil,
 			},
 		}},
 		Imports: nil,
 		Name:    "strings.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 16,
@@ -707,7 +1232,8 @@ var pkgAST = &ast.Package{
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 16,
@@ -3,6 +3,7 @@ package strings
 import (
 	"fmt"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/semantic"
@@ -14,6 +15,13 @@ const (
 	cutset    = "cutset"
 	prefix    = "prefix"
 	suffix    = "suffix"
+	firstArg  = "a"
+	secondArg = "b"
+	separator = "t"
+	limit     = "n"
+	count     = "i"
+	width     = "width"
+	pad       = "pad"
 )
 
 func generateSingleArgStringFunction(name string, stringFn func(string) string) values.Function {
@@ -81,6 +89,224 @@ func generateDualArgStringFunction(name string, argNames []string, stringFn func
 	)
 }
 
+// levenshtein returns the edit distance between two strings.
+// The distance is counted in runes so that a single multi-byte
+// UTF-8 character counts as one edit.
+func levenshtein(a, b string) int {
+	s, t := []rune(a), []rune(b)
+	if len(s) == 0 {
+		return len(t)
+	}
+	if len(t) == 0 {
+		return len(s)
+	}
+
+	// Only the previous row of the distance matrix is needed
+	// to compute the next one.
+	row := make([]int, len(t)+1)
+	for j := range row {
+		row[j] = j
+	}
+	for i := 1; i <= len(s); i++ {
+		prev := row[0]
+		row[0] = i
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			cur := row[j] + 1
+			if d := row[j-1] + 1; d < cur {
+				cur = d
+			}
+			if d := prev + cost; d < cur {
+				cur = d
+			}
+			prev, row[j] = row[j], cur
+		}
+	}
+	return row[len(t)]
+}
+
+var levenshteinFunc = values.NewFunction(
+	"levenshtein",
+	semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{
+			firstArg:  semantic.String,
+			secondArg: semantic.String,
+		},
+		Required: semantic.LabelSet{firstArg, secondArg},
+		Return:   semantic.Int,
+	}),
+	func(args values.Object) (values.Value, error) {
+		var argVals = make([]string, 2)
+
+		for i, name := range []string{firstArg, secondArg} {
+			val, ok := args.Get(name)
+			if !ok {
+				return nil, fmt.Errorf("missing argument %q", name)
+			}
+
+			if val.Type().Nature() != semantic.String {
+				return nil, fmt.Errorf("expected argument %q to be of type %v, got type %v", name, semantic.String, val.Type().Nature())
+			}
+
+			argVals[i] = val.Str()
+		}
+
+		return values.NewInt(int64(levenshtein(argVals[0], argVals[1]))), nil
+	},
+	false,
+)
+
+// splitNFunc splits a string into substrings separated by a separator
+// and follows the semantics of strings.SplitN for the limit n.
+var splitNFunc = values.NewFunction(
+	"splitN",
+	semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{
+			stringArg: semantic.String,
+			separator: semantic.String,
+			limit:     semantic.Int,
+		},
+		Required: semantic.LabelSet{stringArg, separator, limit},
+		Return:   semantic.NewArrayPolyType(semantic.String),
+	}),
+	func(args values.Object) (values.Value, error) {
+		var argVals = make([]string, 2)
+
+		for i, name := range []string{stringArg, separator} {
+			val, ok := args.Get(name)
+			if !ok {
+				return nil, fmt.Errorf("missing argument %q", name)
+			}
+
+			if val.Type().Nature() != semantic.String {
+				return nil, fmt.Errorf("expected argument %q to be of type %v, got type %v", name, semantic.String, val.Type().Nature())
+			}
+
+			argVals[i] = val.Str()
+		}
+
+		n, ok := args.Get(limit)
+		if !ok {
+			return nil, fmt.Errorf("missing argument %q", limit)
+		}
+		if n.Type().Nature() != semantic.Int {
+			return nil, fmt.Errorf("expected argument %q to be of type %v, got type %v", limit, semantic.Int, n.Type().Nature())
+		}
+
+		parts := strings.SplitN(argVals[0], argVals[1], int(n.Int()))
+		elements := make([]values.Value, len(parts))
+		for i, part := range parts {
+			elements[i] = values.NewString(part)
+		}
+		return values.NewArrayWithBacking(semantic.String, elements), nil
+	},
+	false,
+)
+
+// repeatFunc returns a string of i copies of v.
+var repeatFunc = values.NewFunction(
+	"repeat",
+	semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{
+			stringArg: semantic.String,
+			count:     semantic.Int,
+		},
+		Required: semantic.LabelSet{stringArg, count},
+		Return:   semantic.String,
+	}),
+	func(args values.Object) (values.Value, error) {
+		v, ok := args.Get(stringArg)
+		if !ok {
+			return nil, fmt.Errorf("missing argument %q", stringArg)
+		}
+		if v.Type().Nature() != semantic.String {
+			return nil, fmt.Errorf("expected argument %q to be of type %v, got type %v", stringArg, semantic.String, v.Type().Nature())
+		}
+		i, ok := args.Get(count)
+		if !ok {
+			return nil, fmt.Errorf("missing argument %q", count)
+		}
+		if i.Type().Nature() != semantic.Int {
+			return nil, fmt.Errorf("expected argument %q to be of type %v, got type %v", count, semantic.Int, i.Type().Nature())
+		}
+
+		str, n := v.Str(), i.Int()
+		if n < 0 {
+			return nil, fmt.Errorf("argument %q must not be negative, got %d", count, n)
+		}
+		if n > 0 && int64(len(str))*n/n != int64(len(str)) {
+			return nil, fmt.Errorf("repeating a string of %d bytes %d times overflows", len(str), n)
+		}
+		return values.NewString(strings.Repeat(str, int(n))), nil
+	},
+	false,
+)
+
+// generatePadFunction returns a function that pads v with copies of pad until it is width runes long.
+// The padding is added at the start of v when atStart is true and at its end otherwise.
+// Strings that are already at least width runes long are returned unchanged.
+func generatePadFunction(name string, atStart bool) values.Function {
+	return values.NewFunction(
+		name,
+		semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+			Parameters: map[string]semantic.PolyType{
+				stringArg: semantic.String,
+				width:     semantic.Int,
+				pad:       semantic.String,
+			},
+			Required: semantic.LabelSet{stringArg, width, pad},
+			Return:   semantic.String,
+		}),
+		func(args values.Object) (values.Value, error) {
+			var argVals = make([]string, 2)
+
+			for i, name := range []string{stringArg, pad} {
+				val, ok := args.Get(name)
+				if !ok {
+					return nil, fmt.Errorf("missing argument %q", name)
+				}
+
+				if val.Type().Nature() != semantic.String {
+					return nil, fmt.Errorf("expected argument %q to be of type %v, got type %v", name, semantic.String, val.Type().Nature())
+				}
+
+				argVals[i] = val.Str()
+			}
+
+			w, ok := args.Get(width)
+			if !ok {
+				return nil, fmt.Errorf("missing argument %q", width)
+			}
+			if w.Type().Nature() != semantic.Int {
+				return nil, fmt.Errorf("expected argument %q to be of type %v, got type %v", width, semantic.Int, w.Type().Nature())
+			}
+
+			str, padStr := argVals[0], []rune(argVals[1])
+			n := w.Int() - int64(utf8.RuneCountInString(str))
+			if n <= 0 {
+				return values.NewString(str), nil
+			}
+			if len(padStr) == 0 {
+				return nil, fmt.Errorf("argument %q must not be empty", pad)
+			}
+
+			// Repeat the pad string and cut the last copy short to fill exactly n runes.
+			padding := make([]rune, n)
+			for i := range padding {
+				padding[i] = padStr[i%len(padStr)]
+			}
+			if atStart {
+				return values.NewString(string(padding) + str), nil
+			}
+			return values.NewString(str + string(padding)), nil
+		},
+		false,
+	)
+}
+
 func init() {
 	flux.RegisterPackageValue("strings", "trim", generateDualArgStringFunction("trim", []string{stringArg, cutset}, strings.Trim))
 	flux.RegisterPackageValue("strings", "trimSpace", generateSingleArgStringFunction("trimSpace", strings.TrimSpace))
@@ -89,4 +315,9 @@ func init() {
 	flux.RegisterPackageValue("strings", "title", generateSingleArgStringFunction("title", strings.Title))
 	flux.RegisterPackageValue("strings", "toUpper", generateSingleArgStringFunction("toUpper", strings.ToUpper))
 	flux.RegisterPackageValue("strings", "toLower", generateSingleArgStringFunction("toLower", strings.ToLower))
+	flux.RegisterPackageValue("strings", "levenshtein", levenshteinFunc)
+	flux.RegisterPackageValue("strings", "splitN", splitNFunc)
+	flux.RegisterPackageValue("strings", "repeat", repeatFunc)
+	flux.RegisterPackageValue("strings", "padStart", generatePadFunction("padStart", true))
+	flux.RegisterPackageValue("strings", "padEnd", generatePadFunction("padEnd", false))
 }
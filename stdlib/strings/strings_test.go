@@ -1,9 +1,11 @@
 package strings
 
 import (
+	"math"
 	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/flux/values"
 )
 
@@ -247,3 +249,318 @@ func TestToLower(t *testing.T) {
 
 	}
 }
+
+func TestLevenshtein(t *testing.T) {
+	testCases := []struct {
+		name string
+		a    string
+		b    string
+		want int64
+	}{
+		{
+			name: "identical strings",
+			a:    "koala",
+			b:    "koala",
+			want: 0,
+		},
+		{
+			name: "empty strings",
+			a:    "",
+			b:    "",
+			want: 0,
+		},
+		{
+			name: "one empty string",
+			a:    "",
+			b:    "koala",
+			want: 5,
+		},
+		{
+			name: "substitution",
+			a:    "koala",
+			b:    "koola",
+			want: 1,
+		},
+		{
+			name: "insertion",
+			a:    "koala",
+			b:    "koalas",
+			want: 1,
+		},
+		{
+			name: "deletion",
+			a:    "koala",
+			b:    "kola",
+			want: 1,
+		},
+		{
+			name: "multiple edits",
+			a:    "kitten",
+			b:    "sitting",
+			want: 3,
+		},
+		{
+			name: "unicode",
+			a:    "café",
+			b:    "cafe",
+			want: 1,
+		},
+		{
+			name: "unicode only",
+			a:    "日本語",
+			b:    "日本",
+			want: 1,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			testCase := values.NewObjectWithValues(map[string]values.Value{"a": values.NewString(tc.a), "b": values.NewString(tc.b)})
+			result, err := levenshteinFunc.Call(testCase)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if res := result.Int(); res != tc.want {
+				t.Errorf("string function result %s expected: %d, got: %d", tc.name, tc.want, res)
+			}
+		})
+	}
+}
+
+func TestSplitN(t *testing.T) {
+	testCases := []struct {
+		name string
+		v    string
+		t    string
+		n    int64
+		want []string
+	}{
+		{
+			name: "n larger than occurrences",
+			v:    "key=value=with=equals",
+			t:    "=",
+			n:    10,
+			want: []string{"key", "value", "with", "equals"},
+		},
+		{
+			name: "remainder kept intact",
+			v:    "key=value=with=equals",
+			t:    "=",
+			n:    2,
+			want: []string{"key", "value=with=equals"},
+		},
+		{
+			name: "n is one",
+			v:    "key=value=with=equals",
+			t:    "=",
+			n:    1,
+			want: []string{"key=value=with=equals"},
+		},
+		{
+			name: "n is zero",
+			v:    "key=value=with=equals",
+			t:    "=",
+			n:    0,
+			want: []string{},
+		},
+		{
+			name: "n is negative",
+			v:    "key=value=with=equals",
+			t:    "=",
+			n:    -1,
+			want: []string{"key", "value", "with", "equals"},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			testCase := values.NewObjectWithValues(map[string]values.Value{
+				"v": values.NewString(tc.v),
+				"t": values.NewString(tc.t),
+				"n": values.NewInt(tc.n),
+			})
+			result, err := splitNFunc.Call(testCase)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			arr := result.Array()
+			got := make([]string, arr.Len())
+			arr.Range(func(i int, v values.Value) {
+				got[i] = v.Str()
+			})
+			if !cmp.Equal(tc.want, got) {
+				t.Errorf("string function result %s unexpected result -want/+got:\n%s", tc.name, cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+func TestRepeat(t *testing.T) {
+	testCases := []struct {
+		name    string
+		v       string
+		i       int64
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "zero",
+			v:    "ab",
+			i:    0,
+			want: "",
+		},
+		{
+			name: "one",
+			v:    "ab",
+			i:    1,
+			want: "ab",
+		},
+		{
+			name: "positive",
+			v:    "-=",
+			i:    3,
+			want: "-=-=-=",
+		},
+		{
+			name: "empty string",
+			v:    "",
+			i:    5,
+			want: "",
+		},
+		{
+			name: "unicode",
+			v:    "日本",
+			i:    2,
+			want: "日本日本",
+		},
+		{
+			name:    "negative",
+			v:       "ab",
+			i:       -1,
+			wantErr: true,
+		},
+		{
+			name:    "overflow",
+			v:       "ab",
+			i:       math.MaxInt64,
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			testCase := values.NewObjectWithValues(map[string]values.Value{
+				"v": values.NewString(tc.v),
+				"i": values.NewInt(tc.i),
+			})
+			result, err := repeatFunc.Call(testCase)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %v", result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := result.Str(); got != tc.want {
+				t.Errorf("string function result %s expected %q, got %q", tc.name, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestPad(t *testing.T) {
+	testCases := []struct {
+		name    string
+		v       string
+		width   int64
+		pad     string
+		start   string
+		end     string
+		wantErr bool
+	}{
+		{
+			name:  "single rune pad",
+			v:     "42",
+			width: 5,
+			pad:   "0",
+			start: "00042",
+			end:   "42000",
+		},
+		{
+			name:  "multi-rune pad",
+			v:     "ab",
+			width: 7,
+			pad:   "-=",
+			start: "-=-=-ab",
+			end:   "ab-=-=-",
+		},
+		{
+			name:  "unicode",
+			v:     "日本",
+			width: 4,
+			pad:   "語",
+			start: "語語日本",
+			end:   "日本語語",
+		},
+		{
+			name:  "already long",
+			v:     "koala",
+			width: 3,
+			pad:   "*",
+			start: "koala",
+			end:   "koala",
+		},
+		{
+			name:  "exact width",
+			v:     "日本",
+			width: 2,
+			pad:   "*",
+			start: "日本",
+			end:   "日本",
+		},
+		{
+			name:  "empty pad no-op",
+			v:     "abc",
+			width: 2,
+			pad:   "",
+			start: "abc",
+			end:   "abc",
+		},
+		{
+			name:    "empty pad",
+			v:       "abc",
+			width:   5,
+			pad:     "",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			testCase := values.NewObjectWithValues(map[string]values.Value{
+				"v":     values.NewString(tc.v),
+				"width": values.NewInt(tc.width),
+				"pad":   values.NewString(tc.pad),
+			})
+			for name, want := range map[string]string{"padStart": tc.start, "padEnd": tc.end} {
+				result, err := generatePadFunction(name, name == "padStart").Call(testCase)
+				if tc.wantErr {
+					if err == nil {
+						t.Fatalf("%s expected error, got %v", name, result)
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got := result.Str(); got != want {
+					t.Errorf("string function result %s expected %q, got %q", name, want, got)
+				}
+			}
+		})
+	}
+}
@@ -109,6 +109,9 @@ type AssertEqualsTransformation struct {
 	a     *memory.Allocator
 
 	name string
+	// tolerance is the tolerance used to compare float columns,
+	// the columns are compared exactly when it is nil.
+	tolerance *tolerance
 }
 
 type AssertEqualsError struct {
@@ -193,7 +196,13 @@ func (t *AssertEqualsTransformation) Process(id execute.DatasetID, tbl flux.Tabl
 		if err != nil {
 			return err
 		}
-		if ok, err := execute.TablesEqual(cacheTable, tbl, t.a); err != nil {
+		var ok bool
+		if t.tolerance != nil {
+			ok, err = tablesEqualWithTolerance(cacheTable, tbl, *t.tolerance)
+		} else {
+			ok, err = execute.TablesEqual(cacheTable, tbl, t.a)
+		}
+		if err != nil {
 			return err
 		} else if !ok {
 			t.unequal = true
@@ -0,0 +1,234 @@
+package testing
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+const AssertEqualsWithToleranceKind = "assertEqualsWithTolerance"
+
+type AssertEqualsWithToleranceOpSpec struct {
+	Name              string  `json:"name"`
+	Tolerance         float64 `json:"tolerance"`
+	RelativeTolerance float64 `json:"relativeTolerance,omitempty"`
+}
+
+func (s *AssertEqualsWithToleranceOpSpec) Kind() flux.OperationKind {
+	return AssertEqualsWithToleranceKind
+}
+
+func init() {
+	assertEqualsWithToleranceSignature := semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{
+			"name":              semantic.String,
+			"got":               flux.TableObjectType,
+			"want":              flux.TableObjectType,
+			"tolerance":         semantic.Float,
+			"relativeTolerance": semantic.Float,
+		},
+		Required:     semantic.LabelSet{"name", "got", "want", "tolerance"},
+		Return:       flux.TableObjectType,
+		PipeArgument: "got",
+	}
+
+	flux.RegisterPackageValue("testing", "assertEqualsWithTolerance", flux.FunctionValue(AssertEqualsWithToleranceKind, createAssertEqualsWithToleranceOpSpec, assertEqualsWithToleranceSignature))
+	flux.RegisterOpSpec(AssertEqualsWithToleranceKind, newAssertEqualsWithToleranceOp)
+	plan.RegisterProcedureSpec(AssertEqualsWithToleranceKind, newAssertEqualsWithToleranceProcedure, AssertEqualsWithToleranceKind)
+	execute.RegisterTransformation(AssertEqualsWithToleranceKind, createAssertEqualsWithToleranceTransformation)
+}
+
+func createAssertEqualsWithToleranceOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	t, err := args.GetRequiredObject("got")
+	if err != nil {
+		return nil, err
+	}
+	p, ok := t.(*flux.TableObject)
+	if !ok {
+		return nil, errors.New("got input to assertEqualsWithTolerance is not a table object")
+	}
+	a.AddParent(p)
+
+	t, err = args.GetRequiredObject("want")
+	if err != nil {
+		return nil, err
+	}
+	p, ok = t.(*flux.TableObject)
+	if !ok {
+		return nil, errors.New("want input to assertEqualsWithTolerance is not a table object")
+	}
+	a.AddParent(p)
+
+	spec := new(AssertEqualsWithToleranceOpSpec)
+	if spec.Name, err = args.GetRequiredString("name"); err != nil {
+		return nil, err
+	}
+	if spec.Tolerance, err = args.GetRequiredFloat("tolerance"); err != nil {
+		return nil, err
+	}
+	if spec.Tolerance < 0 {
+		return nil, errors.New("tolerance must not be negative")
+	}
+	if rel, ok, err := args.GetFloat("relativeTolerance"); err != nil {
+		return nil, err
+	} else if ok {
+		if rel < 0 {
+			return nil, errors.New("relativeTolerance must not be negative")
+		}
+		spec.RelativeTolerance = rel
+	}
+	return spec, nil
+}
+
+func newAssertEqualsWithToleranceOp() flux.OperationSpec {
+	return new(AssertEqualsWithToleranceOpSpec)
+}
+
+type AssertEqualsWithToleranceProcedureSpec struct {
+	plan.DefaultCost
+	Name              string
+	Tolerance         float64
+	RelativeTolerance float64
+}
+
+func (s *AssertEqualsWithToleranceProcedureSpec) Kind() plan.ProcedureKind {
+	return AssertEqualsWithToleranceKind
+}
+
+func (s *AssertEqualsWithToleranceProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := *s
+	return &ns
+}
+
+func newAssertEqualsWithToleranceProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*AssertEqualsWithToleranceOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+	return &AssertEqualsWithToleranceProcedureSpec{
+		Name:              spec.Name,
+		Tolerance:         spec.Tolerance,
+		RelativeTolerance: spec.RelativeTolerance,
+	}, nil
+}
+
+func createAssertEqualsWithToleranceTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	if len(a.Parents()) != 2 {
+		return nil, nil, errors.New("assertEqualsWithTolerance should have exactly 2 parents")
+	}
+
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	dataset := execute.NewDataset(id, mode, cache)
+	pspec, ok := spec.(*AssertEqualsWithToleranceProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+
+	transform := NewAssertEqualsWithToleranceTransformation(dataset, cache, pspec, a.Parents()[0], a.Parents()[1], a.Allocator())
+	return transform, dataset, nil
+}
+
+// NewAssertEqualsWithToleranceTransformation creates an assertEquals transformation
+// that compares float columns within the tolerance of the spec.
+func NewAssertEqualsWithToleranceTransformation(d execute.Dataset, cache execute.TableBuilderCache, spec *AssertEqualsWithToleranceProcedureSpec, gotID, wantID execute.DatasetID, a *memory.Allocator) *AssertEqualsTransformation {
+	t := NewAssertEqualsTransformation(d, cache, &AssertEqualsProcedureSpec{Name: spec.Name}, gotID, wantID, a)
+	t.tolerance = &tolerance{
+		absolute: spec.Tolerance,
+		relative: spec.RelativeTolerance,
+	}
+	return t
+}
+
+// tolerance is how far apart two float values may be and still be equal.
+type tolerance struct {
+	absolute float64
+	relative float64
+}
+
+// equal reports whether the difference between a and b is within the absolute tolerance
+// or within the relative tolerance of the larger magnitude of a and b.
+// NaN is equal to NaN and infinities are equal when they have the same sign.
+func (t tolerance) equal(a, b float64) bool {
+	if a == b || (math.IsNaN(a) && math.IsNaN(b)) {
+		return true
+	}
+	diff := math.Abs(a - b)
+	if math.IsNaN(diff) || math.IsInf(diff, 0) {
+		return false
+	}
+	return diff <= t.absolute || diff <= t.relative*math.Max(math.Abs(a), math.Abs(b))
+}
+
+// tablesEqualWithTolerance reports whether two tables have the same columns and rows.
+// Float values are compared with the tolerance and all other values are compared exactly.
+// Null values are only equal to other null values.
+func tablesEqualWithTolerance(left, right flux.Table, tol tolerance) (bool, error) {
+	if !colsEqual(left.Key().Cols(), right.Key().Cols()) || !colsEqual(left.Cols(), right.Cols()) {
+		return false, nil
+	}
+	l, err := tableRows(left)
+	if err != nil {
+		return false, err
+	}
+	r, err := tableRows(right)
+	if err != nil {
+		return false, err
+	}
+	if len(l) != len(r) {
+		return false, nil
+	}
+	for i := range l {
+		for j, c := range left.Cols() {
+			lv, rv := l[i][j], r[i][j]
+			if lv.IsNull() || rv.IsNull() {
+				if lv.IsNull() != rv.IsNull() {
+					return false, nil
+				}
+				continue
+			}
+			if c.Type == flux.TFloat {
+				if !tol.equal(lv.Float(), rv.Float()) {
+					return false, nil
+				}
+			} else if !lv.Equal(rv) {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+func colsEqual(left, right []flux.ColMeta) bool {
+	if len(left) != len(right) {
+		return false
+	}
+	for j, c := range left {
+		if c != right[j] {
+			return false
+		}
+	}
+	return true
+}
+
+// tableRows reads the values of each row of a table.
+func tableRows(tbl flux.Table) ([][]values.Value, error) {
+	var rows [][]values.Value
+	err := tbl.Do(func(cr flux.ColReader) error {
+		for i := 0; i < cr.Len(); i++ {
+			row := make([]values.Value, len(cr.Cols()))
+			for j := range row {
+				row[j] = execute.ValueForRow(cr, i, j)
+			}
+			rows = append(rows, row)
+		}
+		return nil
+	})
+	return rows, err
+}
@@ -0,0 +1,170 @@
+package testing_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influxdata/flux"
+	_ "github.com/influxdata/flux/builtin"
+	"github.com/influxdata/flux/lang"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/querytest"
+	fluxtesting "github.com/influxdata/flux/stdlib/testing"
+)
+
+func TestAssertEqualsWithToleranceOperation_Marshaling(t *testing.T) {
+	data := []byte(`{"id":"assertEqualsWithTolerance","kind":"assertEqualsWithTolerance","spec":{"name":"simple","tolerance":0.01,"relativeTolerance":0.001}}`)
+	op := &flux.Operation{
+		ID: "assertEqualsWithTolerance",
+		Spec: &fluxtesting.AssertEqualsWithToleranceOpSpec{
+			Name:              "simple",
+			Tolerance:         0.01,
+			RelativeTolerance: 0.001,
+		},
+	}
+
+	querytest.OperationMarshalingTestHelper(t, data, op)
+}
+
+func TestAssertEqualsWithTolerance(t *testing.T) {
+	const header = `
+#datatype,string,long,string,dateTime:RFC3339,double
+#group,false,false,true,false,false
+#default,_result,,,,
+,result,table,host,_time,_value`
+	const want = `
+,,0,A,2019-01-01T00:00:00Z,1.0
+,,0,A,2019-01-01T00:00:10Z,
+,,0,A,2019-01-01T00:00:20Z,NaN
+,,0,A,2019-01-01T00:00:30Z,1000.0
+`
+	testCases := []struct {
+		name    string
+		got     string
+		args    string
+		wantErr bool
+	}{
+		{
+			name: "within tolerance",
+			got: `
+,,0,A,2019-01-01T00:00:00Z,1.009
+,,0,A,2019-01-01T00:00:10Z,
+,,0,A,2019-01-01T00:00:20Z,NaN
+,,0,A,2019-01-01T00:00:30Z,999.991
+`,
+			args: `tolerance: 0.01`,
+		},
+		{
+			name: "just outside tolerance",
+			got: `
+,,0,A,2019-01-01T00:00:00Z,1.011
+,,0,A,2019-01-01T00:00:10Z,
+,,0,A,2019-01-01T00:00:20Z,NaN
+,,0,A,2019-01-01T00:00:30Z,1000.0
+`,
+			args:    `tolerance: 0.01`,
+			wantErr: true,
+		},
+		{
+			name: "within relative tolerance",
+			got: `
+,,0,A,2019-01-01T00:00:00Z,1.0
+,,0,A,2019-01-01T00:00:10Z,
+,,0,A,2019-01-01T00:00:20Z,NaN
+,,0,A,2019-01-01T00:00:30Z,1000.9
+`,
+			args: `tolerance: 0.01, relativeTolerance: 0.001`,
+		},
+		{
+			name: "just outside relative tolerance",
+			got: `
+,,0,A,2019-01-01T00:00:00Z,1.0
+,,0,A,2019-01-01T00:00:10Z,
+,,0,A,2019-01-01T00:00:20Z,NaN
+,,0,A,2019-01-01T00:00:30Z,1001.1
+`,
+			args:    `tolerance: 0.01, relativeTolerance: 0.001`,
+			wantErr: true,
+		},
+		{
+			name: "null compared to value",
+			got: `
+,,0,A,2019-01-01T00:00:00Z,1.0
+,,0,A,2019-01-01T00:00:10Z,0.0
+,,0,A,2019-01-01T00:00:20Z,NaN
+,,0,A,2019-01-01T00:00:30Z,1000.0
+`,
+			args:    `tolerance: 0.01`,
+			wantErr: true,
+		},
+		{
+			name: "NaN compared to value",
+			got: `
+,,0,A,2019-01-01T00:00:00Z,1.0
+,,0,A,2019-01-01T00:00:10Z,
+,,0,A,2019-01-01T00:00:20Z,0.0
+,,0,A,2019-01-01T00:00:30Z,1000.0
+`,
+			args:    `tolerance: 0.01`,
+			wantErr: true,
+		},
+		{
+			name: "non-float column compared exactly",
+			got: `
+,,0,A,2019-01-01T00:00:00Z,1.0
+,,0,A,2019-01-01T00:00:10Z,
+,,0,A,2019-01-01T00:00:20Z,NaN
+,,0,A,2019-01-01T00:00:31Z,1000.0
+`,
+			args:    `tolerance: 10.0`,
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			script := fmt.Sprintf(`
+import "csv"
+import "testing"
+
+want = csv.from(csv: %q)
+got = csv.from(csv: %q)
+got |> testing.assertEqualsWithTolerance(name: "tolerance", want: want, %s)
+`, header+want, header+tc.got, tc.args)
+			err := runScript(script)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				if got, want := err.Error(), "test tolerance: tables not equal"; !strings.Contains(got, want) {
+					t.Errorf("unexpected error: want %q, got %q", want, got)
+				}
+			} else if err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func runScript(script string) error {
+	program, err := lang.Compile(script, time.Unix(0, 0))
+	if err != nil {
+		return err
+	}
+	q, err := program.Start(context.Background(), &memory.Allocator{})
+	if err != nil {
+		return err
+	}
+	defer q.Done()
+	for res := range q.Results() {
+		if err := res.Tables().Do(func(flux.Table) error {
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return q.Err()
+}
@@ -0,0 +1,170 @@
+package universe
+
+import (
+	"fmt"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+)
+
+const ColumnTypesKind = "columnTypes"
+
+type ColumnTypesOpSpec struct {
+	Column     string `json:"column"`
+	TypeColumn string `json:"typeColumn"`
+}
+
+func init() {
+	columnTypesSignature := flux.FunctionSignature(map[string]semantic.PolyType{
+		"column":     semantic.String,
+		"typeColumn": semantic.String,
+	}, nil)
+
+	flux.RegisterPackageValue("universe", ColumnTypesKind, flux.FunctionValue(ColumnTypesKind, createColumnTypesOpSpec, columnTypesSignature))
+	flux.RegisterOpSpec(ColumnTypesKind, newColumnTypesOp)
+	plan.RegisterProcedureSpec(ColumnTypesKind, newColumnTypesProcedure, ColumnTypesKind)
+	execute.RegisterTransformation(ColumnTypesKind, createColumnTypesTransformation)
+}
+
+func createColumnTypesOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+
+	spec := &ColumnTypesOpSpec{
+		Column:     execute.DefaultValueColLabel,
+		TypeColumn: "type",
+	}
+
+	if col, found, err := args.GetString("column"); err != nil {
+		return nil, err
+	} else if found {
+		spec.Column = col
+	}
+	if col, found, err := args.GetString("typeColumn"); err != nil {
+		return nil, err
+	} else if found {
+		spec.TypeColumn = col
+	}
+	if spec.Column == spec.TypeColumn {
+		return nil, fmt.Errorf("column and typeColumn must be different, both are %q", spec.Column)
+	}
+
+	return spec, nil
+}
+
+func newColumnTypesOp() flux.OperationSpec {
+	return new(ColumnTypesOpSpec)
+}
+
+func (s *ColumnTypesOpSpec) Kind() flux.OperationKind {
+	return ColumnTypesKind
+}
+
+type ColumnTypesProcedureSpec struct {
+	plan.DefaultCost
+	Column     string
+	TypeColumn string
+}
+
+func newColumnTypesProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*ColumnTypesOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+
+	return &ColumnTypesProcedureSpec{
+		Column:     spec.Column,
+		TypeColumn: spec.TypeColumn,
+	}, nil
+}
+
+func (s *ColumnTypesProcedureSpec) Kind() plan.ProcedureKind {
+	return ColumnTypesKind
+}
+
+func (s *ColumnTypesProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := new(ColumnTypesProcedureSpec)
+	*ns = *s
+	return ns
+}
+
+func createColumnTypesTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*ColumnTypesProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewColumnTypesTransformation(d, cache, s)
+	return t, d, nil
+}
+
+// columnTypesTransformation outputs a single table, with an empty group key,
+// that lists each distinct column label and type of its input tables
+// in the order they are first seen.
+type columnTypesTransformation struct {
+	d     execute.Dataset
+	cache execute.TableBuilderCache
+
+	column     string
+	typeColumn string
+	seen       map[flux.ColMeta]bool
+}
+
+func NewColumnTypesTransformation(d execute.Dataset, cache execute.TableBuilderCache, spec *ColumnTypesProcedureSpec) *columnTypesTransformation {
+	return &columnTypesTransformation{
+		d:          d,
+		cache:      cache,
+		column:     spec.Column,
+		typeColumn: spec.TypeColumn,
+		seen:       make(map[flux.ColMeta]bool),
+	}
+}
+
+func (t *columnTypesTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return t.d.RetractTable(key)
+}
+
+func (t *columnTypesTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	builder, created := t.cache.TableBuilder(execute.NewGroupKey(nil, nil))
+	if created {
+		if _, err := builder.AddCol(flux.ColMeta{Label: t.column, Type: flux.TString}); err != nil {
+			return err
+		}
+		if _, err := builder.AddCol(flux.ColMeta{Label: t.typeColumn, Type: flux.TString}); err != nil {
+			return err
+		}
+	}
+
+	for _, c := range tbl.Cols() {
+		if t.seen[c] {
+			continue
+		}
+		t.seen[c] = true
+		if err := builder.AppendString(0, c.Label); err != nil {
+			return err
+		}
+		if err := builder.AppendString(1, c.Type.String()); err != nil {
+			return err
+		}
+	}
+
+	// Only the schema is needed, but the table must still be consumed
+	// so the resources held by the upstream transformation are released.
+	return tbl.Do(func(flux.ColReader) error {
+		return nil
+	})
+}
+
+func (t *columnTypesTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+func (t *columnTypesTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+func (t *columnTypesTransformation) Finish(id execute.DatasetID, err error) {
+	t.d.Finish(err)
+}
@@ -0,0 +1,202 @@
+package universe_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/querytest"
+	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
+	"github.com/influxdata/flux/stdlib/universe"
+)
+
+func TestColumnTypes_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "defaults",
+			Raw:  `from(bucket:"mydb") |> columnTypes()`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID:   "from0",
+						Spec: &influxdb.FromOpSpec{Bucket: "mydb"},
+					},
+					{
+						ID: "columnTypes1",
+						Spec: &universe.ColumnTypesOpSpec{
+							Column:     "_value",
+							TypeColumn: "type",
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "columnTypes1"},
+				},
+			},
+		},
+		{
+			Name: "custom labels",
+			Raw:  `from(bucket:"mydb") |> columnTypes(column: "label", typeColumn: "kind")`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID:   "from0",
+						Spec: &influxdb.FromOpSpec{Bucket: "mydb"},
+					},
+					{
+						ID: "columnTypes1",
+						Spec: &universe.ColumnTypesOpSpec{
+							Column:     "label",
+							TypeColumn: "kind",
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "columnTypes1"},
+				},
+			},
+		},
+		{
+			Name:    "same labels",
+			Raw:     `from(bucket:"mydb") |> columnTypes(column: "x", typeColumn: "x")`,
+			WantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+func TestColumnTypesOperation_Marshaling(t *testing.T) {
+	data := []byte(`{"id":"columnTypes","kind":"columnTypes","spec":{"column":"label","typeColumn":"kind"}}`)
+	op := &flux.Operation{
+		ID: "columnTypes",
+		Spec: &universe.ColumnTypesOpSpec{
+			Column:     "label",
+			TypeColumn: "kind",
+		},
+	}
+
+	querytest.OperationMarshalingTestHelper(t, data, op)
+}
+
+func TestColumnTypes_Process(t *testing.T) {
+	testCases := []struct {
+		name string
+		spec *universe.ColumnTypesProcedureSpec
+		data []flux.Table
+		want []*executetest.Table
+	}{
+		{
+			name: "one table",
+			spec: &universe.ColumnTypesProcedureSpec{
+				Column:     "_value",
+				TypeColumn: "type",
+			},
+			data: []flux.Table{
+				&executetest.Table{
+					KeyCols: []string{"tag0"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "tag0", Type: flux.TString},
+						{Label: "valid", Type: flux.TBool},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 2.0, "a", true},
+						{execute.Time(2), 3.0, "a", false},
+					},
+				},
+			},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_value", Type: flux.TString},
+					{Label: "type", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{"_time", "time"},
+					{"_value", "float"},
+					{"tag0", "string"},
+					{"valid", "bool"},
+				},
+			}},
+		},
+		{
+			name: "mixed schemas",
+			spec: &universe.ColumnTypesProcedureSpec{
+				Column:     "label",
+				TypeColumn: "kind",
+			},
+			data: []flux.Table{
+				&executetest.Table{
+					KeyCols: []string{"_field"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_field", Type: flux.TString},
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{"temp", execute.Time(1), 2.0},
+					},
+				},
+				&executetest.Table{
+					KeyCols: []string{"_field"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_field", Type: flux.TString},
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TInt},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{"count", execute.Time(1), int64(2), "a"},
+					},
+				},
+				&executetest.Table{
+					KeyCols: []string{"_field"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_field", Type: flux.TString},
+						{Label: "_time", Type: flux.TTime},
+						{Label: "ok", Type: flux.TBool},
+					},
+					Data: [][]interface{}{
+						{"up", execute.Time(1), true},
+					},
+				},
+			},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "label", Type: flux.TString},
+					{Label: "kind", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{"_field", "string"},
+					{"_time", "time"},
+					{"_value", "float"},
+					{"_value", "int"},
+					{"host", "string"},
+					{"ok", "bool"},
+				},
+			}},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			executetest.ProcessTestHelper(
+				t,
+				tc.data,
+				tc.want,
+				nil,
+				func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+					return universe.NewColumnTypesTransformation(d, c, tc.spec)
+				},
+			)
+		})
+	}
+}
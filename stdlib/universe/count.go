@@ -88,7 +88,7 @@ func createCountTransformation(id execute.DatasetID, mode execute.AccumulationMo
 		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
 	}
 
-	t, d := execute.NewAggregateTransformationAndDataset(id, mode, new(CountAgg), s.AggregateConfig, a.Allocator())
+	t, d := execute.NewAggregateTransformationAndDataset(a.Context(), id, mode, new(CountAgg), s.AggregateConfig, a.Allocator())
 	return t, d, nil
 }
 
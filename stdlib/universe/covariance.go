@@ -187,17 +187,29 @@ func (t *CovarianceTransformation) Process(id execute.DatasetID, tbl flux.Table)
 		return fmt.Errorf("specified column does not exist in table: %v", t.spec.Columns[1])
 	}
 
-	if cols[xIdx].Type != cols[yIdx].Type {
-		return errors.New("cannot compute the covariance between different types")
+	xType, yType := cols[xIdx].Type, cols[yIdx].Type
+	for _, typ := range []flux.ColType{xType, yType} {
+		switch typ {
+		case flux.TFloat, flux.TInt, flux.TUInt:
+		default:
+			return fmt.Errorf("covariance does not support %v", typ)
+		}
 	}
 
 	t.reset()
 	err = tbl.Do(func(cr flux.ColReader) error {
-		switch typ := cols[xIdx].Type; typ {
-		case flux.TFloat:
+		if xType == flux.TFloat && yType == flux.TFloat {
 			t.DoFloat(cr.Floats(xIdx), cr.Floats(yIdx))
-		default:
-			return fmt.Errorf("covariance does not support %v", typ)
+			return nil
+		}
+		xs, ys := numericValues(cr, xIdx, xType), numericValues(cr, yIdx, yType)
+		for i := 0; i < cr.Len(); i++ {
+			x, xok := xs(i)
+			y, yok := ys(i)
+			if !xok || !yok {
+				continue
+			}
+			t.add(x, y)
 		}
 		return nil
 	})
@@ -208,9 +220,35 @@ func (t *CovarianceTransformation) Process(id execute.DatasetID, tbl flux.Table)
 	if err := execute.AppendKeyValues(tbl.Key(), builder); err != nil {
 		return err
 	}
+	if t.n < 2 {
+		// The covariance of fewer than two pairs is undefined.
+		return builder.AppendNil(valueIdx)
+	}
 	return builder.AppendFloat(valueIdx, t.value())
 }
 
+// numericValues returns a function that reads the value of a numeric column as a float.
+// It reports false for null values.
+func numericValues(cr flux.ColReader, j int, typ flux.ColType) func(i int) (float64, bool) {
+	switch typ {
+	case flux.TInt:
+		vs := cr.Ints(j)
+		return func(i int) (float64, bool) {
+			return float64(vs.Value(i)), vs.IsValid(i)
+		}
+	case flux.TUInt:
+		vs := cr.UInts(j)
+		return func(i int) (float64, bool) {
+			return float64(vs.Value(i)), vs.IsValid(i)
+		}
+	default:
+		vs := cr.Floats(j)
+		return func(i int) (float64, bool) {
+			return vs.Value(i), vs.IsValid(i)
+		}
+	}
+}
+
 func (t *CovarianceTransformation) reset() {
 	t.n = 0
 	t.xm1 = 0
@@ -220,36 +258,35 @@ func (t *CovarianceTransformation) reset() {
 	t.xym2 = 0
 }
 func (t *CovarianceTransformation) DoFloat(xs, ys *array.Float64) {
-	var xdelta, ydelta, xdelta2, ydelta2 float64
 	for i := 0; i < xs.Len(); i++ {
 		if xs.IsNull(i) || ys.IsNull(i) {
 			continue
 		}
-		x, y := xs.Value(i), ys.Value(i)
-
-		t.n++
-
-		// Update means
-		xdelta = x - t.xm1
-		ydelta = y - t.ym1
-		t.xm1 += xdelta / t.n
-		t.ym1 += ydelta / t.n
-
-		// Update variance sums
-		xdelta2 = x - t.xm1
-		ydelta2 = y - t.ym1
-		t.xm2 += xdelta * xdelta2
-		t.ym2 += ydelta * ydelta2
-
-		// Update covariance sum
-		// Covariance is symetric so we do not need to compute the yxm2 value.
-		t.xym2 += xdelta * ydelta2
+		t.add(xs.Value(i), ys.Value(i))
 	}
 }
+
+// add adds a pair of values to the covariance.
+func (t *CovarianceTransformation) add(x, y float64) {
+	t.n++
+
+	// Update means
+	xdelta := x - t.xm1
+	ydelta := y - t.ym1
+	t.xm1 += xdelta / t.n
+	t.ym1 += ydelta / t.n
+
+	// Update variance sums
+	xdelta2 := x - t.xm1
+	ydelta2 := y - t.ym1
+	t.xm2 += xdelta * xdelta2
+	t.ym2 += ydelta * ydelta2
+
+	// Update covariance sum
+	// Covariance is symetric so we do not need to compute the yxm2 value.
+	t.xym2 += xdelta * ydelta2
+}
 func (t *CovarianceTransformation) value() float64 {
-	if t.n < 2 {
-		return math.NaN()
-	}
 	if t.spec.PearsonCorrelation {
 		return (t.xym2) / math.Sqrt(t.xm2*t.ym2)
 	}
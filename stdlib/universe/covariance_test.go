@@ -1,6 +1,7 @@
 package universe_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/influxdata/flux"
@@ -140,10 +141,11 @@ func TestCovarianceOperation_Marshaling(t *testing.T) {
 
 func TestCovariance_Process(t *testing.T) {
 	testCases := []struct {
-		name string
-		spec *universe.CovarianceProcedureSpec
-		data []flux.Table
-		want []*executetest.Table
+		name    string
+		spec    *universe.CovarianceProcedureSpec
+		data    []flux.Table
+		want    []*executetest.Table
+		wantErr error
 	}{
 		{
 			name: "variance",
@@ -322,6 +324,203 @@ func TestCovariance_Process(t *testing.T) {
 				},
 			}},
 		},
+		{
+			name: "correlated integers",
+			spec: &universe.CovarianceProcedureSpec{
+				ValueLabel: execute.DefaultValueColLabel,
+				Columns:    []string{"x", "y"},
+			},
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"_start", "_stop"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_start", Type: flux.TTime},
+					{Label: "_stop", Type: flux.TTime},
+					{Label: "_time", Type: flux.TTime},
+					{Label: "x", Type: flux.TInt},
+					{Label: "y", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), execute.Time(5), execute.Time(0), int64(1), int64(2)},
+					{execute.Time(0), execute.Time(5), execute.Time(1), int64(2), int64(4)},
+					{execute.Time(0), execute.Time(5), execute.Time(2), int64(3), int64(6)},
+					{execute.Time(0), execute.Time(5), execute.Time(3), int64(4), int64(8)},
+					{execute.Time(0), execute.Time(5), execute.Time(4), int64(5), int64(10)},
+				},
+			}},
+			want: []*executetest.Table{{
+				KeyCols: []string{"_start", "_stop"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_start", Type: flux.TTime},
+					{Label: "_stop", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), execute.Time(5), 5.0},
+				},
+			}},
+		},
+		{
+			name: "anti-correlated mixed types",
+			spec: &universe.CovarianceProcedureSpec{
+				ValueLabel:         execute.DefaultValueColLabel,
+				PearsonCorrelation: true,
+				Columns:            []string{"x", "y"},
+			},
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"_start", "_stop"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_start", Type: flux.TTime},
+					{Label: "_stop", Type: flux.TTime},
+					{Label: "_time", Type: flux.TTime},
+					{Label: "x", Type: flux.TInt},
+					{Label: "y", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), execute.Time(5), execute.Time(0), int64(1), 10.0},
+					{execute.Time(0), execute.Time(5), execute.Time(1), int64(2), 8.0},
+					{execute.Time(0), execute.Time(5), execute.Time(2), int64(3), 6.0},
+					{execute.Time(0), execute.Time(5), execute.Time(3), int64(4), 4.0},
+					{execute.Time(0), execute.Time(5), execute.Time(4), int64(5), 2.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				KeyCols: []string{"_start", "_stop"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_start", Type: flux.TTime},
+					{Label: "_stop", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), execute.Time(5), -1.0},
+				},
+			}},
+		},
+		{
+			name: "correlated unsigned integers",
+			spec: &universe.CovarianceProcedureSpec{
+				ValueLabel:         execute.DefaultValueColLabel,
+				PearsonCorrelation: true,
+				Columns:            []string{"x", "y"},
+			},
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"_start", "_stop"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_start", Type: flux.TTime},
+					{Label: "_stop", Type: flux.TTime},
+					{Label: "_time", Type: flux.TTime},
+					{Label: "x", Type: flux.TUInt},
+					{Label: "y", Type: flux.TUInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), execute.Time(5), execute.Time(0), uint64(1), uint64(4)},
+					{execute.Time(0), execute.Time(5), execute.Time(1), uint64(2), uint64(7)},
+					{execute.Time(0), execute.Time(5), execute.Time(2), uint64(3), uint64(10)},
+					{execute.Time(0), execute.Time(5), execute.Time(3), uint64(4), uint64(13)},
+					{execute.Time(0), execute.Time(5), execute.Time(4), uint64(5), uint64(16)},
+				},
+			}},
+			want: []*executetest.Table{{
+				KeyCols: []string{"_start", "_stop"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_start", Type: flux.TTime},
+					{Label: "_stop", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), execute.Time(5), 1.0},
+				},
+			}},
+		},
+		{
+			name: "pearson correlation with unequal nulls",
+			spec: &universe.CovarianceProcedureSpec{
+				ValueLabel:         execute.DefaultValueColLabel,
+				PearsonCorrelation: true,
+				Columns:            []string{"x", "y"},
+			},
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"_start", "_stop"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_start", Type: flux.TTime},
+					{Label: "_stop", Type: flux.TTime},
+					{Label: "_time", Type: flux.TTime},
+					{Label: "x", Type: flux.TFloat},
+					{Label: "y", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), execute.Time(6), execute.Time(0), 1.0, 3.0},
+					{execute.Time(0), execute.Time(6), execute.Time(1), 2.0, nil},
+					{execute.Time(0), execute.Time(6), execute.Time(2), nil, 7.0},
+					{execute.Time(0), execute.Time(6), execute.Time(3), 4.0, 9.0},
+					{execute.Time(0), execute.Time(6), execute.Time(4), 5.0, 11.0},
+					{execute.Time(0), execute.Time(6), execute.Time(5), 6.0, 13.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				KeyCols: []string{"_start", "_stop"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_start", Type: flux.TTime},
+					{Label: "_stop", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), execute.Time(6), 1.0},
+				},
+			}},
+		},
+		{
+			name: "single pair",
+			spec: &universe.CovarianceProcedureSpec{
+				ValueLabel: execute.DefaultValueColLabel,
+				Columns:    []string{"x", "y"},
+			},
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"_start", "_stop"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_start", Type: flux.TTime},
+					{Label: "_stop", Type: flux.TTime},
+					{Label: "_time", Type: flux.TTime},
+					{Label: "x", Type: flux.TFloat},
+					{Label: "y", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), execute.Time(2), execute.Time(0), 1.0, 2.0},
+					{execute.Time(0), execute.Time(2), execute.Time(1), nil, 3.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				KeyCols: []string{"_start", "_stop"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_start", Type: flux.TTime},
+					{Label: "_stop", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), execute.Time(2), nil},
+				},
+			}},
+		},
+		{
+			name: "unsupported type",
+			spec: &universe.CovarianceProcedureSpec{
+				ValueLabel: execute.DefaultValueColLabel,
+				Columns:    []string{"x", "y"},
+			},
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"_start", "_stop"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_start", Type: flux.TTime},
+					{Label: "_stop", Type: flux.TTime},
+					{Label: "_time", Type: flux.TTime},
+					{Label: "x", Type: flux.TString},
+					{Label: "y", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), execute.Time(1), execute.Time(0), "a", "b"},
+				},
+			}},
+			wantErr: errors.New("covariance does not support string"),
+		},
 		{
 			name: "variance with nulls",
 			spec: &universe.CovarianceProcedureSpec{
@@ -369,7 +568,7 @@ func TestCovariance_Process(t *testing.T) {
 				t,
 				tc.data,
 				tc.want,
-				nil,
+				tc.wantErr,
 				func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
 					return universe.NewCovarianceTransformation(d, c, tc.spec)
 				},
@@ -8,18 +8,21 @@ import (
 	"github.com/influxdata/flux/interpreter"
 	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
 )
 
 const CumulativeSumKind = "cumulativeSum"
 
 type CumulativeSumOpSpec struct {
-	Columns []string `json:"columns"`
+	Columns     []string `json:"columns"`
+	ResetColumn string   `json:"resetColumn,omitempty"`
 }
 
 func init() {
 	cumulativeSumSignature := flux.FunctionSignature(
 		map[string]semantic.PolyType{
-			"columns": semantic.NewArrayPolyType(semantic.String),
+			"columns":     semantic.NewArrayPolyType(semantic.String),
+			"resetColumn": semantic.String,
 		},
 		nil,
 	)
@@ -47,6 +50,11 @@ func createCumulativeSumOpSpec(args flux.Arguments, a *flux.Administration) (flu
 	} else {
 		spec.Columns = []string{execute.DefaultValueColLabel}
 	}
+	if col, ok, err := args.GetString("resetColumn"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.ResetColumn = col
+	}
 	return spec, nil
 }
 
@@ -60,7 +68,8 @@ func (s *CumulativeSumOpSpec) Kind() flux.OperationKind {
 
 type CumulativeSumProcedureSpec struct {
 	plan.DefaultCost
-	Columns []string
+	Columns     []string
+	ResetColumn string
 }
 
 func newCumulativeSumProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
@@ -70,7 +79,8 @@ func newCumulativeSumProcedure(qs flux.OperationSpec, pa plan.Administration) (p
 	}
 
 	return &CumulativeSumProcedureSpec{
-		Columns: spec.Columns,
+		Columns:     spec.Columns,
+		ResetColumn: spec.ResetColumn,
 	}, nil
 }
 
@@ -140,8 +150,29 @@ func (t *cumulativeSumTransformation) Process(id execute.DatasetID, tbl flux.Tab
 			}
 		}
 	}
+
+	resetIdx := -1
+	if t.spec.ResetColumn != "" {
+		if resetIdx = execute.ColIdx(t.spec.ResetColumn, cols); resetIdx < 0 {
+			return fmt.Errorf("cumulative sum reset column %q does not exist", t.spec.ResetColumn)
+		}
+	}
+	// prev is the value of the reset column in the previous row,
+	// which may be within a previous column reader.
+	var prev values.Value
 	return tbl.Do(func(cr flux.ColReader) error {
 		l := cr.Len()
+		// resets marks the rows at which the reset column changes value
+		// and the sums start again from zero.
+		var resets []bool
+		if resetIdx >= 0 {
+			resets = make([]bool, l)
+			for i := 0; i < l; i++ {
+				v := execute.ValueForRow(cr, i, resetIdx)
+				resets[i] = prev != nil && !sameValue(prev, v)
+				prev = v
+			}
+		}
 		for j, c := range cols {
 			switch c.Type {
 			case flux.TBool:
@@ -153,6 +184,9 @@ func (t *cumulativeSumTransformation) Process(id execute.DatasetID, tbl flux.Tab
 			case flux.TInt:
 				if sumers[j] != nil {
 					for i := 0; i < l; i++ {
+						if resets != nil && resets[i] {
+							sumers[j].reset()
+						}
 						if vs := cr.Ints(j); vs.IsValid(i) {
 							sumers[j].sumInt(vs.Value(i))
 						}
@@ -169,6 +203,9 @@ func (t *cumulativeSumTransformation) Process(id execute.DatasetID, tbl flux.Tab
 			case flux.TUInt:
 				if sumers[j] != nil {
 					for i := 0; i < l; i++ {
+						if resets != nil && resets[i] {
+							sumers[j].reset()
+						}
 						if vs := cr.UInts(j); vs.IsValid(i) {
 							sumers[j].sumUInt(vs.Value(i))
 						}
@@ -185,6 +222,9 @@ func (t *cumulativeSumTransformation) Process(id execute.DatasetID, tbl flux.Tab
 			case flux.TFloat:
 				if sumers[j] != nil {
 					for i := 0; i < l; i++ {
+						if resets != nil && resets[i] {
+							sumers[j].reset()
+						}
 						if vs := cr.Floats(j); vs.IsValid(i) {
 							sumers[j].sumFloat(vs.Value(i))
 						}
@@ -232,6 +272,10 @@ type cumulativeSum struct {
 	floatVal float64
 }
 
+func (s *cumulativeSum) reset() {
+	*s = cumulativeSum{}
+}
+
 func (s *cumulativeSum) sumInt(val int64) int64 {
 	s.intVal += val
 	return s.intVal
@@ -246,3 +290,12 @@ func (s *cumulativeSum) sumFloat(val float64) float64 {
 	s.floatVal += val
 	return s.floatVal
 }
+
+// sameValue reports whether two values of the reset column are the same.
+// Unlike values.Value.Equal, two null values are the same.
+func sameValue(a, b values.Value) bool {
+	if a.IsNull() || b.IsNull() {
+		return a.IsNull() && b.IsNull()
+	}
+	return a.Equal(b)
+}
@@ -1,6 +1,7 @@
 package universe_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/influxdata/flux"
@@ -19,6 +20,18 @@ func TestCumulativeSumOperation_Marshaling(t *testing.T) {
 	querytest.OperationMarshalingTestHelper(t, data, op)
 }
 
+func TestCumulativeSumOperation_MarshalingResetColumn(t *testing.T) {
+	data := []byte(`{"id":"cumulativeSum","kind":"cumulativeSum","spec":{"columns":["_value"],"resetColumn":"session"}}`)
+	op := &flux.Operation{
+		ID: "cumulativeSum",
+		Spec: &universe.CumulativeSumOpSpec{
+			Columns:     []string{"_value"},
+			ResetColumn: "session",
+		},
+	}
+	querytest.OperationMarshalingTestHelper(t, data, op)
+}
+
 func TestCumulativeSum_PassThrough(t *testing.T) {
 	executetest.TransformationPassThroughTestHelper(t, func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
 		s := universe.NewCumulativeSumTransformation(
@@ -257,6 +270,143 @@ func TestCumulativeSum_Process(t *testing.T) {
 				},
 			}},
 		},
+		{
+			name: "reset column",
+			spec: &universe.CumulativeSumProcedureSpec{
+				Columns:     []string{"int", "_value"},
+				ResetColumn: "session",
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "int", Type: flux.TInt},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "session", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), int64(2), 1.0, "s1"},
+					{execute.Time(1), int64(1), 2.0, "s1"},
+					{execute.Time(2), int64(3), 3.0, "s2"},
+					{execute.Time(3), int64(4), nil, "s2"},
+					{execute.Time(4), int64(2), 5.0, "s2"},
+					{execute.Time(5), int64(6), 6.0, "s1"},
+					{execute.Time(6), int64(2), 7.0, "s1"},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "int", Type: flux.TInt},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "session", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), int64(2), 1.0, "s1"},
+					{execute.Time(1), int64(3), 3.0, "s1"},
+					{execute.Time(2), int64(3), 3.0, "s2"},
+					{execute.Time(3), int64(7), 3.0, "s2"},
+					{execute.Time(4), int64(9), 8.0, "s2"},
+					{execute.Time(5), int64(6), 6.0, "s1"},
+					{execute.Time(6), int64(8), 13.0, "s1"},
+				},
+			}},
+		},
+		{
+			name: "reset column with nulls",
+			spec: &universe.CumulativeSumProcedureSpec{
+				Columns:     []string{"_value"},
+				ResetColumn: "session",
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TInt},
+					{Label: "session", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), int64(1), nil},
+					{execute.Time(1), int64(2), nil},
+					{execute.Time(2), int64(3), int64(7)},
+					{execute.Time(3), int64(4), int64(7)},
+					{execute.Time(4), int64(5), nil},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TInt},
+					{Label: "session", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), int64(1), nil},
+					{execute.Time(1), int64(3), nil},
+					{execute.Time(2), int64(3), int64(7)},
+					{execute.Time(3), int64(7), int64(7)},
+					{execute.Time(4), int64(5), nil},
+				},
+			}},
+		},
+		{
+			name: "reset column across tables",
+			spec: &universe.CumulativeSumProcedureSpec{
+				Columns:     []string{"_value"},
+				ResetColumn: "session",
+			},
+			data: []flux.Table{
+				&executetest.Table{
+					KeyCols: []string{"t"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_value", Type: flux.TUInt},
+						{Label: "session", Type: flux.TString},
+						{Label: "t", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{uint64(1), "s1", "a"},
+						{uint64(2), "s1", "a"},
+					},
+				},
+				&executetest.Table{
+					KeyCols: []string{"t"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_value", Type: flux.TUInt},
+						{Label: "session", Type: flux.TString},
+						{Label: "t", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{uint64(3), "s1", "b"},
+						{uint64(4), "s2", "b"},
+						{uint64(5), "s2", "b"},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"t"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_value", Type: flux.TUInt},
+						{Label: "session", Type: flux.TString},
+						{Label: "t", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{uint64(1), "s1", "a"},
+						{uint64(3), "s1", "a"},
+					},
+				},
+				{
+					KeyCols: []string{"t"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_value", Type: flux.TUInt},
+						{Label: "session", Type: flux.TString},
+						{Label: "t", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{uint64(3), "s1", "b"},
+						{uint64(4), "s2", "b"},
+						{uint64(9), "s2", "b"},
+					},
+				},
+			},
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -273,3 +423,26 @@ func TestCumulativeSum_Process(t *testing.T) {
 		})
 	}
 }
+
+func TestCumulativeSum_MissingResetColumn(t *testing.T) {
+	executetest.ProcessTestHelper(
+		t,
+		[]flux.Table{&executetest.Table{
+			ColMeta: []flux.ColMeta{
+				{Label: "_time", Type: flux.TTime},
+				{Label: "_value", Type: flux.TFloat},
+			},
+			Data: [][]interface{}{
+				{execute.Time(0), 1.0},
+			},
+		}},
+		nil,
+		errors.New(`cumulative sum reset column "session" does not exist`),
+		func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+			return universe.NewCumulativeSumTransformation(d, c, &universe.CumulativeSumProcedureSpec{
+				Columns:     []string{"_value"},
+				ResetColumn: "session",
+			})
+		},
+	)
+}
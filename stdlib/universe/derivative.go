@@ -18,6 +18,7 @@ const DerivativeKind = "derivative"
 type DerivativeOpSpec struct {
 	Unit        flux.Duration `json:"unit"`
 	NonNegative bool          `json:"nonNegative"`
+	Counter     bool          `json:"counter"`
 	Columns     []string      `json:"columns"`
 	TimeColumn  string        `json:"timeColumn"`
 }
@@ -27,6 +28,7 @@ func init() {
 		map[string]semantic.PolyType{
 			"unit":        semantic.Duration,
 			"nonNegative": semantic.Bool,
+			"counter":     semantic.Bool,
 			"columns":     semantic.NewArrayPolyType(semantic.String),
 			"timeColumn":  semantic.String,
 		},
@@ -60,6 +62,11 @@ func createDerivativeOpSpec(args flux.Arguments, a *flux.Administration) (flux.O
 	} else if ok {
 		spec.NonNegative = nn
 	}
+	if c, ok, err := args.GetBool("counter"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Counter = c
+	}
 	if timeCol, ok, err := args.GetString("timeColumn"); err != nil {
 		return nil, err
 	} else if ok {
@@ -94,6 +101,7 @@ type DerivativeProcedureSpec struct {
 	plan.DefaultCost
 	Unit        flux.Duration `json:"unit"`
 	NonNegative bool          `json:"non_negative"`
+	Counter     bool          `json:"counter"`
 	Columns     []string      `json:"columns"`
 	TimeColumn  string        `json:"timeColumn"`
 }
@@ -107,6 +115,7 @@ func newDerivativeProcedure(qs flux.OperationSpec, pa plan.Administration) (plan
 	return &DerivativeProcedureSpec{
 		Unit:        spec.Unit,
 		NonNegative: spec.NonNegative,
+		Counter:     spec.Counter,
 		Columns:     spec.Columns,
 		TimeColumn:  spec.TimeColumn,
 	}, nil
@@ -147,6 +156,7 @@ type derivativeTransformation struct {
 
 	unit        float64
 	nonNegative bool
+	counter     bool
 	columns     []string
 	timeCol     string
 }
@@ -157,6 +167,7 @@ func NewDerivativeTransformation(d execute.Dataset, cache execute.TableBuilderCa
 		cache:       cache,
 		unit:        float64(spec.Unit),
 		nonNegative: spec.NonNegative,
+		counter:     spec.Counter,
 		columns:     spec.Columns,
 		timeCol:     spec.TimeColumn,
 	}
@@ -352,7 +363,8 @@ func (t *derivativeTransformation) doInt(ts, vs *array.Int64, b execute.TableBui
 		} else {
 			// We have a valid previous value and current value.
 			cValue := vs.Value(i)
-			if t.nonNegative && pValue > cValue {
+			isNeg := pValue > cValue
+			if t.nonNegative && isNeg && !t.counter {
 				if err := b.AppendNil(bj); err != nil {
 					return err
 				}
@@ -360,6 +372,11 @@ func (t *derivativeTransformation) doInt(ts, vs *array.Int64, b execute.TableBui
 				// Finally, do the derivative.
 				elapsed := float64(cTime-pValueTime) / t.unit
 				diff := float64(cValue - pValue)
+				if t.counter && isNeg {
+					// A decrease is a counter reset, so the counter
+					// has increased from zero to the current value.
+					diff = float64(cValue)
+				}
 				if err := b.AppendFloat(bj, diff/elapsed); err != nil {
 					return err
 				}
@@ -437,7 +454,7 @@ func (t *derivativeTransformation) doUInt(ts *array.Int64, vs *array.Uint64, b e
 			// We have a valid previous value and current value.
 			cValue := vs.Value(i)
 			isNeg := pValue > cValue
-			if t.nonNegative && isNeg {
+			if t.nonNegative && isNeg && !t.counter {
 				if err := b.AppendNil(bj); err != nil {
 					return err
 				}
@@ -446,7 +463,11 @@ func (t *derivativeTransformation) doUInt(ts *array.Int64, vs *array.Uint64, b e
 				elapsed := float64(cTime-pValueTime) / t.unit
 
 				var diff float64
-				if isNeg {
+				if t.counter && isNeg {
+					// A decrease is a counter reset, so the counter
+					// has increased from zero to the current value.
+					diff = float64(cValue)
+				} else if isNeg {
 					// Avoid wrapping on unsigned subtraction
 					diff = -float64(pValue - cValue)
 				} else {
@@ -529,7 +550,8 @@ func (t *derivativeTransformation) doFloat(ts *array.Int64, vs *array.Float64, b
 		} else {
 			// We have a valid previous value and current value.
 			cValue := vs.Value(i)
-			if t.nonNegative && pValue > cValue {
+			isNeg := pValue > cValue
+			if t.nonNegative && isNeg && !t.counter {
 				if err := b.AppendNil(bj); err != nil {
 					return err
 				}
@@ -537,6 +559,11 @@ func (t *derivativeTransformation) doFloat(ts *array.Int64, vs *array.Float64, b
 				// Finally, do the derivative.
 				elapsed := float64(cTime-pValueTime) / t.unit
 				diff := float64(cValue - pValue)
+				if t.counter && isNeg {
+					// A decrease is a counter reset, so the counter
+					// has increased from zero to the current value.
+					diff = float64(cValue)
+				}
 				if err := b.AppendFloat(bj, diff/elapsed); err != nil {
 					return err
 				}
@@ -181,6 +181,109 @@ func TestDerivative_Process(t *testing.T) {
 				},
 			}},
 		},
+		{
+			name: "int counter reset",
+			spec: &universe.DerivativeProcedureSpec{
+				Columns:    []string{execute.DefaultValueColLabel},
+				TimeColumn: execute.DefaultTimeColLabel,
+				Unit:       flux.Duration(time.Second),
+				Counter:    true,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), int64(100)},
+					{execute.Time(10 * time.Second), int64(150)},
+					{execute.Time(20 * time.Second), int64(200)},
+					{execute.Time(30 * time.Second), int64(30)},
+					{execute.Time(40 * time.Second), int64(80)},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(10 * time.Second), 5.0},
+					{execute.Time(20 * time.Second), 5.0},
+					{execute.Time(30 * time.Second), 3.0},
+					{execute.Time(40 * time.Second), 5.0},
+				},
+			}},
+		},
+		{
+			name: "uint counter reset",
+			spec: &universe.DerivativeProcedureSpec{
+				Columns:    []string{execute.DefaultValueColLabel},
+				TimeColumn: execute.DefaultTimeColLabel,
+				Unit:       flux.Duration(time.Second),
+				Counter:    true,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TUInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), uint64(100)},
+					{execute.Time(10 * time.Second), uint64(150)},
+					{execute.Time(20 * time.Second), uint64(200)},
+					{execute.Time(30 * time.Second), uint64(30)},
+					{execute.Time(40 * time.Second), uint64(80)},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(10 * time.Second), 5.0},
+					{execute.Time(20 * time.Second), 5.0},
+					{execute.Time(30 * time.Second), 3.0},
+					{execute.Time(40 * time.Second), 5.0},
+				},
+			}},
+		},
+		{
+			name: "float counter reset with non negative",
+			spec: &universe.DerivativeProcedureSpec{
+				Columns:     []string{execute.DefaultValueColLabel},
+				TimeColumn:  execute.DefaultTimeColLabel,
+				Unit:        flux.Duration(time.Second),
+				NonNegative: true,
+				Counter:     true,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), 100.0},
+					{execute.Time(10 * time.Second), 150.0},
+					{execute.Time(20 * time.Second), 200.0},
+					{execute.Time(30 * time.Second), 30.0},
+					{execute.Time(40 * time.Second), 80.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(10 * time.Second), 5.0},
+					{execute.Time(20 * time.Second), 5.0},
+					{execute.Time(30 * time.Second), 3.0},
+					{execute.Time(40 * time.Second), 5.0},
+				},
+			}},
+		},
 		{
 			name: "uint",
 			spec: &universe.DerivativeProcedureSpec{
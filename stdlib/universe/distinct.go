@@ -1,12 +1,16 @@
 package universe
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/influxdata/flux/values"
 
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/interpreter"
 	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/semantic"
 )
@@ -14,13 +18,15 @@ import (
 const DistinctKind = "distinct"
 
 type DistinctOpSpec struct {
-	Column string `json:"column"`
+	Column  string   `json:"column"`
+	Columns []string `json:"columns,omitempty"`
 }
 
 func init() {
 	distinctSignature := flux.FunctionSignature(
 		map[string]semantic.PolyType{
-			"column": semantic.String,
+			"column":  semantic.String,
+			"columns": semantic.NewArrayPolyType(semantic.String),
 		},
 		nil,
 	)
@@ -38,9 +44,28 @@ func createDistinctOpSpec(args flux.Arguments, a *flux.Administration) (flux.Ope
 
 	spec := new(DistinctOpSpec)
 
-	if col, ok, err := args.GetString("column"); err != nil {
+	col, colOk, err := args.GetString("column")
+	if err != nil {
+		return nil, err
+	}
+	if cols, ok, err := args.GetArray("columns", semantic.String); err != nil {
 		return nil, err
 	} else if ok {
+		if colOk {
+			return nil, errors.New("distinct cannot use both column and columns")
+		}
+		columns, err := interpreter.ToStringArray(cols)
+		if err != nil {
+			return nil, err
+		}
+		if len(columns) == 0 {
+			return nil, errors.New("distinct requires at least one column")
+		}
+		spec.Columns = columns
+		return spec, nil
+	}
+
+	if colOk {
 		spec.Column = col
 	} else {
 		spec.Column = execute.DefaultValueColLabel
@@ -60,6 +85,9 @@ func (s *DistinctOpSpec) Kind() flux.OperationKind {
 type DistinctProcedureSpec struct {
 	plan.DefaultCost
 	Column string
+	// Columns is the list of columns whose unique combinations are produced.
+	// When it is set, Column is ignored.
+	Columns []string
 }
 
 func newDistinctProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
@@ -69,7 +97,8 @@ func newDistinctProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.P
 	}
 
 	return &DistinctProcedureSpec{
-		Column: spec.Column,
+		Column:  spec.Column,
+		Columns: spec.Columns,
 	}, nil
 }
 
@@ -104,14 +133,16 @@ type distinctTransformation struct {
 	d     execute.Dataset
 	cache execute.TableBuilderCache
 
-	column string
+	column  string
+	columns []string
 }
 
 func NewDistinctTransformation(d execute.Dataset, cache execute.TableBuilderCache, spec *DistinctProcedureSpec) *distinctTransformation {
 	return &distinctTransformation{
-		d:      d,
-		cache:  cache,
-		column: spec.Column,
+		d:       d,
+		cache:   cache,
+		column:  spec.Column,
+		columns: spec.Columns,
 	}
 }
 
@@ -125,6 +156,10 @@ func (t *distinctTransformation) Process(id execute.DatasetID, tbl flux.Table) e
 		return fmt.Errorf("distinct found duplicate table with key: %v", tbl.Key())
 	}
 
+	if len(t.columns) > 0 {
+		return t.processColumns(tbl, builder)
+	}
+
 	colIdx := execute.ColIdx(t.column, tbl.Cols())
 	if colIdx < 0 {
 		// doesn't exist in this table, so add an empty value
@@ -351,6 +386,89 @@ func (t *distinctTransformation) Process(id execute.DatasetID, tbl flux.Table) e
 	})
 }
 
+// processColumns produces one row for each unique combination of values in the columns.
+// The columns keep their labels in the output. A column that is part of the group key
+// is already in the output and does not affect uniqueness, and a column that is missing
+// from the table is treated as a string column containing only nulls.
+func (t *distinctTransformation) processColumns(tbl flux.Table, builder execute.TableBuilder) error {
+	if err := execute.AddTableKeyCols(tbl.Key(), builder); err != nil {
+		return err
+	}
+
+	var (
+		srcIdxs []int
+		dstIdxs []int
+	)
+	for _, label := range t.columns {
+		if tbl.Key().HasCol(label) || execute.ColIdx(label, builder.Cols()) >= 0 {
+			continue
+		}
+		srcIdx := execute.ColIdx(label, tbl.Cols())
+		typ := flux.TString
+		if srcIdx >= 0 {
+			typ = tbl.Cols()[srcIdx].Type
+		}
+		dstIdx, err := builder.AddCol(flux.ColMeta{
+			Label: label,
+			Type:  typ,
+		})
+		if err != nil {
+			return err
+		}
+		srcIdxs = append(srcIdxs, srcIdx)
+		dstIdxs = append(dstIdxs, dstIdx)
+	}
+
+	seen := make(map[string]bool)
+	row := make([]values.Value, len(srcIdxs))
+	return tbl.Do(func(cr flux.ColReader) error {
+		for i, l := 0, cr.Len(); i < l; i++ {
+			for k, j := range srcIdxs {
+				if j < 0 {
+					row[k] = values.NewNull(semantic.String)
+					continue
+				}
+				row[k] = execute.ValueForRow(cr, i, j)
+			}
+			key := distinctTupleKey(row)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			for k, v := range row {
+				if err := builder.AppendValue(dstIdxs[k], v); err != nil {
+					return err
+				}
+			}
+			if err := execute.AppendKeyValues(tbl.Key(), builder); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// distinctTupleKey encodes a combination of values as a string
+// so that null is distinct from every other value.
+func distinctTupleKey(vs []values.Value) string {
+	var b strings.Builder
+	for _, v := range vs {
+		if v.IsNull() {
+			b.WriteString("n;")
+			continue
+		}
+		b.WriteByte('v')
+		if v.Type() == semantic.String {
+			b.WriteString(strconv.Quote(v.Str()))
+		} else {
+			fmt.Fprint(&b, v)
+		}
+		b.WriteByte(';')
+	}
+	return b.String()
+}
+
 func (t *distinctTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
 	return t.d.UpdateWatermark(mark)
 }
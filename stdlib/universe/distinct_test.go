@@ -204,6 +204,140 @@ func TestDistinct_Process(t *testing.T) {
 				},
 			}},
 		},
+		{
+			name: "multiple columns",
+			spec: &universe.DistinctProcedureSpec{Columns: []string{"tag1", "_value"}},
+			data: []flux.Table{
+				&executetest.Table{
+					KeyCols: []string{"tag0"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "tag0", Type: flux.TString},
+						{Label: "tag1", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 2.0, "a", "b"},
+						{execute.Time(2), 2.0, "a", "c"},
+						{execute.Time(3), 2.0, "a", "b"},
+						{execute.Time(4), 3.0, "a", "b"},
+						{execute.Time(5), 3.0, "a", "c"},
+						{execute.Time(6), 2.0, "a", "c"},
+					},
+				},
+				&executetest.Table{
+					KeyCols: []string{"tag0"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "tag0", Type: flux.TString},
+						{Label: "tag1", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 2.0, "b", "b"},
+						{execute.Time(2), 2.0, "b", "b"},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"tag0"},
+					ColMeta: []flux.ColMeta{
+						{Label: "tag0", Type: flux.TString},
+						{Label: "tag1", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{"a", "b", 2.0},
+						{"a", "c", 2.0},
+						{"a", "b", 3.0},
+						{"a", "c", 3.0},
+					},
+				},
+				{
+					KeyCols: []string{"tag0"},
+					ColMeta: []flux.ColMeta{
+						{Label: "tag0", Type: flux.TString},
+						{Label: "tag1", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{"b", "b", 2.0},
+					},
+				},
+			},
+		},
+		{
+			name: "multiple columns with nulls",
+			spec: &universe.DistinctProcedureSpec{Columns: []string{"tag1", "_value"}},
+			data: []flux.Table{
+				&executetest.Table{
+					KeyCols: []string{"tag0"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "tag0", Type: flux.TString},
+						{Label: "tag1", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), nil, "a", "b"},
+						{execute.Time(2), 2.0, "a", nil},
+						{execute.Time(3), nil, "a", nil},
+						{execute.Time(4), nil, "a", "b"},
+						{execute.Time(5), 2.0, "a", nil},
+						{execute.Time(6), nil, "a", nil},
+						{execute.Time(7), 2.0, "a", "b"},
+						{execute.Time(8), 2.0, "a", ""},
+					},
+				},
+			},
+			want: []*executetest.Table{{
+				KeyCols: []string{"tag0"},
+				ColMeta: []flux.ColMeta{
+					{Label: "tag0", Type: flux.TString},
+					{Label: "tag1", Type: flux.TString},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{"a", "b", nil},
+					{"a", nil, 2.0},
+					{"a", nil, nil},
+					{"a", "b", 2.0},
+					{"a", "", 2.0},
+				},
+			}},
+		},
+		{
+			name: "multiple columns inside group key",
+			spec: &universe.DistinctProcedureSpec{Columns: []string{"tag0", "tag1"}},
+			data: []flux.Table{
+				&executetest.Table{
+					KeyCols: []string{"tag0"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "tag0", Type: flux.TString},
+						{Label: "tag1", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 2.0, "a", "b"},
+						{execute.Time(2), 2.0, "a", "c"},
+						{execute.Time(3), 2.0, "a", "b"},
+					},
+				},
+			},
+			want: []*executetest.Table{{
+				KeyCols: []string{"tag0"},
+				ColMeta: []flux.ColMeta{
+					{Label: "tag0", Type: flux.TString},
+					{Label: "tag1", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{"a", "b"},
+					{"a", "c"},
+				},
+			}},
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -0,0 +1,230 @@
+package universe
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+	"github.com/pkg/errors"
+)
+
+const ElapsedKind = "elapsed"
+
+type ElapsedOpSpec struct {
+	Unit       flux.Duration `json:"unit"`
+	TimeColumn string        `json:"timeColumn"`
+	ColumnName string        `json:"columnName"`
+	KeepFirst  bool          `json:"keepFirst"`
+}
+
+func init() {
+	elapsedSignature := flux.FunctionSignature(
+		map[string]semantic.PolyType{
+			"unit":       semantic.Duration,
+			"timeColumn": semantic.String,
+			"columnName": semantic.String,
+			"keepFirst":  semantic.Bool,
+		},
+		nil,
+	)
+
+	flux.RegisterPackageValue("universe", ElapsedKind, flux.FunctionValue(ElapsedKind, createElapsedOpSpec, elapsedSignature))
+	flux.RegisterOpSpec(ElapsedKind, newElapsedOp)
+	plan.RegisterProcedureSpec(ElapsedKind, newElapsedProcedure, ElapsedKind)
+	execute.RegisterTransformation(ElapsedKind, createElapsedTransformation)
+}
+
+func createElapsedOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+
+	spec := &ElapsedOpSpec{
+		Unit:       flux.Duration(time.Second),
+		TimeColumn: execute.DefaultTimeColLabel,
+		ColumnName: "elapsed",
+	}
+
+	if unit, ok, err := args.GetDuration("unit"); err != nil {
+		return nil, err
+	} else if ok {
+		if unit <= 0 {
+			return nil, errors.New("elapsed unit must be greater than zero")
+		}
+		spec.Unit = unit
+	}
+	if label, ok, err := args.GetString("timeColumn"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.TimeColumn = label
+	}
+	if label, ok, err := args.GetString("columnName"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.ColumnName = label
+	}
+	if keepFirst, ok, err := args.GetBool("keepFirst"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.KeepFirst = keepFirst
+	}
+	return spec, nil
+}
+
+func newElapsedOp() flux.OperationSpec {
+	return new(ElapsedOpSpec)
+}
+
+func (s *ElapsedOpSpec) Kind() flux.OperationKind {
+	return ElapsedKind
+}
+
+type ElapsedProcedureSpec struct {
+	plan.DefaultCost
+	Unit       flux.Duration
+	TimeColumn string
+	ColumnName string
+	KeepFirst  bool
+}
+
+func newElapsedProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*ElapsedOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+
+	return &ElapsedProcedureSpec{
+		Unit:       spec.Unit,
+		TimeColumn: spec.TimeColumn,
+		ColumnName: spec.ColumnName,
+		KeepFirst:  spec.KeepFirst,
+	}, nil
+}
+
+func (s *ElapsedProcedureSpec) Kind() plan.ProcedureKind {
+	return ElapsedKind
+}
+func (s *ElapsedProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := new(ElapsedProcedureSpec)
+	*ns = *s
+	return ns
+}
+
+// TriggerSpec implements plan.TriggerAwareProcedureSpec
+func (s *ElapsedProcedureSpec) TriggerSpec() plan.TriggerSpec {
+	return plan.NarrowTransformationTriggerSpec{}
+}
+
+func createElapsedTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*ElapsedProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewElapsedTransformation(d, cache, s)
+	return t, d, nil
+}
+
+type elapsedTransformation struct {
+	d     execute.Dataset
+	cache execute.TableBuilderCache
+
+	unit       int64
+	timeCol    string
+	columnName string
+	keepFirst  bool
+}
+
+func NewElapsedTransformation(d execute.Dataset, cache execute.TableBuilderCache, spec *ElapsedProcedureSpec) *elapsedTransformation {
+	return &elapsedTransformation{
+		d:          d,
+		cache:      cache,
+		unit:       int64(spec.Unit),
+		timeCol:    spec.TimeColumn,
+		columnName: spec.ColumnName,
+		keepFirst:  spec.KeepFirst,
+	}
+}
+
+func (t *elapsedTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return t.d.RetractTable(key)
+}
+
+func (t *elapsedTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	builder, created := t.cache.TableBuilder(tbl.Key())
+	if !created {
+		return fmt.Errorf("elapsed found duplicate table with key: %v", tbl.Key())
+	}
+	if err := execute.AddTableCols(tbl, builder); err != nil {
+		return err
+	}
+
+	timeIdx := execute.ColIdx(t.timeCol, tbl.Cols())
+	if timeIdx < 0 {
+		return fmt.Errorf("no column %q exists", t.timeCol)
+	}
+	if typ := tbl.Cols()[timeIdx].Type; typ != flux.TTime {
+		return fmt.Errorf("elapsed time column %q has type %v, expected %v", t.timeCol, typ, flux.TTime)
+	}
+	elapsedCol, err := builder.AddCol(flux.ColMeta{
+		Label: t.columnName,
+		Type:  flux.TInt,
+	})
+	if err != nil {
+		return err
+	}
+
+	var (
+		prevTime values.Time
+		first    = true
+	)
+	return tbl.Do(func(cr flux.ColReader) error {
+		colMap := make([]int, len(cr.Cols()))
+		colMap = execute.ColMap(colMap, builder, cr)
+		ts := cr.Times(timeIdx)
+		for i := 0; i < cr.Len(); i++ {
+			if ts.IsNull(i) {
+				return errors.New("got a null timestamp")
+			}
+			tValue := values.Time(ts.Value(i))
+			if !first && tValue < prevTime {
+				return errors.New("got an out-of-order timestamp")
+			}
+			elapsed := int64(tValue-prevTime) / t.unit
+			isFirst := first
+			prevTime, first = tValue, false
+
+			// The first row has no previous row to measure from.
+			if isFirst && !t.keepFirst {
+				continue
+			}
+			if err := execute.AppendMappedRecordExplicit(i, cr, builder, colMap); err != nil {
+				return err
+			}
+			if isFirst {
+				err = builder.AppendNil(elapsedCol)
+			} else {
+				err = builder.AppendInt(elapsedCol, elapsed)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (t *elapsedTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+func (t *elapsedTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+func (t *elapsedTransformation) Finish(id execute.DatasetID, err error) {
+	t.d.Finish(err)
+}
@@ -0,0 +1,271 @@
+package universe_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/querytest"
+	"github.com/influxdata/flux/stdlib/universe"
+)
+
+func TestElapsedOperation_Marshaling(t *testing.T) {
+	data := []byte(`{"id":"elapsed","kind":"elapsed","spec":{"unit":"1m","timeColumn":"_start","columnName":"gap","keepFirst":true}}`)
+	op := &flux.Operation{
+		ID: "elapsed",
+		Spec: &universe.ElapsedOpSpec{
+			Unit:       flux.Duration(time.Minute),
+			TimeColumn: "_start",
+			ColumnName: "gap",
+			KeepFirst:  true,
+		},
+	}
+	querytest.OperationMarshalingTestHelper(t, data, op)
+}
+
+func TestElapsed_PassThrough(t *testing.T) {
+	executetest.TransformationPassThroughTestHelper(t, func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+		s := universe.NewElapsedTransformation(
+			d,
+			c,
+			&universe.ElapsedProcedureSpec{
+				Unit:       flux.Duration(time.Second),
+				TimeColumn: execute.DefaultTimeColLabel,
+				ColumnName: "elapsed",
+			},
+		)
+		return s
+	})
+}
+
+func TestElapsed_Process(t *testing.T) {
+	testCases := []struct {
+		name    string
+		spec    *universe.ElapsedProcedureSpec
+		data    []flux.Table
+		want    []*executetest.Table
+		wantErr error
+	}{
+		{
+			name: "regular spacing",
+			spec: &universe.ElapsedProcedureSpec{
+				Unit:       flux.Duration(time.Second),
+				TimeColumn: "_time",
+				ColumnName: "elapsed",
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), 2.0},
+					{execute.Time(10 * time.Second), 1.0},
+					{execute.Time(20 * time.Second), 3.0},
+					{execute.Time(30 * time.Second), 4.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "elapsed", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(10 * time.Second), 1.0, int64(10)},
+					{execute.Time(20 * time.Second), 3.0, int64(10)},
+					{execute.Time(30 * time.Second), 4.0, int64(10)},
+				},
+			}},
+		},
+		{
+			name: "irregular spacing",
+			spec: &universe.ElapsedProcedureSpec{
+				Unit:       flux.Duration(time.Millisecond),
+				TimeColumn: "_time",
+				ColumnName: "elapsed",
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), 2.0},
+					{execute.Time(1 * time.Second), 1.0},
+					{execute.Time(1*time.Second + 500*time.Millisecond), nil},
+					{execute.Time(1*time.Second + 500*time.Millisecond), 3.0},
+					{execute.Time(65 * time.Second), 4.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "elapsed", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1 * time.Second), 1.0, int64(1000)},
+					{execute.Time(1*time.Second + 500*time.Millisecond), nil, int64(500)},
+					{execute.Time(1*time.Second + 500*time.Millisecond), 3.0, int64(0)},
+					{execute.Time(65 * time.Second), 4.0, int64(63500)},
+				},
+			}},
+		},
+		{
+			name: "keep first",
+			spec: &universe.ElapsedProcedureSpec{
+				Unit:       flux.Duration(time.Minute),
+				TimeColumn: "_time",
+				ColumnName: "elapsed",
+				KeepFirst:  true,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), 2.0},
+					{execute.Time(2 * time.Minute), 1.0},
+					{execute.Time(10 * time.Minute), 3.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "elapsed", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), 2.0, nil},
+					{execute.Time(2 * time.Minute), 1.0, int64(2)},
+					{execute.Time(10 * time.Minute), 3.0, int64(8)},
+				},
+			}},
+		},
+		{
+			name: "custom columns",
+			spec: &universe.ElapsedProcedureSpec{
+				Unit:       flux.Duration(time.Second),
+				TimeColumn: "t",
+				ColumnName: "gap",
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "t", Type: flux.TTime},
+					{Label: "_value", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1 * time.Second), int64(1)},
+					{execute.Time(3 * time.Second), int64(2)},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "t", Type: flux.TTime},
+					{Label: "_value", Type: flux.TInt},
+					{Label: "gap", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(3 * time.Second), int64(2), int64(2)},
+				},
+			}},
+		},
+		{
+			name: "multiple tables",
+			spec: &universe.ElapsedProcedureSpec{
+				Unit:       flux.Duration(time.Second),
+				TimeColumn: "_time",
+				ColumnName: "elapsed",
+				KeepFirst:  true,
+			},
+			data: []flux.Table{
+				&executetest.Table{
+					KeyCols: []string{"t1"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "t1", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1 * time.Second), "a"},
+						{execute.Time(2 * time.Second), "a"},
+					},
+				},
+				&executetest.Table{
+					KeyCols: []string{"t1"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "t1", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(5 * time.Second), "b"},
+						{execute.Time(9 * time.Second), "b"},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"t1"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "t1", Type: flux.TString},
+						{Label: "elapsed", Type: flux.TInt},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1 * time.Second), "a", nil},
+						{execute.Time(2 * time.Second), "a", int64(1)},
+					},
+				},
+				{
+					KeyCols: []string{"t1"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "t1", Type: flux.TString},
+						{Label: "elapsed", Type: flux.TInt},
+					},
+					Data: [][]interface{}{
+						{execute.Time(5 * time.Second), "b", nil},
+						{execute.Time(9 * time.Second), "b", int64(4)},
+					},
+				},
+			},
+		},
+		{
+			name: "out of order",
+			spec: &universe.ElapsedProcedureSpec{
+				Unit:       flux.Duration(time.Second),
+				TimeColumn: "_time",
+				ColumnName: "elapsed",
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(2 * time.Second), 2.0},
+					{execute.Time(1 * time.Second), 1.0},
+				},
+			}},
+			wantErr: errors.New("got an out-of-order timestamp"),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			executetest.ProcessTestHelper(
+				t,
+				tc.data,
+				tc.want,
+				tc.wantErr,
+				func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+					return universe.NewElapsedTransformation(d, c, tc.spec)
+				},
+			)
+		})
+	}
+}
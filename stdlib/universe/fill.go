@@ -3,6 +3,7 @@ package universe
 import (
 	"errors"
 	"fmt"
+	"math"
 	"strconv"
 
 	"github.com/influxdata/flux"
@@ -14,11 +15,20 @@ import (
 
 const FillKind = "fill"
 
+// FillMethodLinear fills nulls by linearly interpolating
+// between the surrounding non-null values using the time column.
+const FillMethodLinear = "linear"
+
 type FillOpSpec struct {
 	Column      string `json:"column"`
 	Type        string `json:"type"`
 	Value       string `json:"value"`
 	UsePrevious bool   `json:"use_previous"`
+	Method      string `json:"method,omitempty"`
+	// Limit is the number of consecutive nulls that are filled with the previous value.
+	Limit int64 `json:"limit,omitempty"`
+	// LimitDuration is how long after the previous value nulls are filled with it.
+	LimitDuration flux.Duration `json:"limit_duration,omitempty"`
 }
 
 func init() {
@@ -27,6 +37,8 @@ func init() {
 			"column":      semantic.String,
 			"value":       semantic.Tvar(1),
 			"usePrevious": semantic.Bool,
+			"method":      semantic.String,
+			"limit":       semantic.Tvar(2),
 		},
 		[]string{},
 	)
@@ -79,14 +91,53 @@ func createFillOpSpec(args flux.Arguments, a *flux.Administration) (flux.Operati
 	if err != nil {
 		return nil, err
 	}
+
+	method, methodOk, err := args.GetString("method")
+	if err != nil {
+		return nil, err
+	}
+	if methodOk {
+		if _, ok := args.Get("limit"); ok {
+			return nil, errors.New("fill limit can only be used with usePrevious: true")
+		}
+		if method != FillMethodLinear {
+			return nil, fmt.Errorf("unknown fill method %q", method)
+		}
+		if valOk || usePrevious {
+			return nil, errors.New("fill method cannot be used with value or usePrevious")
+		}
+		spec.Method = method
+		return spec, nil
+	}
+
 	if prevOk == valOk {
-		return nil, errors.New("fill requires exactly one of value or usePrevious")
+		return nil, errors.New("fill requires exactly one of value, usePrevious or method")
 	}
 
 	if prevOk {
 		spec.UsePrevious = usePrevious
 	}
 
+	if limit, ok := args.Get("limit"); ok {
+		if !spec.UsePrevious {
+			return nil, errors.New("fill limit can only be used with usePrevious: true")
+		}
+		switch limit.Type() {
+		case semantic.Int:
+			if limit.Int() <= 0 {
+				return nil, errors.New("fill limit must be positive")
+			}
+			spec.Limit = limit.Int()
+		case semantic.Duration:
+			if limit.Duration() <= 0 {
+				return nil, errors.New("fill limit must be positive")
+			}
+			spec.LimitDuration = flux.Duration(limit.Duration())
+		default:
+			return nil, errors.New("fill limit must be an int or a duration")
+		}
+	}
+
 	return spec, nil
 }
 
@@ -103,6 +154,12 @@ type FillProcedureSpec struct {
 	Column      string
 	Value       values.Value
 	UsePrevious bool
+	Method      string
+	// Limit and LimitDuration stop carrying the previous value forward
+	// after that many consecutive nulls or that long after the previous value.
+	// A zero value means there is no limit.
+	Limit         int64
+	LimitDuration execute.Duration
 }
 
 func newFillProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
@@ -112,10 +169,13 @@ func newFillProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.Proce
 	}
 
 	pspec := &FillProcedureSpec{
-		Column:      spec.Column,
-		UsePrevious: spec.UsePrevious,
+		Column:        spec.Column,
+		UsePrevious:   spec.UsePrevious,
+		Method:        spec.Method,
+		Limit:         spec.Limit,
+		LimitDuration: execute.Duration(spec.LimitDuration),
 	}
-	if !spec.UsePrevious {
+	if !spec.UsePrevious && spec.Method == "" {
 		switch spec.Type {
 		case "bool":
 			v, err := strconv.ParseBool(spec.Value)
@@ -199,6 +259,10 @@ func (t *fillTransformation) RetractTable(id execute.DatasetID, key flux.GroupKe
 }
 
 func (t *fillTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	if t.spec.Method == FillMethodLinear {
+		return t.processLinear(tbl)
+	}
+
 	key := tbl.Key()
 	if idx := execute.ColIdx(t.spec.Column, tbl.Key().Cols()); idx >= 0 {
 		var err error
@@ -226,7 +290,27 @@ func (t *fillTransformation) Process(id execute.DatasetID, tbl flux.Table) error
 		if builder.Cols()[idx].Type != flux.ColumnType(prevNonNull.Type()) {
 			return fmt.Errorf("fill column type mismatch: %s/%s", builder.Cols()[idx].Type.String(), flux.ColumnType(prevNonNull.Type()).String())
 		}
+	} else {
+		// The previous value is carried across the buffers of the table.
+		prevNonNull = values.Null(flux.SemanticType(builder.Cols()[idx].Type))
+	}
+
+	timeIdx := -1
+	if t.spec.LimitDuration > 0 {
+		timeIdx = execute.ColIdx(execute.DefaultTimeColLabel, builder.Cols())
+		if timeIdx < 0 {
+			return fmt.Errorf("fill limit with a duration requires the time column %q", execute.DefaultTimeColLabel)
+		}
+		if builder.Cols()[timeIdx].Type != flux.TTime {
+			return fmt.Errorf("time column %q must be of type time", execute.DefaultTimeColLabel)
+		}
 	}
+	// nulls counts the nulls since the previous value and
+	// prevTime is the time of the previous value, if it has one.
+	var (
+		nulls    int64
+		prevTime values.Value = values.Null(semantic.Time)
+	)
 	return tbl.Do(func(cr flux.ColReader) error {
 		for j := range cr.Cols() {
 			if j == idx {
@@ -239,14 +323,15 @@ func (t *fillTransformation) Process(id execute.DatasetID, tbl flux.Table) error
 		// Set new value
 		l := cr.Len()
 
-		if t.spec.UsePrevious {
-			prevNonNull = execute.ValueForRow(cr, 0, idx)
-		}
-
 		for i := 0; i < l; i++ {
 			v := execute.ValueForRow(cr, i, idx)
 			if v.IsNull() {
-				if err := builder.AppendValue(idx, prevNonNull); err != nil {
+				nulls++
+				fill := prevNonNull
+				if t.spec.UsePrevious && !t.withinLimit(nulls, prevTime, cr, i, timeIdx) {
+					fill = v
+				}
+				if err := builder.AppendValue(idx, fill); err != nil {
 					return err
 				}
 			} else {
@@ -255,6 +340,10 @@ func (t *fillTransformation) Process(id execute.DatasetID, tbl flux.Table) error
 				}
 				if t.spec.UsePrevious {
 					prevNonNull = v
+					nulls = 0
+					if timeIdx >= 0 {
+						prevTime = execute.ValueForRow(cr, i, timeIdx)
+					}
 				}
 
 			}
@@ -263,6 +352,128 @@ func (t *fillTransformation) Process(id execute.DatasetID, tbl flux.Table) error
 	})
 }
 
+// withinLimit reports whether the previous value may be carried forward to the null in row i
+// that is the nth consecutive null after the previous value.
+// When the limit is a duration, the null is only filled if both it and
+// the previous value have a time and they are at most the duration apart.
+func (t *fillTransformation) withinLimit(n int64, prevTime values.Value, cr flux.ColReader, i, timeIdx int) bool {
+	if t.spec.Limit > 0 && n > t.spec.Limit {
+		return false
+	}
+	if t.spec.LimitDuration > 0 {
+		ts := execute.ValueForRow(cr, i, timeIdx)
+		if ts.IsNull() || prevTime.IsNull() {
+			return false
+		}
+		if execute.Duration(ts.Time()-prevTime.Time()) > t.spec.LimitDuration {
+			return false
+		}
+	}
+	return true
+}
+
+// processLinear fills nulls by interpolating between the closest non-null values
+// before and after them. Interpolation needs the next non-null value,
+// so the values of the fill column are buffered for the entire table.
+// Nulls that are not between two non-null values remain null.
+func (t *fillTransformation) processLinear(tbl flux.Table) error {
+	builder, created := t.cache.TableBuilder(tbl.Key())
+	if created {
+		if err := execute.AddTableCols(tbl, builder); err != nil {
+			return err
+		}
+	}
+	idx := execute.ColIdx(t.spec.Column, builder.Cols())
+	if idx < 0 {
+		return fmt.Errorf("fill column not found: %s", t.spec.Column)
+	}
+	typ := builder.Cols()[idx].Type
+	switch typ {
+	case flux.TInt, flux.TUInt, flux.TFloat:
+	default:
+		return fmt.Errorf("linear fill requires a numeric column, column %q has type %v", t.spec.Column, typ)
+	}
+	timeIdx := execute.ColIdx(execute.DefaultTimeColLabel, builder.Cols())
+	if timeIdx < 0 {
+		return fmt.Errorf("linear fill requires the time column %q", execute.DefaultTimeColLabel)
+	}
+	if builder.Cols()[timeIdx].Type != flux.TTime {
+		return fmt.Errorf("time column %q must be of type time", execute.DefaultTimeColLabel)
+	}
+
+	var (
+		vs    []values.Value
+		times []values.Value
+	)
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		for j := range cr.Cols() {
+			if j == idx {
+				continue
+			}
+			if err := execute.AppendCol(j, j, cr, builder); err != nil {
+				return err
+			}
+		}
+		for i := 0; i < cr.Len(); i++ {
+			vs = append(vs, execute.ValueForRow(cr, i, idx))
+			times = append(times, execute.ValueForRow(cr, i, timeIdx))
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	// prev is the index of the last non-null value with a valid time.
+	prev := -1
+	for i, v := range vs {
+		if v.IsNull() || times[i].IsNull() {
+			continue
+		}
+		if prev >= 0 && i-prev > 1 {
+			for j := prev + 1; j < i; j++ {
+				if times[j].IsNull() {
+					continue
+				}
+				vs[j] = interpolate(vs[prev], vs[i], times[prev].Time(), times[i].Time(), times[j].Time())
+			}
+		}
+		prev = i
+	}
+
+	for _, v := range vs {
+		if err := builder.AppendValue(idx, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// interpolate computes the value at time t on the line between (t0, v0) and (t1, v1).
+// Integer results are rounded to the nearest integer.
+func interpolate(v0, v1 values.Value, t0, t1, t values.Time) values.Value {
+	var f0, f1 float64
+	switch v0.Type() {
+	case semantic.Int:
+		f0, f1 = float64(v0.Int()), float64(v1.Int())
+	case semantic.UInt:
+		f0, f1 = float64(v0.UInt()), float64(v1.UInt())
+	default:
+		f0, f1 = v0.Float(), v1.Float()
+	}
+	f := f0
+	if t1 != t0 {
+		f += (f1 - f0) * float64(t-t0) / float64(t1-t0)
+	}
+	switch v0.Type() {
+	case semantic.Int:
+		return values.NewInt(int64(math.Round(f)))
+	case semantic.UInt:
+		return values.NewUInt(uint64(math.Round(f)))
+	default:
+		return values.NewFloat(f)
+	}
+}
+
 func (t *fillTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
 	return t.d.UpdateWatermark(mark)
 }
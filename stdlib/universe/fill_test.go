@@ -1,6 +1,7 @@
 package universe_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -65,6 +66,18 @@ func TestFillOperation_Marshaling(t *testing.T) {
 	}
 
 	querytest.OperationMarshalingTestHelper(t, data, op)
+
+	data = []byte(`{"id":"fill","kind":"fill","spec":{"column":"_value","type":"","value":"","use_previous":true,"limit_duration":"5m0s"}}`)
+	op = &flux.Operation{
+		ID: "fill",
+		Spec: &universe.FillOpSpec{
+			Column:        "_value",
+			UsePrevious:   true,
+			LimitDuration: flux.Duration(5 * time.Minute),
+		},
+	}
+
+	querytest.OperationMarshalingTestHelper(t, data, op)
 }
 
 func TestFill_NewQuery(t *testing.T) {
@@ -111,6 +124,127 @@ func TestFill_NewQuery(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "from with range and linear fill",
+			Raw:  `from(bucket:"mydb") |> range(start:-4h, stop:-2h) |> fill(method: "linear")`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "mydb",
+						},
+					},
+					{
+						ID: "range1",
+						Spec: &universe.RangeOpSpec{
+							Start: flux.Time{
+								Relative:   -4 * time.Hour,
+								IsRelative: true,
+							},
+							Stop: flux.Time{
+								Relative:   -2 * time.Hour,
+								IsRelative: true,
+							},
+							TimeColumn:  "_time",
+							StartColumn: "_start",
+							StopColumn:  "_stop",
+						},
+					},
+					{
+						ID: "fill2",
+						Spec: &universe.FillOpSpec{
+							Column: "_value",
+							Method: "linear",
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "range1"},
+					{Parent: "range1", Child: "fill2"},
+				},
+			},
+		},
+		{
+			Name:    "linear fill with value",
+			Raw:     `from(bucket:"mydb") |> range(start:-4h, stop:-2h) |> fill(value: 1.0, method: "linear")`,
+			WantErr: true,
+		},
+		{
+			Name:    "unknown fill method",
+			Raw:     `from(bucket:"mydb") |> range(start:-4h, stop:-2h) |> fill(method: "cubic")`,
+			WantErr: true,
+		},
+		{
+			Name: "fill previous with limit",
+			Raw:  `from(bucket:"mydb") |> fill(usePrevious: true, limit: 3)`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "mydb",
+						},
+					},
+					{
+						ID: "fill1",
+						Spec: &universe.FillOpSpec{
+							Column:      "_value",
+							UsePrevious: true,
+							Limit:       3,
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "fill1"},
+				},
+			},
+		},
+		{
+			Name: "fill previous with duration limit",
+			Raw:  `from(bucket:"mydb") |> fill(usePrevious: true, limit: 10m)`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "mydb",
+						},
+					},
+					{
+						ID: "fill1",
+						Spec: &universe.FillOpSpec{
+							Column:        "_value",
+							UsePrevious:   true,
+							LimitDuration: flux.Duration(10 * time.Minute),
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "fill1"},
+				},
+			},
+		},
+		{
+			Name:    "fill value with limit",
+			Raw:     `from(bucket:"mydb") |> fill(value: 1.0, limit: 3)`,
+			WantErr: true,
+		},
+		{
+			Name:    "linear fill with limit",
+			Raw:     `from(bucket:"mydb") |> fill(method: "linear", limit: 3)`,
+			WantErr: true,
+		},
+		{
+			Name:    "fill previous with zero limit",
+			Raw:     `from(bucket:"mydb") |> fill(usePrevious: true, limit: 0)`,
+			WantErr: true,
+		},
+		{
+			Name:    "fill previous with float limit",
+			Raw:     `from(bucket:"mydb") |> fill(usePrevious: true, limit: 1.5)`,
+			WantErr: true,
+		},
 	}
 	for _, tc := range tests {
 		tc := tc
@@ -421,3 +555,361 @@ func TestFill_Process(t *testing.T) {
 		})
 	}
 }
+
+func TestFill_ProcessLimit(t *testing.T) {
+	cols := []flux.ColMeta{
+		{Label: "_time", Type: flux.TTime},
+		{Label: "_value", Type: flux.TFloat},
+	}
+	// The first gap has two nulls and the second gap has four nulls.
+	data := [][]interface{}{
+		{execute.Time(1), 1.0},
+		{execute.Time(2), nil},
+		{execute.Time(3), nil},
+		{execute.Time(4), 2.0},
+		{execute.Time(10), nil},
+		{execute.Time(11), nil},
+		{execute.Time(12), nil},
+		{execute.Time(20), nil},
+		{execute.Time(21), 3.0},
+	}
+	testCases := []struct {
+		name    string
+		spec    *universe.FillProcedureSpec
+		rowWise bool
+		data    [][]interface{}
+		colMeta []flux.ColMeta
+		want    [][]interface{}
+		wantErr error
+	}{
+		{
+			name: "count limit",
+			spec: &universe.FillProcedureSpec{
+				Column:      "_value",
+				UsePrevious: true,
+				Limit:       2,
+			},
+			want: [][]interface{}{
+				{execute.Time(1), 1.0},
+				{execute.Time(2), 1.0},
+				{execute.Time(3), 1.0},
+				{execute.Time(4), 2.0},
+				{execute.Time(10), 2.0},
+				{execute.Time(11), 2.0},
+				{execute.Time(12), nil},
+				{execute.Time(20), nil},
+				{execute.Time(21), 3.0},
+			},
+		},
+		{
+			name: "count limit across buffers",
+			spec: &universe.FillProcedureSpec{
+				Column:      "_value",
+				UsePrevious: true,
+				Limit:       3,
+			},
+			rowWise: true,
+			want: [][]interface{}{
+				{execute.Time(1), 1.0},
+				{execute.Time(2), 1.0},
+				{execute.Time(3), 1.0},
+				{execute.Time(4), 2.0},
+				{execute.Time(10), 2.0},
+				{execute.Time(11), 2.0},
+				{execute.Time(12), 2.0},
+				{execute.Time(20), nil},
+				{execute.Time(21), 3.0},
+			},
+		},
+		{
+			name: "duration limit",
+			spec: &universe.FillProcedureSpec{
+				Column:        "_value",
+				UsePrevious:   true,
+				LimitDuration: 7,
+			},
+			want: [][]interface{}{
+				{execute.Time(1), 1.0},
+				{execute.Time(2), 1.0},
+				{execute.Time(3), 1.0},
+				{execute.Time(4), 2.0},
+				{execute.Time(10), 2.0},
+				{execute.Time(11), 2.0},
+				{execute.Time(12), nil},
+				{execute.Time(20), nil},
+				{execute.Time(21), 3.0},
+			},
+		},
+		{
+			name: "duration limit longer than gaps",
+			spec: &universe.FillProcedureSpec{
+				Column:        "_value",
+				UsePrevious:   true,
+				LimitDuration: 20,
+			},
+			rowWise: true,
+			want: [][]interface{}{
+				{execute.Time(1), 1.0},
+				{execute.Time(2), 1.0},
+				{execute.Time(3), 1.0},
+				{execute.Time(4), 2.0},
+				{execute.Time(10), 2.0},
+				{execute.Time(11), 2.0},
+				{execute.Time(12), 2.0},
+				{execute.Time(20), 2.0},
+				{execute.Time(21), 3.0},
+			},
+		},
+		{
+			name: "duration limit with null times",
+			spec: &universe.FillProcedureSpec{
+				Column:        "_value",
+				UsePrevious:   true,
+				LimitDuration: 5,
+			},
+			data: [][]interface{}{
+				{nil, 1.0},
+				{execute.Time(2), nil},
+				{execute.Time(3), 2.0},
+				{nil, nil},
+				{execute.Time(4), nil},
+			},
+			want: [][]interface{}{
+				{nil, 1.0},
+				{execute.Time(2), nil},
+				{execute.Time(3), 2.0},
+				{nil, nil},
+				{execute.Time(4), 2.0},
+			},
+		},
+		{
+			name: "duration limit without time column",
+			spec: &universe.FillProcedureSpec{
+				Column:        "_value",
+				UsePrevious:   true,
+				LimitDuration: 5,
+			},
+			colMeta: []flux.ColMeta{
+				{Label: "_value", Type: flux.TFloat},
+			},
+			data: [][]interface{}{
+				{1.0},
+				{nil},
+			},
+			wantErr: errors.New(`fill limit with a duration requires the time column "_time"`),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			colMeta, rows := cols, data
+			if tc.colMeta != nil {
+				colMeta = tc.colMeta
+			}
+			if tc.data != nil {
+				rows = tc.data
+			}
+			tbl := &executetest.Table{
+				ColMeta: colMeta,
+				Data:    rows,
+			}
+			input := []flux.Table{tbl}
+			if tc.rowWise {
+				input = []flux.Table{&executetest.RowWiseTable{Table: tbl}}
+			}
+			var want []*executetest.Table
+			if tc.wantErr == nil {
+				want = []*executetest.Table{{
+					ColMeta: colMeta,
+					Data:    tc.want,
+				}}
+			}
+			executetest.ProcessTestHelper(
+				t,
+				input,
+				want,
+				tc.wantErr,
+				func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+					return universe.NewFillTransformation(d, c, tc.spec)
+				},
+			)
+		})
+	}
+}
+
+func TestFill_ProcessLinear(t *testing.T) {
+	spec := &universe.FillProcedureSpec{
+		Column: "_value",
+		Method: universe.FillMethodLinear,
+	}
+	testCases := []struct {
+		name    string
+		data    []flux.Table
+		want    []*executetest.Table
+		wantErr error
+	}{
+		{
+			name: "interior gaps",
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0},
+					{execute.Time(2), nil},
+					{execute.Time(3), 3.0},
+					{execute.Time(4), nil},
+					{execute.Time(5), nil},
+					{execute.Time(8), 0.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0},
+					{execute.Time(2), 2.0},
+					{execute.Time(3), 3.0},
+					{execute.Time(4), 2.4},
+					{execute.Time(5), 1.8},
+					{execute.Time(8), 0.0},
+				},
+			}},
+		},
+		{
+			name: "boundary nulls",
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), nil},
+					{execute.Time(2), 2.0},
+					{execute.Time(3), nil},
+					{execute.Time(4), 4.0},
+					{execute.Time(5), nil},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), nil},
+					{execute.Time(2), 2.0},
+					{execute.Time(3), 3.0},
+					{execute.Time(4), 4.0},
+					{execute.Time(5), nil},
+				},
+			}},
+		},
+		{
+			name: "all nulls",
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), nil},
+					{execute.Time(2), nil},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), nil},
+					{execute.Time(2), nil},
+				},
+			}},
+		},
+		{
+			name: "int",
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), int64(0)},
+					{execute.Time(1), nil},
+					{execute.Time(2), nil},
+					{execute.Time(3), int64(-5)},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), int64(0)},
+					{execute.Time(1), int64(-2)},
+					{execute.Time(2), int64(-3)},
+					{execute.Time(3), int64(-5)},
+				},
+			}},
+		},
+		{
+			name: "uint",
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TUInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), uint64(10)},
+					{execute.Time(1), nil},
+					{execute.Time(4), uint64(2)},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TUInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), uint64(10)},
+					{execute.Time(1), uint64(8)},
+					{execute.Time(4), uint64(2)},
+				},
+			}},
+		},
+		{
+			name: "string",
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "a"},
+					{execute.Time(2), nil},
+				},
+			}},
+			wantErr: errors.New(`linear fill requires a numeric column, column "_value" has type string`),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			executetest.ProcessTestHelper(
+				t,
+				tc.data,
+				tc.want,
+				tc.wantErr,
+				func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+					return universe.NewFillTransformation(d, c, spec)
+				},
+			)
+		})
+	}
+}
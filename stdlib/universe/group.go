@@ -6,10 +6,13 @@ import (
 	"sort"
 
 	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/compiler"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/memory"
 	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
 )
 
 const GroupKind = "group"
@@ -22,6 +25,8 @@ const (
 type GroupOpSpec struct {
 	Mode    string   `json:"mode"`
 	Columns []string `json:"columns"`
+	// Predicate selects the columns of the mode by their name and type, instead of Columns.
+	Predicate *semantic.FunctionExpression `json:"fn,omitempty"`
 }
 
 func init() {
@@ -29,6 +34,14 @@ func init() {
 		map[string]semantic.PolyType{
 			"mode":    semantic.String,
 			"columns": semantic.NewArrayPolyType(semantic.String),
+			"fn": semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+				Parameters: map[string]semantic.PolyType{
+					"column": semantic.String,
+					"type":   semantic.String,
+				},
+				Required: semantic.LabelSet{"column"},
+				Return:   semantic.Bool,
+			}),
 		},
 		nil,
 	)
@@ -59,14 +72,27 @@ func createGroupOpSpec(args flux.Arguments, a *flux.Administration) (flux.Operat
 		spec.Mode = groupModeBy
 	}
 
+	if f, ok, err := args.GetFunction("fn"); err != nil {
+		return nil, err
+	} else if ok {
+		fn, err := interpreter.ResolveFunction(f)
+		if err != nil {
+			return nil, err
+		}
+		spec.Predicate = fn
+	}
+
 	if columns, ok, err := args.GetArray("columns", semantic.String); err != nil {
 		return nil, err
 	} else if ok {
+		if spec.Predicate != nil {
+			return nil, errors.New("group error: both column list and predicate provided")
+		}
 		spec.Columns, err = interpreter.ToStringArray(columns)
 		if err != nil {
 			return nil, err
 		}
-	} else {
+	} else if spec.Predicate == nil {
 		spec.Columns = []string{}
 	}
 
@@ -96,6 +122,8 @@ type GroupProcedureSpec struct {
 	plan.DefaultCost
 	GroupMode flux.GroupMode
 	GroupKeys []string
+	// Predicate selects the columns of the group mode instead of GroupKeys, when it is set.
+	Predicate *semantic.FunctionExpression
 }
 
 func newGroupProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
@@ -112,6 +140,7 @@ func newGroupProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.Proc
 	p := &GroupProcedureSpec{
 		GroupMode: mode,
 		GroupKeys: spec.Columns,
+		Predicate: spec.Predicate,
 	}
 	return p, nil
 }
@@ -127,6 +156,10 @@ func (s *GroupProcedureSpec) Copy() plan.ProcedureSpec {
 	ns.GroupKeys = make([]string, len(s.GroupKeys))
 	copy(ns.GroupKeys, s.GroupKeys)
 
+	if s.Predicate != nil {
+		ns.Predicate = s.Predicate.Copy().(*semantic.FunctionExpression)
+	}
+
 	return ns
 }
 
@@ -137,24 +170,40 @@ func createGroupTransformation(id execute.DatasetID, mode execute.AccumulationMo
 	}
 	cache := execute.NewTableBuilderCache(a.Allocator())
 	d := execute.NewDataset(id, mode, cache)
-	t := NewGroupTransformation(d, cache, s)
+	t := NewGroupTransformation(d, cache, s, a.Allocator())
 	return t, d, nil
 }
 
 type groupTransformation struct {
 	d     execute.Dataset
 	cache execute.TableBuilderCache
+	alloc *memory.Allocator
+
+	// allocated is the number of bytes charged to alloc
+	// for the group keys and string data buffered by group.
+	allocated int
 
 	mode flux.GroupMode
 	keys []string
+
+	// predicate selects the columns of the mode, when fn is set.
+	// It is compiled when the first table is processed.
+	fn               *semantic.FunctionExpression
+	predicate        compiler.Func
+	param, typeParam string
+	predicateInput   values.Object
 }
 
-func NewGroupTransformation(d execute.Dataset, cache execute.TableBuilderCache, spec *GroupProcedureSpec) *groupTransformation {
+// NewGroupTransformation creates a transformation that regroups its input tables.
+// The memory buffered for each output table is charged to the allocator.
+func NewGroupTransformation(d execute.Dataset, cache execute.TableBuilderCache, spec *GroupProcedureSpec, a *memory.Allocator) *groupTransformation {
 	t := &groupTransformation{
 		d:     d,
 		cache: cache,
+		alloc: a,
 		mode:  spec.GroupMode,
 		keys:  spec.GroupKeys,
+		fn:    spec.Predicate,
 	}
 	sort.Strings(t.keys)
 	return t
@@ -164,7 +213,23 @@ func (t *groupTransformation) RetractTable(id execute.DatasetID, key flux.GroupK
 	panic("not implemented")
 }
 
-func (t *groupTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+func (t *groupTransformation) Process(id execute.DatasetID, tbl flux.Table) (err error) {
+	// The table builders panic when the allocator limit is reached.
+	// Report that as an error so the query fails cleanly.
+	defer func() {
+		if e := recover(); e != nil {
+			lerr, ok := e.(memory.LimitExceededError)
+			if !ok {
+				panic(e)
+			}
+			err = lerr
+		}
+	}()
+
+	if t.fn != nil {
+		return t.processPredicate(tbl)
+	}
+
 	cols := tbl.Cols()
 	on := make(map[string]bool, len(cols))
 	switch t.mode {
@@ -191,7 +256,15 @@ func (t *groupTransformation) Process(id execute.DatasetID, tbl flux.Table) erro
 		l := cr.Len()
 		for i := 0; i < l; i++ {
 			key := execute.GroupKeyForRowOn(i, cr, on)
-			builder, _ := t.cache.TableBuilder(key)
+			builder, created := t.cache.TableBuilder(key)
+
+			size := stringRowSize(i, cr)
+			if created {
+				size += groupKeySize(key)
+			}
+			if err := t.allocate(size); err != nil {
+				return err
+			}
 
 			colMap, err := execute.AddNewTableCols(tbl, builder, colMap)
 			if err != nil {
@@ -207,6 +280,117 @@ func (t *groupTransformation) Process(id execute.DatasetID, tbl flux.Table) erro
 	})
 }
 
+// processPredicate groups the rows of the table by the columns that the predicate selects.
+// The columns of each group key are ordered by their labels,
+// so that the keys do not depend on the order of the columns of the input tables.
+func (t *groupTransformation) processPredicate(tbl flux.Table) error {
+	if t.predicate == nil {
+		compiled, param, typeParam, err := compileColumnPredicate(t.fn)
+		if err != nil {
+			return err
+		}
+		t.predicate, t.param, t.typeParam = compiled, param, typeParam
+		t.predicateInput = values.NewObject()
+	}
+
+	cols := tbl.Cols()
+	on := make([]int, 0, len(cols))
+	for j, c := range cols {
+		t.predicateInput.Set(t.param, values.NewString(c.Label))
+		if t.typeParam != "" {
+			t.predicateInput.Set(t.typeParam, values.NewString(c.Type.String()))
+		}
+		match, err := t.predicate.EvalBool(t.predicateInput)
+		if err != nil {
+			return err
+		}
+		// The predicate selects the columns to group by in the by mode
+		// and the columns to leave out of the group key in the except mode.
+		if match == (t.mode == flux.GroupModeBy) {
+			on = append(on, j)
+		}
+	}
+	sort.Slice(on, func(i, j int) bool {
+		return cols[on[i]].Label < cols[on[j]].Label
+	})
+	keyCols := make([]flux.ColMeta, len(on))
+	for i, j := range on {
+		keyCols[i] = cols[j]
+	}
+
+	colMap := make([]int, 0, len(cols))
+	return tbl.Do(func(cr flux.ColReader) error {
+		l := cr.Len()
+		for i := 0; i < l; i++ {
+			vs := make([]values.Value, len(on))
+			for k, j := range on {
+				vs[k] = execute.ValueForRow(cr, i, j)
+			}
+			key := execute.NewGroupKey(keyCols, vs)
+			builder, created := t.cache.TableBuilder(key)
+
+			size := stringRowSize(i, cr)
+			if created {
+				size += groupKeySize(key)
+			}
+			if err := t.allocate(size); err != nil {
+				return err
+			}
+
+			colMap, err := execute.AddNewTableCols(tbl, builder, colMap)
+			if err != nil {
+				return err
+			}
+
+			if err := execute.AppendMappedRecordWithNulls(i, cr, builder, colMap); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// allocate charges size bytes to the allocator.
+func (t *groupTransformation) allocate(size int) error {
+	if err := t.alloc.Allocate(size); err != nil {
+		return err
+	}
+	t.allocated += size
+	return nil
+}
+
+// groupKeySize estimates the number of bytes retained by a group key.
+// The column builders do not account for the key of their table.
+func groupKeySize(key flux.GroupKey) int {
+	size := 0
+	for j, c := range key.Cols() {
+		size += len(c.Label)
+		switch {
+		case key.IsNull(j):
+		case c.Type == flux.TString:
+			size += len(key.ValueString(j))
+		default:
+			size += 8
+		}
+	}
+	return size
+}
+
+// stringRowSize returns the number of bytes in the string values of row i.
+// The column builders only account for the string headers.
+func stringRowSize(i int, cr flux.ColReader) int {
+	size := 0
+	for j, c := range cr.Cols() {
+		if c.Type != flux.TString {
+			continue
+		}
+		if vs := cr.Strings(j); vs.IsValid(i) {
+			size += len(vs.ValueString(i))
+		}
+	}
+	return size
+}
+
 func (t *groupTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
 	return t.d.UpdateWatermark(mark)
 }
@@ -215,6 +399,8 @@ func (t *groupTransformation) UpdateProcessingTime(id execute.DatasetID, pt exec
 }
 func (t *groupTransformation) Finish(id execute.DatasetID, err error) {
 	t.d.Finish(err)
+	t.alloc.Free(t.allocated)
+	t.allocated = 0
 }
 
 // `MergeGroupRule` merges two group operations and keeps only the last one
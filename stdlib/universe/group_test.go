@@ -2,12 +2,16 @@ package universe_test
 
 import (
 	"errors"
+	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
 	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/memory"
 	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/plan/plantest"
 	"github.com/influxdata/flux/querytest"
@@ -203,6 +207,33 @@ func TestGroup_NewQuery(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "group with predicate",
+			Raw:  `from(bucket: "telegraf") |> group(fn: (column) => column =~ /^tag_/)`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID:   "from0",
+						Spec: &influxdb.FromOpSpec{Bucket: "telegraf"},
+					},
+					{
+						ID: "group1",
+						Spec: &universe.GroupOpSpec{
+							Mode:      "by",
+							Predicate: tagPrefixPredicate,
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "group1"},
+				},
+			},
+		},
+		{
+			Name:    "group with columns and predicate",
+			Raw:     `from(bucket: "telegraf") |> group(columns: ["host"], fn: (column) => column =~ /^tag_/)`,
+			WantErr: true,
+		},
 	}
 	for _, tc := range tests {
 		tc := tc
@@ -213,6 +244,45 @@ func TestGroup_NewQuery(t *testing.T) {
 	}
 }
 
+// tagPrefixPredicate is the predicate (column) => column =~ /^tag_/.
+var tagPrefixPredicate = &semantic.FunctionExpression{
+	Block: &semantic.FunctionBlock{
+		Parameters: &semantic.FunctionParameters{
+			List: []*semantic.FunctionParameter{{Key: &semantic.Identifier{Name: "column"}}},
+		},
+		Body: &semantic.BinaryExpression{
+			Operator: ast.RegexpMatchOperator,
+			Left: &semantic.IdentifierExpression{
+				Name: "column",
+			},
+			Right: &semantic.RegexpLiteral{
+				Value: regexp.MustCompile(`^tag_`),
+			},
+		},
+	},
+}
+
+// notStringPredicate is the predicate (column, type) => type != "string".
+var notStringPredicate = &semantic.FunctionExpression{
+	Block: &semantic.FunctionBlock{
+		Parameters: &semantic.FunctionParameters{
+			List: []*semantic.FunctionParameter{
+				{Key: &semantic.Identifier{Name: "column"}},
+				{Key: &semantic.Identifier{Name: "type"}},
+			},
+		},
+		Body: &semantic.BinaryExpression{
+			Operator: ast.NotEqualOperator,
+			Left: &semantic.IdentifierExpression{
+				Name: "type",
+			},
+			Right: &semantic.StringLiteral{
+				Value: "string",
+			},
+		},
+	},
+}
+
 func TestGroup_Process(t *testing.T) {
 	testCases := []struct {
 		name    string
@@ -751,6 +821,149 @@ func TestGroup_Process(t *testing.T) {
 				},
 			},
 		},
+		{
+			// The group key columns are ordered by label, not by their order in the table.
+			name: "predicate by column prefix",
+			spec: &universe.GroupProcedureSpec{
+				GroupMode: flux.GroupModeBy,
+				Predicate: tagPrefixPredicate,
+			},
+			data: []flux.Table{
+				&executetest.Table{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "tag_b", Type: flux.TString},
+						{Label: "tag_a", Type: flux.TString},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, "p", "a", "x"},
+						{execute.Time(2), 2.0, "q", "a", "x"},
+					},
+				},
+				&executetest.Table{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "tag_b", Type: flux.TString},
+						{Label: "tag_a", Type: flux.TString},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(3), 3.0, "p", "a", "y"},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"tag_a", "tag_b"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "tag_b", Type: flux.TString},
+						{Label: "tag_a", Type: flux.TString},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, "p", "a", "x"},
+						{execute.Time(3), 3.0, "p", "a", "y"},
+					},
+				},
+				{
+					KeyCols: []string{"tag_a", "tag_b"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "tag_b", Type: flux.TString},
+						{Label: "tag_a", Type: flux.TString},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 2.0, "q", "a", "x"},
+					},
+				},
+			},
+		},
+		{
+			name: "predicate except column type",
+			spec: &universe.GroupProcedureSpec{
+				GroupMode: flux.GroupModeExcept,
+				Predicate: notStringPredicate,
+			},
+			data: []flux.Table{
+				&executetest.Table{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "tag_b", Type: flux.TString},
+						{Label: "tag_a", Type: flux.TString},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, "p", "a", "x"},
+						{execute.Time(2), 2.0, "q", "a", "x"},
+					},
+				},
+				&executetest.Table{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "tag_b", Type: flux.TString},
+						{Label: "tag_a", Type: flux.TString},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(3), 3.0, "p", "a", "y"},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"host", "tag_a", "tag_b"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "tag_b", Type: flux.TString},
+						{Label: "tag_a", Type: flux.TString},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, "p", "a", "x"},
+					},
+				},
+				{
+					KeyCols: []string{"host", "tag_a", "tag_b"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "tag_b", Type: flux.TString},
+						{Label: "tag_a", Type: flux.TString},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 2.0, "q", "a", "x"},
+					},
+				},
+				{
+					KeyCols: []string{"host", "tag_a", "tag_b"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "tag_b", Type: flux.TString},
+						{Label: "tag_a", Type: flux.TString},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(3), 3.0, "p", "a", "y"},
+					},
+				},
+			},
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -761,13 +974,46 @@ func TestGroup_Process(t *testing.T) {
 				tc.want,
 				tc.wantErr,
 				func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
-					return universe.NewGroupTransformation(d, c, tc.spec)
+					return universe.NewGroupTransformation(d, c, tc.spec, executetest.UnlimitedAllocator)
 				},
 			)
 		})
 	}
 }
 
+func TestGroup_MemoryLimit(t *testing.T) {
+	data := make([][]interface{}, 10000)
+	for i := range data {
+		data[i] = []interface{}{execute.Time(i), float64(i), fmt.Sprintf("tag-%d", i)}
+	}
+	tbl := &executetest.Table{
+		ColMeta: []flux.ColMeta{
+			{Label: "_time", Type: flux.TTime},
+			{Label: "_value", Type: flux.TFloat},
+			{Label: "t1", Type: flux.TString},
+		},
+		Data: data,
+	}
+
+	limit := int64(64 * 1024)
+	alloc := &memory.Allocator{Limit: &limit}
+	d := executetest.NewDataset(executetest.RandomDatasetID())
+	c := execute.NewTableBuilderCache(alloc)
+	c.SetTriggerSpec(plan.DefaultTriggerSpec)
+	group := universe.NewGroupTransformation(d, c, &universe.GroupProcedureSpec{
+		GroupMode: flux.GroupModeBy,
+		GroupKeys: []string{"t1"},
+	}, alloc)
+
+	err := group.Process(executetest.RandomDatasetID(), tbl)
+	if _, ok := err.(memory.LimitExceededError); !ok {
+		t.Fatalf("expected memory limit error, got: %v", err)
+	}
+	if got := alloc.Allocated(); got > limit {
+		t.Errorf("allocated memory exceeds the limit: %d > %d", got, limit)
+	}
+}
+
 func TestMergeGroupRule(t *testing.T) {
 	var (
 		from      = &influxdb.FromProcedureSpec{}
@@ -257,20 +257,24 @@ func (t *histogramQuantileTransformation) computeQuantile(cdf []bucket) (float64
 	if len(cdf) == 0 {
 		return 0, errors.New("histogram is empty")
 	}
-	// Find rank index and check counts are monotonic
+	// Check counts are monotonic
 	prevCount := 0.0
-	totalCount := cdf[len(cdf)-1].count
-	rank := t.spec.Quantile * totalCount
-	rankIdx := -1
-	for i, b := range cdf {
+	for _, b := range cdf {
 		if b.count < prevCount {
 			return 0, errors.New("histogram records counts are not monotonic")
 		}
 		prevCount = b.count
-
-		if rank >= b.count {
-			rankIdx = i
-		}
+	}
+	// Find the first bucket whose count reaches the rank,
+	// this is the bucket that contains the quantile.
+	totalCount := cdf[len(cdf)-1].count
+	rank := t.spec.Quantile * totalCount
+	rankIdx := sort.Search(len(cdf), func(i int) bool {
+		return cdf[i].count >= rank
+	})
+	if rankIdx == len(cdf) {
+		// The quantile is greater than one, use the highest bucket
+		rankIdx = len(cdf) - 1
 	}
 	var (
 		lowerCount,
@@ -278,21 +282,23 @@ func (t *histogramQuantileTransformation) computeQuantile(cdf []bucket) (float64
 		upperCount,
 		upperBound float64
 	)
-	switch rankIdx {
-	case -1:
+	if rankIdx == 0 {
 		// Quantile is below the lowest upper bound, interpolate using the min value
 		lowerCount = 0
 		lowerBound = t.spec.MinValue
-		upperCount = cdf[0].count
-		upperBound = cdf[0].upperBound
-	case len(cdf) - 1:
-		// Quantile is above the highest upper bound, simply return it as it must be finite
-		return cdf[len(cdf)-1].upperBound, nil
-	default:
-		lowerCount = cdf[rankIdx].count
-		lowerBound = cdf[rankIdx].upperBound
-		upperCount = cdf[rankIdx+1].count
-		upperBound = cdf[rankIdx+1].upperBound
+	} else {
+		lowerCount = cdf[rankIdx-1].count
+		lowerBound = cdf[rankIdx-1].upperBound
+	}
+	upperCount = cdf[rankIdx].count
+	upperBound = cdf[rankIdx].upperBound
+	if rank == upperCount {
+		// No need to interpolate
+		if math.IsInf(upperBound, 1) && rankIdx > 0 {
+			// The quantile is in the infinite bucket, use the highest finite bound
+			return lowerBound, nil
+		}
+		return upperBound, nil
 	}
 	if rank == lowerCount {
 		// No need to interpolate
@@ -280,7 +280,7 @@ func TestHistogramQuantile_Process(t *testing.T) {
 					{Label: "_value", Type: flux.TFloat},
 				},
 				Data: [][]interface{}{
-					{execute.Time(1), execute.Time(3), math.Inf(1)},
+					{execute.Time(1), execute.Time(3), 1.0},
 				},
 			}},
 		},
@@ -343,7 +343,7 @@ func TestHistogramQuantile_Process(t *testing.T) {
 					{Label: "_value", Type: flux.TFloat},
 				},
 				Data: [][]interface{}{
-					{execute.Time(1), execute.Time(3), 1.0},
+					{execute.Time(1), execute.Time(3), 0.8},
 				},
 			}},
 		},
@@ -389,6 +389,108 @@ func TestHistogramQuantile_Process(t *testing.T) {
 				},
 			}},
 		},
+		{
+			name: "30th nonlinear interpolated",
+			spec: &universe.HistogramQuantileProcedureSpec{
+				Quantile:         0.3,
+				CountColumn:      "_value",
+				UpperBoundColumn: "le",
+				ValueColumn:      "_value",
+			},
+			data: nonLinearHist,
+			want: []*executetest.Table{{
+				KeyCols: []string{"_start", "_stop"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_start", Type: flux.TTime},
+					{Label: "_stop", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					// The rank 3.3 falls in the bucket (0.1, 0.5].
+					// Snapping to the upper bound of that bucket would give 0.5.
+					{execute.Time(1), execute.Time(3), 0.1 + 0.4*(2.3/4.0)},
+				},
+			}},
+		},
+		{
+			name: "infinite bucket",
+			spec: &universe.HistogramQuantileProcedureSpec{
+				Quantile:         0.95,
+				CountColumn:      "_value",
+				UpperBoundColumn: "le",
+				ValueColumn:      "_value",
+			},
+			data: nonLinearHist,
+			want: []*executetest.Table{{
+				KeyCols: []string{"_start", "_stop"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_start", Type: flux.TTime},
+					{Label: "_stop", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), execute.Time(3), 1.0},
+				},
+			}},
+		},
+		{
+			name: "100th infinite bucket",
+			spec: &universe.HistogramQuantileProcedureSpec{
+				Quantile:         1.0,
+				CountColumn:      "_value",
+				UpperBoundColumn: "le",
+				ValueColumn:      "_value",
+			},
+			data: nonLinearHist,
+			want: []*executetest.Table{{
+				KeyCols: []string{"_start", "_stop"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_start", Type: flux.TTime},
+					{Label: "_stop", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), execute.Time(3), 1.0},
+				},
+			}},
+		},
+		{
+			name: "empty buckets",
+			spec: &universe.HistogramQuantileProcedureSpec{
+				Quantile:         0.5,
+				CountColumn:      "_value",
+				UpperBoundColumn: "le",
+				ValueColumn:      "_value",
+			},
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"_start", "_stop"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_start", Type: flux.TTime},
+					{Label: "_stop", Type: flux.TTime},
+					{Label: "_time", Type: flux.TTime},
+					{Label: "le", Type: flux.TFloat},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), execute.Time(3), execute.Time(1), 1.0, 1.0},
+					{execute.Time(1), execute.Time(3), execute.Time(1), 2.0, 1.0},
+					{execute.Time(1), execute.Time(3), execute.Time(1), 3.0, 2.0},
+					{execute.Time(1), execute.Time(3), execute.Time(1), math.Inf(1), 2.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				KeyCols: []string{"_start", "_stop"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_start", Type: flux.TTime},
+					{Label: "_stop", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					// The first bucket already holds half of the values.
+					{execute.Time(1), execute.Time(3), 1.0},
+				},
+			}},
+		},
 		{
 			name: "null in count column",
 			spec: &universe.HistogramQuantileProcedureSpec{
@@ -6,6 +6,7 @@ import (
 	"sort"
 	"sync"
 
+	"github.com/apache/arrow/go/arrow/array"
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/interpreter"
@@ -22,9 +23,13 @@ const MergeJoinKind = "merge-join"
 func init() {
 	joinSignature := semantic.FunctionPolySignature{
 		Parameters: map[string]semantic.PolyType{
-			"tables": semantic.NewObjectPolyType(nil, nil, semantic.AllLabels()),
-			"on":     semantic.NewArrayPolyType(semantic.String),
-			"method": semantic.String,
+			"tables":     semantic.NewObjectPolyType(nil, nil, semantic.AllLabels()),
+			"on":         semantic.NewArrayPolyType(semantic.String),
+			"method":     semantic.String,
+			"tolerance":  semantic.Duration,
+			"direction":  semantic.String,
+			"unmatched":  semantic.String,
+			"timeColumn": semantic.String,
 		},
 		Required: semantic.LabelSet{"tables"},
 		Return:   flux.TableObjectType,
@@ -39,14 +44,40 @@ func init() {
 // All supported join types in Flux
 var methods = map[string]bool{
 	"inner": true,
+	"asof":  true,
 }
 
+// Directions in which an as-of join searches for a matching right row.
+const (
+	asOfBackward = "backward"
+	asOfForward  = "forward"
+	asOfNearest  = "nearest"
+)
+
+var asOfDirections = map[string]bool{
+	asOfBackward: true,
+	asOfForward:  true,
+	asOfNearest:  true,
+}
+
+// Ways in which an as-of join handles left rows without a matching right row.
+const (
+	asOfUnmatchedNull = "null"
+	asOfUnmatchedDrop = "drop"
+)
+
 // JoinOpSpec specifies a particular join operation
 type JoinOpSpec struct {
 	TableNames map[flux.OperationID]string `json:"tableNames"`
 	On         []string                    `json:"on"`
 	Method     string                      `json:"method"`
 
+	// The following fields only apply to the asof method.
+	Tolerance  flux.Duration `json:"tolerance,omitempty"`
+	Direction  string        `json:"direction,omitempty"`
+	Unmatched  string        `json:"unmatched,omitempty"`
+	TimeColumn string        `json:"timeColumn,omitempty"`
+
 	// Note: this field below is non-exported and is not part of the public Flux.Spec
 	// interface (used by the transpiler).  It should not be assumed to be populated
 	// outside of the codepath that creates a flux.Spec from Flux text.
@@ -112,6 +143,10 @@ func createJoinOpSpec(args flux.Arguments, a *flux.Administration) (flux.Operati
 		return nil, errors.New("cross product and 'on' are mutually exclusive")
 	}
 
+	if err := readAsOfArgs(spec, args); err != nil {
+		return nil, err
+	}
+
 	tables, err := args.GetRequiredObject("tables")
 	if err != nil {
 		return nil, err
@@ -150,6 +185,56 @@ func createJoinOpSpec(args flux.Arguments, a *flux.Administration) (flux.Operati
 	return spec, nil
 }
 
+// readAsOfArgs reads the arguments that configure an as-of join.
+// They are an error for any other join method.
+func readAsOfArgs(spec *JoinOpSpec, args flux.Arguments) error {
+	if spec.Method != "asof" {
+		for _, name := range []string{"tolerance", "direction", "unmatched", "timeColumn"} {
+			if _, ok := args.Get(name); ok {
+				return fmt.Errorf("%q is only valid for the asof join method", name)
+			}
+		}
+		return nil
+	}
+
+	if tolerance, ok, err := args.GetDuration("tolerance"); err != nil {
+		return err
+	} else if ok {
+		if tolerance <= 0 {
+			return errors.New("tolerance must be a positive duration")
+		}
+		spec.Tolerance = tolerance
+	}
+
+	spec.Direction = asOfBackward
+	if direction, ok, err := args.GetString("direction"); err != nil {
+		return err
+	} else if ok {
+		if !asOfDirections[direction] {
+			return fmt.Errorf("%s is not a valid as-of join direction", direction)
+		}
+		spec.Direction = direction
+	}
+
+	spec.Unmatched = asOfUnmatchedNull
+	if unmatched, ok, err := args.GetString("unmatched"); err != nil {
+		return err
+	} else if ok {
+		if unmatched != asOfUnmatchedNull && unmatched != asOfUnmatchedDrop {
+			return fmt.Errorf("%s is not a valid value for unmatched, must be %q or %q", unmatched, asOfUnmatchedNull, asOfUnmatchedDrop)
+		}
+		spec.Unmatched = unmatched
+	}
+
+	spec.TimeColumn = execute.DefaultTimeColLabel
+	if timeColumn, ok, err := args.GetString("timeColumn"); err != nil {
+		return err
+	} else if ok {
+		spec.TimeColumn = timeColumn
+	}
+	return nil
+}
+
 func (t *JoinOpSpec) IDer(ider flux.IDer) {
 	for i, name := range t.params.names {
 		operation := t.params.operations[i]
@@ -169,6 +254,13 @@ type MergeJoinProcedureSpec struct {
 	plan.DefaultCost
 	TableNames []string `json:"table_names"`
 	On         []string `json:"keys"`
+	Method     string   `json:"method,omitempty"`
+
+	// The following fields only apply to the asof method.
+	Tolerance  flux.Duration `json:"tolerance,omitempty"`
+	Direction  string        `json:"direction,omitempty"`
+	Unmatched  string        `json:"unmatched,omitempty"`
+	TimeColumn string        `json:"timeColumn,omitempty"`
 }
 
 func newMergeJoinProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
@@ -195,6 +287,11 @@ func newMergeJoinProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.
 	return &MergeJoinProcedureSpec{
 		On:         on,
 		TableNames: tableNames,
+		Method:     spec.Method,
+		Tolerance:  spec.Tolerance,
+		Direction:  spec.Direction,
+		Unmatched:  spec.Unmatched,
+		TimeColumn: spec.TimeColumn,
 	}, nil
 }
 
@@ -207,6 +304,12 @@ func (s *MergeJoinProcedureSpec) Copy() plan.ProcedureSpec {
 	ns.On = make([]string, len(s.On))
 	copy(ns.On, s.On)
 
+	ns.Method = s.Method
+	ns.Tolerance = s.Tolerance
+	ns.Direction = s.Direction
+	ns.Unmatched = s.Unmatched
+	ns.TimeColumn = s.TimeColumn
+
 	return ns
 }
 
@@ -227,6 +330,9 @@ func createMergeJoinTransformation(id execute.DatasetID, mode execute.Accumulati
 	}
 
 	cache := NewMergeJoinCache(a.Allocator(), parents, tableNames, s.On)
+	if s.Method == "asof" {
+		cache.SetAsOf(s)
+	}
 	d := execute.NewDataset(id, mode, cache)
 	t := NewMergeJoinTransformation(d, cache, s, parents, tableNames)
 	return t, d, nil
@@ -351,17 +457,20 @@ func (t *mergeJoinTransformation) Finish(id execute.DatasetID, err error) {
 // buffers:         Buffers to hold the tables for each incoming stream.
 //
 // postJoinKeys:    The post-join group keys for all joined tables.
-//                  These group keys are constructed and stored as soon
-//                  as a table is consumed by the join operator, but prior
-//                  to actually joining the data.
+//
+//	These group keys are constructed and stored as soon
+//	as a table is consumed by the join operator, but prior
+//	to actually joining the data.
 //
 // reverseLookup:   Each output group key that is stored is mapped to its
-//                  corresponding pre-join group keys. These pre-join group
-//                  keys are then used to retrieve their corresponding
-//                  tables from the buffers.
+//
+//	corresponding pre-join group keys. These pre-join group
+//	keys are then used to retrieve their corresponding
+//	tables from the buffers.
 //
 // tables:          All output tables are materialized and stored in this
-//                  map before being sent to downstream operators.
+//
+//	map before being sent to downstream operators.
 type MergeJoinCache struct {
 	leftID  execute.DatasetID
 	rightID execute.DatasetID
@@ -384,6 +493,20 @@ type MergeJoinCache struct {
 	tables      map[flux.GroupKey]flux.Table
 	alloc       *memory.Allocator
 	triggerSpec plan.TriggerSpec
+
+	// asOf is set when the cache performs an as-of join.
+	asOf *asOfJoin
+}
+
+// asOfJoin describes how rows are matched by an as-of join.
+// Rows must be equal on the exact columns and the time of the
+// matching right row must be within tolerance of the left row.
+type asOfJoin struct {
+	exact      map[string]bool
+	timeColumn string
+	tolerance  int64
+	direction  string
+	dropEmpty  bool
 }
 
 type streamBuffer struct {
@@ -540,6 +663,44 @@ func NewMergeJoinCache(alloc *memory.Allocator, datasetIDs []execute.DatasetID,
 	}
 }
 
+// SetAsOf configures the cache to perform an as-of join.
+// Each left row is joined with the right row that is equal on the on columns
+// and whose time is closest in the direction given by spec within the tolerance.
+func (c *MergeJoinCache) SetAsOf(spec *MergeJoinProcedureSpec) {
+	timeColumn := spec.TimeColumn
+	if timeColumn == "" {
+		timeColumn = execute.DefaultTimeColLabel
+	}
+	direction := spec.Direction
+	if direction == "" {
+		direction = asOfBackward
+	}
+	exact := make(map[string]bool, len(c.on))
+	for k := range c.on {
+		if k != timeColumn {
+			exact[k] = true
+		}
+	}
+	c.asOf = &asOfJoin{
+		exact:      exact,
+		timeColumn: timeColumn,
+		tolerance:  int64(spec.Tolerance),
+		direction:  direction,
+		dropEmpty:  spec.Unmatched == asOfUnmatchedDrop,
+	}
+
+	// The time column is shared by the joined rows and takes
+	// its value from the left row, so it joins like an on column.
+	c.on[timeColumn] = true
+	order := make([]string, 0, len(c.order)+1)
+	for _, k := range c.order {
+		if k != timeColumn {
+			order = append(order, k)
+		}
+	}
+	c.order = append(order, timeColumn)
+}
+
 // Table joins the two tables associated with a single output group key and returns the resulting table
 func (c *MergeJoinCache) Table(key flux.GroupKey) (flux.Table, error) {
 	preJoinGroupKeys, ok := c.reverseLookup[key]
@@ -851,6 +1012,10 @@ func equalJoinkeys(left, right flux.GroupKey) bool {
 }
 
 func (c *MergeJoinCache) join(left, right *execute.ColListTableBuilder) (flux.Table, error) {
+	if c.asOf != nil {
+		return c.joinAsOf(left, right)
+	}
+
 	// Sort input tables
 	left.Sort(c.order, false)
 	right.Sort(c.order, false)
@@ -858,9 +1023,149 @@ func (c *MergeJoinCache) join(left, right *execute.ColListTableBuilder) (flux.Ta
 	var leftSet, rightSet subset
 	var leftKey, rightKey flux.GroupKey
 
-	leftSet, leftKey = c.advance(leftSet.Stop, left)
-	rightSet, rightKey = c.advance(rightSet.Stop, right)
+	leftSet, leftKey = c.advance(leftSet.Stop, left, c.on)
+	rightSet, rightKey = c.advance(rightSet.Stop, right, c.on)
+
+	builder, err := c.newJoinBuilder(left, right)
+	if err != nil {
+		return nil, err
+	}
+
+	// Perform sort merge join
+	for !leftSet.Empty() && !rightSet.Empty() {
+		if equalJoinkeys(leftKey, rightKey) {
+
+			for l := leftSet.Start; l < leftSet.Stop; l++ {
+				for r := rightSet.Start; r < rightSet.Stop; r++ {
+					c.appendJoinedRow(builder, left.GetRow(l), right.GetRow(r), nil)
+				}
+			}
+			leftSet, leftKey = c.advance(leftSet.Stop, left, c.on)
+			rightSet, rightKey = c.advance(rightSet.Stop, right, c.on)
+		} else if leftKey.Less(rightKey) {
+			leftSet, leftKey = c.advance(leftSet.Stop, left, c.on)
+		} else {
+			rightSet, rightKey = c.advance(rightSet.Stop, right, c.on)
+		}
+	}
+
+	return builder.Table()
+}
+
+// joinAsOf joins each row of the left table with at most one row of the right table.
+func (c *MergeJoinCache) joinAsOf(left, right *execute.ColListTableBuilder) (flux.Table, error) {
+	// Sorting on the exact columns followed by the time column
+	// orders the rows of each subset by time.
+	left.Sort(c.order, false)
+	right.Sort(c.order, false)
+
+	leftTimes, err := c.asOf.times(left)
+	if err != nil {
+		return nil, err
+	}
+	rightTimes, err := c.asOf.times(right)
+	if err != nil {
+		return nil, err
+	}
+
+	builder, err := c.newJoinBuilder(left, right)
+	if err != nil {
+		return nil, err
+	}
+
+	appendLeft := func(set subset, rightSet subset) {
+		for l := set.Start; l < set.Stop; l++ {
+			r := -1
+			if !rightSet.Empty() && leftTimes.IsValid(l) {
+				r = c.asOf.match(leftTimes.Value(l), rightTimes, rightSet)
+			}
+			if r >= 0 {
+				c.appendJoinedRow(builder, left.GetRow(l), right.GetRow(r), nil)
+			} else if !c.asOf.dropEmpty {
+				c.appendJoinedRow(builder, left.GetRow(l), nil, right.Cols())
+			}
+		}
+	}
+
+	leftSet, leftKey := c.advance(0, left, c.asOf.exact)
+	rightSet, rightKey := c.advance(0, right, c.asOf.exact)
+	for !leftSet.Empty() {
+		if !rightSet.Empty() && equalJoinkeys(leftKey, rightKey) {
+			appendLeft(leftSet, rightSet)
+			leftSet, leftKey = c.advance(leftSet.Stop, left, c.asOf.exact)
+			rightSet, rightKey = c.advance(rightSet.Stop, right, c.asOf.exact)
+		} else if rightSet.Empty() || leftKey.Less(rightKey) {
+			// There are no right rows for these left rows.
+			appendLeft(leftSet, subset{})
+			leftSet, leftKey = c.advance(leftSet.Stop, left, c.asOf.exact)
+		} else {
+			rightSet, rightKey = c.advance(rightSet.Stop, right, c.asOf.exact)
+		}
+	}
+
+	return builder.Table()
+}
+
+// times returns the time column of a table that is being joined.
+func (j *asOfJoin) times(table *execute.ColListTableBuilder) (*array.Int64, error) {
+	// TODO(jlapacik): this is a temporary hack
+	// remove when ColListTableBuilder implements ColReader
+	tbl, _ := table.Table()
+	cr := tbl.(flux.ColReader)
+	idx := execute.ColIdx(j.timeColumn, cr.Cols())
+	if idx < 0 {
+		return nil, fmt.Errorf("as-of join: table is missing time column %q", j.timeColumn)
+	}
+	if typ := cr.Cols()[idx].Type; typ != flux.TTime {
+		return nil, fmt.Errorf("as-of join: column %q has type %v, expected time", j.timeColumn, typ)
+	}
+	return cr.Times(idx), nil
+}
+
+// match returns the index of the right row that joins with a left row at time t,
+// or -1 if no right row within the set is within the tolerance.
+// The right rows of the set must be sorted by time.
+func (j *asOfJoin) match(t int64, times *array.Int64, set subset) int {
+	n := set.Stop - set.Start
+	// The last row at or before t.
+	before := set.Start + sort.Search(n, func(i int) bool {
+		return times.Value(set.Start+i) > t
+	}) - 1
+	if before < set.Start || !times.IsValid(before) || !j.within(t-times.Value(before)) {
+		before = -1
+	}
+	// The first row at or after t.
+	after := set.Start + sort.Search(n, func(i int) bool {
+		return times.Value(set.Start+i) >= t
+	})
+	if after >= set.Stop || !times.IsValid(after) || !j.within(times.Value(after)-t) {
+		after = -1
+	}
+
+	switch j.direction {
+	case asOfForward:
+		return after
+	case asOfNearest:
+		// Ties prefer the earlier row.
+		if before >= 0 && after >= 0 && times.Value(after)-t < t-times.Value(before) {
+			return after
+		} else if before >= 0 {
+			return before
+		}
+		return after
+	default:
+		return before
+	}
+}
+
+// within reports whether a distance between two times is within the tolerance.
+// A tolerance of zero does not limit the distance.
+func (j *asOfJoin) within(d int64) bool {
+	return j.tolerance <= 0 || d <= j.tolerance
+}
 
+// newJoinBuilder creates a builder for the table that results from joining left and right.
+func (c *MergeJoinCache) newJoinBuilder(left, right *execute.ColListTableBuilder) (*execute.ColListTableBuilder, error) {
 	keys := map[execute.DatasetID]flux.GroupKey{
 		c.leftID:  left.Key(),
 		c.rightID: right.Key(),
@@ -876,53 +1181,50 @@ func (c *MergeJoinCache) join(left, right *execute.ColListTableBuilder) (flux.Ta
 			return nil, err
 		}
 	}
+	return builder, nil
+}
 
-	// Perform sort merge join
-	for !leftSet.Empty() && !rightSet.Empty() {
-		if equalJoinkeys(leftKey, rightKey) {
-
-			for l := leftSet.Start; l < leftSet.Stop; l++ {
-				for r := rightSet.Start; r < rightSet.Stop; r++ {
+// appendJoinedRow appends the values of a left and a right row to builder.
+// When rightRecord is nil the columns of rightCols are appended as null values.
+func (c *MergeJoinCache) appendJoinedRow(builder *execute.ColListTableBuilder, leftRecord, rightRecord values.Object, rightCols []flux.ColMeta) {
+	leftRecord.Range(func(columnName string, columnVal values.Value) {
+		column := tableCol{
+			table: c.names[c.leftID],
+			col:   columnName,
+		}
+		newColumn := c.schemaMap[column]
+		newColumnIdx := c.colIndex[newColumn]
+		_ = builder.AppendValue(newColumnIdx, columnVal)
+	})
 
-					leftRecord := left.GetRow(l)
-					rightRecord := right.GetRow(r)
-
-					leftRecord.Range(func(columnName string, columnVal values.Value) {
-						column := tableCol{
-							table: c.names[c.leftID],
-							col:   columnName,
-						}
-						newColumn := c.schemaMap[column]
-						newColumnIdx := c.colIndex[newColumn]
-						_ = builder.AppendValue(newColumnIdx, columnVal)
-					})
-
-					rightRecord.Range(func(columnName string, columnVal values.Value) {
-						column := tableCol{
-							table: c.names[c.rightID],
-							col:   columnName,
-						}
-						newColumn := c.schemaMap[column]
-						newColumnIdx := c.colIndex[newColumn]
-
-						// No need to append value if column is part of the join key.
-						// Because value already appended when iterating over left record.
-						if !c.on[newColumn.Label] {
-							_ = builder.AppendValue(newColumnIdx, columnVal)
-						}
-					})
-				}
+	if rightRecord == nil {
+		for _, col := range rightCols {
+			column := tableCol{
+				table: c.names[c.rightID],
+				col:   col.Label,
+			}
+			newColumn := c.schemaMap[column]
+			if !c.on[newColumn.Label] {
+				_ = builder.AppendNil(c.colIndex[newColumn])
 			}
-			leftSet, leftKey = c.advance(leftSet.Stop, left)
-			rightSet, rightKey = c.advance(rightSet.Stop, right)
-		} else if leftKey.Less(rightKey) {
-			leftSet, leftKey = c.advance(leftSet.Stop, left)
-		} else {
-			rightSet, rightKey = c.advance(rightSet.Stop, right)
 		}
+		return
 	}
 
-	return builder.Table()
+	rightRecord.Range(func(columnName string, columnVal values.Value) {
+		column := tableCol{
+			table: c.names[c.rightID],
+			col:   columnName,
+		}
+		newColumn := c.schemaMap[column]
+		newColumnIdx := c.colIndex[newColumn]
+
+		// No need to append value if column is part of the join key.
+		// Because value already appended when iterating over left record.
+		if !c.on[newColumn.Label] {
+			_ = builder.AppendValue(newColumnIdx, columnVal)
+		}
+	})
 }
 
 // postJoinGroupKey produces a new group key value from a left and a right group key value
@@ -959,8 +1261,9 @@ func (c *MergeJoinCache) postJoinGroupKey(keys map[execute.DatasetID]flux.GroupK
 	return execute.NewGroupKey(key.cols, key.vals)
 }
 
-// advance advances the row pointer of a sorted table that is being joined
-func (c *MergeJoinCache) advance(offset int, table *execute.ColListTableBuilder) (subset, flux.GroupKey) {
+// advance advances the row pointer of a sorted table that is being joined.
+// The rows of the returned subset are equal on the given columns.
+func (c *MergeJoinCache) advance(offset int, table *execute.ColListTableBuilder, on map[string]bool) (subset, flux.GroupKey) {
 	// TODO(jlapacik): this is a temporary hack
 	// remove when ColListTableBuilder implements ColReader
 	tbl, _ := table.Table()
@@ -969,10 +1272,10 @@ func (c *MergeJoinCache) advance(offset int, table *execute.ColListTableBuilder)
 		return subset{Start: n, Stop: n}, nil
 	}
 	start := offset
-	key := execute.GroupKeyForRowOn(start, cr, c.on)
+	key := execute.GroupKeyForRowOn(start, cr, on)
 	sequence := subset{Start: start}
 	offset++
-	for offset < cr.Len() && equalRowKeys(start, offset, cr, c.on) {
+	for offset < cr.Len() && equalRowKeys(start, offset, cr, on) {
 		offset++
 	}
 	sequence.Stop = offset
@@ -153,6 +153,93 @@ func TestJoin_NewQuery(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "asof join",
+			Raw: `
+				a = from(bucket:"dbA") |> range(start:-1h)
+				b = from(bucket:"dbB") |> range(start:-1h)
+				join(tables:{a:a,b:b}, on:["host"], method:"asof", tolerance:1m, direction:"nearest", unmatched:"drop")`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "dbA",
+						},
+					},
+					{
+						ID: "range1",
+						Spec: &universe.RangeOpSpec{
+							Start: flux.Time{
+								Relative:   -1 * time.Hour,
+								IsRelative: true,
+							},
+							Stop: flux.Time{
+								IsRelative: true,
+							},
+							TimeColumn:  "_time",
+							StartColumn: "_start",
+							StopColumn:  "_stop",
+						},
+					},
+					{
+						ID: "from2",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "dbB",
+						},
+					},
+					{
+						ID: "range3",
+						Spec: &universe.RangeOpSpec{
+							Start: flux.Time{
+								Relative:   -1 * time.Hour,
+								IsRelative: true,
+							},
+							Stop: flux.Time{
+								IsRelative: true,
+							},
+							TimeColumn:  "_time",
+							StartColumn: "_start",
+							StopColumn:  "_stop",
+						},
+					},
+					{
+						ID: "join4",
+						Spec: &universe.JoinOpSpec{
+							On:         []string{"host"},
+							TableNames: map[flux.OperationID]string{"range1": "a", "range3": "b"},
+							Method:     "asof",
+							Tolerance:  flux.Duration(time.Minute),
+							Direction:  "nearest",
+							Unmatched:  "drop",
+							TimeColumn: "_time",
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "range1"},
+					{Parent: "from2", Child: "range3"},
+					{Parent: "range1", Child: "join4"},
+					{Parent: "range3", Child: "join4"},
+				},
+			},
+		},
+		{
+			Name: "tolerance without asof",
+			Raw: `
+				a = from(bucket:"dbA") |> range(start:-1h)
+				b = from(bucket:"dbB") |> range(start:-1h)
+				join(tables:{a:a,b:b}, on:["host"], tolerance:1m)`,
+			WantErr: true,
+		},
+		{
+			Name: "asof with invalid direction",
+			Raw: `
+				a = from(bucket:"dbA") |> range(start:-1h)
+				b = from(bucket:"dbB") |> range(start:-1h)
+				join(tables:{a:a,b:b}, on:["host"], method:"asof", direction:"sideways")`,
+			WantErr: true,
+		},
 	}
 	for _, tc := range tests {
 		tc := tc
@@ -1504,6 +1591,334 @@ func TestMergeJoin_Process(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "asof backward with misaligned times",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"host"},
+				TableNames: tableNames,
+				Method:     "asof",
+			},
+			data0: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "host", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), "a", 1.0},
+						{execute.Time(4), "b", 4.0},
+						{execute.Time(6), "a", 2.0},
+						{execute.Time(11), "a", 3.0},
+					},
+				},
+			},
+			data1: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "host", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), "a", 10.0},
+						{execute.Time(5), "a", 20.0},
+						{execute.Time(12), "a", 30.0},
+						{execute.Time(3), "c", 40.0},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 1.0, 10.0, "a"},
+						{execute.Time(6), 2.0, 20.0, "a"},
+						{execute.Time(11), 3.0, 20.0, "a"},
+						{execute.Time(4), 4.0, nil, "b"},
+					},
+				},
+			},
+		},
+		{
+			name: "asof backward with boundary tolerance",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"host"},
+				TableNames: tableNames,
+				Method:     "asof",
+				Tolerance:  flux.Duration(1),
+				Direction:  "backward",
+			},
+			data0: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "host", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), "a", 1.0},
+						{execute.Time(4), "b", 4.0},
+						{execute.Time(6), "a", 2.0},
+						{execute.Time(11), "a", 3.0},
+					},
+				},
+			},
+			data1: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "host", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), "a", 10.0},
+						{execute.Time(5), "a", 20.0},
+						{execute.Time(12), "a", 30.0},
+						{execute.Time(3), "c", 40.0},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 1.0, 10.0, "a"},
+						{execute.Time(6), 2.0, 20.0, "a"},
+						{execute.Time(11), 3.0, nil, "a"},
+						{execute.Time(4), 4.0, nil, "b"},
+					},
+				},
+			},
+		},
+		{
+			name: "asof forward",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"host"},
+				TableNames: tableNames,
+				Method:     "asof",
+				Direction:  "forward",
+			},
+			data0: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "host", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), "a", 1.0},
+						{execute.Time(4), "b", 4.0},
+						{execute.Time(6), "a", 2.0},
+						{execute.Time(11), "a", 3.0},
+					},
+				},
+			},
+			data1: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "host", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), "a", 10.0},
+						{execute.Time(5), "a", 20.0},
+						{execute.Time(12), "a", 30.0},
+						{execute.Time(3), "c", 40.0},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 1.0, 20.0, "a"},
+						{execute.Time(6), 2.0, 30.0, "a"},
+						{execute.Time(11), 3.0, 30.0, "a"},
+						{execute.Time(4), 4.0, nil, "b"},
+					},
+				},
+			},
+		},
+		{
+			name: "asof forward with boundary tolerance",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"host"},
+				TableNames: tableNames,
+				Method:     "asof",
+				Tolerance:  flux.Duration(3),
+				Direction:  "forward",
+			},
+			data0: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "host", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), "a", 1.0},
+						{execute.Time(4), "b", 4.0},
+						{execute.Time(6), "a", 2.0},
+						{execute.Time(11), "a", 3.0},
+					},
+				},
+			},
+			data1: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "host", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), "a", 10.0},
+						{execute.Time(5), "a", 20.0},
+						{execute.Time(12), "a", 30.0},
+						{execute.Time(3), "c", 40.0},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 1.0, 20.0, "a"},
+						{execute.Time(6), 2.0, nil, "a"},
+						{execute.Time(11), 3.0, 30.0, "a"},
+						{execute.Time(4), 4.0, nil, "b"},
+					},
+				},
+			},
+		},
+		{
+			name: "asof nearest prefers earlier row on ties",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"host"},
+				TableNames: tableNames,
+				Method:     "asof",
+				Direction:  "nearest",
+			},
+			data0: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "host", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(3), "a", 1.0},
+						{execute.Time(6), "a", 2.0},
+						{execute.Time(11), "a", 3.0},
+					},
+				},
+			},
+			data1: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "host", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), "a", 10.0},
+						{execute.Time(5), "a", 20.0},
+						{execute.Time(12), "a", 30.0},
+						{execute.Time(3), "c", 40.0},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(3), 1.0, 10.0, "a"},
+						{execute.Time(6), 2.0, 20.0, "a"},
+						{execute.Time(11), 3.0, 30.0, "a"},
+					},
+				},
+			},
+		},
+		{
+			name: "asof drop unmatched",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"host"},
+				TableNames: tableNames,
+				Method:     "asof",
+				Tolerance:  flux.Duration(1),
+				Unmatched:  "drop",
+			},
+			data0: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "host", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), "a", 1.0},
+						{execute.Time(4), "b", 4.0},
+						{execute.Time(6), "a", 2.0},
+						{execute.Time(11), "a", 3.0},
+					},
+				},
+			},
+			data1: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "host", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), "a", 10.0},
+						{execute.Time(5), "a", 20.0},
+						{execute.Time(12), "a", 30.0},
+						{execute.Time(3), "c", 40.0},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 1.0, 10.0, "a"},
+						{execute.Time(6), 2.0, 20.0, "a"},
+					},
+				},
+			},
+		},
 		{
 			name: "two failures",
 			spec: &universe.MergeJoinProcedureSpec{
@@ -1552,6 +1967,9 @@ func TestMergeJoin_Process(t *testing.T) {
 
 			d := executetest.NewDataset(executetest.RandomDatasetID())
 			c := universe.NewMergeJoinCache(executetest.UnlimitedAllocator, parents, tableNames, tc.spec.On)
+			if tc.spec.Method == "asof" {
+				c.SetAsOf(tc.spec)
+			}
 			c.SetTriggerSpec(plan.DefaultTriggerSpec)
 			jt := universe.NewMergeJoinTransformation(d, c, tc.spec, parents, tableNames)
 
@@ -51,6 +51,9 @@ func createLimitOpSpec(args flux.Arguments, a *flux.Administration) (flux.Operat
 	if offset, ok, err := args.GetInt("offset"); err != nil {
 		return nil, err
 	} else if ok {
+		if offset < 0 {
+			return nil, fmt.Errorf("offset must be non-negative, got %d", offset)
+		}
 		spec.Offset = offset
 	}
 
@@ -7,6 +7,7 @@ import (
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/execute/executetest"
 	"github.com/influxdata/flux/querytest"
+	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
 	"github.com/influxdata/flux/stdlib/universe"
 )
 
@@ -22,6 +23,42 @@ func TestLimitOperation_Marshaling(t *testing.T) {
 	querytest.OperationMarshalingTestHelper(t, data, op)
 }
 
+func TestLimit_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "limit with offset",
+			Raw:  `from(bucket:"mydb") |> limit(n: 10, offset: 5)`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID:   "from0",
+						Spec: &influxdb.FromOpSpec{Bucket: "mydb"},
+					},
+					{
+						ID:   "limit1",
+						Spec: &universe.LimitOpSpec{N: 10, Offset: 5},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "limit1"},
+				},
+			},
+		},
+		{
+			Name:    "limit with negative offset",
+			Raw:     `from(bucket:"mydb") |> limit(n: 10, offset: -1)`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
 func TestLimit_Process(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -161,6 +198,83 @@ func TestLimit_Process(t *testing.T) {
 				},
 			}},
 		},
+		{
+			name: "one table with offset at last row",
+			spec: &universe.LimitProcedureSpec{
+				N:      2,
+				Offset: 2,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 2.0},
+					{execute.Time(2), 1.0},
+					{execute.Time(3), 0.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(3), 0.0},
+				},
+			}},
+		},
+		{
+			name: "one table with offset at end",
+			spec: &universe.LimitProcedureSpec{
+				N:      1,
+				Offset: 3,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 2.0},
+					{execute.Time(2), 1.0},
+					{execute.Time(3), 0.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: nil,
+			}},
+		},
+		{
+			name: "one table with offset past end",
+			spec: &universe.LimitProcedureSpec{
+				N:      1,
+				Offset: 5,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 2.0},
+					{execute.Time(2), 1.0},
+					{execute.Time(3), 0.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: nil,
+			}},
+		},
 		{
 			name: "multiple tables",
 			spec: &universe.LimitProcedureSpec{
@@ -0,0 +1,277 @@
+package universe
+
+import (
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/plan"
+)
+
+// ModeKind is the registration name for Flux, query, plan, and execution.
+const ModeKind = "mode"
+
+func init() {
+	modeSignature := execute.AggregateSignature(nil, nil)
+
+	flux.RegisterPackageValue("universe", ModeKind, flux.FunctionValue(ModeKind, createModeOpSpec, modeSignature))
+	flux.RegisterOpSpec(ModeKind, newModeOp)
+	plan.RegisterProcedureSpec(ModeKind, newModeProcedure, ModeKind)
+	execute.RegisterTransformation(ModeKind, createModeTransformation)
+}
+
+func createModeOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+
+	s := new(ModeOpSpec)
+	if err := s.AggregateConfig.ReadArgs(args); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func newModeOp() flux.OperationSpec {
+	return new(ModeOpSpec)
+}
+
+// ModeOpSpec defines the required arguments for Flux.
+// Mode only takes the column to aggregate.
+type ModeOpSpec struct {
+	execute.AggregateConfig
+}
+
+// Kind is used to lookup createModeOpSpec producing ModeOpSpec
+func (s *ModeOpSpec) Kind() flux.OperationKind {
+	return ModeKind
+}
+
+func newModeProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*ModeOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+	return &ModeProcedureSpec{
+		AggregateConfig: spec.AggregateConfig,
+	}, nil
+}
+
+// ModeProcedureSpec is created when mapping from ModeOpSpec.Kind
+// to a CreateProcedureSpec.
+type ModeProcedureSpec struct {
+	execute.AggregateConfig
+}
+
+// Kind is used to lookup CreateTransformation producing ModeAgg
+func (s *ModeProcedureSpec) Kind() plan.ProcedureKind {
+	return ModeKind
+}
+func (s *ModeProcedureSpec) Copy() plan.ProcedureSpec {
+	return &ModeProcedureSpec{
+		AggregateConfig: s.AggregateConfig.Copy(),
+	}
+}
+
+// TriggerSpec implements plan.TriggerAwareProcedureSpec
+func (s *ModeProcedureSpec) TriggerSpec() plan.TriggerSpec {
+	return plan.NarrowTransformationTriggerSpec{}
+}
+
+func createModeTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*ModeProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+
+	t, d := execute.NewAggregateTransformationAndDataset(a.Context(), id, mode, new(ModeAgg), s.AggregateConfig, a.Allocator())
+	return t, d, nil
+}
+
+// ModeAgg finds the most frequent non null value of a column.
+// When several values are equally frequent, the smallest of them is the mode,
+// where false is smaller than true and strings are compared lexically.
+type ModeAgg struct{}
+
+type ModeBoolAgg struct {
+	counts map[bool]int64
+}
+type ModeIntAgg struct {
+	counts map[int64]int64
+}
+type ModeUIntAgg struct {
+	counts map[uint64]int64
+}
+type ModeFloatAgg struct {
+	counts map[float64]int64
+}
+type ModeStringAgg struct {
+	counts map[string]int64
+}
+
+func (a *ModeAgg) NewBoolAgg() execute.DoBoolAgg {
+	return &ModeBoolAgg{counts: make(map[bool]int64)}
+}
+
+func (a *ModeAgg) NewIntAgg() execute.DoIntAgg {
+	return &ModeIntAgg{counts: make(map[int64]int64)}
+}
+
+func (a *ModeAgg) NewUIntAgg() execute.DoUIntAgg {
+	return &ModeUIntAgg{counts: make(map[uint64]int64)}
+}
+
+func (a *ModeAgg) NewFloatAgg() execute.DoFloatAgg {
+	return &ModeFloatAgg{counts: make(map[float64]int64)}
+}
+
+func (a *ModeAgg) NewStringAgg() execute.DoStringAgg {
+	return &ModeStringAgg{counts: make(map[string]int64)}
+}
+
+// DoBool counts each non null value of the array.
+func (a *ModeBoolAgg) DoBool(vs *array.Boolean) {
+	for i := 0; i < vs.Len(); i++ {
+		if vs.IsValid(i) {
+			a.counts[vs.Value(i)]++
+		}
+	}
+}
+
+func (a *ModeBoolAgg) Type() flux.ColType {
+	return flux.TBool
+}
+
+func (a *ModeBoolAgg) IsNull() bool {
+	return len(a.counts) == 0
+}
+
+// ValueBool returns the most frequent value, or false if both values are equally frequent.
+func (a *ModeBoolAgg) ValueBool() bool {
+	return a.counts[true] > a.counts[false]
+}
+
+// DoInt counts each non null value of the array.
+func (a *ModeIntAgg) DoInt(vs *array.Int64) {
+	for i := 0; i < vs.Len(); i++ {
+		if vs.IsValid(i) {
+			a.counts[vs.Value(i)]++
+		}
+	}
+}
+
+func (a *ModeIntAgg) Type() flux.ColType {
+	return flux.TInt
+}
+
+func (a *ModeIntAgg) IsNull() bool {
+	return len(a.counts) == 0
+}
+
+// ValueInt returns the most frequent value, or the smallest of the most frequent values.
+func (a *ModeIntAgg) ValueInt() int64 {
+	var (
+		mode int64
+		max  int64
+	)
+	for v, n := range a.counts {
+		if n > max || n == max && v < mode {
+			mode, max = v, n
+		}
+	}
+	return mode
+}
+
+// DoUInt counts each non null value of the array.
+func (a *ModeUIntAgg) DoUInt(vs *array.Uint64) {
+	for i := 0; i < vs.Len(); i++ {
+		if vs.IsValid(i) {
+			a.counts[vs.Value(i)]++
+		}
+	}
+}
+
+func (a *ModeUIntAgg) Type() flux.ColType {
+	return flux.TUInt
+}
+
+func (a *ModeUIntAgg) IsNull() bool {
+	return len(a.counts) == 0
+}
+
+// ValueUInt returns the most frequent value, or the smallest of the most frequent values.
+func (a *ModeUIntAgg) ValueUInt() uint64 {
+	var (
+		mode uint64
+		max  int64
+	)
+	for v, n := range a.counts {
+		if n > max || n == max && v < mode {
+			mode, max = v, n
+		}
+	}
+	return mode
+}
+
+// DoFloat counts each non null value of the array.
+func (a *ModeFloatAgg) DoFloat(vs *array.Float64) {
+	for i := 0; i < vs.Len(); i++ {
+		if vs.IsValid(i) {
+			a.counts[vs.Value(i)]++
+		}
+	}
+}
+
+func (a *ModeFloatAgg) Type() flux.ColType {
+	return flux.TFloat
+}
+
+func (a *ModeFloatAgg) IsNull() bool {
+	return len(a.counts) == 0
+}
+
+// ValueFloat returns the most frequent value, or the smallest of the most frequent values.
+func (a *ModeFloatAgg) ValueFloat() float64 {
+	var (
+		mode float64
+		max  int64
+	)
+	for v, n := range a.counts {
+		if n > max || n == max && v < mode {
+			mode, max = v, n
+		}
+	}
+	return mode
+}
+
+// DoString counts each non null value of the array.
+func (a *ModeStringAgg) DoString(vs *array.Binary) {
+	for i := 0; i < vs.Len(); i++ {
+		if vs.IsValid(i) {
+			a.counts[vs.ValueString(i)]++
+		}
+	}
+}
+
+func (a *ModeStringAgg) Type() flux.ColType {
+	return flux.TString
+}
+
+func (a *ModeStringAgg) IsNull() bool {
+	return len(a.counts) == 0
+}
+
+// ValueString returns the most frequent value, or the smallest of the most frequent values.
+func (a *ModeStringAgg) ValueString() string {
+	var (
+		mode string
+		max  int64
+	)
+	for v, n := range a.counts {
+		if n > max || n == max && v < mode {
+			mode, max = v, n
+		}
+	}
+	return mode
+}
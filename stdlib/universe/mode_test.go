@@ -0,0 +1,129 @@
+package universe_test
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/arrow"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/querytest"
+	"github.com/influxdata/flux/stdlib/universe"
+)
+
+func TestModeOperation_Marshaling(t *testing.T) {
+	data := []byte(`{"id":"mode","kind":"mode","spec":{"columns":["_value"]}}`)
+	op := &flux.Operation{
+		ID: "mode",
+		Spec: &universe.ModeOpSpec{
+			AggregateConfig: execute.AggregateConfig{
+				Columns: []string{"_value"},
+			},
+		},
+	}
+
+	querytest.OperationMarshalingTestHelper(t, data, op)
+}
+
+func TestMode_Process(t *testing.T) {
+	testCases := []struct {
+		name string
+		data func() *array.Float64
+		want interface{}
+	}{
+		{
+			name: "clear mode",
+			data: func() *array.Float64 {
+				return arrow.NewFloat([]float64{3, 1, 2, 1, 3, 1, 4}, nil)
+			},
+			want: 1.0,
+		},
+		{
+			name: "tie",
+			data: func() *array.Float64 {
+				return arrow.NewFloat([]float64{5, 2, 4, 5, 2, 4, 1}, nil)
+			},
+			want: 2.0,
+		},
+		{
+			name: "with nulls",
+			data: func() *array.Float64 {
+				b := arrow.NewFloatBuilder(nil)
+				defer b.Release()
+				b.AppendValues([]float64{1, 2}, nil)
+				b.AppendNull()
+				b.AppendNull()
+				b.AppendNull()
+				b.AppendValues([]float64{2}, nil)
+				return b.NewFloat64Array()
+			},
+			want: 2.0,
+		},
+		{
+			name: "empty",
+			data: func() *array.Float64 {
+				return arrow.NewFloat(nil, nil)
+			},
+			want: nil,
+		},
+		{
+			name: "only nulls",
+			data: func() *array.Float64 {
+				b := arrow.NewFloatBuilder(nil)
+				defer b.Release()
+				b.AppendNull()
+				b.AppendNull()
+				return b.NewFloat64Array()
+			},
+			want: nil,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			executetest.AggFuncTestHelper(
+				t,
+				new(universe.ModeAgg),
+				tc.data(),
+				tc.want,
+			)
+		})
+	}
+}
+
+func TestMode_Types(t *testing.T) {
+	agg := new(universe.ModeAgg)
+
+	ia := agg.NewIntAgg()
+	ia.DoInt(arrow.NewInt([]int64{7, -3, 7, -3, 9}, nil))
+	if want, got := int64(-3), ia.(execute.IntValueFunc).ValueInt(); want != got {
+		t.Errorf("unexpected int mode: want %d, got %d", want, got)
+	}
+
+	ua := agg.NewUIntAgg()
+	ua.DoUInt(arrow.NewUint([]uint64{4, 8, 8}, nil))
+	if want, got := uint64(8), ua.(execute.UIntValueFunc).ValueUInt(); want != got {
+		t.Errorf("unexpected uint mode: want %d, got %d", want, got)
+	}
+
+	sa := agg.NewStringAgg()
+	sa.DoString(arrow.NewString([]string{"b", "c", "a", "c", "b"}, nil))
+	if want, got := "b", sa.(execute.StringValueFunc).ValueString(); want != got {
+		t.Errorf("unexpected string mode: want %q, got %q", want, got)
+	}
+
+	ba := agg.NewBoolAgg()
+	ba.DoBool(arrow.NewBool([]bool{true, false, true, false}, nil))
+	if want, got := false, ba.(execute.BoolValueFunc).ValueBool(); want != got {
+		t.Errorf("unexpected bool mode: want %v, got %v", want, got)
+	}
+	ba.DoBool(arrow.NewBool([]bool{true}, nil))
+	if want, got := true, ba.(execute.BoolValueFunc).ValueBool(); want != got {
+		t.Errorf("unexpected bool mode: want %v, got %v", want, got)
+	}
+
+	if sa := agg.NewStringAgg(); !sa.IsNull() {
+		t.Error("expected the mode of no values to be null")
+	}
+}
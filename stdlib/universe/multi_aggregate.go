@@ -0,0 +1,304 @@
+package universe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+)
+
+const MultiAggregateKind = "aggregate"
+
+// multiAggregates are the aggregates that the aggregate function can compute, by name.
+var multiAggregates = map[string]func() execute.Aggregate{
+	"count":  func() execute.Aggregate { return new(CountAgg) },
+	"max":    func() execute.Aggregate { return extremumAgg{selectMax: true} },
+	"mean":   func() execute.Aggregate { return new(MeanAgg) },
+	"min":    func() execute.Aggregate { return extremumAgg{} },
+	"skew":   func() execute.Aggregate { return new(SkewAgg) },
+	"spread": func() execute.Aggregate { return new(SpreadAgg) },
+	"stddev": func() execute.Aggregate { return &StddevAgg{Mode: modeSample} },
+	"sum":    func() execute.Aggregate { return new(SumAgg) },
+}
+
+type MultiAggregateOpSpec struct {
+	Fns    []string `json:"fns"`
+	Column string   `json:"column"`
+}
+
+func init() {
+	multiAggregateSignature := flux.FunctionSignature(
+		map[string]semantic.PolyType{
+			"fns":    semantic.NewArrayPolyType(semantic.String),
+			"column": semantic.String,
+		},
+		[]string{"fns"},
+	)
+
+	flux.RegisterPackageValue("universe", MultiAggregateKind, flux.FunctionValue(MultiAggregateKind, createMultiAggregateOpSpec, multiAggregateSignature))
+	flux.RegisterOpSpec(MultiAggregateKind, newMultiAggregateOp)
+	plan.RegisterProcedureSpec(MultiAggregateKind, newMultiAggregateProcedure, MultiAggregateKind)
+	execute.RegisterTransformation(MultiAggregateKind, createMultiAggregateTransformation)
+}
+
+func createMultiAggregateOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+
+	spec := &MultiAggregateOpSpec{
+		Column: execute.DefaultValueColLabel,
+	}
+
+	arr, err := args.GetRequiredArray("fns", semantic.String)
+	if err != nil {
+		return nil, err
+	}
+	fns, err := interpreter.ToStringArray(arr)
+	if err != nil {
+		return nil, err
+	}
+	if len(fns) == 0 {
+		return nil, errors.New("aggregate requires at least one aggregate")
+	}
+	seen := make(map[string]bool, len(fns))
+	for _, fn := range fns {
+		if _, ok := multiAggregates[fn]; !ok {
+			return nil, fmt.Errorf("unknown aggregate %q, expected one of %s", fn, strings.Join(multiAggregateNames(), ", "))
+		}
+		if seen[fn] {
+			return nil, fmt.Errorf("duplicate aggregate %q", fn)
+		}
+		seen[fn] = true
+	}
+	spec.Fns = fns
+
+	if col, ok, err := args.GetString("column"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Column = col
+	}
+	return spec, nil
+}
+
+func multiAggregateNames() []string {
+	names := make([]string, 0, len(multiAggregates))
+	for name := range multiAggregates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func newMultiAggregateOp() flux.OperationSpec {
+	return new(MultiAggregateOpSpec)
+}
+
+func (s *MultiAggregateOpSpec) Kind() flux.OperationKind {
+	return MultiAggregateKind
+}
+
+type MultiAggregateProcedureSpec struct {
+	plan.DefaultCost
+	Fns    []string
+	Column string
+}
+
+func newMultiAggregateProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*MultiAggregateOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+
+	return &MultiAggregateProcedureSpec{
+		Fns:    spec.Fns,
+		Column: spec.Column,
+	}, nil
+}
+
+func (s *MultiAggregateProcedureSpec) Kind() plan.ProcedureKind {
+	return MultiAggregateKind
+}
+func (s *MultiAggregateProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := new(MultiAggregateProcedureSpec)
+	*ns = *s
+	if s.Fns != nil {
+		ns.Fns = make([]string, len(s.Fns))
+		copy(ns.Fns, s.Fns)
+	}
+	return ns
+}
+
+// TriggerSpec implements plan.TriggerAwareProcedureSpec
+func (s *MultiAggregateProcedureSpec) TriggerSpec() plan.TriggerSpec {
+	return plan.NarrowTransformationTriggerSpec{}
+}
+
+func createMultiAggregateTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*MultiAggregateProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewMultiAggregateTransformation(a.Context(), d, cache, s)
+	return t, d, nil
+}
+
+type multiAggregateTransformation struct {
+	ctx   context.Context
+	d     execute.Dataset
+	cache execute.TableBuilderCache
+
+	fns    []string
+	column string
+}
+
+// NewMultiAggregateTransformation creates a transformation that computes
+// several aggregates of a column in a single pass over each table.
+func NewMultiAggregateTransformation(ctx context.Context, d execute.Dataset, cache execute.TableBuilderCache, spec *MultiAggregateProcedureSpec) *multiAggregateTransformation {
+	return &multiAggregateTransformation{
+		ctx:    ctx,
+		d:      d,
+		cache:  cache,
+		fns:    spec.Fns,
+		column: spec.Column,
+	}
+}
+
+func (t *multiAggregateTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return t.d.RetractTable(key)
+}
+
+func (t *multiAggregateTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	builder, created := t.cache.TableBuilder(tbl.Key())
+	if !created {
+		return fmt.Errorf("aggregate found duplicate table with key: %v", tbl.Key())
+	}
+	if err := execute.AddTableKeyCols(tbl.Key(), builder); err != nil {
+		return err
+	}
+
+	idx := execute.ColIdx(t.column, tbl.Cols())
+	if idx < 0 {
+		return fmt.Errorf("column %q does not exist", t.column)
+	}
+	if tbl.Key().HasCol(t.column) {
+		return errors.New("cannot aggregate columns that are part of the group key")
+	}
+	typ := tbl.Cols()[idx].Type
+
+	// Each aggregate is output to a column named after the aggregated column and the aggregate.
+	aggregates := make([]execute.ValueFunc, len(t.fns))
+	cols := make([]int, len(t.fns))
+	for i, fn := range t.fns {
+		vf := execute.NewAggregateValueFunc(multiAggregates[fn](), typ)
+		if vf == nil {
+			return fmt.Errorf("unsupported %s column type %v", fn, typ)
+		}
+		j, err := builder.AddCol(flux.ColMeta{
+			Label: t.column + "_" + fn,
+			Type:  vf.Type(),
+		})
+		if err != nil {
+			return err
+		}
+		aggregates[i], cols[i] = vf, j
+	}
+
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		if err := t.ctx.Err(); err != nil {
+			return err
+		}
+		for _, vf := range aggregates {
+			if err := execute.DoAggregate(vf, cr, idx); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	for i, vf := range aggregates {
+		if err := execute.AppendAggregateValue(vf, builder, cols[i]); err != nil {
+			return err
+		}
+	}
+	return execute.AppendKeyValues(tbl.Key(), builder)
+}
+
+func (t *multiAggregateTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+func (t *multiAggregateTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+func (t *multiAggregateTransformation) Finish(id execute.DatasetID, err error) {
+	t.d.Finish(err)
+}
+
+// extremumAgg finds the min or the max value of a table.
+// It keeps the min and max values like SpreadAgg and outputs only one of them.
+type extremumAgg struct {
+	selectMax bool
+}
+type extremumIntAgg struct {
+	SpreadIntAgg
+	selectMax bool
+}
+type extremumUIntAgg struct {
+	SpreadUIntAgg
+	selectMax bool
+}
+type extremumFloatAgg struct {
+	SpreadFloatAgg
+	selectMax bool
+}
+
+func (a extremumAgg) NewBoolAgg() execute.DoBoolAgg {
+	return nil
+}
+
+func (a extremumAgg) NewIntAgg() execute.DoIntAgg {
+	return &extremumIntAgg{selectMax: a.selectMax}
+}
+
+func (a extremumAgg) NewUIntAgg() execute.DoUIntAgg {
+	return &extremumUIntAgg{selectMax: a.selectMax}
+}
+
+func (a extremumAgg) NewFloatAgg() execute.DoFloatAgg {
+	return &extremumFloatAgg{selectMax: a.selectMax}
+}
+
+func (a extremumAgg) NewStringAgg() execute.DoStringAgg {
+	return nil
+}
+
+func (a *extremumIntAgg) ValueInt() int64 {
+	if a.selectMax {
+		return a.max
+	}
+	return a.min
+}
+
+func (a *extremumUIntAgg) ValueUInt() uint64 {
+	if a.selectMax {
+		return a.max
+	}
+	return a.min
+}
+
+func (a *extremumFloatAgg) ValueFloat() float64 {
+	if a.selectMax {
+		return a.max
+	}
+	return a.min
+}
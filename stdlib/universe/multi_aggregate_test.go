@@ -0,0 +1,326 @@
+package universe_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/lang"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/querytest"
+	_ "github.com/influxdata/flux/stdlib/csv"
+	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
+	"github.com/influxdata/flux/stdlib/universe"
+)
+
+func TestMultiAggregateOperation_Marshaling(t *testing.T) {
+	data := []byte(`{"id":"aggregate","kind":"aggregate","spec":{"fns":["min","max","mean"],"column":"_value"}}`)
+	op := &flux.Operation{
+		ID: "aggregate",
+		Spec: &universe.MultiAggregateOpSpec{
+			Fns:    []string{"min", "max", "mean"},
+			Column: "_value",
+		},
+	}
+	querytest.OperationMarshalingTestHelper(t, data, op)
+}
+
+func TestMultiAggregate_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "default column",
+			Raw:  `from(bucket:"testdb") |> aggregate(fns: ["min", "max", "mean"])`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "testdb",
+						},
+					},
+					{
+						ID: "aggregate1",
+						Spec: &universe.MultiAggregateOpSpec{
+							Fns:    []string{"min", "max", "mean"},
+							Column: "_value",
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "aggregate1"},
+				},
+			},
+		},
+		{
+			Name:    "no aggregates",
+			Raw:     `from(bucket:"testdb") |> aggregate(fns: [])`,
+			WantErr: true,
+		},
+		{
+			Name:    "unknown aggregate",
+			Raw:     `from(bucket:"testdb") |> aggregate(fns: ["median"])`,
+			WantErr: true,
+		},
+		{
+			Name:    "duplicate aggregate",
+			Raw:     `from(bucket:"testdb") |> aggregate(fns: ["min", "min"])`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+func TestMultiAggregate_Process(t *testing.T) {
+	testCases := []struct {
+		name    string
+		spec    *universe.MultiAggregateProcedureSpec
+		data    []flux.Table
+		want    []*executetest.Table
+		wantErr error
+	}{
+		{
+			name: "float",
+			spec: &universe.MultiAggregateProcedureSpec{
+				Fns:    []string{"min", "max", "mean"},
+				Column: "_value",
+			},
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"t1"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "t1", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 2.0, "a"},
+					{execute.Time(2), nil, "a"},
+					{execute.Time(3), -1.0, "a"},
+					{execute.Time(4), 5.0, "a"},
+				},
+			}},
+			want: []*executetest.Table{{
+				KeyCols: []string{"t1"},
+				ColMeta: []flux.ColMeta{
+					{Label: "t1", Type: flux.TString},
+					{Label: "_value_min", Type: flux.TFloat},
+					{Label: "_value_max", Type: flux.TFloat},
+					{Label: "_value_mean", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{"a", -1.0, 5.0, 2.0},
+				},
+			}},
+		},
+		{
+			name: "int",
+			spec: &universe.MultiAggregateProcedureSpec{
+				Fns:    []string{"count", "sum", "spread", "max"},
+				Column: "x",
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "x", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), int64(4)},
+					{execute.Time(2), int64(-3)},
+					{execute.Time(3), int64(7)},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "x_count", Type: flux.TInt},
+					{Label: "x_sum", Type: flux.TInt},
+					{Label: "x_spread", Type: flux.TInt},
+					{Label: "x_max", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{int64(3), int64(8), int64(10), int64(7)},
+				},
+			}},
+		},
+		{
+			name: "only nulls",
+			spec: &universe.MultiAggregateProcedureSpec{
+				Fns:    []string{"count", "min", "mean"},
+				Column: "_value",
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), nil},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_value_count", Type: flux.TInt},
+					{Label: "_value_min", Type: flux.TFloat},
+					{Label: "_value_mean", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{int64(1), nil, nil},
+				},
+			}},
+		},
+		{
+			name: "unsupported type",
+			spec: &universe.MultiAggregateProcedureSpec{
+				Fns:    []string{"count", "mean"},
+				Column: "_value",
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "a"},
+				},
+			}},
+			wantErr: errors.New("unsupported mean column type string"),
+		},
+		{
+			name: "missing column",
+			spec: &universe.MultiAggregateProcedureSpec{
+				Fns:    []string{"min"},
+				Column: "x",
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0},
+				},
+			}},
+			wantErr: errors.New(`column "x" does not exist`),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			executetest.ProcessTestHelper(
+				t,
+				tc.data,
+				tc.want,
+				tc.wantErr,
+				func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+					return universe.NewMultiAggregateTransformation(context.Background(), d, c, tc.spec)
+				},
+			)
+		})
+	}
+}
+
+// TestDownsample_MatchesAggregateWindow checks that downsample computes the same
+// values as separate calls to aggregateWindow with each of the aggregates.
+func TestDownsample_MatchesAggregateWindow(t *testing.T) {
+	script := `
+import "csv"
+
+data = "
+#datatype,string,long,dateTime:RFC3339,double,string
+#group,false,false,false,false,true
+#default,_result,,,,
+,result,table,_time,_value,host
+,,0,2018-05-22T00:00:00Z,30,a
+,,0,2018-05-22T00:00:10Z,12.5,a
+,,0,2018-05-22T00:00:20Z,41,a
+,,0,2018-05-22T00:00:30Z,-4,a
+,,0,2018-05-22T00:00:40Z,40,a
+,,0,2018-05-22T00:00:50Z,8.25,a
+,,1,2018-05-22T00:00:00Z,35,b
+,,1,2018-05-22T00:00:10Z,35,b
+,,1,2018-05-22T00:00:20Z,1,b
+,,1,2018-05-22T00:00:30Z,45,b
+,,1,2018-05-22T00:00:40Z,46.5,b
+,,1,2018-05-22T00:00:50Z,0,b
+"
+
+input = csv.from(csv: data)
+    |> range(start: 2018-05-22T00:00:00Z, stop: 2018-05-22T00:01:00Z)
+
+input |> downsample(every: 20s, fns: ["min", "max", "mean"]) |> yield(name: "downsample")
+input |> aggregateWindow(every: 20s, fn: min) |> yield(name: "min")
+input |> aggregateWindow(every: 20s, fn: max) |> yield(name: "max")
+input |> aggregateWindow(every: 20s, fn: mean) |> yield(name: "mean")
+`
+	program, err := lang.Compile(script, time.Unix(0, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := program.Start(context.Background(), &memory.Allocator{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer q.Done()
+
+	// Collect the value of each window by the host and time of the window.
+	type window struct {
+		host string
+		time execute.Time
+	}
+	results := make(map[string]map[window]interface{})
+	for res := range q.Results() {
+		if err := res.Tables().Do(func(tbl flux.Table) error {
+			t, err := executetest.ConvertTable(tbl)
+			if err != nil {
+				return err
+			}
+			hostIdx := execute.ColIdx("host", t.ColMeta)
+			timeIdx := execute.ColIdx("_time", t.ColMeta)
+			for j, c := range t.ColMeta {
+				fn := res.Name()
+				if fn == "downsample" {
+					if !strings.HasPrefix(c.Label, "_value_") {
+						continue
+					}
+					fn = strings.TrimPrefix(c.Label, "_value_")
+				} else if c.Label != "_value" {
+					continue
+				}
+				key := fn + "/" + res.Name()
+				if results[key] == nil {
+					results[key] = make(map[window]interface{})
+				}
+				for _, row := range t.Data {
+					w := window{host: row[hostIdx].(string), time: row[timeIdx].(execute.Time)}
+					results[key][w] = row[j]
+				}
+			}
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := q.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, fn := range []string{"min", "max", "mean"} {
+		want, got := results[fn+"/"+fn], results[fn+"/downsample"]
+		if len(want) != 6 {
+			t.Fatalf("unexpected number of %s windows: want 6, got %d", fn, len(want))
+		}
+		if !cmp.Equal(want, got) {
+			t.Errorf("unexpected %s values -want/+got:\n%s", fn, cmp.Diff(want, got))
+		}
+	}
+}
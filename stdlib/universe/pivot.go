@@ -17,10 +17,20 @@ const (
 	nullValueLabel = "null"
 )
 
+// The policies for resolving two input rows that map to the same
+// output row and column.
+const (
+	PivotOnConflictError = "error"
+	PivotOnConflictFirst = "first"
+	PivotOnConflictLast  = "last"
+	PivotOnConflictSum   = "sum"
+)
+
 type PivotOpSpec struct {
 	RowKey      []string `json:"rowKey"`
 	ColumnKey   []string `json:"columnKey"`
 	ValueColumn string   `json:"valueColumn"`
+	OnConflict  string   `json:"onConflict,omitempty"`
 }
 
 func init() {
@@ -29,6 +39,7 @@ func init() {
 			"rowKey":      semantic.NewArrayPolyType(semantic.String),
 			"columnKey":   semantic.NewArrayPolyType(semantic.String),
 			"valueColumn": semantic.String,
+			"onConflict":  semantic.String,
 		},
 		[]string{"rowKey", "columnKey", "valueColumn"},
 	)
@@ -84,6 +95,18 @@ func createPivotOpSpec(args flux.Arguments, a *flux.Administration) (flux.Operat
 	}
 	spec.ValueColumn = valueCol
 
+	if onConflict, ok, err := args.GetString("onConflict"); err != nil {
+		return nil, err
+	} else if ok {
+		switch onConflict {
+		case PivotOnConflictError, PivotOnConflictFirst, PivotOnConflictLast, PivotOnConflictSum:
+			spec.OnConflict = onConflict
+		default:
+			return nil, fmt.Errorf("onConflict must be one of %q, %q, %q or %q, but was %q",
+				PivotOnConflictError, PivotOnConflictFirst, PivotOnConflictLast, PivotOnConflictSum, onConflict)
+		}
+	}
+
 	return spec, nil
 }
 
@@ -100,6 +123,7 @@ type PivotProcedureSpec struct {
 	RowKey      []string
 	ColumnKey   []string
 	ValueColumn string
+	OnConflict  string
 }
 
 func newPivotProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
@@ -112,6 +136,10 @@ func newPivotProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.Proc
 		RowKey:      spec.RowKey,
 		ColumnKey:   spec.ColumnKey,
 		ValueColumn: spec.ValueColumn,
+		OnConflict:  spec.OnConflict,
+	}
+	if p.OnConflict == "" {
+		p.OnConflict = PivotOnConflictLast
 	}
 
 	return p, nil
@@ -127,6 +155,7 @@ func (s *PivotProcedureSpec) Copy() plan.ProcedureSpec {
 	ns.ColumnKey = make([]string, len(s.ColumnKey))
 	copy(ns.ColumnKey, s.ColumnKey)
 	ns.ValueColumn = s.ValueColumn
+	ns.OnConflict = s.OnConflict
 	return ns
 }
 
@@ -147,6 +176,11 @@ type rowCol struct {
 	nextRow int
 }
 
+// pivotCell is the position of a value in the output table.
+type pivotCell struct {
+	row, col int
+}
+
 type pivotTransformation struct {
 	d     execute.Dataset
 	cache execute.TableBuilderCache
@@ -155,6 +189,10 @@ type pivotTransformation struct {
 	colKeyMaps map[string]map[string]int
 	rowKeyMaps map[string]map[string]int
 	nextRowCol map[string]rowCol
+	// cells holds the values that have been set for each table.
+	// It is only used when the conflict policy needs to know
+	// whether a position has already been set.
+	cells map[string]map[pivotCell]values.Value
 }
 
 func NewPivotTransformation(d execute.Dataset, cache execute.TableBuilderCache, spec *PivotProcedureSpec) *pivotTransformation {
@@ -165,6 +203,10 @@ func NewPivotTransformation(d execute.Dataset, cache execute.TableBuilderCache,
 		colKeyMaps: make(map[string]map[string]int),
 		rowKeyMaps: make(map[string]map[string]int),
 		nextRowCol: make(map[string]rowCol),
+		cells:      make(map[string]map[pivotCell]values.Value),
+	}
+	if t.spec.OnConflict == "" {
+		t.spec.OnConflict = PivotOnConflictLast
 	}
 	return t
 }
@@ -227,6 +269,14 @@ func (t *pivotTransformation) Process(id execute.DatasetID, tbl flux.Table) erro
 		}
 	}
 
+	if t.spec.OnConflict == PivotOnConflictSum {
+		switch valueColType {
+		case flux.TInt, flux.TUInt, flux.TFloat:
+		default:
+			return fmt.Errorf("pivot cannot sum conflicting values of type %v", valueColType)
+		}
+	}
+
 	newGroupKey := execute.NewGroupKey(keyCols, keyValues)
 	builder, created := t.cache.TableBuilder(newGroupKey)
 	groupKeyString := newGroupKey.String()
@@ -241,6 +291,9 @@ func (t *pivotTransformation) Process(id execute.DatasetID, tbl flux.Table) erro
 		t.colKeyMaps[groupKeyString] = make(map[string]int)
 		t.rowKeyMaps[groupKeyString] = make(map[string]int)
 		t.nextRowCol[groupKeyString] = rowCol{nextCol: len(cols), nextRow: 0}
+		if t.spec.OnConflict != PivotOnConflictLast {
+			t.cells[groupKeyString] = make(map[pivotCell]values.Value)
+		}
 	}
 
 	return tbl.Do(func(cr flux.ColReader) error {
@@ -304,7 +357,15 @@ func (t *pivotTransformation) Process(id execute.DatasetID, tbl flux.Table) erro
 			// if we found a new row key, we added a new row with zeroes set for all the value columns
 			// so in all cases we know the row exists, and the column exists.  we need to grab the
 			// value from valueCol and assign it to its pivoted position.
-			if err := builder.SetValue(t.rowKeyMaps[groupKeyString][rowKey], t.colKeyMaps[groupKeyString][colKey], execute.ValueForRow(cr, row, valueColIndex)); err != nil {
+			cell := pivotCell{
+				row: t.rowKeyMaps[groupKeyString][rowKey],
+				col: t.colKeyMaps[groupKeyString][colKey],
+			}
+			v, err := t.resolve(t.cells[groupKeyString], cell, execute.ValueForRow(cr, row, valueColIndex), rowKey, colKey)
+			if err != nil {
+				return err
+			}
+			if err := builder.SetValue(cell.row, cell.col, v); err != nil {
 				return err
 			}
 
@@ -313,6 +374,47 @@ func (t *pivotTransformation) Process(id execute.DatasetID, tbl flux.Table) erro
 	})
 }
 
+// resolve returns the value to set at the cell according to the
+// conflict policy, given the value v from the current input row.
+func (t *pivotTransformation) resolve(cells map[pivotCell]values.Value, cell pivotCell, v values.Value, rowKey, colKey string) (values.Value, error) {
+	if t.spec.OnConflict == PivotOnConflictLast {
+		return v, nil
+	}
+	prev, ok := cells[cell]
+	if !ok {
+		cells[cell] = v
+		return v, nil
+	}
+	switch t.spec.OnConflict {
+	case PivotOnConflictError:
+		return nil, fmt.Errorf("pivot found more than one value for row key %q and column %q", rowKey, colKey)
+	case PivotOnConflictFirst:
+		return prev, nil
+	case PivotOnConflictSum:
+		if v.IsNull() {
+			return prev, nil
+		} else if prev.IsNull() {
+			cells[cell] = v
+			return v, nil
+		}
+		var sum values.Value
+		switch v.Type() {
+		case semantic.Int:
+			sum = values.NewInt(prev.Int() + v.Int())
+		case semantic.UInt:
+			sum = values.NewUInt(prev.UInt() + v.UInt())
+		case semantic.Float:
+			sum = values.NewFloat(prev.Float() + v.Float())
+		default:
+			return nil, fmt.Errorf("pivot cannot sum conflicting values of type %v", v.Type())
+		}
+		cells[cell] = sum
+		return sum, nil
+	default:
+		return nil, fmt.Errorf("unknown pivot conflict policy %q", t.spec.OnConflict)
+	}
+}
+
 func growColumn(builder execute.TableBuilder, colType flux.ColType, colIdx, nRows int) error {
 	switch colType {
 	case flux.TBool:
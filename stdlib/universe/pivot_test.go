@@ -1,6 +1,7 @@
 package universe_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -55,6 +56,37 @@ func TestPivot_NewQuery(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "pivot with onConflict",
+			Raw:  `from(bucket:"testdb") |> pivot(rowKey: ["_time"], columnKey: ["_field"], valueColumn: "_value", onConflict: "sum")`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "testdb",
+						},
+					},
+					{
+						ID: "pivot1",
+						Spec: &universe.PivotOpSpec{
+							RowKey:      []string{"_time"},
+							ColumnKey:   []string{"_field"},
+							ValueColumn: "_value",
+							OnConflict:  "sum",
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "pivot1"},
+				},
+			},
+		},
+		{
+			Name:    "invalid onConflict",
+			Raw:     `from(bucket:"testdb") |> pivot(rowKey: ["_time"], columnKey: ["_field"], valueColumn: "_value", onConflict: "max")`,
+			WantErr: true,
+		},
 		{
 			Name:    "overlapping rowKey and columnKey",
 			Raw:     `from(bucket:"testdb") |> range(start: -1h) |> pivot(rowKey: ["_time", "a"], columnKey: ["_measurement", "_field", "a"], valueColumn: "_value")`,
@@ -93,10 +125,11 @@ func TestPivotOperation_Marshaling(t *testing.T) {
 
 func TestPivot_Process(t *testing.T) {
 	testCases := []struct {
-		name string
-		spec *universe.PivotProcedureSpec
-		data []flux.Table
-		want []*executetest.Table
+		name    string
+		spec    *universe.PivotProcedureSpec
+		data    []flux.Table
+		want    []*executetest.Table
+		wantErr error
 	}{
 		{
 			name: "_field flatten case one table",
@@ -669,6 +702,248 @@ func TestPivot_Process(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "conflict error",
+			spec: &universe.PivotProcedureSpec{
+				RowKey:      []string{"_time"},
+				ColumnKey:   []string{"_field"},
+				ValueColumn: "_value",
+				OnConflict:  universe.PivotOnConflictError,
+			},
+			data: []flux.Table{
+				&executetest.Table{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "_field", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, "f1"},
+						{execute.Time(1), 2.0, "f2"},
+						{execute.Time(1), 3.0, "f1"},
+						{execute.Time(2), 4.0, "f1"},
+						{execute.Time(1), 5.0, "f1"},
+					},
+				},
+			},
+			wantErr: errors.New(`pivot found more than one value for row key "1970-01-01T00:00:00.000000001Z" and column "f1"`),
+		},
+		{
+			name: "conflict first",
+			spec: &universe.PivotProcedureSpec{
+				RowKey:      []string{"_time"},
+				ColumnKey:   []string{"_field"},
+				ValueColumn: "_value",
+				OnConflict:  universe.PivotOnConflictFirst,
+			},
+			data: []flux.Table{
+				&executetest.Table{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "_field", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, "f1"},
+						{execute.Time(1), 2.0, "f2"},
+						{execute.Time(1), 3.0, "f1"},
+						{execute.Time(2), 4.0, "f1"},
+						{execute.Time(1), 5.0, "f1"},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "f1", Type: flux.TFloat},
+						{Label: "f2", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, 2.0},
+						{execute.Time(2), 4.0, nil},
+					},
+				},
+			},
+		},
+		{
+			name: "conflict last",
+			spec: &universe.PivotProcedureSpec{
+				RowKey:      []string{"_time"},
+				ColumnKey:   []string{"_field"},
+				ValueColumn: "_value",
+				OnConflict:  universe.PivotOnConflictLast,
+			},
+			data: []flux.Table{
+				&executetest.Table{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "_field", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, "f1"},
+						{execute.Time(1), 2.0, "f2"},
+						{execute.Time(1), 3.0, "f1"},
+						{execute.Time(2), 4.0, "f1"},
+						{execute.Time(1), 5.0, "f1"},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "f1", Type: flux.TFloat},
+						{Label: "f2", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 5.0, 2.0},
+						{execute.Time(2), 4.0, nil},
+					},
+				},
+			},
+		},
+		{
+			name: "conflict sum",
+			spec: &universe.PivotProcedureSpec{
+				RowKey:      []string{"_time"},
+				ColumnKey:   []string{"_field"},
+				ValueColumn: "_value",
+				OnConflict:  universe.PivotOnConflictSum,
+			},
+			data: []flux.Table{
+				&executetest.Table{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "_field", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, "f1"},
+						{execute.Time(1), 2.0, "f2"},
+						{execute.Time(1), 3.0, "f1"},
+						{execute.Time(2), 4.0, "f1"},
+						{execute.Time(1), 5.0, "f1"},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "f1", Type: flux.TFloat},
+						{Label: "f2", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 9.0, 2.0},
+						{execute.Time(2), 4.0, nil},
+					},
+				},
+			},
+		},
+		{
+			name: "conflict sum int with null",
+			spec: &universe.PivotProcedureSpec{
+				RowKey:      []string{"_time"},
+				ColumnKey:   []string{"_field"},
+				ValueColumn: "_value",
+				OnConflict:  universe.PivotOnConflictSum,
+			},
+			data: []flux.Table{
+				&executetest.Table{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TInt},
+						{Label: "_field", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), int64(1), "f1"},
+						{execute.Time(1), int64(2), "f2"},
+						{execute.Time(1), nil, "f1"},
+						{execute.Time(2), int64(4), "f1"},
+						{execute.Time(1), int64(5), "f1"},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "f1", Type: flux.TInt},
+						{Label: "f2", Type: flux.TInt},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), int64(6), int64(2)},
+						{execute.Time(2), int64(4), nil},
+					},
+				},
+			},
+		},
+		{
+			name: "conflict first with null",
+			spec: &universe.PivotProcedureSpec{
+				RowKey:      []string{"_time"},
+				ColumnKey:   []string{"_field"},
+				ValueColumn: "_value",
+				OnConflict:  universe.PivotOnConflictFirst,
+			},
+			data: []flux.Table{
+				&executetest.Table{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TString},
+						{Label: "_field", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), nil, "f1"},
+						{execute.Time(1), "b", "f2"},
+						{execute.Time(1), "c", "f1"},
+						{execute.Time(2), "d", "f1"},
+						{execute.Time(1), "e", "f1"},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "f1", Type: flux.TString},
+						{Label: "f2", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), nil, "b"},
+						{execute.Time(2), "d", nil},
+					},
+				},
+			},
+		},
+		{
+			name: "conflict sum string",
+			spec: &universe.PivotProcedureSpec{
+				RowKey:      []string{"_time"},
+				ColumnKey:   []string{"_field"},
+				ValueColumn: "_value",
+				OnConflict:  universe.PivotOnConflictSum,
+			},
+			data: []flux.Table{
+				&executetest.Table{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TString},
+						{Label: "_field", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), "a", "f1"},
+						{execute.Time(1), "b", "f2"},
+						{execute.Time(1), "c", "f1"},
+						{execute.Time(2), "d", "f1"},
+						{execute.Time(1), "e", "f1"},
+					},
+				},
+			},
+			wantErr: errors.New("pivot cannot sum conflicting values of type string"),
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -677,7 +952,7 @@ func TestPivot_Process(t *testing.T) {
 				t,
 				tc.data,
 				tc.want,
-				nil,
+				tc.wantErr,
 				func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
 					return universe.NewPivotTransformation(d, c, tc.spec)
 				},
@@ -217,7 +217,7 @@ func createQuantileTransformation(id execute.DatasetID, mode execute.Accumulatio
 		Quantile:    ps.Quantile,
 		Compression: ps.Compression,
 	}
-	t, d := execute.NewAggregateTransformationAndDataset(id, mode, agg, ps.AggregateConfig, a.Allocator())
+	t, d := execute.NewAggregateTransformationAndDataset(a.Context(), id, mode, agg, ps.AggregateConfig, a.Allocator())
 	return t, d, nil
 }
 func (a *QuantileAgg) Copy() *QuantileAgg {
@@ -281,7 +281,7 @@ func createExactQuantileAggTransformation(id execute.DatasetID, mode execute.Acc
 	agg := &ExactQuantileAgg{
 		Quantile: ps.Quantile,
 	}
-	t, d := execute.NewAggregateTransformationAndDataset(id, mode, agg, ps.AggregateConfig, a.Allocator())
+	t, d := execute.NewAggregateTransformationAndDataset(a.Context(), id, mode, agg, ps.AggregateConfig, a.Allocator())
 	return t, d, nil
 }
 
@@ -1,6 +1,8 @@
 package universe_test
 
 import (
+	"fmt"
+	"math"
 	"testing"
 	"time"
 
@@ -756,3 +758,55 @@ func BenchmarkQuantile(b *testing.B) {
 		13.843815760607427,
 	)
 }
+
+func TestQuantileAgg_ApproximatesExact(t *testing.T) {
+	data := arrow.NewFloat(NormalData, &memory.Allocator{})
+	defer data.Release()
+
+	for _, q := range []float64{0.01, 0.25, 0.5, 0.75, 0.99} {
+		q := q
+		t.Run(fmt.Sprint(q), func(t *testing.T) {
+			exact := (&universe.ExactQuantileAgg{Quantile: q}).NewFloatAgg()
+			exact.DoFloat(data)
+			want := exact.(execute.FloatValueFunc).ValueFloat()
+
+			estimate := (&universe.QuantileAgg{Quantile: q, Compression: 1000}).NewFloatAgg()
+			estimate.DoFloat(data)
+			got := estimate.(execute.FloatValueFunc).ValueFloat()
+
+			// The data is normally distributed so allow
+			// an error relative to the standard deviation.
+			if tolerance := 0.01 * Sigma; math.Abs(want-got) > tolerance {
+				t.Errorf("estimated quantile %v differs from exact quantile %v by more than %v", got, want, tolerance)
+			}
+		})
+	}
+}
+
+// BenchmarkMedian compares the exact and estimated medians for increasing amounts of data.
+// The exact method allocates memory proportional to the number of values,
+// while the memory used by the estimate depends mostly on its compression.
+func BenchmarkMedian(b *testing.B) {
+	for _, n := range []int{1e3, 1e4, 1e5, 1e6} {
+		data := arrow.NewFloat(NormalData[:n], &memory.Allocator{})
+		for _, bc := range []struct {
+			method string
+			agg    interface {
+				NewFloatAgg() execute.DoFloatAgg
+			}
+		}{
+			{method: "exact_mean", agg: &universe.ExactQuantileAgg{Quantile: 0.5}},
+			{method: "estimate_tdigest", agg: &universe.QuantileAgg{Quantile: 0.5, Compression: 1000}},
+		} {
+			b.Run(fmt.Sprintf("%s/%d", bc.method, n), func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					vf := bc.agg.NewFloatAgg()
+					vf.DoFloat(data)
+					vf.(execute.FloatValueFunc).ValueFloat()
+				}
+			})
+		}
+		data.Release()
+	}
+}
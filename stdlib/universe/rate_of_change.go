@@ -0,0 +1,246 @@
+package universe
+
+import (
+	"fmt"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+)
+
+const RateOfChangeKind = "rateOfChange"
+
+type RateOfChangeOpSpec struct {
+	N       int64    `json:"n"`
+	Columns []string `json:"columns"`
+}
+
+func init() {
+	rateOfChangeSignature := flux.FunctionSignature(
+		map[string]semantic.PolyType{
+			"n":       semantic.Int,
+			"columns": semantic.NewArrayPolyType(semantic.String),
+		},
+		[]string{"n"},
+	)
+
+	flux.RegisterPackageValue("universe", RateOfChangeKind, flux.FunctionValue(RateOfChangeKind, createRateOfChangeOpSpec, rateOfChangeSignature))
+	flux.RegisterOpSpec(RateOfChangeKind, newRateOfChangeOp)
+	plan.RegisterProcedureSpec(RateOfChangeKind, newRateOfChangeProcedure, RateOfChangeKind)
+	execute.RegisterTransformation(RateOfChangeKind, createRateOfChangeTransformation)
+}
+
+func createRateOfChangeOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+
+	spec := new(RateOfChangeOpSpec)
+
+	n, err := args.GetRequiredInt("n")
+	if err != nil {
+		return nil, err
+	} else if n <= 0 {
+		return nil, fmt.Errorf("rateOfChange n must be greater than zero, got %d", n)
+	}
+	spec.N = n
+
+	if cols, ok, err := args.GetArray("columns", semantic.String); err != nil {
+		return nil, err
+	} else if ok {
+		columns, err := interpreter.ToStringArray(cols)
+		if err != nil {
+			return nil, err
+		}
+		spec.Columns = columns
+	} else {
+		spec.Columns = []string{execute.DefaultValueColLabel}
+	}
+
+	return spec, nil
+}
+
+func newRateOfChangeOp() flux.OperationSpec {
+	return new(RateOfChangeOpSpec)
+}
+
+func (s *RateOfChangeOpSpec) Kind() flux.OperationKind {
+	return RateOfChangeKind
+}
+
+type RateOfChangeProcedureSpec struct {
+	plan.DefaultCost
+	N       int64    `json:"n"`
+	Columns []string `json:"columns"`
+}
+
+func newRateOfChangeProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*RateOfChangeOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+
+	return &RateOfChangeProcedureSpec{
+		N:       spec.N,
+		Columns: spec.Columns,
+	}, nil
+}
+
+func (s *RateOfChangeProcedureSpec) Kind() plan.ProcedureKind {
+	return RateOfChangeKind
+}
+func (s *RateOfChangeProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := new(RateOfChangeProcedureSpec)
+	*ns = *s
+	if s.Columns != nil {
+		ns.Columns = make([]string, len(s.Columns))
+		copy(ns.Columns, s.Columns)
+	}
+	return ns
+}
+
+// TriggerSpec implements plan.TriggerAwareProcedureSpec
+func (s *RateOfChangeProcedureSpec) TriggerSpec() plan.TriggerSpec {
+	return plan.NarrowTransformationTriggerSpec{}
+}
+
+func createRateOfChangeTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*RateOfChangeProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewRateOfChangeTransformation(d, cache, s)
+	return t, d, nil
+}
+
+type rateOfChangeTransformation struct {
+	d     execute.Dataset
+	cache execute.TableBuilderCache
+
+	n       int64
+	columns []string
+}
+
+func NewRateOfChangeTransformation(d execute.Dataset, cache execute.TableBuilderCache, spec *RateOfChangeProcedureSpec) *rateOfChangeTransformation {
+	return &rateOfChangeTransformation{
+		d:       d,
+		cache:   cache,
+		n:       spec.N,
+		columns: spec.Columns,
+	}
+}
+
+func (t *rateOfChangeTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return t.d.RetractTable(key)
+}
+
+func (t *rateOfChangeTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	builder, created := t.cache.TableBuilder(tbl.Key())
+	if !created {
+		return fmt.Errorf("rateOfChange found duplicate table with key: %v", tbl.Key())
+	}
+	cols := tbl.Cols()
+	rates := make([]*rateOfChange, len(cols))
+	for j, c := range cols {
+		if execute.ContainsStr(t.columns, c.Label) {
+			switch c.Type {
+			case flux.TInt, flux.TUInt, flux.TFloat:
+			default:
+				return fmt.Errorf("rateOfChange cannot compute on column %q of type %v", c.Label, c.Type)
+			}
+			if _, err := builder.AddCol(flux.ColMeta{
+				Label: c.Label,
+				Type:  flux.TFloat,
+			}); err != nil {
+				return err
+			}
+			rates[j] = &rateOfChange{n: t.n}
+		} else if _, err := builder.AddCol(c); err != nil {
+			return err
+		}
+	}
+
+	return tbl.Do(func(cr flux.ColReader) error {
+		for j, c := range cols {
+			roc := rates[j]
+			if roc == nil {
+				if err := execute.AppendCol(j, j, cr, builder); err != nil {
+					return err
+				}
+				continue
+			}
+			for i := 0; i < cr.Len(); i++ {
+				var (
+					v     float64
+					valid bool
+				)
+				switch c.Type {
+				case flux.TInt:
+					vs := cr.Ints(j)
+					v, valid = float64(vs.Value(i)), vs.IsValid(i)
+				case flux.TUInt:
+					vs := cr.UInts(j)
+					v, valid = float64(vs.Value(i)), vs.IsValid(i)
+				case flux.TFloat:
+					vs := cr.Floats(j)
+					v, valid = vs.Value(i), vs.IsValid(i)
+				}
+				if !valid {
+					if err := builder.AppendNil(j); err != nil {
+						return err
+					}
+					continue
+				}
+				if rate, ok := roc.update(v); ok {
+					if err := builder.AppendFloat(j, rate); err != nil {
+						return err
+					}
+				} else if err := builder.AppendNil(j); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func (t *rateOfChangeTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+func (t *rateOfChangeTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+func (t *rateOfChangeTransformation) Finish(id execute.DatasetID, err error) {
+	t.d.Finish(err)
+}
+
+// rateOfChange computes the percentage change of a column over n periods.
+// The last n non-null values are kept in a ring buffer.
+type rateOfChange struct {
+	n int64
+
+	values []float64
+	next   int
+}
+
+// update adds the next non-null value and returns the rate of change at that value.
+// It returns false until n values have been seen, or if the value n periods ago is zero.
+func (roc *rateOfChange) update(v float64) (float64, bool) {
+	if int64(len(roc.values)) < roc.n {
+		roc.values = append(roc.values, v)
+		return 0, false
+	}
+
+	prev := roc.values[roc.next]
+	roc.values[roc.next] = v
+	roc.next = (roc.next + 1) % len(roc.values)
+
+	if prev == 0 {
+		return 0, false
+	}
+	return (v - prev) / prev * 100, true
+}
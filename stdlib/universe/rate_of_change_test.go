@@ -0,0 +1,297 @@
+package universe_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/querytest"
+	"github.com/influxdata/flux/stdlib/universe"
+)
+
+func TestRateOfChangeOperation_Marshaling(t *testing.T) {
+	data := []byte(`{"id":"rateOfChange","kind":"rateOfChange","spec":{"n":10,"columns":["_value"]}}`)
+	op := &flux.Operation{
+		ID: "rateOfChange",
+		Spec: &universe.RateOfChangeOpSpec{
+			N:       10,
+			Columns: []string{"_value"},
+		},
+	}
+	querytest.OperationMarshalingTestHelper(t, data, op)
+}
+
+func TestRateOfChange_PassThrough(t *testing.T) {
+	executetest.TransformationPassThroughTestHelper(t, func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+		s := universe.NewRateOfChangeTransformation(
+			d,
+			c,
+			&universe.RateOfChangeProcedureSpec{N: 10},
+		)
+		return s
+	})
+}
+
+func TestRateOfChange_Process(t *testing.T) {
+	testCases := []struct {
+		name    string
+		spec    *universe.RateOfChangeProcedureSpec
+		data    []flux.Table
+		want    []*executetest.Table
+		wantErr error
+	}{
+		{
+			name: "float",
+			spec: &universe.RateOfChangeProcedureSpec{
+				N:       2,
+				Columns: []string{"_value"},
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 10.0},
+					{execute.Time(2), 12.0},
+					{execute.Time(3), 15.0},
+					{execute.Time(4), 9.0},
+					{execute.Time(5), 18.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), nil},
+					{execute.Time(2), nil},
+					{execute.Time(3), 50.0},
+					{execute.Time(4), -25.0},
+					{execute.Time(5), 20.0},
+				},
+			}},
+		},
+		{
+			name: "int and uint",
+			spec: &universe.RateOfChangeProcedureSpec{
+				N:       2,
+				Columns: []string{"a", "b"},
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "a", Type: flux.TInt},
+					{Label: "b", Type: flux.TUInt},
+					{Label: "c", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), int64(10), uint64(4), int64(1)},
+					{execute.Time(2), int64(12), uint64(2), int64(2)},
+					{execute.Time(3), int64(15), uint64(1), int64(3)},
+					{execute.Time(4), int64(9), uint64(2), int64(4)},
+					{execute.Time(5), int64(18), uint64(4), int64(5)},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "a", Type: flux.TFloat},
+					{Label: "b", Type: flux.TFloat},
+					{Label: "c", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), nil, nil, int64(1)},
+					{execute.Time(2), nil, nil, int64(2)},
+					{execute.Time(3), 50.0, -75.0, int64(3)},
+					{execute.Time(4), -25.0, 0.0, int64(4)},
+					{execute.Time(5), 20.0, 300.0, int64(5)},
+				},
+			}},
+		},
+		{
+			name: "nulls",
+			spec: &universe.RateOfChangeProcedureSpec{
+				N:       2,
+				Columns: []string{"_value"},
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 10.0},
+					{execute.Time(2), nil},
+					{execute.Time(3), 12.0},
+					{execute.Time(4), 15.0},
+					{execute.Time(5), nil},
+					{execute.Time(6), 9.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), nil},
+					{execute.Time(2), nil},
+					{execute.Time(3), nil},
+					{execute.Time(4), 50.0},
+					{execute.Time(5), nil},
+					{execute.Time(6), -25.0},
+				},
+			}},
+		},
+		{
+			name: "zero denominator",
+			spec: &universe.RateOfChangeProcedureSpec{
+				N:       1,
+				Columns: []string{"_value"},
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 0.0},
+					{execute.Time(2), 5.0},
+					{execute.Time(3), 10.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), nil},
+					{execute.Time(2), nil},
+					{execute.Time(3), 100.0},
+				},
+			}},
+		},
+		{
+			name: "fewer rows than n",
+			spec: &universe.RateOfChangeProcedureSpec{
+				N:       10,
+				Columns: []string{"_value"},
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0},
+					{execute.Time(2), 2.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), nil},
+					{execute.Time(2), nil},
+				},
+			}},
+		},
+		{
+			name: "multiple tables",
+			spec: &universe.RateOfChangeProcedureSpec{
+				N:       1,
+				Columns: []string{"_value"},
+			},
+			data: []flux.Table{
+				&executetest.Table{
+					KeyCols: []string{"t1"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "t1", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, "a"},
+						{execute.Time(2), 2.0, "a"},
+					},
+				},
+				&executetest.Table{
+					KeyCols: []string{"t1"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "t1", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 4.0, "b"},
+						{execute.Time(2), 1.0, "b"},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"t1"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "t1", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), nil, "a"},
+						{execute.Time(2), 100.0, "a"},
+					},
+				},
+				{
+					KeyCols: []string{"t1"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "t1", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), nil, "b"},
+						{execute.Time(2), -75.0, "b"},
+					},
+				},
+			},
+		},
+		{
+			name: "string column",
+			spec: &universe.RateOfChangeProcedureSpec{
+				N:       2,
+				Columns: []string{"_value"},
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "a"},
+				},
+			}},
+			wantErr: errors.New(`rateOfChange cannot compute on column "_value" of type string`),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			executetest.ProcessTestHelper(
+				t,
+				tc.data,
+				tc.want,
+				tc.wantErr,
+				func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+					return universe.NewRateOfChangeTransformation(d, c, tc.spec)
+				},
+			)
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package universe
+
+import (
+	"fmt"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+)
+
+const RelativeStrengthIndexKind = "relativeStrengthIndex"
+
+type RelativeStrengthIndexOpSpec struct {
+	N       int64    `json:"n"`
+	Columns []string `json:"columns"`
+}
+
+func init() {
+	relativeStrengthIndexSignature := flux.FunctionSignature(
+		map[string]semantic.PolyType{
+			"n":       semantic.Int,
+			"columns": semantic.NewArrayPolyType(semantic.String),
+		},
+		[]string{"n"},
+	)
+
+	flux.RegisterPackageValue("universe", RelativeStrengthIndexKind, flux.FunctionValue(RelativeStrengthIndexKind, createRelativeStrengthIndexOpSpec, relativeStrengthIndexSignature))
+	flux.RegisterOpSpec(RelativeStrengthIndexKind, newRelativeStrengthIndexOp)
+	plan.RegisterProcedureSpec(RelativeStrengthIndexKind, newRelativeStrengthIndexProcedure, RelativeStrengthIndexKind)
+	execute.RegisterTransformation(RelativeStrengthIndexKind, createRelativeStrengthIndexTransformation)
+}
+
+func createRelativeStrengthIndexOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+
+	spec := new(RelativeStrengthIndexOpSpec)
+
+	n, err := args.GetRequiredInt("n")
+	if err != nil {
+		return nil, err
+	} else if n <= 0 {
+		return nil, fmt.Errorf("relativeStrengthIndex n must be greater than zero, got %d", n)
+	}
+	spec.N = n
+
+	if cols, ok, err := args.GetArray("columns", semantic.String); err != nil {
+		return nil, err
+	} else if ok {
+		columns, err := interpreter.ToStringArray(cols)
+		if err != nil {
+			return nil, err
+		}
+		spec.Columns = columns
+	} else {
+		spec.Columns = []string{execute.DefaultValueColLabel}
+	}
+
+	return spec, nil
+}
+
+func newRelativeStrengthIndexOp() flux.OperationSpec {
+	return new(RelativeStrengthIndexOpSpec)
+}
+
+func (s *RelativeStrengthIndexOpSpec) Kind() flux.OperationKind {
+	return RelativeStrengthIndexKind
+}
+
+type RelativeStrengthIndexProcedureSpec struct {
+	plan.DefaultCost
+	N       int64    `json:"n"`
+	Columns []string `json:"columns"`
+}
+
+func newRelativeStrengthIndexProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*RelativeStrengthIndexOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+
+	return &RelativeStrengthIndexProcedureSpec{
+		N:       spec.N,
+		Columns: spec.Columns,
+	}, nil
+}
+
+func (s *RelativeStrengthIndexProcedureSpec) Kind() plan.ProcedureKind {
+	return RelativeStrengthIndexKind
+}
+func (s *RelativeStrengthIndexProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := new(RelativeStrengthIndexProcedureSpec)
+	*ns = *s
+	if s.Columns != nil {
+		ns.Columns = make([]string, len(s.Columns))
+		copy(ns.Columns, s.Columns)
+	}
+	return ns
+}
+
+// TriggerSpec implements plan.TriggerAwareProcedureSpec
+func (s *RelativeStrengthIndexProcedureSpec) TriggerSpec() plan.TriggerSpec {
+	return plan.NarrowTransformationTriggerSpec{}
+}
+
+func createRelativeStrengthIndexTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*RelativeStrengthIndexProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewRelativeStrengthIndexTransformation(d, cache, s)
+	return t, d, nil
+}
+
+type relativeStrengthIndexTransformation struct {
+	d     execute.Dataset
+	cache execute.TableBuilderCache
+
+	n       int64
+	columns []string
+}
+
+func NewRelativeStrengthIndexTransformation(d execute.Dataset, cache execute.TableBuilderCache, spec *RelativeStrengthIndexProcedureSpec) *relativeStrengthIndexTransformation {
+	return &relativeStrengthIndexTransformation{
+		d:       d,
+		cache:   cache,
+		n:       spec.N,
+		columns: spec.Columns,
+	}
+}
+
+func (t *relativeStrengthIndexTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return t.d.RetractTable(key)
+}
+
+func (t *relativeStrengthIndexTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	builder, created := t.cache.TableBuilder(tbl.Key())
+	if !created {
+		return fmt.Errorf("relativeStrengthIndex found duplicate table with key: %v", tbl.Key())
+	}
+	cols := tbl.Cols()
+	indexes := make([]*relativeStrengthIndex, len(cols))
+	for j, c := range cols {
+		if execute.ContainsStr(t.columns, c.Label) {
+			switch c.Type {
+			case flux.TInt, flux.TUInt, flux.TFloat:
+			default:
+				return fmt.Errorf("relativeStrengthIndex cannot compute on column %q of type %v", c.Label, c.Type)
+			}
+			if _, err := builder.AddCol(flux.ColMeta{
+				Label: c.Label,
+				Type:  flux.TFloat,
+			}); err != nil {
+				return err
+			}
+			indexes[j] = &relativeStrengthIndex{n: t.n}
+		} else if _, err := builder.AddCol(c); err != nil {
+			return err
+		}
+	}
+
+	return tbl.Do(func(cr flux.ColReader) error {
+		for j, c := range cols {
+			rsi := indexes[j]
+			if rsi == nil {
+				if err := execute.AppendCol(j, j, cr, builder); err != nil {
+					return err
+				}
+				continue
+			}
+			for i := 0; i < cr.Len(); i++ {
+				var (
+					v     float64
+					valid bool
+				)
+				switch c.Type {
+				case flux.TInt:
+					vs := cr.Ints(j)
+					v, valid = float64(vs.Value(i)), vs.IsValid(i)
+				case flux.TUInt:
+					vs := cr.UInts(j)
+					v, valid = float64(vs.Value(i)), vs.IsValid(i)
+				case flux.TFloat:
+					vs := cr.Floats(j)
+					v, valid = vs.Value(i), vs.IsValid(i)
+				}
+				if !valid {
+					if err := builder.AppendNil(j); err != nil {
+						return err
+					}
+					continue
+				}
+				if index, ok := rsi.update(v); ok {
+					if err := builder.AppendFloat(j, index); err != nil {
+						return err
+					}
+				} else if err := builder.AppendNil(j); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func (t *relativeStrengthIndexTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+func (t *relativeStrengthIndexTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+func (t *relativeStrengthIndexTransformation) Finish(id execute.DatasetID, err error) {
+	t.d.Finish(err)
+}
+
+// relativeStrengthIndex computes Wilder's relative strength index of a column.
+// The first average gain and loss are the means of the first n changes,
+// and each later average is smoothed as (previous * (n - 1) + current) / n.
+type relativeStrengthIndex struct {
+	n int64
+
+	prev    float64
+	started bool
+	changes int64
+
+	avgGain float64
+	avgLoss float64
+}
+
+// update adds the next non-null value and returns the index at that value.
+// It returns false until n changes have been seen.
+func (rsi *relativeStrengthIndex) update(v float64) (float64, bool) {
+	if !rsi.started {
+		rsi.prev, rsi.started = v, true
+		return 0, false
+	}
+
+	var gain, loss float64
+	if change := v - rsi.prev; change > 0 {
+		gain = change
+	} else {
+		loss = -change
+	}
+	rsi.prev = v
+	rsi.changes++
+
+	n := float64(rsi.n)
+	switch {
+	case rsi.changes < rsi.n:
+		rsi.avgGain += gain
+		rsi.avgLoss += loss
+		return 0, false
+	case rsi.changes == rsi.n:
+		rsi.avgGain = (rsi.avgGain + gain) / n
+		rsi.avgLoss = (rsi.avgLoss + loss) / n
+	default:
+		rsi.avgGain = (rsi.avgGain*(n-1) + gain) / n
+		rsi.avgLoss = (rsi.avgLoss*(n-1) + loss) / n
+	}
+
+	// Without any losses the relative strength is unbounded.
+	if rsi.avgLoss == 0 {
+		return 100, true
+	}
+	return 100 - 100/(1+rsi.avgGain/rsi.avgLoss), true
+}
@@ -0,0 +1,364 @@
+package universe_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/querytest"
+	"github.com/influxdata/flux/stdlib/universe"
+)
+
+func TestRelativeStrengthIndexOperation_Marshaling(t *testing.T) {
+	data := []byte(`{"id":"relativeStrengthIndex","kind":"relativeStrengthIndex","spec":{"n":14,"columns":["_value"]}}`)
+	op := &flux.Operation{
+		ID: "relativeStrengthIndex",
+		Spec: &universe.RelativeStrengthIndexOpSpec{
+			N:       14,
+			Columns: []string{"_value"},
+		},
+	}
+	querytest.OperationMarshalingTestHelper(t, data, op)
+}
+
+func TestRelativeStrengthIndex_PassThrough(t *testing.T) {
+	executetest.TransformationPassThroughTestHelper(t, func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+		s := universe.NewRelativeStrengthIndexTransformation(
+			d,
+			c,
+			&universe.RelativeStrengthIndexProcedureSpec{N: 14},
+		)
+		return s
+	})
+}
+
+func TestRelativeStrengthIndex_Process(t *testing.T) {
+	testCases := []struct {
+		name    string
+		spec    *universe.RelativeStrengthIndexProcedureSpec
+		data    []flux.Table
+		want    []*executetest.Table
+		wantErr error
+	}{
+		{
+			name: "float",
+			spec: &universe.RelativeStrengthIndexProcedureSpec{
+				N:       2,
+				Columns: []string{"_value"},
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0},
+					{execute.Time(2), 2.0},
+					{execute.Time(3), 4.0},
+					{execute.Time(4), 3.0},
+					{execute.Time(5), 5.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), nil},
+					{execute.Time(2), nil},
+					{execute.Time(3), 100.0},
+					{execute.Time(4), 60.0},
+					{execute.Time(5), 84.61538461538461},
+				},
+			}},
+		},
+		{
+			name: "int and uint",
+			spec: &universe.RelativeStrengthIndexProcedureSpec{
+				N:       2,
+				Columns: []string{"a", "b"},
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "a", Type: flux.TInt},
+					{Label: "b", Type: flux.TUInt},
+					{Label: "c", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), int64(1), uint64(5), int64(1)},
+					{execute.Time(2), int64(2), uint64(4), int64(2)},
+					{execute.Time(3), int64(4), uint64(3), int64(3)},
+					{execute.Time(4), int64(3), uint64(4), int64(4)},
+					{execute.Time(5), int64(5), uint64(3), int64(5)},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "a", Type: flux.TFloat},
+					{Label: "b", Type: flux.TFloat},
+					{Label: "c", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), nil, nil, int64(1)},
+					{execute.Time(2), nil, nil, int64(2)},
+					{execute.Time(3), 100.0, 0.0, int64(3)},
+					{execute.Time(4), 60.0, 50.0, int64(4)},
+					{execute.Time(5), 84.61538461538461, 25.0, int64(5)},
+				},
+			}},
+		},
+		{
+			name: "nulls",
+			spec: &universe.RelativeStrengthIndexProcedureSpec{
+				N:       2,
+				Columns: []string{"_value"},
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0},
+					{execute.Time(2), nil},
+					{execute.Time(3), 2.0},
+					{execute.Time(4), 4.0},
+					{execute.Time(5), nil},
+					{execute.Time(6), 3.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), nil},
+					{execute.Time(2), nil},
+					{execute.Time(3), nil},
+					{execute.Time(4), 100.0},
+					{execute.Time(5), nil},
+					{execute.Time(6), 60.0},
+				},
+			}},
+		},
+		{
+			name: "no changes",
+			spec: &universe.RelativeStrengthIndexProcedureSpec{
+				N:       2,
+				Columns: []string{"_value"},
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0},
+					{execute.Time(2), 1.0},
+					{execute.Time(3), 1.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), nil},
+					{execute.Time(2), nil},
+					{execute.Time(3), 100.0},
+				},
+			}},
+		},
+		{
+			name: "fewer rows than n",
+			spec: &universe.RelativeStrengthIndexProcedureSpec{
+				N:       14,
+				Columns: []string{"_value"},
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0},
+					{execute.Time(2), 2.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), nil},
+					{execute.Time(2), nil},
+				},
+			}},
+		},
+		{
+			name: "multiple tables",
+			spec: &universe.RelativeStrengthIndexProcedureSpec{
+				N:       1,
+				Columns: []string{"_value"},
+			},
+			data: []flux.Table{
+				&executetest.Table{
+					KeyCols: []string{"t1"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "t1", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, "a"},
+						{execute.Time(2), 2.0, "a"},
+					},
+				},
+				&executetest.Table{
+					KeyCols: []string{"t1"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "t1", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 3.0, "b"},
+						{execute.Time(2), 1.0, "b"},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"t1"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "t1", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), nil, "a"},
+						{execute.Time(2), 100.0, "a"},
+					},
+				},
+				{
+					KeyCols: []string{"t1"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "t1", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), nil, "b"},
+						{execute.Time(2), 0.0, "b"},
+					},
+				},
+			},
+		},
+		{
+			name: "string column",
+			spec: &universe.RelativeStrengthIndexProcedureSpec{
+				N:       2,
+				Columns: []string{"_value"},
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "a"},
+				},
+			}},
+			wantErr: errors.New(`relativeStrengthIndex cannot compute on column "_value" of type string`),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			executetest.ProcessTestHelper(
+				t,
+				tc.data,
+				tc.want,
+				tc.wantErr,
+				func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+					return universe.NewRelativeStrengthIndexTransformation(d, c, tc.spec)
+				},
+			)
+		})
+	}
+}
+
+// TestRelativeStrengthIndex_Reference compares the index to a published 14 period series,
+// whose values are rounded to two decimal places.
+func TestRelativeStrengthIndex_Reference(t *testing.T) {
+	closes := []float64{
+		44.34, 44.09, 44.15, 43.61, 44.33, 44.83, 45.10, 45.42, 45.84, 46.08, 45.89,
+		46.03, 45.61, 46.28, 46.28, 46.00, 46.03, 46.41, 46.22, 45.64, 46.21, 46.25,
+		45.71, 46.45, 45.78, 45.35, 44.03, 44.18, 44.22, 44.57, 43.42, 42.66, 43.13,
+	}
+	want := []float64{
+		70.53, 66.32, 66.55, 69.41, 66.36, 57.97, 62.93, 63.26, 56.06, 62.38,
+		54.71, 50.42, 39.99, 41.46, 41.87, 45.46, 37.30, 33.08, 37.77,
+	}
+	const n = 14
+	// The published series rounds the first averages before smoothing them.
+	const tolerance = 0.1
+
+	data := &executetest.Table{
+		ColMeta: []flux.ColMeta{
+			{Label: "_time", Type: flux.TTime},
+			{Label: "_value", Type: flux.TFloat},
+		},
+	}
+	for i, v := range closes {
+		data.Data = append(data.Data, []interface{}{execute.Time(i), v})
+	}
+
+	d := executetest.NewDataset(executetest.RandomDatasetID())
+	c := execute.NewTableBuilderCache(executetest.UnlimitedAllocator)
+	c.SetTriggerSpec(plan.DefaultTriggerSpec)
+	tx := universe.NewRelativeStrengthIndexTransformation(d, c, &universe.RelativeStrengthIndexProcedureSpec{
+		N:       n,
+		Columns: []string{"_value"},
+	})
+	if err := tx.Process(executetest.RandomDatasetID(), data); err != nil {
+		t.Fatal(err)
+	}
+	got, err := executetest.TablesFromCache(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("unexpected number of tables: want 1, got %d", len(got))
+	}
+	rows := got[0].Data
+	if len(rows) != len(closes) {
+		t.Fatalf("unexpected number of rows: want %d, got %d", len(closes), len(rows))
+	}
+	for i, row := range rows {
+		if i < n {
+			if row[1] != nil {
+				t.Errorf("row %d: want null during warm-up, got %v", i, row[1])
+			}
+			continue
+		}
+		v, ok := row[1].(float64)
+		if !ok {
+			t.Errorf("row %d: want a float, got %v", i, row[1])
+			continue
+		}
+		if w := want[i-n]; math.Abs(v-w) > tolerance {
+			t.Errorf("row %d: want %v within %v, got %v", i, w, tolerance, v)
+		}
+	}
+}
@@ -114,6 +114,11 @@ type SampleSelector struct {
 	N   int
 	Pos int
 
+	// Rand is the source of random values used to choose
+	// the position when Pos is negative. If it is nil,
+	// the default source from the math/rand package is used.
+	Rand *rand.Rand
+
 	offset   int
 	selected []int
 }
@@ -125,8 +130,9 @@ func createSampleTransformation(id execute.DatasetID, mode execute.AccumulationM
 	}
 
 	ss := &SampleSelector{
-		N:   int(ps.N),
-		Pos: int(ps.Pos),
+		N:    int(ps.N),
+		Pos:  int(ps.Pos),
+		Rand: a.Dependencies().Rand(id),
 	}
 	t, d := execute.NewIndexSelectorTransformationAndDataset(id, mode, ss, ps.SelectorConfig, a.Allocator())
 	return t, d, nil
@@ -135,7 +141,11 @@ func createSampleTransformation(id execute.DatasetID, mode execute.AccumulationM
 func (s *SampleSelector) reset() {
 	pos := s.Pos
 	if pos < 0 {
-		pos = rand.Intn(s.N)
+		if s.Rand != nil {
+			pos = s.Rand.Intn(s.N)
+		} else {
+			pos = rand.Intn(s.N)
+		}
 	}
 	s.offset = pos
 }
@@ -33,8 +33,9 @@ type KeepOpSpec struct {
 }
 
 type DuplicateOpSpec struct {
-	Column string `json:"columns"`
-	As     string `json:"as"`
+	Column    string `json:"columns"`
+	As        string `json:"as"`
+	Overwrite bool   `json:"overwrite,omitempty"`
 }
 
 // The base kind for SchemaMutations
@@ -93,6 +94,7 @@ var Registrars = []MutationRegistrar{
 			"fn": semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
 				Parameters: map[string]semantic.PolyType{
 					"column": semantic.String,
+					"type":   semantic.String,
 				},
 				Required: semantic.LabelSet{"column"},
 				Return:   semantic.Bool,
@@ -108,6 +110,7 @@ var Registrars = []MutationRegistrar{
 			"fn": semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
 				Parameters: map[string]semantic.PolyType{
 					"column": semantic.String,
+					"type":   semantic.String,
 				},
 				Required: semantic.LabelSet{"column"},
 				Return:   semantic.Bool,
@@ -119,8 +122,9 @@ var Registrars = []MutationRegistrar{
 	{
 		Kind: DuplicateKind,
 		Args: map[string]semantic.PolyType{
-			"column": semantic.String,
-			"as":     semantic.String,
+			"column":    semantic.String,
+			"as":        semantic.String,
+			"overwrite": semantic.Bool,
 		},
 		Create: createDuplicateOpSpec,
 		New:    newDuplicateOp,
@@ -302,9 +306,15 @@ func createDuplicateOpSpec(args flux.Arguments, a *flux.Administration) (flux.Op
 		return nil, err
 	}
 
+	overwrite, _, err := args.GetBool("overwrite")
+	if err != nil {
+		return nil, err
+	}
+
 	return &DuplicateOpSpec{
-		Column: col,
-		As:     newName,
+		Column:    col,
+		As:        newName,
+		Overwrite: overwrite,
 	}, nil
 }
 
@@ -374,8 +384,9 @@ func (s *KeepOpSpec) Copy() SchemaMutation {
 
 func (s *DuplicateOpSpec) Copy() SchemaMutation {
 	return &DuplicateOpSpec{
-		Column: s.Column,
-		As:     s.As,
+		Column:    s.Column,
+		As:        s.As,
+		Overwrite: s.Overwrite,
 	}
 }
 
@@ -141,6 +141,38 @@ func TestSchemaMutions_NewQueries(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "test duplicate query with overwrite",
+			Raw:  `from(bucket:"mybucket") |> duplicate(column: "col1", as: "col2", overwrite: true) |> sum()`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "mybucket",
+						},
+					},
+					{
+						ID: "duplicate1",
+						Spec: &universe.DuplicateOpSpec{
+							Column:    "col1",
+							As:        "col2",
+							Overwrite: true,
+						},
+					},
+					{
+						ID: "sum2",
+						Spec: &universe.SumOpSpec{
+							AggregateConfig: execute.DefaultAggregateConfig,
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "duplicate1"},
+					{Parent: "duplicate1", Child: "sum2"},
+				},
+			},
+		},
 		{
 			Name: "test drop query fn param",
 			Raw:  `from(bucket:"mybucket") |> drop(fn: (column) => column =~ /reg*/) |> sum()`,
@@ -579,6 +611,132 @@ func TestDropRenameKeep_Process(t *testing.T) {
 				},
 			}},
 		},
+		{
+			name: "drop predicate (column, type) => type == \"string\"",
+			spec: &universe.SchemaMutationProcedureSpec{
+				Mutations: []universe.SchemaMutation{
+					&universe.DropOpSpec{
+						Predicate: &semantic.FunctionExpression{
+							Block: &semantic.FunctionBlock{
+								Parameters: &semantic.FunctionParameters{
+									List: []*semantic.FunctionParameter{{Key: &semantic.Identifier{Name: "column"}}, {Key: &semantic.Identifier{Name: "type"}}},
+								},
+								Body: &semantic.BinaryExpression{
+									Operator: ast.EqualOperator,
+									Left: &semantic.IdentifierExpression{
+										Name: "type",
+									},
+									Right: &semantic.StringLiteral{
+										Value: "string",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			data: []flux.Table{
+				&executetest.Table{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "host", Type: flux.TString},
+						{Label: "msg", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), "a", "x", 1.0},
+						{execute.Time(2), "a", "y", 2.0},
+					},
+				},
+				&executetest.Table{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "host", Type: flux.TString},
+						{Label: "msg", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(3), "b", "z", 3.0},
+					},
+				},
+			},
+			want: []*executetest.Table{{
+				KeyCols: []string(nil),
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0},
+					{execute.Time(2), 2.0},
+					{execute.Time(3), 3.0},
+				},
+			}},
+		},
+		{
+			name: "keep predicate (column, type) => type == \"float\" or column == \"host\"",
+			spec: &universe.SchemaMutationProcedureSpec{
+				Mutations: []universe.SchemaMutation{
+					&universe.KeepOpSpec{
+						Predicate: &semantic.FunctionExpression{
+							Block: &semantic.FunctionBlock{
+								Parameters: &semantic.FunctionParameters{
+									List: []*semantic.FunctionParameter{{Key: &semantic.Identifier{Name: "type"}}, {Key: &semantic.Identifier{Name: "column"}}},
+								},
+								Body: &semantic.LogicalExpression{
+									Operator: ast.OrOperator,
+									Left: &semantic.BinaryExpression{
+										Operator: ast.EqualOperator,
+										Left: &semantic.IdentifierExpression{
+											Name: "type",
+										},
+										Right: &semantic.StringLiteral{
+											Value: "float",
+										},
+									},
+									Right: &semantic.BinaryExpression{
+										Operator: ast.EqualOperator,
+										Left: &semantic.IdentifierExpression{
+											Name: "column",
+										},
+										Right: &semantic.StringLiteral{
+											Value: "host",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"host", "region"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "host", Type: flux.TString},
+					{Label: "region", Type: flux.TString},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "count", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "a", "west", 1.0, int64(1)},
+					{execute.Time(2), "a", "west", 2.0, int64(2)},
+				},
+			}},
+			want: []*executetest.Table{{
+				KeyCols: []string{"host"},
+				ColMeta: []flux.ColMeta{
+					{Label: "host", Type: flux.TString},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{"a", 1.0},
+					{"a", 2.0},
+				},
+			}},
+		},
 		{
 			name: "drop and rename",
 			spec: &universe.SchemaMutationProcedureSpec{
@@ -725,6 +883,61 @@ func TestDropRenameKeep_Process(t *testing.T) {
 			want:    []*executetest.Table(nil),
 			wantErr: errors.New(`duplicate error: column "no_exist" doesn't exist`),
 		},
+		{
+			name: "duplicate already exists",
+			spec: &universe.SchemaMutationProcedureSpec{
+				Mutations: []universe.SchemaMutation{
+					&universe.DuplicateOpSpec{
+						Column: "_value",
+						As:     "host",
+					},
+				},
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "host", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{1.0, "a"},
+					{2.0, "b"},
+				},
+			}},
+			want:    []*executetest.Table(nil),
+			wantErr: errors.New(`duplicate error: column "host" already exists`),
+		},
+		{
+			name: "duplicate overwrite",
+			spec: &universe.SchemaMutationProcedureSpec{
+				Mutations: []universe.SchemaMutation{
+					&universe.DuplicateOpSpec{
+						Column:    "_value",
+						As:        "host",
+						Overwrite: true,
+					},
+				},
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "host", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{1.0, "a"},
+					{2.0, "b"},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "host", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{1.0, 1.0},
+					{2.0, 2.0},
+				},
+			}},
+		},
 		{
 			name: "rename group key",
 			spec: &universe.SchemaMutationProcedureSpec{
@@ -1236,6 +1449,29 @@ func TestDropRenameKeep_Process(t *testing.T) {
 	}
 }
 
+func TestDropKeepMutator_InvalidPredicate(t *testing.T) {
+	spec := &universe.DropOpSpec{
+		Predicate: &semantic.FunctionExpression{
+			Block: &semantic.FunctionBlock{
+				Parameters: &semantic.FunctionParameters{
+					List: []*semantic.FunctionParameter{
+						{Key: &semantic.Identifier{Name: "column"}},
+						{Key: &semantic.Identifier{Name: "kind"}},
+					},
+				},
+				Body: &semantic.BooleanLiteral{Value: true},
+			},
+		},
+	}
+	_, err := universe.NewDropKeepMutator(spec)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if got, want := err.Error(), `function parameters must be "column" and "type", got "kind"`; got != want {
+		t.Errorf("unexpected error: want %q got %q", want, got)
+	}
+}
+
 // TODO: determine SchemaMutationProcedureSpec pushdown/rewrite rules
 /*
 func TestRenameDrop_PushDown(t *testing.T) {
@@ -163,9 +163,43 @@ type DropKeepMutator struct {
 	Predicate     compiler.Func
 	FlipPredicate bool
 	ParamName     string
+	// TypeParamName is the name of the parameter of the predicate
+	// that receives the type of the column, if the predicate has one.
+	TypeParamName string
 	Input         values.Object
 }
 
+const (
+	predicateColumnParam = "column"
+	predicateTypeParam   = "type"
+)
+
+// compileColumnPredicate compiles a predicate over the name of a column.
+// A predicate with two parameters must name them column and type,
+// and also receives the type of the column.
+func compileColumnPredicate(fn *semantic.FunctionExpression) (compiler.Func, string, string, error) {
+	if fn.Block.Parameters == nil || len(fn.Block.Parameters.List) != 2 {
+		compiled, param, err := compiler.CompileFnParam(fn, semantic.String, semantic.Bool)
+		return compiled, param, "", err
+	}
+	for _, p := range fn.Block.Parameters.List {
+		if name := p.Key.Name; name != predicateColumnParam && name != predicateTypeParam {
+			return nil, "", "", fmt.Errorf("function parameters must be %q and %q, got %q", predicateColumnParam, predicateTypeParam, name)
+		}
+	}
+	compiled, err := compiler.NewCompilationCache(fn, flux.BuiltIns()).Compile(semantic.NewObjectType(map[string]semantic.Type{
+		predicateColumnParam: semantic.String,
+		predicateTypeParam:   semantic.String,
+	}))
+	if err != nil {
+		return nil, "", "", err
+	}
+	if compiled.Type() != semantic.Bool {
+		return nil, "", "", fmt.Errorf("provided function does not evaluate to type %s", semantic.Bool.Nature())
+	}
+	return compiled, predicateColumnParam, predicateTypeParam, nil
+}
+
 func NewDropKeepMutator(qs flux.OperationSpec) (*DropKeepMutator, error) {
 	m := &DropKeepMutator{}
 
@@ -175,12 +209,13 @@ func NewDropKeepMutator(qs flux.OperationSpec) (*DropKeepMutator, error) {
 			m.DropCols = toStringSet(s.Columns)
 		}
 		if s.Predicate != nil {
-			compiledFn, param, err := compiler.CompileFnParam(s.Predicate, semantic.String, semantic.Bool)
+			compiledFn, param, typeParam, err := compileColumnPredicate(s.Predicate)
 			if err != nil {
 				return nil, err
 			}
 			m.Predicate = compiledFn
 			m.ParamName = param
+			m.TypeParamName = typeParam
 			m.Input = values.NewObject()
 		}
 	case *KeepOpSpec:
@@ -188,7 +223,7 @@ func NewDropKeepMutator(qs flux.OperationSpec) (*DropKeepMutator, error) {
 			m.KeepCols = toStringSet(s.Columns)
 		}
 		if s.Predicate != nil {
-			compiledFn, param, err := compiler.CompileFnParam(s.Predicate, semantic.String, semantic.Bool)
+			compiledFn, param, typeParam, err := compileColumnPredicate(s.Predicate)
 			if err != nil {
 				return nil, err
 			}
@@ -196,6 +231,7 @@ func NewDropKeepMutator(qs flux.OperationSpec) (*DropKeepMutator, error) {
 			m.FlipPredicate = true
 
 			m.ParamName = param
+			m.TypeParamName = typeParam
 			m.Input = values.NewObject()
 		}
 	default:
@@ -216,8 +252,11 @@ func (m *DropKeepMutator) checkKeepColumns(tableCols []flux.ColMeta) error {
 	return nil
 }
 
-func (m *DropKeepMutator) shouldDrop(col string) (bool, error) {
-	m.Input.Set(m.ParamName, values.NewString(col))
+func (m *DropKeepMutator) shouldDrop(col flux.ColMeta) (bool, error) {
+	m.Input.Set(m.ParamName, values.NewString(col.Label))
+	if m.TypeParamName != "" {
+		m.Input.Set(m.TypeParamName, values.NewString(col.Type.String()))
+	}
 	if shouldDrop, err := m.Predicate.EvalBool(m.Input); err != nil {
 		return false, err
 	} else if m.FlipPredicate {
@@ -227,9 +266,9 @@ func (m *DropKeepMutator) shouldDrop(col string) (bool, error) {
 	}
 }
 
-func (m *DropKeepMutator) shouldDropCol(col string) (bool, error) {
+func (m *DropKeepMutator) shouldDropCol(col flux.ColMeta) (bool, error) {
 	if m.DropCols != nil {
-		if _, exists := m.DropCols[col]; exists {
+		if _, exists := m.DropCols[col.Label]; exists {
 			return true, nil
 		}
 	} else if m.Predicate != nil {
@@ -269,7 +308,7 @@ func (m *DropKeepMutator) Mutate(ctx *BuilderContext) error {
 	newColMap := make([]int, 0, len(ctx.Cols()))
 
 	for i, c := range ctx.Cols() {
-		if shouldDrop, err := m.shouldDropCol(c.Label); err != nil {
+		if shouldDrop, err := m.shouldDropCol(c); err != nil {
 			return err
 		} else if shouldDrop {
 			continue
@@ -292,8 +331,9 @@ func (m *DropKeepMutator) Mutate(ctx *BuilderContext) error {
 }
 
 type DuplicateMutator struct {
-	Column string
-	As     string
+	Column    string
+	As        string
+	Overwrite bool
 }
 
 func NewDuplicateMutator(qs flux.OperationSpec) (*DuplicateMutator, error) {
@@ -303,8 +343,9 @@ func NewDuplicateMutator(qs flux.OperationSpec) (*DuplicateMutator, error) {
 	}
 
 	return &DuplicateMutator{
-		Column: s.Column,
-		As:     s.As,
+		Column:    s.Column,
+		As:        s.As,
+		Overwrite: s.Overwrite,
 	}, nil
 }
 
@@ -320,7 +361,11 @@ func (m *DuplicateMutator) Mutate(ctx *BuilderContext) error {
 		ctx.TableColumns = append(ctx.TableColumns, newCol)
 		ctx.ColIdxMap = append(ctx.ColIdxMap, ctx.ColIdxMap[fromIdx])
 		asIdx = len(ctx.TableColumns) - 1
+	} else if !m.Overwrite {
+		return fmt.Errorf(`duplicate error: column "%s" already exists`, m.As)
 	} else {
+		// The columns may still belong to the input table, so copy them before replacing one.
+		ctx.TableColumns = append(ctx.TableColumns[:0:0], ctx.TableColumns...)
 		ctx.TableColumns[asIdx] = newCol
 		ctx.ColIdxMap[asIdx] = ctx.ColIdxMap[fromIdx]
 	}
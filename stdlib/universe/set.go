@@ -133,6 +133,7 @@ func (t *setTransformation) Process(id execute.DatasetID, tbl flux.Table) error
 		for j, c := range key.Cols() {
 			cols[j] = c
 			if j == idx {
+				cols[j].Type = flux.TString
 				vs[j] = values.NewString(t.value)
 			} else {
 				vs[j] = key.Value(j)
@@ -142,12 +143,18 @@ func (t *setTransformation) Process(id execute.DatasetID, tbl flux.Table) error
 	}
 	builder, created := t.cache.TableBuilder(key)
 	if created {
-		err := execute.AddTableCols(tbl, builder)
-		if err != nil {
-			return err
+		// An existing column is overwritten with a string column,
+		// regardless of its original type.
+		for _, c := range tbl.Cols() {
+			if c.Label == t.key {
+				c.Type = flux.TString
+			}
+			if _, err := builder.AddCol(c); err != nil {
+				return err
+			}
 		}
 		if !execute.HasCol(t.key, builder.Cols()) {
-			if _, err = builder.AddCol(flux.ColMeta{
+			if _, err := builder.AddCol(flux.ColMeta{
 				Label: t.key,
 				Type:  flux.TString,
 			}); err != nil {
@@ -285,6 +285,78 @@ func TestSet_Process(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "replace non-string col",
+			spec: &universe.SetProcedureSpec{
+				Key:   "_value",
+				Value: "bob",
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "t1", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0, "jim"},
+					{execute.Time(2), nil, "sue"},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TString},
+					{Label: "t1", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "bob", "jim"},
+					{execute.Time(2), "bob", "sue"},
+				},
+			}},
+		},
+		{
+			name: "replace non-string key col, merging tables",
+			spec: &universe.SetProcedureSpec{
+				Key:   "id",
+				Value: "all",
+			},
+			data: []flux.Table{
+				&executetest.Table{
+					KeyCols: []string{"id"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "id", Type: flux.TInt},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, int64(1)},
+					},
+				},
+				&executetest.Table{
+					KeyCols: []string{"id"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "id", Type: flux.TInt},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 2.0, int64(2)},
+					},
+				},
+			},
+			want: []*executetest.Table{{
+				KeyCols: []string{"id"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "id", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0, "all"},
+					{execute.Time(2), 2.0, "all"},
+				},
+			}},
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
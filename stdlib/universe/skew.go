@@ -82,7 +82,7 @@ func createSkewTransformation(id execute.DatasetID, mode execute.AccumulationMod
 	if !ok {
 		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
 	}
-	t, d := execute.NewAggregateTransformationAndDataset(id, mode, new(SkewAgg), s.AggregateConfig, a.Allocator())
+	t, d := execute.NewAggregateTransformationAndDataset(a.Context(), id, mode, new(SkewAgg), s.AggregateConfig, a.Allocator())
 	return t, d, nil
 }
 
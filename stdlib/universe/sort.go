@@ -14,15 +14,17 @@ import (
 const SortKind = "sort"
 
 type SortOpSpec struct {
-	Columns []string `json:"columns"`
-	Desc    bool     `json:"desc"`
+	Columns     []string `json:"columns"`
+	Desc        bool     `json:"desc"`
+	DescColumns []string `json:"descColumns,omitempty"`
 }
 
 func init() {
 	sortSignature := flux.FunctionSignature(
 		map[string]semantic.PolyType{
-			"columns": semantic.NewArrayPolyType(semantic.String),
-			"desc":    semantic.Bool,
+			"columns":     semantic.NewArrayPolyType(semantic.String),
+			"desc":        semantic.Bool,
+			"descColumns": semantic.NewArrayPolyType(semantic.String),
 		},
 		nil,
 	)
@@ -58,6 +60,20 @@ func createSortOpSpec(args flux.Arguments, a *flux.Administration) (flux.Operati
 		spec.Desc = desc
 	}
 
+	if array, ok, err := args.GetArray("descColumns", semantic.String); err != nil {
+		return nil, err
+	} else if ok {
+		spec.DescColumns, err = interpreter.ToStringArray(array)
+		if err != nil {
+			return nil, err
+		}
+		for _, label := range spec.DescColumns {
+			if !execute.ContainsStr(spec.Columns, label) {
+				return nil, fmt.Errorf("descending column %q is not a sort column", label)
+			}
+		}
+	}
+
 	return spec, nil
 }
 
@@ -71,8 +87,9 @@ func (s *SortOpSpec) Kind() flux.OperationKind {
 
 type SortProcedureSpec struct {
 	plan.DefaultCost
-	Columns []string
-	Desc    bool
+	Columns     []string
+	Desc        bool
+	DescColumns []string
 }
 
 func newSortProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
@@ -82,8 +99,9 @@ func newSortProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.Proce
 	}
 
 	return &SortProcedureSpec{
-		Columns: spec.Columns,
-		Desc:    spec.Desc,
+		Columns:     spec.Columns,
+		Desc:        spec.Desc,
+		DescColumns: spec.DescColumns,
 	}, nil
 }
 
@@ -97,6 +115,11 @@ func (s *SortProcedureSpec) Copy() plan.ProcedureSpec {
 	copy(ns.Columns, s.Columns)
 
 	ns.Desc = s.Desc
+
+	if len(s.DescColumns) > 0 {
+		ns.DescColumns = make([]string, len(s.DescColumns))
+		copy(ns.DescColumns, s.DescColumns)
+	}
 	return ns
 }
 
@@ -121,15 +144,21 @@ type sortTransformation struct {
 	cache execute.TableBuilderCache
 
 	cols []string
-	desc bool
+	// desc reports whether the column at the same index in cols
+	// is sorted in descending order.
+	desc []bool
 }
 
 func NewSortTransformation(d execute.Dataset, cache execute.TableBuilderCache, spec *SortProcedureSpec) *sortTransformation {
+	desc := make([]bool, len(spec.Columns))
+	for i, label := range spec.Columns {
+		desc[i] = spec.Desc || execute.ContainsStr(spec.DescColumns, label)
+	}
 	return &sortTransformation{
 		d:     d,
 		cache: cache,
 		cols:  spec.Columns,
-		desc:  spec.Desc,
+		desc:  desc,
 	}
 }
 
@@ -157,7 +186,7 @@ func (t *sortTransformation) Process(id execute.DatasetID, tbl flux.Table) error
 		return err
 	}
 
-	builder.Sort(t.cols, t.desc)
+	builder.SortBy(t.cols, t.desc)
 	return nil
 }
 
@@ -0,0 +1,151 @@
+package universe
+
+import (
+	"fmt"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/plan"
+)
+
+// SortLimitKind is the kind of the procedure that replaces a sort followed by a limit.
+const SortLimitKind = "sort-limit"
+
+func init() {
+	execute.RegisterTransformation(SortLimitKind, createSortLimitTransformation)
+	plan.RegisterLogicalRules(SortLimitRule{})
+}
+
+// SortLimitProcedureSpec sorts each table and keeps only its first N rows.
+type SortLimitProcedureSpec struct {
+	plan.DefaultCost
+	Columns     []string
+	Desc        bool
+	DescColumns []string
+	N           int64
+}
+
+func (s *SortLimitProcedureSpec) Kind() plan.ProcedureKind {
+	return SortLimitKind
+}
+func (s *SortLimitProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := new(SortLimitProcedureSpec)
+	*ns = *s
+
+	ns.Columns = make([]string, len(s.Columns))
+	copy(ns.Columns, s.Columns)
+
+	if len(s.DescColumns) > 0 {
+		ns.DescColumns = make([]string, len(s.DescColumns))
+		copy(ns.DescColumns, s.DescColumns)
+	}
+	return ns
+}
+
+// TriggerSpec implements plan.TriggerAwareProcedureSpec
+func (s *SortLimitProcedureSpec) TriggerSpec() plan.TriggerSpec {
+	return plan.NarrowTransformationTriggerSpec{}
+}
+
+// SortLimitRule merges `sort |> limit` into a single sort-limit procedure
+// that only keeps the rows that can be returned by the limit while sorting.
+type SortLimitRule struct{}
+
+func (SortLimitRule) Name() string {
+	return "SortLimitRule"
+}
+
+// returns the pattern that matches `sort |> limit`
+func (SortLimitRule) Pattern() plan.Pattern {
+	return plan.Pat(LimitKind, plan.Pat(SortKind, plan.Any()))
+}
+
+func (SortLimitRule) Rewrite(limitNode plan.Node) (plan.Node, bool, error) {
+	limitSpec := limitNode.ProcedureSpec().(*LimitProcedureSpec)
+	sortNode := limitNode.Predecessors()[0]
+	sortSpec := sortNode.ProcedureSpec().(*SortProcedureSpec)
+
+	// The rows skipped by an offset would have to be kept too,
+	// and any other successor of the sort needs all of the sorted rows.
+	if limitSpec.Offset != 0 || len(sortNode.Successors()) != 1 {
+		return limitNode, false, nil
+	}
+
+	merged, err := plan.MergeToLogicalNode(limitNode, sortNode, &SortLimitProcedureSpec{
+		Columns:     sortSpec.Columns,
+		Desc:        sortSpec.Desc,
+		DescColumns: sortSpec.DescColumns,
+		N:           limitSpec.N,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return merged, true, nil
+}
+
+func createSortLimitTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*SortLimitProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewSortLimitTransformation(d, cache, s)
+	return t, d, nil
+}
+
+type sortLimitTransformation struct {
+	sortTransformation
+
+	n int
+}
+
+// NewSortLimitTransformation creates a transformation that returns the same rows as
+// a sort followed by a limit without an offset, while only buffering about 2n rows per table.
+func NewSortLimitTransformation(d execute.Dataset, cache execute.TableBuilderCache, spec *SortLimitProcedureSpec) *sortLimitTransformation {
+	return &sortLimitTransformation{
+		sortTransformation: *NewSortTransformation(d, cache, &SortProcedureSpec{
+			Columns:     spec.Columns,
+			Desc:        spec.Desc,
+			DescColumns: spec.DescColumns,
+		}),
+		n: int(spec.N),
+	}
+}
+
+func (t *sortLimitTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	key := tbl.Key()
+	for _, label := range t.cols {
+		if key.HasCol(label) {
+			key = t.sortedKey(key)
+			break
+		}
+	}
+
+	builder, created := t.cache.TableBuilder(key)
+	if !created {
+		return fmt.Errorf("sort found duplicate table with key: %v", tbl.Key())
+	}
+	if err := execute.AddTableCols(tbl, builder); err != nil {
+		return err
+	}
+
+	// The sort is stable and the rows that are kept always precede the rows
+	// appended after them, so ties keep the order of a full sort.
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		if err := execute.AppendCols(cr, builder); err != nil {
+			return err
+		}
+		if builder.NRows() > 2*t.n {
+			builder.SortBy(t.cols, t.desc)
+			builder.Truncate(t.n)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	builder.SortBy(t.cols, t.desc)
+	builder.Truncate(t.n)
+	return nil
+}
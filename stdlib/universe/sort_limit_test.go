@@ -0,0 +1,257 @@
+package universe_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/plan/plantest"
+	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
+	"github.com/influxdata/flux/stdlib/universe"
+)
+
+func TestSortLimitRule(t *testing.T) {
+	var (
+		from = &influxdb.FromProcedureSpec{}
+		sort = &universe.SortProcedureSpec{
+			Columns:     []string{"_value", "_time"},
+			DescColumns: []string{"_time"},
+		}
+		limit       = &universe.LimitProcedureSpec{N: 5}
+		limitOffset = &universe.LimitProcedureSpec{N: 5, Offset: 2}
+		mean        = &universe.MeanProcedureSpec{}
+	)
+
+	tests := []plantest.RuleTestCase{
+		{
+			Name:  "sort limit",
+			Rules: []plan.Rule{universe.SortLimitRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreateLogicalNode("from", from),
+					plan.CreateLogicalNode("sort", sort),
+					plan.CreateLogicalNode("limit", limit),
+				},
+				Edges: [][2]int{
+					{0, 1},
+					{1, 2},
+				},
+			},
+			After: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreateLogicalNode("from", from),
+					plan.CreateLogicalNode("merged_sort_limit", &universe.SortLimitProcedureSpec{
+						Columns:     []string{"_value", "_time"},
+						DescColumns: []string{"_time"},
+						N:           5,
+					}),
+				},
+				Edges: [][2]int{
+					{0, 1},
+				},
+			},
+		},
+		{
+			Name:  "limit with offset",
+			Rules: []plan.Rule{universe.SortLimitRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreateLogicalNode("from", from),
+					plan.CreateLogicalNode("sort", sort),
+					plan.CreateLogicalNode("limit", limitOffset),
+				},
+				Edges: [][2]int{
+					{0, 1},
+					{1, 2},
+				},
+			},
+			NoChange: true,
+		},
+		{
+			Name:  "sort with several successors",
+			Rules: []plan.Rule{universe.SortLimitRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreateLogicalNode("from", from),
+					plan.CreateLogicalNode("sort", sort),
+					plan.CreateLogicalNode("limit", limit),
+					plan.CreateLogicalNode("mean", mean),
+				},
+				Edges: [][2]int{
+					{0, 1},
+					{1, 2},
+					{1, 3},
+				},
+			},
+			NoChange: true,
+		},
+		{
+			Name:  "limit without sort",
+			Rules: []plan.Rule{universe.SortLimitRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreateLogicalNode("from", from),
+					plan.CreateLogicalNode("limit", limit),
+				},
+				Edges: [][2]int{
+					{0, 1},
+				},
+			},
+			NoChange: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			plantest.LogicalRuleTestHelper(t, &tc)
+		})
+	}
+}
+
+// TestSortLimit_Process checks that the sort-limit transformation returns
+// the same tables as a sort transformation followed by a limit transformation.
+func TestSortLimit_Process(t *testing.T) {
+	// Many rows share the same value so that the order of ties is checked.
+	data := make([][]interface{}, 50)
+	for i := range data {
+		var v interface{} = float64(i * 7 % 5)
+		if i%11 == 0 {
+			v = nil
+		}
+		data[i] = []interface{}{execute.Time(i), v, "a", int64(i % 3)}
+	}
+	tables := []*executetest.Table{
+		{
+			KeyCols: []string{"t1"},
+			ColMeta: []flux.ColMeta{
+				{Label: "_time", Type: flux.TTime},
+				{Label: "_value", Type: flux.TFloat},
+				{Label: "t1", Type: flux.TString},
+				{Label: "x", Type: flux.TInt},
+			},
+			Data: data,
+		},
+		{
+			KeyCols: []string{"t1"},
+			ColMeta: []flux.ColMeta{
+				{Label: "_time", Type: flux.TTime},
+				{Label: "_value", Type: flux.TFloat},
+				{Label: "t1", Type: flux.TString},
+				{Label: "x", Type: flux.TInt},
+			},
+			Data: [][]interface{}{
+				{execute.Time(1), 2.0, "b", int64(1)},
+				{execute.Time(2), 1.0, "b", int64(1)},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name string
+		spec *universe.SortLimitProcedureSpec
+	}{
+		{
+			name: "ascending",
+			spec: &universe.SortLimitProcedureSpec{
+				Columns: []string{"_value"},
+				N:       7,
+			},
+		},
+		{
+			name: "descending",
+			spec: &universe.SortLimitProcedureSpec{
+				Columns: []string{"_value"},
+				Desc:    true,
+				N:       7,
+			},
+		},
+		{
+			name: "descending columns",
+			spec: &universe.SortLimitProcedureSpec{
+				Columns:     []string{"x", "_value"},
+				DescColumns: []string{"x"},
+				N:           12,
+			},
+		},
+		{
+			name: "group key column",
+			spec: &universe.SortLimitProcedureSpec{
+				Columns: []string{"t1", "x"},
+				N:       4,
+			},
+		},
+		{
+			name: "limit larger than table",
+			spec: &universe.SortLimitProcedureSpec{
+				Columns: []string{"_value"},
+				N:       100,
+			},
+		},
+		{
+			name: "zero limit",
+			spec: &universe.SortLimitProcedureSpec{
+				Columns: []string{"_value"},
+				N:       0,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			// Compute the expected tables with a sort and a limit.
+			sorted := processTables(t, tables, func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+				return universe.NewSortTransformation(d, c, &universe.SortProcedureSpec{
+					Columns:     tc.spec.Columns,
+					Desc:        tc.spec.Desc,
+					DescColumns: tc.spec.DescColumns,
+				})
+			})
+			want := processTables(t, sorted, func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+				return universe.NewLimitTransformation(d, c, &universe.LimitProcedureSpec{N: tc.spec.N})
+			})
+
+			// Process the input one row at a time so that the buffered rows are truncated repeatedly.
+			input := make([]flux.Table, len(tables))
+			for i, tbl := range tables {
+				input[i] = &executetest.RowWiseTable{Table: tbl}
+			}
+			executetest.ProcessTestHelper(
+				t,
+				input,
+				want,
+				nil,
+				func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+					return universe.NewSortLimitTransformation(d, c, tc.spec)
+				},
+			)
+		})
+	}
+}
+
+// processTables processes the tables with the transformation and returns the tables it builds.
+func processTables(t *testing.T, tables []*executetest.Table, create func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation) []*executetest.Table {
+	t.Helper()
+
+	d := executetest.NewDataset(executetest.RandomDatasetID())
+	c := execute.NewTableBuilderCache(executetest.UnlimitedAllocator)
+	c.SetTriggerSpec(plan.DefaultTriggerSpec)
+	tx := create(d, c)
+
+	parentID := executetest.RandomDatasetID()
+	for _, tbl := range tables {
+		if err := tx.Process(parentID, tbl); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tx.Finish(parentID, nil)
+
+	got, err := executetest.TablesFromCache(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return got
+}
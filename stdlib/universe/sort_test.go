@@ -7,9 +7,51 @@ import (
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/execute/executetest"
 	"github.com/influxdata/flux/querytest"
+	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
 	"github.com/influxdata/flux/stdlib/universe"
 )
 
+func TestSort_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "sort with descending columns",
+			Raw:  `from(bucket:"mybucket") |> sort(columns: ["host", "_value"], descColumns: ["_value"])`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "mybucket",
+						},
+					},
+					{
+						ID: "sort1",
+						Spec: &universe.SortOpSpec{
+							Columns:     []string{"host", "_value"},
+							DescColumns: []string{"_value"},
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "sort1"},
+				},
+			},
+		},
+		{
+			Name:    "sort with descending column that is not a sort column",
+			Raw:     `from(bucket:"mybucket") |> sort(columns: ["host"], descColumns: ["_value"])`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
 func TestSortOperation_Marshaling(t *testing.T) {
 	data := []byte(`{"id":"sort","kind":"sort","spec":{"columns":["t1","t2"],"desc":true}}`)
 	op := &flux.Operation{
@@ -97,6 +139,72 @@ func TestSort_Process(t *testing.T) {
 				},
 			}},
 		},
+		{
+			name: "one table with ties",
+			spec: &universe.SortProcedureSpec{
+				Columns: []string{"_value"},
+				Desc:    false,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 2.0},
+					{execute.Time(2), 1.0},
+					{execute.Time(3), 2.0},
+					{execute.Time(4), 1.0},
+					{execute.Time(5), 2.0},
+					{execute.Time(6), 1.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(2), 1.0},
+					{execute.Time(4), 1.0},
+					{execute.Time(6), 1.0},
+					{execute.Time(1), 2.0},
+					{execute.Time(3), 2.0},
+					{execute.Time(5), 2.0},
+				},
+			}},
+		},
+		{
+			name: "one table descending with ties",
+			spec: &universe.SortProcedureSpec{
+				Columns: []string{"_value"},
+				Desc:    true,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0},
+					{execute.Time(2), 2.0},
+					{execute.Time(3), 1.0},
+					{execute.Time(4), 2.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(2), 2.0},
+					{execute.Time(4), 2.0},
+					{execute.Time(1), 1.0},
+					{execute.Time(3), 1.0},
+				},
+			}},
+		},
 		{
 			name: "one table multiple columns",
 			spec: &universe.SortProcedureSpec{
@@ -129,7 +237,7 @@ func TestSort_Process(t *testing.T) {
 		{
 			name: "one table multiple columns descending",
 			spec: &universe.SortProcedureSpec{
-				Columns: []string{"_value", "time"},
+				Columns: []string{"_value", "_time"},
 				Desc:    true,
 			},
 			data: []flux.Table{&executetest.Table{
@@ -190,6 +298,128 @@ func TestSort_Process(t *testing.T) {
 				},
 			}},
 		},
+		{
+			name: "one table multiple columns with ties is stable",
+			spec: &universe.SortProcedureSpec{
+				Columns: []string{"tag", "_value"},
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "tag", Type: flux.TString},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "b", 1.0},
+					{execute.Time(2), "a", 2.0},
+					{execute.Time(3), "b", 1.0},
+					{execute.Time(4), "a", 1.0},
+					{execute.Time(5), "b", 2.0},
+					{execute.Time(6), "a", 2.0},
+					{execute.Time(7), "b", 1.0},
+					{execute.Time(8), "a", 1.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "tag", Type: flux.TString},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(4), "a", 1.0},
+					{execute.Time(8), "a", 1.0},
+					{execute.Time(2), "a", 2.0},
+					{execute.Time(6), "a", 2.0},
+					{execute.Time(1), "b", 1.0},
+					{execute.Time(3), "b", 1.0},
+					{execute.Time(7), "b", 1.0},
+					{execute.Time(5), "b", 2.0},
+				},
+			}},
+		},
+		{
+			name: "one table with ascending and descending columns",
+			spec: &universe.SortProcedureSpec{
+				Columns:     []string{"tag", "_value"},
+				DescColumns: []string{"_value"},
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "tag", Type: flux.TString},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "b", 1.0},
+					{execute.Time(2), "a", 2.0},
+					{execute.Time(3), "b", 1.0},
+					{execute.Time(4), "a", 1.0},
+					{execute.Time(5), "b", 2.0},
+					{execute.Time(6), "a", 2.0},
+					{execute.Time(7), "b", 1.0},
+					{execute.Time(8), "a", 1.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "tag", Type: flux.TString},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(2), "a", 2.0},
+					{execute.Time(6), "a", 2.0},
+					{execute.Time(4), "a", 1.0},
+					{execute.Time(8), "a", 1.0},
+					{execute.Time(5), "b", 2.0},
+					{execute.Time(1), "b", 1.0},
+					{execute.Time(3), "b", 1.0},
+					{execute.Time(7), "b", 1.0},
+				},
+			}},
+		},
+		{
+			name: "one table with descending and ascending columns",
+			spec: &universe.SortProcedureSpec{
+				Columns:     []string{"_value", "tag"},
+				DescColumns: []string{"_value"},
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "tag", Type: flux.TString},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "b", 1.0},
+					{execute.Time(2), "a", 2.0},
+					{execute.Time(3), "b", 1.0},
+					{execute.Time(4), "a", 1.0},
+					{execute.Time(5), "b", 2.0},
+					{execute.Time(6), "a", 2.0},
+					{execute.Time(7), "b", 1.0},
+					{execute.Time(8), "a", 1.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "tag", Type: flux.TString},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(2), "a", 2.0},
+					{execute.Time(6), "a", 2.0},
+					{execute.Time(5), "b", 2.0},
+					{execute.Time(4), "a", 1.0},
+					{execute.Time(8), "a", 1.0},
+					{execute.Time(1), "b", 1.0},
+					{execute.Time(3), "b", 1.0},
+					{execute.Time(7), "b", 1.0},
+				},
+			}},
+		},
 		{
 			name: "multiple tables",
 			spec: &universe.SortProcedureSpec{
@@ -85,7 +85,7 @@ func createSpreadTransformation(id execute.DatasetID, mode execute.AccumulationM
 		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
 	}
 
-	t, d := execute.NewAggregateTransformationAndDataset(id, mode, new(SpreadAgg), s.AggregateConfig, a.Allocator())
+	t, d := execute.NewAggregateTransformationAndDataset(a.Context(), id, mode, new(SpreadAgg), s.AggregateConfig, a.Allocator())
 	return t, d, nil
 }
 
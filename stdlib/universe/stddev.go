@@ -109,7 +109,7 @@ func createStddevTransformation(id execute.DatasetID, mode execute.AccumulationM
 	if !ok {
 		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
 	}
-	t, d := execute.NewAggregateTransformationAndDataset(id, mode, &StddevAgg{Mode: s.Mode}, s.AggregateConfig, a.Allocator())
+	t, d := execute.NewAggregateTransformationAndDataset(a.Context(), id, mode, &StddevAgg{Mode: s.Mode}, s.AggregateConfig, a.Allocator())
 	return t, d, nil
 }
 
@@ -190,5 +190,9 @@ func (a *StddevAgg) ValueFloat() float64 {
 	return math.Sqrt(a.m2 / float64(n))
 }
 func (a *StddevAgg) IsNull() bool {
+	if a.Mode == modeSample {
+		// The sample standard deviation of a single value is undefined.
+		return a.n < 2
+	}
 	return a.n == 0
 }
@@ -1,18 +1,84 @@
 package universe_test
 
 import (
-	"math"
 	"testing"
 
 	"github.com/apache/arrow/go/arrow/array"
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/arrow"
+	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/execute/executetest"
 	"github.com/influxdata/flux/memory"
 	"github.com/influxdata/flux/querytest"
+	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
 	"github.com/influxdata/flux/stdlib/universe"
 )
 
+func TestStddev_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "default mode",
+			Raw:  `from(bucket:"mydb") |> stddev()`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "mydb",
+						},
+					},
+					{
+						ID: "stddev1",
+						Spec: &universe.StddevOpSpec{
+							Mode:            "sample",
+							AggregateConfig: execute.DefaultAggregateConfig,
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "stddev1"},
+				},
+			},
+		},
+		{
+			Name: "population mode",
+			Raw:  `from(bucket:"mydb") |> stddev(mode: "population")`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "mydb",
+						},
+					},
+					{
+						ID: "stddev1",
+						Spec: &universe.StddevOpSpec{
+							Mode:            "population",
+							AggregateConfig: execute.DefaultAggregateConfig,
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "stddev1"},
+				},
+			},
+		},
+		{
+			Name:    "invalid mode",
+			Raw:     `from(bucket:"mydb") |> stddev(mode: "median")`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
 func TestStddevOperation_Marshaling(t *testing.T) {
 	data := []byte(`{"id":"stddev","kind":"stddev","spec":{"mode":"sample"}}`)
 	op := &flux.Operation{
@@ -50,12 +116,39 @@ func TestStddev_Process(t *testing.T) {
 			},
 		},
 		{
-			name: "NaN",
+			name: "hand computed",
+			data: func() *array.Float64 {
+				return arrow.NewFloat([]float64{2, 4, 4, 4, 5, 5, 7, 9}, nil)
+			},
+			wantForMode: map[string]interface{}{
+				// sqrt(32 / 7)
+				"sample": 2.138089935299395,
+				// sqrt(32 / 8)
+				"population": 2.0,
+			},
+		},
+		{
+			name: "single value",
 			data: func() *array.Float64 {
 				return arrow.NewFloat([]float64{1}, nil)
 			},
 			wantForMode: map[string]interface{}{
-				"sample":     math.NaN(),
+				"sample":     nil,
+				"population": 0.0,
+			},
+		},
+		{
+			name: "single value with nulls",
+			data: func() *array.Float64 {
+				b := arrow.NewFloatBuilder(nil)
+				defer b.Release()
+				b.AppendNull()
+				b.Append(1)
+				b.AppendNull()
+				return b.NewFloat64Array()
+			},
+			wantForMode: map[string]interface{}{
+				"sample":     nil,
 				"population": 0.0,
 			},
 		},
@@ -88,7 +88,7 @@ func createSumTransformation(id execute.DatasetID, mode execute.AccumulationMode
 		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
 	}
 
-	t, d := execute.NewAggregateTransformationAndDataset(id, mode, new(SumAgg), s.AggregateConfig, a.Allocator())
+	t, d := execute.NewAggregateTransformationAndDataset(a.Context(), id, mode, new(SumAgg), s.AggregateConfig, a.Allocator())
 	return t, d, nil
 }
 
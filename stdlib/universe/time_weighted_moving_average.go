@@ -0,0 +1,323 @@
+package universe
+
+import (
+	"fmt"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+)
+
+const TimeWeightedMovingAverageKind = "timeWeightedMovingAverage"
+
+type TimeWeightedMovingAverageOpSpec struct {
+	Period     flux.Duration `json:"period"`
+	Columns    []string      `json:"columns"`
+	TimeColumn string        `json:"timeColumn"`
+}
+
+func init() {
+	timeWeightedMovingAverageSignature := flux.FunctionSignature(
+		map[string]semantic.PolyType{
+			"period":     semantic.Duration,
+			"columns":    semantic.NewArrayPolyType(semantic.String),
+			"timeColumn": semantic.String,
+		},
+		[]string{"period"},
+	)
+
+	flux.RegisterPackageValue("universe", TimeWeightedMovingAverageKind, flux.FunctionValue(TimeWeightedMovingAverageKind, createTimeWeightedMovingAverageOpSpec, timeWeightedMovingAverageSignature))
+	flux.RegisterOpSpec(TimeWeightedMovingAverageKind, newTimeWeightedMovingAverageOp)
+	plan.RegisterProcedureSpec(TimeWeightedMovingAverageKind, newTimeWeightedMovingAverageProcedure, TimeWeightedMovingAverageKind)
+	execute.RegisterTransformation(TimeWeightedMovingAverageKind, createTimeWeightedMovingAverageTransformation)
+}
+
+func createTimeWeightedMovingAverageOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+
+	spec := new(TimeWeightedMovingAverageOpSpec)
+
+	period, err := args.GetRequiredDuration("period")
+	if err != nil {
+		return nil, err
+	} else if period <= 0 {
+		return nil, fmt.Errorf("period must be positive, but was %v", period)
+	}
+	spec.Period = period
+
+	if timeCol, ok, err := args.GetString("timeColumn"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.TimeColumn = timeCol
+	} else {
+		spec.TimeColumn = execute.DefaultTimeColLabel
+	}
+
+	if cols, ok, err := args.GetArray("columns", semantic.String); err != nil {
+		return nil, err
+	} else if ok {
+		columns, err := interpreter.ToStringArray(cols)
+		if err != nil {
+			return nil, err
+		}
+		spec.Columns = columns
+	} else {
+		spec.Columns = []string{execute.DefaultValueColLabel}
+	}
+	return spec, nil
+}
+
+func newTimeWeightedMovingAverageOp() flux.OperationSpec {
+	return new(TimeWeightedMovingAverageOpSpec)
+}
+
+func (s *TimeWeightedMovingAverageOpSpec) Kind() flux.OperationKind {
+	return TimeWeightedMovingAverageKind
+}
+
+type TimeWeightedMovingAverageProcedureSpec struct {
+	plan.DefaultCost
+	Period     flux.Duration `json:"period"`
+	Columns    []string      `json:"columns"`
+	TimeColumn string        `json:"timeColumn"`
+}
+
+func newTimeWeightedMovingAverageProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*TimeWeightedMovingAverageOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+
+	return &TimeWeightedMovingAverageProcedureSpec{
+		Period:     spec.Period,
+		Columns:    spec.Columns,
+		TimeColumn: spec.TimeColumn,
+	}, nil
+}
+
+func (s *TimeWeightedMovingAverageProcedureSpec) Kind() plan.ProcedureKind {
+	return TimeWeightedMovingAverageKind
+}
+func (s *TimeWeightedMovingAverageProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := new(TimeWeightedMovingAverageProcedureSpec)
+	*ns = *s
+	if s.Columns != nil {
+		ns.Columns = make([]string, len(s.Columns))
+		copy(ns.Columns, s.Columns)
+	}
+	return ns
+}
+
+// TriggerSpec implements plan.TriggerAwareProcedureSpec
+func (s *TimeWeightedMovingAverageProcedureSpec) TriggerSpec() plan.TriggerSpec {
+	return plan.NarrowTransformationTriggerSpec{}
+}
+
+func createTimeWeightedMovingAverageTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*TimeWeightedMovingAverageProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewTimeWeightedMovingAverageTransformation(d, cache, s)
+	return t, d, nil
+}
+
+type timeWeightedMovingAverageTransformation struct {
+	d     execute.Dataset
+	cache execute.TableBuilderCache
+
+	spec TimeWeightedMovingAverageProcedureSpec
+}
+
+func NewTimeWeightedMovingAverageTransformation(d execute.Dataset, cache execute.TableBuilderCache, spec *TimeWeightedMovingAverageProcedureSpec) *timeWeightedMovingAverageTransformation {
+	return &timeWeightedMovingAverageTransformation{
+		d:     d,
+		cache: cache,
+		spec:  *spec,
+	}
+}
+
+func (t *timeWeightedMovingAverageTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return t.d.RetractTable(key)
+}
+
+func (t *timeWeightedMovingAverageTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	builder, created := t.cache.TableBuilder(tbl.Key())
+	if !created {
+		return fmt.Errorf("timeWeightedMovingAverage found duplicate table with key: %v", tbl.Key())
+	}
+
+	cols := tbl.Cols()
+	averages := make([]*timeWeightedAverage, len(cols))
+	for _, label := range t.spec.Columns {
+		idx := execute.ColIdx(label, cols)
+		if idx < 0 {
+			return fmt.Errorf("column %q does not exist", label)
+		}
+		if tbl.Key().HasCol(label) {
+			return fmt.Errorf("cannot compute a moving average over columns that are part of the group key")
+		}
+		if typ := cols[idx].Type; typ != flux.TFloat &&
+			typ != flux.TInt &&
+			typ != flux.TUInt {
+			return fmt.Errorf("cannot compute a time weighted moving average over %v", typ)
+		}
+		averages[idx] = newTimeWeightedAverage(execute.Duration(t.spec.Period))
+	}
+	for j, c := range cols {
+		if averages[j] != nil {
+			// The average is always a float
+			c.Type = flux.TFloat
+		}
+		if _, err := builder.AddCol(c); err != nil {
+			return err
+		}
+	}
+
+	timeIdx := execute.ColIdx(t.spec.TimeColumn, cols)
+	if timeIdx < 0 {
+		return fmt.Errorf("no column %q exists", t.spec.TimeColumn)
+	}
+
+	var pTime execute.Time
+	first := true
+	return tbl.Do(func(cr flux.ColReader) error {
+		ts := cr.Times(timeIdx)
+		if ts.NullN() > 0 {
+			return fmt.Errorf("timeWeightedMovingAverage found null time in time column")
+		}
+		l := cr.Len()
+		for i := 0; i < l; i++ {
+			cTime := execute.Time(ts.Value(i))
+			if !first && cTime < pTime {
+				return fmt.Errorf("timeWeightedMovingAverage found out-of-order times in time column")
+			}
+			pTime, first = cTime, false
+		}
+
+		for j, avg := range averages {
+			if avg == nil {
+				if err := execute.AppendCol(j, j, cr, builder); err != nil {
+					return err
+				}
+				continue
+			}
+			for i := 0; i < l; i++ {
+				var (
+					v     float64
+					valid bool
+				)
+				switch cols[j].Type {
+				case flux.TInt:
+					if vs := cr.Ints(j); vs.IsValid(i) {
+						v, valid = float64(vs.Value(i)), true
+					}
+				case flux.TUInt:
+					if vs := cr.UInts(j); vs.IsValid(i) {
+						v, valid = float64(vs.Value(i)), true
+					}
+				case flux.TFloat:
+					if vs := cr.Floats(j); vs.IsValid(i) {
+						v, valid = vs.Value(i), true
+					}
+				}
+
+				if mean, ok := avg.update(execute.Time(ts.Value(i)), v, valid); ok {
+					if err := builder.AppendFloat(j, mean); err != nil {
+						return err
+					}
+				} else {
+					if err := builder.AppendNil(j); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func (t *timeWeightedMovingAverageTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+func (t *timeWeightedMovingAverageTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+func (t *timeWeightedMovingAverageTransformation) Finish(id execute.DatasetID, err error) {
+	t.d.Finish(err)
+}
+
+// timeWeightedSegment is a span of time during which a single value was in effect.
+type timeWeightedSegment struct {
+	start, stop execute.Time
+	value       float64
+}
+
+// timeWeightedAverage computes the average of a step function over a
+// trailing window of time. Each value is in effect from its own time until
+// the time of the next row. A null value is not in effect for any
+// duration, so the span up to the next row is a gap that does not
+// contribute to the average.
+type timeWeightedAverage struct {
+	period execute.Duration
+
+	segments []timeWeightedSegment
+
+	first  bool
+	pTime  execute.Time
+	pValue float64
+	pValid bool
+}
+
+func newTimeWeightedAverage(period execute.Duration) *timeWeightedAverage {
+	return &timeWeightedAverage{
+		period: period,
+		first:  true,
+	}
+}
+
+// update adds the value at time t and returns the time weighted average
+// over the window (t - period, t]. It returns false if no value was in
+// effect for any part of the window.
+func (a *timeWeightedAverage) update(t execute.Time, v float64, valid bool) (float64, bool) {
+	if !a.first && a.pValid && t > a.pTime {
+		a.segments = append(a.segments, timeWeightedSegment{
+			start: a.pTime,
+			stop:  t,
+			value: a.pValue,
+		})
+	}
+	a.first = false
+	a.pTime, a.pValue, a.pValid = t, v, valid
+
+	// Drop the segments that ended before the window starts.
+	start := t.Add(-a.period)
+	n := 0
+	for n < len(a.segments) && a.segments[n].stop <= start {
+		n++
+	}
+	if n > 0 {
+		a.segments = append(a.segments[:0], a.segments[n:]...)
+	}
+
+	var sum, covered float64
+	for _, s := range a.segments {
+		from := s.start
+		if from < start {
+			from = start
+		}
+		d := float64(s.stop - from)
+		sum += s.value * d
+		covered += d
+	}
+	if covered == 0 {
+		return 0, false
+	}
+	return sum / covered, true
+}
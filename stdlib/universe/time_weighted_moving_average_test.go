@@ -0,0 +1,278 @@
+package universe_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/querytest"
+	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
+	"github.com/influxdata/flux/stdlib/universe"
+)
+
+func TestTimeWeightedMovingAverage_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "defaults",
+			Raw:  `from(bucket:"mybucket") |> timeWeightedMovingAverage(period: 5m)`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "mybucket",
+						},
+					},
+					{
+						ID: "timeWeightedMovingAverage1",
+						Spec: &universe.TimeWeightedMovingAverageOpSpec{
+							Period:     flux.Duration(5 * time.Minute),
+							Columns:    []string{"_value"},
+							TimeColumn: "_time",
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "timeWeightedMovingAverage1"},
+				},
+			},
+		},
+		{
+			Name: "columns and time column",
+			Raw:  `from(bucket:"mybucket") |> timeWeightedMovingAverage(period: 1h, columns: ["a", "b"], timeColumn: "t")`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "mybucket",
+						},
+					},
+					{
+						ID: "timeWeightedMovingAverage1",
+						Spec: &universe.TimeWeightedMovingAverageOpSpec{
+							Period:     flux.Duration(time.Hour),
+							Columns:    []string{"a", "b"},
+							TimeColumn: "t",
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "timeWeightedMovingAverage1"},
+				},
+			},
+		},
+		{
+			Name:    "missing period",
+			Raw:     `from(bucket:"mybucket") |> timeWeightedMovingAverage()`,
+			WantErr: true,
+		},
+		{
+			Name:    "negative period",
+			Raw:     `from(bucket:"mybucket") |> timeWeightedMovingAverage(period: -1m)`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+func TestTimeWeightedMovingAverage_PassThrough(t *testing.T) {
+	executetest.TransformationPassThroughTestHelper(t, func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+		s := universe.NewTimeWeightedMovingAverageTransformation(
+			d,
+			c,
+			&universe.TimeWeightedMovingAverageProcedureSpec{},
+		)
+		return s
+	})
+}
+
+func TestTimeWeightedMovingAverage_Process(t *testing.T) {
+	testCases := []struct {
+		name    string
+		spec    *universe.TimeWeightedMovingAverageProcedureSpec
+		data    []flux.Table
+		want    []*executetest.Table
+		wantErr error
+	}{
+		{
+			name: "irregular float",
+			spec: &universe.TimeWeightedMovingAverageProcedureSpec{
+				Period:     10,
+				Columns:    []string{execute.DefaultValueColLabel},
+				TimeColumn: execute.DefaultTimeColLabel,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), 10.0},
+					{execute.Time(2), 20.0},
+					{execute.Time(7), 5.0},
+					{execute.Time(8), nil},
+					{execute.Time(15), 40.0},
+					{execute.Time(30), 0.0},
+					{execute.Time(31), 1.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					// No value has been in effect yet.
+					{execute.Time(0), nil},
+					// 10 for 2ns, the window is only partially covered.
+					{execute.Time(2), 10.0},
+					// 10 for 2ns and 20 for 5ns.
+					{execute.Time(7), (10.0*2 + 20.0*5) / 7},
+					// 10 for 2ns, 20 for 5ns and 5 for 1ns.
+					{execute.Time(8), (10.0*2 + 20.0*5 + 5.0*1) / 8},
+					// The window starts at 5, so 20 for 2ns and 5 for 1ns.
+					// The null at 8 leaves a gap until 15.
+					{execute.Time(15), (20.0*2 + 5.0*1) / 3},
+					// 40 for the last 10ns of its 15ns.
+					{execute.Time(30), 40.0},
+					// 40 for 9ns and 0 for 1ns.
+					{execute.Time(31), (40.0*9 + 0.0*1) / 10},
+				},
+			}},
+		},
+		{
+			name: "int with repeated times",
+			spec: &universe.TimeWeightedMovingAverageProcedureSpec{
+				Period:     4,
+				Columns:    []string{execute.DefaultValueColLabel},
+				TimeColumn: execute.DefaultTimeColLabel,
+			},
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"t"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TInt},
+					{Label: "t", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), int64(1), "a"},
+					{execute.Time(1), int64(3), "a"},
+					{execute.Time(1), int64(5), "a"},
+					{execute.Time(4), int64(2), "a"},
+					{execute.Time(9), int64(6), "a"},
+				},
+			}},
+			want: []*executetest.Table{{
+				KeyCols: []string{"t"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "t", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), nil, "a"},
+					{execute.Time(1), 1.0, "a"},
+					{execute.Time(1), 1.0, "a"},
+					// The last value at time 1 is in effect until 4.
+					{execute.Time(4), (1.0*1 + 5.0*3) / 4, "a"},
+					{execute.Time(9), 2.0, "a"},
+				},
+			}},
+		},
+		{
+			name: "multiple columns",
+			spec: &universe.TimeWeightedMovingAverageProcedureSpec{
+				Period:     2,
+				Columns:    []string{"x", "y"},
+				TimeColumn: execute.DefaultTimeColLabel,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "x", Type: flux.TUInt},
+					{Label: "y", Type: flux.TFloat},
+					{Label: "s", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), uint64(4), 1.0, "a"},
+					{execute.Time(1), uint64(2), nil, "b"},
+					{execute.Time(3), uint64(0), 3.0, "c"},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "x", Type: flux.TFloat},
+					{Label: "y", Type: flux.TFloat},
+					{Label: "s", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), nil, nil, "a"},
+					{execute.Time(1), 4.0, 1.0, "b"},
+					{execute.Time(3), 2.0, nil, "c"},
+				},
+			}},
+		},
+		{
+			name: "times out of order",
+			spec: &universe.TimeWeightedMovingAverageProcedureSpec{
+				Period:     10,
+				Columns:    []string{execute.DefaultValueColLabel},
+				TimeColumn: execute.DefaultTimeColLabel,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(2), 1.0},
+					{execute.Time(1), 2.0},
+				},
+			}},
+			wantErr: errors.New("timeWeightedMovingAverage found out-of-order times in time column"),
+		},
+		{
+			name: "string column",
+			spec: &universe.TimeWeightedMovingAverageProcedureSpec{
+				Period:     10,
+				Columns:    []string{execute.DefaultValueColLabel},
+				TimeColumn: execute.DefaultTimeColLabel,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "a"},
+				},
+			}},
+			wantErr: errors.New("cannot compute a time weighted moving average over string"),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			executetest.ProcessTestHelper(
+				t,
+				tc.data,
+				tc.want,
+				tc.wantErr,
+				func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+					return universe.NewTimeWeightedMovingAverageTransformation(d, c, tc.spec)
+				},
+			)
+		})
+	}
+}
@@ -631,9 +631,11 @@ func (c *durationConv) Call(args values.Object) (values.Value, error) {
 	}
 	switch v.Type().Nature() {
 	case semantic.String:
+		// Strings use the fixed units of Go durations,
+		// so calendar units such as "mo" and "y" are rejected.
 		n, err := values.ParseDuration(v.Str())
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("cannot convert string %q to duration: %v", v.Str(), err)
 		}
 		d = n
 	case semantic.Int:
@@ -0,0 +1,83 @@
+package universe_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/values"
+)
+
+func TestTypeConv_Duration(t *testing.T) {
+	testCases := []struct {
+		name string
+		expr string
+		want values.Value
+	}{
+		{
+			name: "duration to int",
+			expr: `int(v: 1h30m)`,
+			want: values.NewInt(int64(90 * time.Minute)),
+		},
+		{
+			name: "negative duration to int",
+			expr: `int(v: -5s)`,
+			want: values.NewInt(int64(-5 * time.Second)),
+		},
+		{
+			name: "int to duration",
+			expr: `duration(v: 1500000000)`,
+			want: values.NewDuration(values.Duration(1500 * time.Millisecond)),
+		},
+		{
+			name: "round trip",
+			expr: `duration(v: int(v: 2d3h))`,
+			want: values.NewDuration(values.Duration(51 * time.Hour)),
+		},
+		{
+			name: "arithmetic",
+			expr: `duration(v: int(v: 1m) * 3 + 1)`,
+			want: values.NewDuration(values.Duration(3*time.Minute + 1)),
+		},
+		{
+			// Calendar units are approximated with average months
+			// when the literal is evaluated.
+			name: "month to int",
+			expr: `int(v: 1mo)`,
+			want: values.NewInt(int64(ast.MonthDuration)),
+		},
+		{
+			name: "year to int",
+			expr: `int(v: 1y)`,
+			want: values.NewInt(int64(12 * ast.MonthDuration)),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, scope, err := flux.Eval("x = " + tc.expr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, ok := scope.Lookup("x")
+			if !ok {
+				t.Fatal("missing value x")
+			}
+			if !got.Equal(tc.want) {
+				t.Errorf("unexpected value -want/+got:\n\t- %v\n\t+ %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestTypeConv_DurationStringCalendarUnit(t *testing.T) {
+	_, _, err := flux.Eval(`x = duration(v: "1mo")`)
+	if err == nil {
+		t.Fatal("expected error converting a calendar duration string")
+	}
+	if want, got := `cannot convert string "1mo" to duration`, err.Error(); !strings.Contains(got, want) {
+		t.Errorf("unexpected error -want/+got:\n\t- %s\n\t+ %s", want, got)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/influxdata/flux"
@@ -16,6 +18,7 @@ import (
 const UnionKind = "union"
 
 type UnionOpSpec struct {
+	Dedup bool `json:"dedup,omitempty"`
 }
 
 func (s *UnionOpSpec) Kind() flux.OperationKind {
@@ -26,6 +29,7 @@ func init() {
 	unionSignature := semantic.FunctionPolySignature{
 		Parameters: map[string]semantic.PolyType{
 			"tables": semantic.NewArrayPolyType(flux.TableObjectType),
+			"dedup":  semantic.Bool,
 		},
 		Required: semantic.LabelSet{"tables"},
 		Return:   flux.TableObjectType,
@@ -61,7 +65,13 @@ func createUnionOpSpec(args flux.Arguments, a *flux.Administration) (flux.Operat
 		return nil, err
 	}
 
-	return &UnionOpSpec{}, nil
+	spec := new(UnionOpSpec)
+	if dedup, ok, err := args.GetBool("dedup"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Dedup = dedup
+	}
+	return spec, nil
 }
 
 func newUnionOp() flux.OperationSpec {
@@ -70,6 +80,7 @@ func newUnionOp() flux.OperationSpec {
 
 type UnionProcedureSpec struct {
 	plan.DefaultCost
+	Dedup bool
 }
 
 func (s *UnionProcedureSpec) Kind() plan.ProcedureKind {
@@ -77,11 +88,15 @@ func (s *UnionProcedureSpec) Kind() plan.ProcedureKind {
 }
 
 func (s *UnionProcedureSpec) Copy() plan.ProcedureSpec {
-	return &UnionProcedureSpec{}
+	return &UnionProcedureSpec{Dedup: s.Dedup}
 }
 
 func newUnionProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
-	return &UnionProcedureSpec{}, nil
+	spec, ok := qs.(*UnionOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+	return &UnionProcedureSpec{Dedup: spec.Dedup}, nil
 }
 
 type unionTransformation struct {
@@ -91,6 +106,10 @@ type unionTransformation struct {
 
 	d     execute.Dataset
 	cache execute.TableBuilderCache
+
+	// seen holds the set of row keys appended to each table
+	// when duplicate rows are dropped.
+	seen *execute.GroupLookup
 }
 
 type unionParentState struct {
@@ -118,11 +137,15 @@ func NewUnionTransformation(d execute.Dataset, cache execute.TableBuilderCache,
 		parentState[id] = new(unionParentState)
 	}
 
-	return &unionTransformation{
+	t := &unionTransformation{
 		parentState: parentState,
 		d:           d,
 		cache:       cache,
 	}
+	if spec.Dedup {
+		t.seen = execute.NewGroupLookup()
+	}
+	return t
 }
 
 func (t *unionTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
@@ -141,6 +164,10 @@ func (t *unionTransformation) Process(id execute.DatasetID, tbl flux.Table) erro
 		return err
 	}
 
+	if t.seen != nil {
+		return t.appendDistinctRows(tbl, builder, colMap)
+	}
+
 	if err := execute.AppendMappedTable(tbl, builder, colMap); err != nil {
 		return err
 	}
@@ -148,6 +175,71 @@ func (t *unionTransformation) Process(id execute.DatasetID, tbl flux.Table) erro
 	return nil
 }
 
+// appendDistinctRows appends the rows of tbl onto builder
+// that are not equal to a row already in the builder.
+func (t *unionTransformation) appendDistinctRows(tbl flux.Table, builder execute.TableBuilder, colMap []int) error {
+	var seen map[string]bool
+	if v, ok := t.seen.Lookup(tbl.Key()); ok {
+		seen = v.(map[string]bool)
+	} else {
+		seen = make(map[string]bool)
+		t.seen.Set(tbl.Key(), seen)
+	}
+
+	// Fill any new columns for the rows already in the builder.
+	if err := builder.LevelColumns(); err != nil {
+		return err
+	}
+	return tbl.Do(func(cr flux.ColReader) error {
+		for i := 0; i < cr.Len(); i++ {
+			key := unionRowKey(i, cr, colMap)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			if err := execute.AppendMappedRecordWithNulls(i, cr, builder, colMap); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// unionRowKey returns a key that is equal for two rows when every column
+// of the builder holds equal values in both rows. Null values are equal to each other
+// and are left out of the key, so the key of a row does not change
+// when the builder gains columns that are null for the row.
+func unionRowKey(i int, cr flux.ColReader, colMap []int) string {
+	var b strings.Builder
+	for j, cj := range colMap {
+		if cj < 0 {
+			continue
+		}
+		v := execute.ValueForRow(cr, i, cj)
+		if v.IsNull() {
+			continue
+		}
+		b.WriteString(strconv.Itoa(j))
+		b.WriteByte('=')
+		switch v.Type().Nature() {
+		case semantic.Bool:
+			b.WriteString(strconv.FormatBool(v.Bool()))
+		case semantic.Int:
+			b.WriteString(strconv.FormatInt(v.Int(), 10))
+		case semantic.UInt:
+			b.WriteString(strconv.FormatUint(v.UInt(), 10))
+		case semantic.Float:
+			b.WriteString(strconv.FormatFloat(v.Float(), 'g', -1, 64))
+		case semantic.String:
+			b.WriteString(strconv.Quote(v.Str()))
+		case semantic.Time:
+			b.WriteString(strconv.FormatInt(int64(v.Time()), 10))
+		}
+		b.WriteByte(',')
+	}
+	return b.String()
+}
+
 func (t *unionTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
 	t.mu.Lock()
 	defer t.mu.Unlock()
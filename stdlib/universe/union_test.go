@@ -155,6 +155,64 @@ func TestUnion_NewQuery(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "two-way union with dedup",
+			Raw: `
+				a = from(bucket:"dbA") |> range(start:-1h)
+				b = from(bucket:"dbB") |> range(start:-1h)
+				union(tables: [a, b], dedup: true)`,
+			Want: &flux.Spec{Operations: []*flux.Operation{
+				{
+					ID:   "from0",
+					Spec: &influxdb.FromOpSpec{Bucket: "dbA"},
+				},
+				{
+					ID: "range1",
+					Spec: &universe.RangeOpSpec{
+						Start: flux.Time{
+							Relative:   -1 * time.Hour,
+							IsRelative: true,
+						},
+						Stop: flux.Time{
+							IsRelative: true,
+						},
+						TimeColumn:  "_time",
+						StartColumn: "_start",
+						StopColumn:  "_stop",
+					},
+				},
+				{
+					ID:   "from2",
+					Spec: &influxdb.FromOpSpec{Bucket: "dbB"},
+				},
+				{
+					ID: "range3",
+					Spec: &universe.RangeOpSpec{
+						Start: flux.Time{
+							Relative:   -1 * time.Hour,
+							IsRelative: true,
+						},
+						Stop: flux.Time{
+							IsRelative: true,
+						},
+						TimeColumn:  "_time",
+						StartColumn: "_start",
+						StopColumn:  "_stop",
+					},
+				},
+				{
+					ID:   "union4",
+					Spec: &universe.UnionOpSpec{Dedup: true},
+				},
+			},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "range1"},
+					{Parent: "from2", Child: "range3"},
+					{Parent: "range1", Child: "union4"},
+					{Parent: "range3", Child: "union4"},
+				},
+			},
+		},
 		{
 			Name: "union no argument",
 			Raw: `
@@ -199,12 +257,11 @@ func TestUnionOperation_Marshaling(t *testing.T) {
 }
 
 func TestUnion_Process(t *testing.T) {
-	spec := &universe.UnionProcedureSpec{}
-
 	testCases := []struct {
-		name string
-		data [][]flux.Table // data from parents
-		want []*executetest.Table
+		name  string
+		dedup bool
+		data  [][]flux.Table // data from parents
+		want  []*executetest.Table
 	}{
 		{
 			name: "two streams union same schema",
@@ -743,6 +800,215 @@ func TestUnion_Process(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "two streams union overlapping tables",
+			data: [][]flux.Table{
+				// stream 1
+				{
+					&executetest.Table{
+						KeyCols: []string{"_field"},
+						ColMeta: []flux.ColMeta{
+							{Label: "_time", Type: flux.TTime},
+							{Label: "_field", Type: flux.TString},
+							{Label: "_value", Type: flux.TFloat},
+						},
+						Data: [][]interface{}{
+							{execute.Time(1), "temp", 70.0},
+							{execute.Time(2), "temp", 75.0},
+							{execute.Time(3), "temp", nil},
+						},
+					},
+				},
+				// stream 2
+				{
+					&executetest.Table{
+						KeyCols: []string{"_field"},
+						ColMeta: []flux.ColMeta{
+							{Label: "_time", Type: flux.TTime},
+							{Label: "_field", Type: flux.TString},
+							{Label: "_value", Type: flux.TFloat},
+						},
+						Data: [][]interface{}{
+							{execute.Time(2), "temp", 75.0},
+							{execute.Time(3), "temp", nil},
+							{execute.Time(4), "temp", 80.0},
+						},
+					},
+					&executetest.Table{
+						KeyCols: []string{"_field"},
+						ColMeta: []flux.ColMeta{
+							{Label: "_time", Type: flux.TTime},
+							{Label: "_field", Type: flux.TString},
+							{Label: "_value", Type: flux.TFloat},
+						},
+						Data: [][]interface{}{
+							{execute.Time(1), "humidity", 81.0},
+							{execute.Time(1), "humidity", 81.0},
+						},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"_field"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_field", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), "temp", 70.0},
+						{execute.Time(2), "temp", 75.0},
+						{execute.Time(3), "temp", nil},
+						{execute.Time(2), "temp", 75.0},
+						{execute.Time(3), "temp", nil},
+						{execute.Time(4), "temp", 80.0},
+					},
+				},
+				{
+					KeyCols: []string{"_field"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_field", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), "humidity", 81.0},
+						{execute.Time(1), "humidity", 81.0},
+					},
+				},
+			},
+		},
+		{
+			name:  "two streams union overlapping tables with dedup",
+			dedup: true,
+			data: [][]flux.Table{
+				// stream 1
+				{
+					&executetest.Table{
+						KeyCols: []string{"_field"},
+						ColMeta: []flux.ColMeta{
+							{Label: "_time", Type: flux.TTime},
+							{Label: "_field", Type: flux.TString},
+							{Label: "_value", Type: flux.TFloat},
+						},
+						Data: [][]interface{}{
+							{execute.Time(1), "temp", 70.0},
+							{execute.Time(2), "temp", 75.0},
+							{execute.Time(3), "temp", nil},
+						},
+					},
+				},
+				// stream 2
+				{
+					&executetest.Table{
+						KeyCols: []string{"_field"},
+						ColMeta: []flux.ColMeta{
+							{Label: "_time", Type: flux.TTime},
+							{Label: "_field", Type: flux.TString},
+							{Label: "_value", Type: flux.TFloat},
+						},
+						Data: [][]interface{}{
+							{execute.Time(2), "temp", 75.0},
+							{execute.Time(3), "temp", nil},
+							{execute.Time(4), "temp", 80.0},
+						},
+					},
+					&executetest.Table{
+						KeyCols: []string{"_field"},
+						ColMeta: []flux.ColMeta{
+							{Label: "_time", Type: flux.TTime},
+							{Label: "_field", Type: flux.TString},
+							{Label: "_value", Type: flux.TFloat},
+						},
+						Data: [][]interface{}{
+							{execute.Time(1), "humidity", 81.0},
+							{execute.Time(1), "humidity", 81.0},
+						},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"_field"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_field", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), "temp", 70.0},
+						{execute.Time(2), "temp", 75.0},
+						{execute.Time(3), "temp", nil},
+						{execute.Time(4), "temp", 80.0},
+					},
+				},
+				{
+					KeyCols: []string{"_field"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_field", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), "humidity", 81.0},
+					},
+				},
+			},
+		},
+		{
+			name:  "two streams union heterogeneous schema with dedup",
+			dedup: true,
+			data: [][]flux.Table{
+				// stream 1
+				{
+					&executetest.Table{
+						KeyCols: []string{"_field"},
+						ColMeta: []flux.ColMeta{
+							{Label: "_time", Type: flux.TTime},
+							{Label: "_field", Type: flux.TString},
+							{Label: "_value", Type: flux.TFloat},
+						},
+						Data: [][]interface{}{
+							{execute.Time(1), "temp", 70.0},
+							{execute.Time(2), "temp", 75.0},
+						},
+					},
+				},
+				// stream 2
+				{
+					&executetest.Table{
+						KeyCols: []string{"_field"},
+						ColMeta: []flux.ColMeta{
+							{Label: "_time", Type: flux.TTime},
+							{Label: "_field", Type: flux.TString},
+							{Label: "_value", Type: flux.TFloat},
+							{Label: "host", Type: flux.TString},
+						},
+						Data: [][]interface{}{
+							{execute.Time(1), "temp", 70.0, nil},
+							{execute.Time(2), "temp", 75.0, "A"},
+						},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"_field"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_field", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), "temp", 70.0, nil},
+						{execute.Time(2), "temp", 75.0, nil},
+						{execute.Time(2), "temp", 75.0, "A"},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -756,6 +1022,7 @@ func TestUnion_Process(t *testing.T) {
 			d := executetest.NewDataset(executetest.RandomDatasetID())
 			c := execute.NewTableBuilderCache(executetest.UnlimitedAllocator)
 			c.SetTriggerSpec(plan.DefaultTriggerSpec)
+			spec := &universe.UnionProcedureSpec{Dedup: tc.dedup}
 			ut := universe.NewUnionTransformation(d, c, spec, parentIds)
 
 			for i, s := range tc.data {
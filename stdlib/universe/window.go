@@ -3,6 +3,7 @@ package universe
 import (
 	"fmt"
 	"math"
+	"time"
 
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/execute"
@@ -22,6 +23,7 @@ type WindowOpSpec struct {
 	StopColumn  string        `json:"stopColumn"`
 	StartColumn string        `json:"startColumn"`
 	CreateEmpty bool          `json:"createEmpty"`
+	Location    string        `json:"location,omitempty"`
 }
 
 var infinityVar = values.NewDuration(math.MaxInt64)
@@ -36,6 +38,7 @@ func init() {
 			"startColumn": semantic.String,
 			"stopColumn":  semantic.String,
 			"createEmpty": semantic.Bool,
+			"location":    semantic.String,
 		},
 		nil,
 	)
@@ -106,6 +109,14 @@ func createWindowOpSpec(args flux.Arguments, a *flux.Administration) (flux.Opera
 	} else {
 		spec.CreateEmpty = false
 	}
+	if location, ok, err := args.GetString("location"); err != nil {
+		return nil, err
+	} else if ok {
+		if _, err := time.LoadLocation(location); err != nil {
+			return nil, fmt.Errorf("invalid window location: %v", err)
+		}
+		spec.Location = location
+	}
 
 	// Apply defaults
 	if !everySet {
@@ -132,6 +143,7 @@ type WindowProcedureSpec struct {
 	StartColumn,
 	StopColumn string
 	CreateEmpty bool
+	Location    string
 }
 
 func newWindowProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
@@ -149,6 +161,7 @@ func newWindowProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.Pro
 		StartColumn: s.StartColumn,
 		StopColumn:  s.StopColumn,
 		CreateEmpty: s.CreateEmpty,
+		Location:    s.Location,
 	}
 	return p, nil
 }
@@ -159,6 +172,7 @@ func (s *WindowProcedureSpec) Kind() plan.ProcedureKind {
 func (s *WindowProcedureSpec) Copy() plan.ProcedureSpec {
 	ns := new(WindowProcedureSpec)
 	ns.Window = s.Window
+	ns.Location = s.Location
 	return ns
 }
 
@@ -175,14 +189,23 @@ func createWindowTransformation(id execute.DatasetID, mode execute.AccumulationM
 		return nil, nil, errors.New("nil bounds passed to window")
 	}
 
+	w := execute.NewWindow(
+		execute.Duration(s.Window.Every),
+		execute.Duration(s.Window.Period),
+		execute.Duration(s.Window.Offset))
+	if s.Location != "" {
+		loc, err := time.LoadLocation(s.Location)
+		if err != nil {
+			return nil, nil, err
+		}
+		w.Location = loc
+	}
+
 	t := NewFixedWindowTransformation(
 		d,
 		cache,
 		*bounds,
-		execute.NewWindow(
-			execute.Duration(s.Window.Every),
-			execute.Duration(s.Window.Period),
-			execute.Duration(s.Window.Offset)),
+		w,
 		s.TimeColumn,
 		s.StartColumn,
 		s.StopColumn,
@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/execute/executetest"
 	"github.com/influxdata/flux/plan"
@@ -48,6 +49,39 @@ func TestWindow_NewQuery(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "from with calendar window",
+			Raw:  `from(bucket:"mybucket") |> window(every:1mo, location: "America/New_York")`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "mybucket",
+						},
+					},
+					{
+						ID: "window1",
+						Spec: &universe.WindowOpSpec{
+							Every:       flux.Duration(ast.MonthDuration),
+							Period:      flux.Duration(ast.MonthDuration),
+							TimeColumn:  execute.DefaultTimeColLabel,
+							StartColumn: execute.DefaultStartColLabel,
+							StopColumn:  execute.DefaultStopColLabel,
+							Location:    "America/New_York",
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "window1"},
+				},
+			},
+		},
+		{
+			Name:    "from with window in unknown location",
+			Raw:     `from(bucket:"mybucket") |> window(every:1mo, location: "Nowhere/Special")`,
+			WantErr: true,
+		},
 	}
 	for _, tc := range tests {
 		tc := tc
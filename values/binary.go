@@ -59,6 +59,16 @@ var binaryFuncLookup = map[BinaryFuncSignature]BinaryFunction{
 		r := rv.Float()
 		return NewFloat(l - r)
 	},
+	{Operator: ast.AdditionOperator, Left: semantic.Duration, Right: semantic.Duration}: func(lv, rv Value) Value {
+		l := lv.Duration()
+		r := rv.Duration()
+		return NewDuration(l + r)
+	},
+	{Operator: ast.SubtractionOperator, Left: semantic.Duration, Right: semantic.Duration}: func(lv, rv Value) Value {
+		l := lv.Duration()
+		r := rv.Duration()
+		return NewDuration(l - r)
+	},
 	{Operator: ast.MultiplicationOperator, Left: semantic.Int, Right: semantic.Int}: func(lv, rv Value) Value {
 		l := lv.Int()
 		r := rv.Int()
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/influxdata/flux/ast"
 	"github.com/influxdata/flux/values"
@@ -39,6 +40,10 @@ func TestBinaryOperator(t *testing.T) {
 		{lhs: uint64(6), op: "/", rhs: uint64(4), want: uint64(1)},
 		// float / float
 		{lhs: 5.0, op: "/", rhs: 2.0, want: 2.5},
+		// duration + duration
+		{lhs: values.Duration(2 * time.Hour), op: "+", rhs: values.Duration(30 * time.Minute), want: values.Duration(150 * time.Minute)},
+		// duration - duration
+		{lhs: values.Duration(2 * time.Hour), op: "-", rhs: values.Duration(30 * time.Minute), want: values.Duration(90 * time.Minute)},
 		// int <= int
 		{lhs: int64(6), op: "<=", rhs: int64(4), want: false},
 		{lhs: int64(4), op: "<=", rhs: int64(4), want: true},
@@ -0,0 +1,97 @@
+package values
+
+import (
+	"math"
+
+	"github.com/influxdata/flux/semantic"
+)
+
+// EqualOption configures the behavior of Equal.
+type EqualOption func(c *equalConfig)
+
+type equalConfig struct {
+	equateNaNs bool
+}
+
+// EquateNaNs returns an option that makes Equal consider two float
+// values that are both NaN to be equal. This is mostly useful in tests.
+func EquateNaNs() EqualOption {
+	return func(c *equalConfig) {
+		c.equateNaNs = true
+	}
+}
+
+// Equal reports whether two values are deeply equal.
+//
+// Two values are never equal when their types differ.
+// Unlike the Equal method on a Value, which follows the
+// semantics of the == operator, two null values of the
+// same type are equal and a null value is never equal
+// to a value that is not null.
+//
+// Float values are compared with ==, so NaN is not equal to
+// any value, including another NaN, unless the EquateNaNs
+// option is given. Positive and negative zero are equal.
+//
+// Arrays are equal when they have the same length and their
+// elements are pairwise equal. Objects are equal when they have
+// the same set of fields and the values for each field are equal;
+// the order in which the fields were set does not matter.
+// Elements of arrays and objects are compared with the same rules,
+// so nulls and NaNs nested within them are handled as described above.
+// Functions are equal only if they are the same function.
+func Equal(a, b Value, opts ...EqualOption) bool {
+	var c equalConfig
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c.equal(a, b)
+}
+
+func (c *equalConfig) equal(a, b Value) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	if a.Type() != b.Type() {
+		return false
+	}
+	if a.IsNull() || b.IsNull() {
+		return a.IsNull() && b.IsNull()
+	}
+
+	switch a.Type().Nature() {
+	case semantic.Float:
+		l, r := a.Float(), b.Float()
+		if c.equateNaNs && math.IsNaN(l) && math.IsNaN(r) {
+			return true
+		}
+		return l == r
+	case semantic.Array:
+		l, r := a.Array(), b.Array()
+		if l.Len() != r.Len() {
+			return false
+		}
+		for i, n := 0, l.Len(); i < n; i++ {
+			if !c.equal(l.Get(i), r.Get(i)) {
+				return false
+			}
+		}
+		return true
+	case semantic.Object:
+		l, r := a.Object(), b.Object()
+		if l.Len() != r.Len() {
+			return false
+		}
+		equal := true
+		l.Range(func(name string, lv Value) {
+			if !equal {
+				return
+			}
+			rv, ok := r.Get(name)
+			equal = ok && c.equal(lv, rv)
+		})
+		return equal
+	default:
+		return a.Equal(b)
+	}
+}
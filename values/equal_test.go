@@ -0,0 +1,179 @@
+package values_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+func TestEqual(t *testing.T) {
+	nan := math.NaN()
+	floats := func(vs ...values.Value) values.Value {
+		return values.NewArrayWithBacking(semantic.Float, vs)
+	}
+
+	testCases := []struct {
+		name string
+		a, b values.Value
+		opts []values.EqualOption
+		want bool
+	}{
+		{
+			name: "ints",
+			a:    values.NewInt(1),
+			b:    values.NewInt(1),
+			want: true,
+		},
+		{
+			name: "different types",
+			a:    values.NewInt(1),
+			b:    values.NewUInt(1),
+			want: false,
+		},
+		{
+			name: "nulls",
+			a:    values.NewNull(semantic.String),
+			b:    values.NewNull(semantic.String),
+			want: true,
+		},
+		{
+			name: "nulls of different types",
+			a:    values.NewNull(semantic.String),
+			b:    values.NewNull(semantic.Int),
+			want: false,
+		},
+		{
+			name: "null and non-null",
+			a:    values.NewNull(semantic.String),
+			b:    values.NewString(""),
+			want: false,
+		},
+		{
+			name: "NaN",
+			a:    values.NewFloat(nan),
+			b:    values.NewFloat(nan),
+			want: false,
+		},
+		{
+			name: "NaN with EquateNaNs",
+			a:    values.NewFloat(nan),
+			b:    values.NewFloat(nan),
+			opts: []values.EqualOption{values.EquateNaNs()},
+			want: true,
+		},
+		{
+			name: "NaN and number with EquateNaNs",
+			a:    values.NewFloat(nan),
+			b:    values.NewFloat(0),
+			opts: []values.EqualOption{values.EquateNaNs()},
+			want: false,
+		},
+		{
+			name: "signed zeros",
+			a:    values.NewFloat(0),
+			b:    values.NewFloat(math.Copysign(0, -1)),
+			want: true,
+		},
+		{
+			name: "array with nulls",
+			a:    floats(values.NewFloat(1), values.NewNull(semantic.Float)),
+			b:    floats(values.NewFloat(1), values.NewNull(semantic.Float)),
+			want: true,
+		},
+		{
+			name: "array with null in different position",
+			a:    floats(values.NewFloat(1), values.NewNull(semantic.Float)),
+			b:    floats(values.NewNull(semantic.Float), values.NewFloat(1)),
+			want: false,
+		},
+		{
+			name: "array with NaNs",
+			a:    floats(values.NewFloat(nan), values.NewFloat(2)),
+			b:    floats(values.NewFloat(nan), values.NewFloat(2)),
+			want: false,
+		},
+		{
+			name: "array with NaNs with EquateNaNs",
+			a:    floats(values.NewFloat(nan), values.NewFloat(2)),
+			b:    floats(values.NewFloat(nan), values.NewFloat(2)),
+			opts: []values.EqualOption{values.EquateNaNs()},
+			want: true,
+		},
+		{
+			name: "array of different length",
+			a:    floats(values.NewFloat(1)),
+			b:    floats(values.NewFloat(1), values.NewFloat(1)),
+			want: false,
+		},
+		{
+			name: "record with nulls",
+			a: values.NewObjectWithValues(map[string]values.Value{
+				"a": values.NewNull(semantic.String),
+				"b": values.NewInt(1),
+			}),
+			b: values.NewObjectWithValues(map[string]values.Value{
+				"a": values.NewNull(semantic.String),
+				"b": values.NewInt(1),
+			}),
+			want: true,
+		},
+		{
+			name: "record with null and non-null",
+			a: values.NewObjectWithValues(map[string]values.Value{
+				"a": values.NewNull(semantic.String),
+			}),
+			b: values.NewObjectWithValues(map[string]values.Value{
+				"a": values.NewString("x"),
+			}),
+			want: false,
+		},
+		{
+			name: "record with nested NaN with EquateNaNs",
+			a: values.NewObjectWithValues(map[string]values.Value{
+				"a": floats(values.NewFloat(nan), values.NewNull(semantic.Float)),
+				"b": values.NewFloat(nan),
+			}),
+			b: values.NewObjectWithValues(map[string]values.Value{
+				"b": values.NewFloat(nan),
+				"a": floats(values.NewFloat(nan), values.NewNull(semantic.Float)),
+			}),
+			opts: []values.EqualOption{values.EquateNaNs()},
+			want: true,
+		},
+		{
+			name: "record with nested NaN",
+			a: values.NewObjectWithValues(map[string]values.Value{
+				"a": floats(values.NewFloat(nan)),
+			}),
+			b: values.NewObjectWithValues(map[string]values.Value{
+				"a": floats(values.NewFloat(nan)),
+			}),
+			want: false,
+		},
+		{
+			name: "record with different values",
+			a: values.NewObjectWithValues(map[string]values.Value{
+				"a": values.NewInt(1),
+				"b": values.NewInt(2),
+			}),
+			b: values.NewObjectWithValues(map[string]values.Value{
+				"a": values.NewInt(1),
+				"b": values.NewInt(3),
+			}),
+			want: false,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if got := values.Equal(tc.a, tc.b, tc.opts...); got != tc.want {
+				t.Errorf("unexpected result: want %v got %v", tc.want, got)
+			}
+			if got := values.Equal(tc.b, tc.a, tc.opts...); got != tc.want {
+				t.Errorf("unexpected result with swapped arguments: want %v got %v", tc.want, got)
+			}
+		})
+	}
+}
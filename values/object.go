@@ -165,9 +165,92 @@ func (o *object) Equal(rhs Value) bool {
 	}
 	for i, l := range o.labels {
 		val, ok := r.Get(l)
-		if !ok && !o.values[i].Equal(val) {
+		if !ok || !o.values[i].Equal(val) {
 			return false
 		}
 	}
 	return true
 }
+
+// RecordBuilder constructs an object value one field at a time.
+// The fields of the constructed object are visited by Range
+// in the order they were added to the builder.
+type RecordBuilder struct {
+	obj *object
+	err error
+}
+
+// NewRecordBuilder creates a new builder for an object value.
+func NewRecordBuilder() *RecordBuilder {
+	return &RecordBuilder{obj: NewObject()}
+}
+
+// Add adds a field with the given name and value.
+// A field with the same name must not have been added already.
+func (b *RecordBuilder) Add(name string, v Value) *RecordBuilder {
+	if b.err != nil {
+		return b
+	}
+	if v == nil {
+		b.err = fmt.Errorf("record field %q has a nil value", name)
+		return b
+	}
+	if _, ok := b.obj.Get(name); ok {
+		b.err = fmt.Errorf("duplicate record field %q", name)
+		return b
+	}
+	b.obj.Set(name, v)
+	return b
+}
+
+// AddNull adds a field with the given name and a null value of the given type.
+func (b *RecordBuilder) AddNull(name string, t semantic.Type) *RecordBuilder {
+	return b.Add(name, NewNull(t))
+}
+
+// AddString adds a field with the given name and string value.
+func (b *RecordBuilder) AddString(name string, v string) *RecordBuilder {
+	return b.Add(name, NewString(v))
+}
+
+// AddInt adds a field with the given name and int value.
+func (b *RecordBuilder) AddInt(name string, v int64) *RecordBuilder {
+	return b.Add(name, NewInt(v))
+}
+
+// AddUInt adds a field with the given name and uint value.
+func (b *RecordBuilder) AddUInt(name string, v uint64) *RecordBuilder {
+	return b.Add(name, NewUInt(v))
+}
+
+// AddFloat adds a field with the given name and float value.
+func (b *RecordBuilder) AddFloat(name string, v float64) *RecordBuilder {
+	return b.Add(name, NewFloat(v))
+}
+
+// AddBool adds a field with the given name and bool value.
+func (b *RecordBuilder) AddBool(name string, v bool) *RecordBuilder {
+	return b.Add(name, NewBool(v))
+}
+
+// AddTime adds a field with the given name and time value.
+func (b *RecordBuilder) AddTime(name string, v Time) *RecordBuilder {
+	return b.Add(name, NewTime(v))
+}
+
+// AddDuration adds a field with the given name and duration value.
+func (b *RecordBuilder) AddDuration(name string, v Duration) *RecordBuilder {
+	return b.Add(name, NewDuration(v))
+}
+
+// Build returns the constructed object or the first error
+// that was encountered while adding fields.
+// The builder must not be used after calling Build.
+func (b *RecordBuilder) Build() (Object, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	obj := b.obj
+	b.obj = nil
+	return obj, nil
+}
@@ -0,0 +1,93 @@
+package values_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+func TestRecordBuilder(t *testing.T) {
+	obj, err := values.NewRecordBuilder().
+		AddString("z", "a").
+		AddInt("b", 1).
+		AddUInt("y", 2).
+		AddFloat("c", 3.5).
+		AddBool("x", true).
+		AddTime("d", values.Time(10)).
+		AddDuration("w", values.Duration(5)).
+		AddNull("a", semantic.String).
+		Add("v", values.NewString("v")).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	obj.Range(func(name string, v values.Value) {
+		got = append(got, name)
+	})
+	if want := []string{"z", "b", "y", "c", "x", "d", "w", "a", "v"}; !cmp.Equal(want, got) {
+		t.Errorf("unexpected field order -want/+got:\n%s", cmp.Diff(want, got))
+	}
+
+	wantType := semantic.NewObjectType(map[string]semantic.Type{
+		"z": semantic.String,
+		"b": semantic.Int,
+		"y": semantic.UInt,
+		"c": semantic.Float,
+		"x": semantic.Bool,
+		"d": semantic.Time,
+		"w": semantic.Duration,
+		"a": semantic.String,
+		"v": semantic.String,
+	})
+	if got := obj.Type(); got != wantType {
+		t.Errorf("unexpected type: want %v got %v", wantType, got)
+	}
+}
+
+func TestRecordBuilder_Errors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		build   func(b *values.RecordBuilder)
+		wantErr string
+	}{
+		{
+			name: "duplicate field",
+			build: func(b *values.RecordBuilder) {
+				b.AddInt("a", 1).AddInt("b", 2).AddString("a", "x")
+			},
+			wantErr: `duplicate record field "a"`,
+		},
+		{
+			name: "nil value",
+			build: func(b *values.RecordBuilder) {
+				b.Add("a", nil)
+			},
+			wantErr: `record field "a" has a nil value`,
+		},
+		{
+			name: "first error is reported",
+			build: func(b *values.RecordBuilder) {
+				b.AddInt("a", 1).AddInt("a", 2).Add("b", nil)
+			},
+			wantErr: `duplicate record field "a"`,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			b := values.NewRecordBuilder()
+			tc.build(b)
+			obj, err := b.Build()
+			if err == nil {
+				t.Fatalf("expected error, got object %v", obj)
+			}
+			if got := err.Error(); got != tc.wantErr {
+				t.Errorf("unexpected error: want %q got %q", tc.wantErr, got)
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package values
 
 import (
 	"time"
+
+	"github.com/influxdata/flux/ast"
 )
 
 type Time int64
@@ -38,6 +40,47 @@ func (t Time) Add(d Duration) Time {
 	return t + Time(d)
 }
 
+// AddIn adds d to t using the calendar of loc, where a nil loc is UTC.
+// A duration of whole calendar months, see Months, moves t by that many months
+// and keeps its day and wall clock time in loc. The day is clamped to the last
+// day of the resulting month, so adding one month to January 31 gives the last
+// day of February. Any other duration is added as a fixed number of nanoseconds.
+func (t Time) AddIn(d Duration, loc *time.Location) Time {
+	months, ok := d.Months()
+	if !ok {
+		return t.Add(d)
+	}
+	tm := t.Time().In(location(loc))
+	year, month, day := tm.Date()
+	// The first day of a month always exists, so the date is not normalized into another month.
+	first := time.Date(year, month+time.Month(months), 1, 0, 0, 0, 0, tm.Location())
+	if last := time.Date(first.Year(), first.Month()+1, 0, 0, 0, 0, 0, tm.Location()).Day(); day > last {
+		day = last
+	}
+	return ConvertTime(time.Date(first.Year(), first.Month(), day, tm.Hour(), tm.Minute(), tm.Second(), tm.Nanosecond(), tm.Location()))
+}
+
+// SubIn returns the duration from u to t using the calendar of loc, where a nil loc is UTC.
+// The duration is a whole number of calendar months if adding those months to u with AddIn gives t.
+// Otherwise it is the fixed duration t-u.
+func (t Time) SubIn(u Time, loc *time.Location) Duration {
+	tm, um := t.Time().In(location(loc)), u.Time().In(location(loc))
+	months := int64(tm.Year()-um.Year())*12 + int64(tm.Month()-um.Month())
+	if months != 0 {
+		if d := Duration(months) * Duration(ast.MonthDuration); u.AddIn(d, loc) == t {
+			return d
+		}
+	}
+	return Duration(t - u)
+}
+
+func location(loc *time.Location) *time.Location {
+	if loc == nil {
+		return time.UTC
+	}
+	return loc
+}
+
 // remainder divides t by d and returns the remainder.
 func (t Time) Remainder(d Duration) (r Duration) {
 	return Duration(int64(t) % int64(d))
@@ -68,6 +111,17 @@ func (t Time) Time() time.Time {
 func (d Duration) Duration() time.Duration {
 	return time.Duration(d)
 }
+
+// Months returns the number of calendar months in d.
+// It reports false unless d is a nonzero whole multiple of ast.MonthDuration.
+func (d Duration) Months() (int64, bool) {
+	const month = Duration(ast.MonthDuration)
+	if d == 0 || d%month != 0 {
+		return 0, false
+	}
+	return int64(d / month), true
+}
+
 func (d Duration) String() string {
 	return time.Duration(d).String()
 }
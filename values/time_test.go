@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/influxdata/flux/ast"
 	"github.com/influxdata/flux/values"
 )
 
@@ -81,3 +82,171 @@ func TestTime_Truncate(t *testing.T) {
 		})
 	}
 }
+
+func TestTime_AddIn(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	month := values.Duration(ast.MonthDuration)
+	for _, tt := range []struct {
+		name string
+		ts   string
+		d    values.Duration
+		loc  *time.Location
+		want string
+	}{
+		{
+			name: "fixed",
+			ts:   "2019-01-31T12:00:00Z",
+			d:    values.Duration(90 * time.Minute),
+			want: "2019-01-31T13:30:00Z",
+		},
+		{
+			name: "fixed across dst",
+			ts:   "2019-03-09T12:00:00-05:00",
+			d:    values.Duration(24 * time.Hour),
+			loc:  newYork,
+			want: "2019-03-10T13:00:00-04:00",
+		},
+		{
+			name: "month",
+			ts:   "2019-01-15T12:00:00Z",
+			d:    month,
+			want: "2019-02-15T12:00:00Z",
+		},
+		{
+			name: "month across year",
+			ts:   "2019-12-15T12:00:00Z",
+			d:    month,
+			want: "2020-01-15T12:00:00Z",
+		},
+		{
+			name: "negative month",
+			ts:   "2019-01-15T12:00:00Z",
+			d:    -month,
+			want: "2018-12-15T12:00:00Z",
+		},
+		{
+			name: "month clamped to end of month",
+			ts:   "2019-01-31T12:00:00Z",
+			d:    month,
+			want: "2019-02-28T12:00:00Z",
+		},
+		{
+			name: "month clamped to leap day",
+			ts:   "2020-01-31T12:00:00Z",
+			d:    month,
+			want: "2020-02-29T12:00:00Z",
+		},
+		{
+			name: "year from leap day",
+			ts:   "2020-02-29T12:00:00Z",
+			d:    12 * month,
+			want: "2021-02-28T12:00:00Z",
+		},
+		{
+			name: "month across dst",
+			ts:   "2019-02-15T22:00:00-05:00",
+			d:    month,
+			loc:  newYork,
+			want: "2019-03-15T22:00:00-04:00",
+		},
+		{
+			name: "month across dst in utc",
+			ts:   "2019-02-15T22:00:00-05:00",
+			d:    month,
+			want: "2019-03-16T03:00:00Z",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ts, want := mustParseTime(t, tt.ts), mustParseTime(t, tt.want)
+			if got := ts.AddIn(tt.d, tt.loc); want != got {
+				t.Fatalf("unexpected time -want/+got\n\t- %s\n\t+ %s", want, got)
+			}
+		})
+	}
+}
+
+func TestTime_SubIn(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	month := values.Duration(ast.MonthDuration)
+	for _, tt := range []struct {
+		name string
+		t, u string
+		loc  *time.Location
+		want values.Duration
+	}{
+		{
+			name: "equal",
+			t:    "2019-01-15T12:00:00Z",
+			u:    "2019-01-15T12:00:00Z",
+			want: 0,
+		},
+		{
+			name: "fixed",
+			t:    "2019-01-15T13:30:00Z",
+			u:    "2019-01-15T12:00:00Z",
+			want: values.Duration(90 * time.Minute),
+		},
+		{
+			name: "months",
+			t:    "2019-03-15T12:00:00Z",
+			u:    "2019-01-15T12:00:00Z",
+			want: 2 * month,
+		},
+		{
+			name: "negative months",
+			t:    "2018-11-15T12:00:00Z",
+			u:    "2019-01-15T12:00:00Z",
+			want: -2 * month,
+		},
+		{
+			name: "month clamped to end of month",
+			t:    "2019-02-28T12:00:00Z",
+			u:    "2019-01-31T12:00:00Z",
+			want: month,
+		},
+		{
+			name: "not a whole month",
+			t:    "2019-01-31T12:00:00Z",
+			u:    "2019-02-28T12:00:00Z",
+			want: values.Duration(-28 * 24 * time.Hour),
+		},
+		{
+			name: "month across dst",
+			t:    "2019-03-15T22:00:00-04:00",
+			u:    "2019-02-15T22:00:00-05:00",
+			loc:  newYork,
+			want: month,
+		},
+		{
+			name: "month across dst in utc",
+			t:    "2019-03-15T22:00:00-04:00",
+			u:    "2019-02-15T22:00:00-05:00",
+			want: values.Duration(28*24*time.Hour - time.Hour),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ts, u := mustParseTime(t, tt.t), mustParseTime(t, tt.u)
+			if got := ts.SubIn(u, tt.loc); tt.want != got {
+				t.Fatalf("unexpected duration -want/+got\n\t- %s\n\t+ %s", tt.want, got)
+			}
+			if got := u.AddIn(tt.want, tt.loc); ts != got {
+				t.Fatalf("unexpected time adding the duration -want/+got\n\t- %s\n\t+ %s", ts, got)
+			}
+		})
+	}
+}
+
+func mustParseTime(t *testing.T, s string) values.Time {
+	t.Helper()
+	ts, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return values.ConvertTime(ts)
+}
@@ -170,6 +170,17 @@ func NewNull(t semantic.Type) Value {
 	}
 }
 
+// Null returns a null value of type t.
+// The null keeps its type so it can be appended to a table column of the same type,
+// use flux.SemanticType to find the type of a column.
+// Null panics if t is nil or invalid as the null would have no type.
+func Null(t semantic.Type) Value {
+	if t == nil || t == semantic.Invalid {
+		panic(errors.New("null value must have a valid type"))
+	}
+	return NewNull(t)
+}
+
 func NewFromString(t semantic.Type, s string) (Value, error) {
 	var err error
 	v := value{t: t}
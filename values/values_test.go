@@ -43,3 +43,44 @@ func TestNewNull(t *testing.T) {
 		t.Fatalf("unexpected value -want/+got\n\t- %v\n\t+ %v", want, got)
 	}
 }
+
+func TestNull(t *testing.T) {
+	for _, typ := range []semantic.Type{
+		semantic.Bool,
+		semantic.Int,
+		semantic.UInt,
+		semantic.Float,
+		semantic.String,
+		semantic.Time,
+		semantic.Duration,
+	} {
+		t.Run(fmt.Sprint(typ), func(t *testing.T) {
+			v := values.Null(typ)
+			if !v.IsNull() {
+				t.Fatalf("expected null value, got %v", v)
+			}
+			if want, got := typ, v.Type(); want != got {
+				t.Fatalf("unexpected type -want/+got\n\t- %s\n\t+ %s", want, got)
+			}
+			if !values.Equal(v, values.Null(typ)) {
+				t.Error("expected nulls of the same type to be equal")
+			}
+			other := semantic.Int
+			if typ == semantic.Int {
+				other = semantic.Float
+			}
+			if values.Equal(v, values.Null(other)) {
+				t.Errorf("expected null %s not to equal null %s", typ, other)
+			}
+		})
+	}
+}
+
+func TestNull_InvalidType(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic for a null with an invalid type")
+		}
+	}()
+	values.Null(semantic.Invalid)
+}